@@ -0,0 +1,139 @@
+package cli
+
+import (
+	"context"
+	"fmt"
+	"strings"
+	"text/tabwriter"
+
+	"github.com/cosmos/gogoproto/proto"
+	"github.com/spf13/cobra"
+
+	"github.com/cosmos/cosmos-sdk/client"
+	"github.com/cosmos/cosmos-sdk/client/flags"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	transfertypes "github.com/cosmos/ibc-go/v7/modules/apps/transfer/types"
+
+	"github.com/Stride-Labs/ibc-rate-limiting/ratelimit/types"
+)
+
+const (
+	FlagShowDenomTrace = "show-denom-trace"
+
+	// Additional --output format that renders rate limits as a table with the flow utilization
+	OutputFormatTable = "table"
+)
+
+// A rate limit along with the full denom trace of its ibc denom
+type rateLimitWithDenomTrace struct {
+	RateLimit  types.RateLimit `json:"rate_limit" yaml:"rate_limit"`
+	DenomTrace string          `json:"denom_trace,omitempty" yaml:"denom_trace,omitempty"`
+}
+
+// Adds the flags used to render rate limits (the table output format and the denom trace option)
+func addRateLimitOutputFlags(cmd *cobra.Command) {
+	cmd.Flags().Bool(FlagShowDenomTrace, false, "Show the full denom trace alongside each ibc/ denom")
+	cmd.Flags().Lookup(flags.FlagOutput).Usage = fmt.Sprintf("Output format (text|json|%s)", OutputFormatTable)
+}
+
+// Prints the query response according to the --output and --show-denom-trace flags
+// With the table output, each rate limit is rendered as a row with its flow and send/recv utilization
+// Otherwise, the response is printed as is, unless the denom traces were requested, in which case
+// each rate limit is printed alongside its denom trace
+func printRateLimits(cmd *cobra.Command, clientCtx client.Context, res proto.Message, rateLimits []types.RateLimit) error {
+	outputFormat, err := cmd.Flags().GetString(flags.FlagOutput)
+	if err != nil {
+		return err
+	}
+	showDenomTrace, err := cmd.Flags().GetBool(FlagShowDenomTrace)
+	if err != nil {
+		return err
+	}
+
+	denomTraces := map[string]string{}
+	if showDenomTrace {
+		denomTraces, err = queryDenomTraces(clientCtx, rateLimits)
+		if err != nil {
+			return err
+		}
+	}
+
+	if outputFormat == OutputFormatTable {
+		return printRateLimitTable(cmd, rateLimits, denomTraces, showDenomTrace)
+	}
+
+	if !showDenomTrace {
+		return clientCtx.PrintProto(res)
+	}
+
+	rateLimitsWithTraces := []rateLimitWithDenomTrace{}
+	for _, rateLimit := range rateLimits {
+		rateLimitsWithTraces = append(rateLimitsWithTraces, rateLimitWithDenomTrace{
+			RateLimit:  rateLimit,
+			DenomTrace: denomTraces[rateLimit.Path.Denom],
+		})
+	}
+	return clientCtx.PrintObjectLegacy(rateLimitsWithTraces)
+}
+
+// Renders each rate limit as a row with the flow, the quota, and the percentage of the
+// send and recv thresholds that have been used in the current window
+func printRateLimitTable(cmd *cobra.Command, rateLimits []types.RateLimit, denomTraces map[string]string, showDenomTrace bool) error {
+	writer := tabwriter.NewWriter(cmd.OutOrStdout(), 0, 0, 2, ' ', 0)
+
+	header := []string{"DENOM"}
+	if showDenomTrace {
+		header = append(header, "DENOM TRACE")
+	}
+	header = append(header, "CHANNEL", "INFLOW", "OUTFLOW", "CHANNEL VALUE", "MAX SEND %", "MAX RECV %", "SEND USED %", "RECV USED %")
+	fmt.Fprintln(writer, strings.Join(header, "\t"))
+
+	for _, rateLimit := range rateLimits {
+		flow := rateLimit.Flow
+		quota := *rateLimit.Quota
+
+		row := []string{rateLimit.Path.Denom}
+		if showDenomTrace {
+			row = append(row, denomTraces[rateLimit.Path.Denom])
+		}
+		row = append(row,
+			rateLimit.Path.ChannelId,
+			flow.Inflow.String(),
+			flow.Outflow.String(),
+			flow.ChannelValue.String(),
+			quota.MaxPercentSend.String(),
+			quota.MaxPercentRecv.String(),
+			formatPercent(flow.GetUtilization(types.PACKET_SEND, quota)),
+			formatPercent(flow.GetUtilization(types.PACKET_RECV, quota)),
+		)
+		fmt.Fprintln(writer, strings.Join(row, "\t"))
+	}
+
+	return writer.Flush()
+}
+
+// Formats a utilization percentage with two decimal places
+func formatPercent(percent sdk.Dec) string {
+	return fmt.Sprintf("%.2f", percent.MustFloat64())
+}
+
+// Looks up the full denom trace of each ibc/ denom from the transfer module
+func queryDenomTraces(clientCtx client.Context, rateLimits []types.RateLimit) (map[string]string, error) {
+	transferQueryClient := transfertypes.NewQueryClient(clientCtx)
+
+	denomTraces := map[string]string{}
+	for _, rateLimit := range rateLimits {
+		denom := rateLimit.Path.Denom
+		if _, found := denomTraces[denom]; found || !strings.HasPrefix(denom, transfertypes.DenomPrefix+"/") {
+			continue
+		}
+
+		res, err := transferQueryClient.DenomTrace(context.Background(), &transfertypes.QueryDenomTraceRequest{Hash: denom})
+		if err != nil {
+			return nil, fmt.Errorf("unable to query denom trace for %s: %w", denom, err)
+		}
+		denomTraces[denom] = res.DenomTrace.GetFullDenomPath()
+	}
+
+	return denomTraces, nil
+}
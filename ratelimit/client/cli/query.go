@@ -9,13 +9,20 @@ import (
 
 	"github.com/cosmos/cosmos-sdk/client"
 	"github.com/cosmos/cosmos-sdk/client/flags"
+	"github.com/cosmos/cosmos-sdk/types/query"
 	"github.com/cosmos/cosmos-sdk/version"
 
 	"github.com/Stride-Labs/ibc-rate-limiting/ratelimit/types"
 )
 
 const (
-	FlagDenom = "denom"
+	FlagDenom    = "denom"
+	FlagSender   = "sender"
+	FlagReceiver = "receiver"
+	FlagMemo     = "memo"
+
+	FlagChannelId      = "channel-id"
+	FlagMinUtilization = "min-utilization"
 )
 
 // GetQueryCmd returns the cli query commands for this module.
@@ -33,10 +40,35 @@ func GetQueryCmd() *cobra.Command {
 		GetCmdQueryRateLimit(),
 		GetCmdQueryAllRateLimits(),
 		GetCmdQueryRateLimitsByChainId(),
+		GetCmdQueryRateLimitsByChannelId(),
+		GetCmdQueryRateLimitsByDenom(),
+		GetCmdQueryRateLimitsOnClosedChannels(),
+		GetCmdQueryAllBlacklistedDenoms(),
+		GetCmdQueryAllWhitelistedAddresses(),
+		GetCmdQueryAllTransferRules(),
+		GetCmdQueryAllDenomGroups(),
+		GetCmdQueryScheduledRateLimitUpdates(),
+		GetCmdQueryCheckTransfer(),
+		GetCmdQueryRateLimitCapacity(),
+		GetCmdQueryPendingSendPackets(),
 	)
 	return cmd
 }
 
+// Reads the page request from the pagination flags
+// If none of the flags were set, no pagination is sent so that the full list is returned
+// (the default --limit would otherwise truncate the results)
+func readPageRequest(cmd *cobra.Command) (*query.PageRequest, error) {
+	for _, flag := range []string{
+		flags.FlagPage, flags.FlagPageKey, flags.FlagOffset, flags.FlagLimit, flags.FlagCountTotal, flags.FlagReverse,
+	} {
+		if cmd.Flags().Changed(flag) {
+			return client.ReadPageRequest(cmd.Flags())
+		}
+	}
+	return nil, nil
+}
+
 // GetCmdQueryRateLimit implements a command to query rate limits by channel-id and denom
 func GetCmdQueryRateLimit() *cobra.Command {
 	cmd := &cobra.Command{
@@ -64,15 +96,21 @@ Example:
 			queryClient := types.NewQueryClient(clientCtx)
 
 			if denom == "" {
+				pageReq, err := readPageRequest(cmd)
+				if err != nil {
+					return err
+				}
+
 				req := &types.QueryRateLimitsByChannelIdRequest{
-					ChannelId: channelId,
+					ChannelId:  channelId,
+					Pagination: pageReq,
 				}
 				res, err := queryClient.RateLimitsByChannelId(context.Background(), req)
 				if err != nil {
 					return err
 				}
 
-				return clientCtx.PrintObjectLegacy(res.RateLimits)
+				return printRateLimits(cmd, clientCtx, res, res.RateLimits)
 			}
 
 			req := &types.QueryRateLimitRequest{
@@ -85,12 +123,14 @@ Example:
 				return err
 			}
 
-			return clientCtx.PrintProto(res.RateLimit)
+			return printRateLimits(cmd, clientCtx, res.RateLimit, []types.RateLimit{*res.RateLimit})
 		},
 	}
 
 	cmd.Flags().String(FlagDenom, "", "The denom identifying a specific rate limit")
 	flags.AddQueryFlagsToCmd(cmd)
+	addRateLimitOutputFlags(cmd)
+	flags.AddPaginationFlagsToCmd(cmd, "rate-limit")
 
 	return cmd
 }
@@ -100,22 +140,62 @@ func GetCmdQueryAllRateLimits() *cobra.Command {
 	cmd := &cobra.Command{
 		Use:   "list-rate-limits",
 		Short: "Query all rate limits",
-		Args:  cobra.NoArgs,
+		Long: strings.TrimSpace(
+			fmt.Sprintf(`Query all rate limits, optionally filtered by denom, channel-id, and utilization.
+
+Example:
+  $ %s query %s list-rate-limits
+  $ %s query %s list-rate-limits --denom=[denom] --channel-id=[channel-id]
+  $ %s query %s list-rate-limits --min-utilization=80 --limit=10
+  $ %s query %s list-rate-limits --output=table --show-denom-trace
+`,
+				version.AppName, types.ModuleName, version.AppName, types.ModuleName, version.AppName, types.ModuleName,
+				version.AppName, types.ModuleName,
+			),
+		),
+		Args: cobra.NoArgs,
 		RunE: func(cmd *cobra.Command, args []string) error {
+			denom, err := cmd.Flags().GetString(FlagDenom)
+			if err != nil {
+				return err
+			}
+			channelId, err := cmd.Flags().GetString(FlagChannelId)
+			if err != nil {
+				return err
+			}
+			minUtilization, err := cmd.Flags().GetString(FlagMinUtilization)
+			if err != nil {
+				return err
+			}
+			pageReq, err := readPageRequest(cmd)
+			if err != nil {
+				return err
+			}
+
 			clientCtx := client.GetClientContextFromCmd(cmd)
 			queryClient := types.NewQueryClient(clientCtx)
 
-			req := &types.QueryAllRateLimitsRequest{}
+			req := &types.QueryAllRateLimitsRequest{
+				Denom:          denom,
+				ChannelId:      channelId,
+				MinUtilization: minUtilization,
+				Pagination:     pageReq,
+			}
 			res, err := queryClient.AllRateLimits(context.Background(), req)
 			if err != nil {
 				return err
 			}
 
-			return clientCtx.PrintProto(res)
+			return printRateLimits(cmd, clientCtx, res, res.RateLimits)
 		},
 	}
 
+	cmd.Flags().String(FlagDenom, "", "Only return rate limits with the given denom")
+	cmd.Flags().String(FlagChannelId, "", "Only return rate limits on the given channel")
+	cmd.Flags().String(FlagMinUtilization, "", "Only return rate limits where the send or recv utilization is at least the given percentage")
 	flags.AddQueryFlagsToCmd(cmd)
+	addRateLimitOutputFlags(cmd)
+	flags.AddPaginationFlagsToCmd(cmd, "rate-limits")
 
 	return cmd
 }
@@ -142,11 +222,508 @@ func GetCmdQueryRateLimitsByChainId() *cobra.Command {
 				return err
 			}
 
+			return printRateLimits(cmd, clientCtx, res, res.RateLimits)
+		},
+	}
+
+	flags.AddQueryFlagsToCmd(cmd)
+	addRateLimitOutputFlags(cmd)
+
+	return cmd
+}
+
+// GetCmdQueryRateLimitsByChannelId return all rate limits on the specified channel
+func GetCmdQueryRateLimitsByChannelId() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "rate-limits-by-channel [channel-id]",
+		Short: "Query all rate limits on the given channel",
+		Long: strings.TrimSpace(
+			fmt.Sprintf(`Query all rate limits on the given channel.
+
+Example:
+  $ %s query %s rate-limits-by-channel channel-0
+  $ %s query %s rate-limits-by-channel channel-0 --output=table --show-denom-trace
+`,
+				version.AppName, types.ModuleName, version.AppName, types.ModuleName,
+			),
+		),
+		Args: cobra.ExactArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			channelId := args[0]
+			pageReq, err := readPageRequest(cmd)
+			if err != nil {
+				return err
+			}
+
+			clientCtx := client.GetClientContextFromCmd(cmd)
+			queryClient := types.NewQueryClient(clientCtx)
+
+			req := &types.QueryRateLimitsByChannelIdRequest{
+				ChannelId:  channelId,
+				Pagination: pageReq,
+			}
+			res, err := queryClient.RateLimitsByChannelId(context.Background(), req)
+			if err != nil {
+				return err
+			}
+
+			return printRateLimits(cmd, clientCtx, res, res.RateLimits)
+		},
+	}
+
+	flags.AddQueryFlagsToCmd(cmd)
+	addRateLimitOutputFlags(cmd)
+	flags.AddPaginationFlagsToCmd(cmd, "rate-limits-by-channel")
+
+	return cmd
+}
+
+// GetCmdQueryRateLimitsByDenom return all rate limits for the specified denom
+func GetCmdQueryRateLimitsByDenom() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "rate-limits-by-denom [denom]",
+		Short: "Query all rate limits for the given denom",
+		Long: strings.TrimSpace(
+			fmt.Sprintf(`Query all rate limits for the given denom, across all channels.
+The denom can be the base denom, the ibc/ hash, or the full denom trace (which will be hashed).
+
+Example:
+  $ %s query %s rate-limits-by-denom ustrd
+  $ %s query %s rate-limits-by-denom transfer/channel-0/uatom
+`,
+				version.AppName, types.ModuleName, version.AppName, types.ModuleName,
+			),
+		),
+		Args: cobra.ExactArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			denom := args[0]
+			pageReq, err := readPageRequest(cmd)
+			if err != nil {
+				return err
+			}
+
+			clientCtx := client.GetClientContextFromCmd(cmd)
+			queryClient := types.NewQueryClient(clientCtx)
+
+			req := &types.QueryRateLimitsByDenomRequest{
+				Denom:      denom,
+				Pagination: pageReq,
+			}
+			res, err := queryClient.RateLimitsByDenom(context.Background(), req)
+			if err != nil {
+				return err
+			}
+
+			return printRateLimits(cmd, clientCtx, res, res.RateLimits)
+		},
+	}
+
+	flags.AddQueryFlagsToCmd(cmd)
+	addRateLimitOutputFlags(cmd)
+	flags.AddPaginationFlagsToCmd(cmd, "rate-limits-by-denom")
+
+	return cmd
+}
+
+// GetCmdQueryRateLimitsOnClosedChannels return the rate limits on channels that do not exist or have been closed
+func GetCmdQueryRateLimitsOnClosedChannels() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "list-closed-channel-rate-limits",
+		Short: "Query the rate limits on channels that do not exist or have been closed",
+		Args:  cobra.NoArgs,
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx := client.GetClientContextFromCmd(cmd)
+			queryClient := types.NewQueryClient(clientCtx)
+
+			pageReq, err := readPageRequest(cmd)
+			if err != nil {
+				return err
+			}
+
+			req := &types.QueryRateLimitsOnClosedChannelsRequest{
+				Pagination: pageReq,
+			}
+			res, err := queryClient.RateLimitsOnClosedChannels(context.Background(), req)
+			if err != nil {
+				return err
+			}
+
+			return clientCtx.PrintProto(res)
+		},
+	}
+
+	flags.AddQueryFlagsToCmd(cmd)
+	flags.AddPaginationFlagsToCmd(cmd, "closed-channel-rate-limits")
+
+	return cmd
+}
+
+// GetCmdQueryAllBlacklistedDenoms return all blacklisted denoms
+func GetCmdQueryAllBlacklistedDenoms() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "list-blacklisted-denoms",
+		Short: "Query all blacklisted denoms",
+		Args:  cobra.NoArgs,
+		RunE: func(cmd *cobra.Command, args []string) error {
+			pageReq, err := readPageRequest(cmd)
+			if err != nil {
+				return err
+			}
+
+			clientCtx := client.GetClientContextFromCmd(cmd)
+			queryClient := types.NewQueryClient(clientCtx)
+
+			req := &types.QueryAllBlacklistedDenomsRequest{
+				Pagination: pageReq,
+			}
+			res, err := queryClient.AllBlacklistedDenoms(context.Background(), req)
+			if err != nil {
+				return err
+			}
+
+			return clientCtx.PrintProto(res)
+		},
+	}
+
+	flags.AddQueryFlagsToCmd(cmd)
+	flags.AddPaginationFlagsToCmd(cmd, "blacklisted-denoms")
+
+	return cmd
+}
+
+// GetCmdQueryAllWhitelistedAddresses return all whitelisted sender/receiver address pairs
+func GetCmdQueryAllWhitelistedAddresses() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "list-whitelisted-addresses",
+		Short: "Query all whitelisted sender/receiver address pairs",
+		Args:  cobra.NoArgs,
+		RunE: func(cmd *cobra.Command, args []string) error {
+			pageReq, err := readPageRequest(cmd)
+			if err != nil {
+				return err
+			}
+
+			clientCtx := client.GetClientContextFromCmd(cmd)
+			queryClient := types.NewQueryClient(clientCtx)
+
+			req := &types.QueryAllWhitelistedAddressesRequest{
+				Pagination: pageReq,
+			}
+			res, err := queryClient.AllWhitelistedAddresses(context.Background(), req)
+			if err != nil {
+				return err
+			}
+
+			return clientCtx.PrintProto(res)
+		},
+	}
+
+	flags.AddQueryFlagsToCmd(cmd)
+	flags.AddPaginationFlagsToCmd(cmd, "whitelisted-addresses")
+
+	return cmd
+}
+
+// GetCmdQueryAllDenomGroups return all denom groups
+func GetCmdQueryAllDenomGroups() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "list-denom-groups",
+		Short: "Query all denom groups",
+		Args:  cobra.NoArgs,
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx := client.GetClientContextFromCmd(cmd)
+			queryClient := types.NewQueryClient(clientCtx)
+
+			pageReq, err := readPageRequest(cmd)
+			if err != nil {
+				return err
+			}
+
+			req := &types.QueryAllDenomGroupsRequest{
+				Pagination: pageReq,
+			}
+			res, err := queryClient.AllDenomGroups(context.Background(), req)
+			if err != nil {
+				return err
+			}
+
+			return clientCtx.PrintProto(res)
+		},
+	}
+
+	flags.AddQueryFlagsToCmd(cmd)
+	flags.AddPaginationFlagsToCmd(cmd, "denom-groups")
+
+	return cmd
+}
+
+// GetCmdQueryScheduledRateLimitUpdates return the upcoming scheduled rate limit updates
+// Optionally filtered by denom and channel
+func GetCmdQueryScheduledRateLimitUpdates() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "list-scheduled-updates",
+		Short: "Query the upcoming scheduled rate limit updates",
+		Args:  cobra.NoArgs,
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx := client.GetClientContextFromCmd(cmd)
+			queryClient := types.NewQueryClient(clientCtx)
+
+			denom, err := cmd.Flags().GetString(FlagDenom)
+			if err != nil {
+				return err
+			}
+			channelId, err := cmd.Flags().GetString(FlagChannelId)
+			if err != nil {
+				return err
+			}
+
+			pageReq, err := readPageRequest(cmd)
+			if err != nil {
+				return err
+			}
+
+			req := &types.QueryScheduledRateLimitUpdatesRequest{
+				Denom:      denom,
+				ChannelId:  channelId,
+				Pagination: pageReq,
+			}
+			res, err := queryClient.ScheduledRateLimitUpdates(context.Background(), req)
+			if err != nil {
+				return err
+			}
+
+			return clientCtx.PrintProto(res)
+		},
+	}
+
+	cmd.Flags().String(FlagDenom, "", "Only return scheduled updates for the given denom")
+	cmd.Flags().String(FlagChannelId, "", "Only return scheduled updates on the given channel")
+	flags.AddQueryFlagsToCmd(cmd)
+	flags.AddPaginationFlagsToCmd(cmd, "scheduled-updates")
+
+	return cmd
+}
+
+// GetCmdQueryAllTransferRules return all memo and receiver based transfer rules
+func GetCmdQueryAllTransferRules() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "list-transfer-rules",
+		Short: "Query all transfer rules",
+		Args:  cobra.NoArgs,
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx := client.GetClientContextFromCmd(cmd)
+			queryClient := types.NewQueryClient(clientCtx)
+
+			pageReq, err := readPageRequest(cmd)
+			if err != nil {
+				return err
+			}
+
+			req := &types.QueryAllTransferRulesRequest{
+				Pagination: pageReq,
+			}
+			res, err := queryClient.AllTransferRules(context.Background(), req)
+			if err != nil {
+				return err
+			}
+
+			return clientCtx.PrintProto(res)
+		},
+	}
+
+	flags.AddQueryFlagsToCmd(cmd)
+	flags.AddPaginationFlagsToCmd(cmd, "transfer-rules")
+
+	return cmd
+}
+
+// GetCmdQueryCheckTransfer checks whether a transfer would be allowed by the rate limits
+func GetCmdQueryCheckTransfer() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "check-transfer [channel-id] [denom] [send|recv] [amount]",
+		Short: "Check whether a transfer would be allowed by the rate limits",
+		Long: strings.TrimSpace(
+			fmt.Sprintf(`Check whether a transfer would be allowed by the rate limits, and the remaining capacity.
+The denom can be the base denom, the ibc/ hash, or the full denom trace (which will be hashed).
+
+Example:
+  $ %s query %s check-transfer channel-0 ustrd send 1000
+  $ %s query %s check-transfer channel-0 ustrd recv 1000 --sender=[sender] --receiver=[receiver]
+`,
+				version.AppName, types.ModuleName, version.AppName, types.ModuleName,
+			),
+		),
+		Args: cobra.ExactArgs(4),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			channelId := args[0]
+			denom := args[1]
+			amount := args[3]
+
+			var direction types.PacketDirection
+			switch strings.ToLower(args[2]) {
+			case "send":
+				direction = types.PACKET_SEND
+			case "recv":
+				direction = types.PACKET_RECV
+			default:
+				return fmt.Errorf("invalid direction (%s), must be either send or recv", args[2])
+			}
+
+			sender, err := cmd.Flags().GetString(FlagSender)
+			if err != nil {
+				return err
+			}
+			receiver, err := cmd.Flags().GetString(FlagReceiver)
+			if err != nil {
+				return err
+			}
+			memo, err := cmd.Flags().GetString(FlagMemo)
+			if err != nil {
+				return err
+			}
+
+			clientCtx := client.GetClientContextFromCmd(cmd)
+			queryClient := types.NewQueryClient(clientCtx)
+
+			req := &types.QueryCheckTransferRequest{
+				Denom:     denom,
+				ChannelId: channelId,
+				Direction: direction,
+				Amount:    amount,
+				Sender:    sender,
+				Receiver:  receiver,
+				Memo:      memo,
+			}
+			res, err := queryClient.CheckTransfer(context.Background(), req)
+			if err != nil {
+				return err
+			}
+
+			return clientCtx.PrintProto(res)
+		},
+	}
+
+	cmd.Flags().String(FlagSender, "", "The sender of the transfer")
+	cmd.Flags().String(FlagReceiver, "", "The receiver of the transfer")
+	cmd.Flags().String(FlagMemo, "", "The memo of the transfer")
+	flags.AddQueryFlagsToCmd(cmd)
+
+	return cmd
+}
+
+// GetCmdQueryRateLimitCapacity implements a command to query the remaining capacity
+// of a rate limit, or of all rate limits if no channel-id is provided
+func GetCmdQueryRateLimitCapacity() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "capacity [channel-id]",
+		Short: "Query the remaining capacity, utilization and next reset of rate limits",
+		Long: strings.TrimSpace(
+			fmt.Sprintf(`Query the remaining send/recv capacity, utilization and next reset of rate limits.
+
+Example:
+  $ %s query %s capacity
+  $ %s query %s capacity [channel-id] --denom=[denom]
+`,
+				version.AppName, types.ModuleName, version.AppName, types.ModuleName,
+			),
+		),
+		Args: cobra.MaximumNArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			denom, err := cmd.Flags().GetString(FlagDenom)
+			if err != nil {
+				return err
+			}
+
+			clientCtx := client.GetClientContextFromCmd(cmd)
+			queryClient := types.NewQueryClient(clientCtx)
+
+			if len(args) == 0 {
+				pageReq, err := readPageRequest(cmd)
+				if err != nil {
+					return err
+				}
+
+				req := &types.QueryAllRateLimitCapacitiesRequest{
+					Pagination: pageReq,
+				}
+				res, err := queryClient.AllRateLimitCapacities(context.Background(), req)
+				if err != nil {
+					return err
+				}
+
+				return clientCtx.PrintProto(res)
+			}
+
+			if denom == "" {
+				return fmt.Errorf("the --%s flag is required when a channel-id is provided", FlagDenom)
+			}
+
+			req := &types.QueryRateLimitCapacityRequest{
+				Denom:     denom,
+				ChannelId: args[0],
+			}
+			res, err := queryClient.RateLimitCapacity(context.Background(), req)
+			if err != nil {
+				return err
+			}
+
+			return clientCtx.PrintProto(res)
+		},
+	}
+
+	cmd.Flags().String(FlagDenom, "", "The denom identifying a specific rate limit")
+	flags.AddQueryFlagsToCmd(cmd)
+	flags.AddPaginationFlagsToCmd(cmd, "capacities")
+
+	return cmd
+}
+
+// GetCmdQueryPendingSendPackets return all pending send packets, optionally filtered by channel
+func GetCmdQueryPendingSendPackets() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "pending-send-packets",
+		Short: "Query all send packets that have not yet been acknowledged or timed out",
+		Long: strings.TrimSpace(
+			fmt.Sprintf(`Query all send packets that have not yet been acknowledged or timed out,
+along with the amount that was charged against the outflow (and would be refunded if the packet fails).
+
+Example:
+  $ %s query %s pending-send-packets
+  $ %s query %s pending-send-packets --channel-id=[channel-id]
+`,
+				version.AppName, types.ModuleName, version.AppName, types.ModuleName,
+			),
+		),
+		Args: cobra.NoArgs,
+		RunE: func(cmd *cobra.Command, args []string) error {
+			channelId, err := cmd.Flags().GetString(FlagChannelId)
+			if err != nil {
+				return err
+			}
+			pageReq, err := readPageRequest(cmd)
+			if err != nil {
+				return err
+			}
+
+			clientCtx := client.GetClientContextFromCmd(cmd)
+			queryClient := types.NewQueryClient(clientCtx)
+
+			req := &types.QueryPendingSendPacketsRequest{
+				ChannelId:  channelId,
+				Pagination: pageReq,
+			}
+			res, err := queryClient.PendingSendPackets(context.Background(), req)
+			if err != nil {
+				return err
+			}
+
 			return clientCtx.PrintProto(res)
 		},
 	}
 
+	cmd.Flags().String(FlagChannelId, "", "Only return pending packets on the given channel")
 	flags.AddQueryFlagsToCmd(cmd)
+	flags.AddPaginationFlagsToCmd(cmd, "pending-send-packets")
 
 	return cmd
 }
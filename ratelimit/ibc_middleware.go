@@ -116,7 +116,20 @@ func (im IBCMiddleware) OnRecvPacket(
 	}
 
 	// If the packet was not rate-limited, pass it down to the Transfer OnRecvPacket callback
-	return im.app.OnRecvPacket(ctx, packet, relayer)
+	ack := im.app.OnRecvPacket(ctx, packet, relayer)
+
+	// If the packet was forwarded by packet-forward-middleware, the outbound leg has already
+	// been sent at this point. If it had a forward memo but was not forwarded, the inflow that
+	// was skipped is counted now
+	// If the ack is an error, the state changes from the packet are discarded, so there's nothing to settle
+	if ack == nil || ack.Success() {
+		if err := im.keeper.SettleForwardedTransfer(ctx, packet); err != nil {
+			im.keeper.Logger(ctx).Error(fmt.Sprintf("ICS20 packet receive was denied: %s", err.Error()))
+			return channeltypes.NewErrorAcknowledgement(err)
+		}
+	}
+
+	return ack
 }
 
 // OnAcknowledgementPacket implements the IBCMiddleware interface
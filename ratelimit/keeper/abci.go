@@ -6,12 +6,26 @@ import (
 	sdk "github.com/cosmos/cosmos-sdk/types"
 )
 
-// Before each hour epoch, check if any of the rate limits have expired,
+// First, if lazy quota resets were just disabled, reset any stale flows
+// Then, before each hour epoch, check if any of the rate limits have expired,
 // and reset them if they have
+// If quotas are reset lazily, the rate limits are not iterated, and each is instead
+// reset the first time it's touched in the new window
+// Afterwards, any scheduled rate limit updates that are due are applied
 func (k Keeper) BeginBlocker(ctx sdk.Context) {
+	k.ProcessParamsUpdate(ctx)
+	k.resetExpiredRateLimits(ctx)
+	k.ProcessScheduledUpdates(ctx)
+}
+
+// Resets each rate limit whose window expires at the start of a new hour epoch
+func (k Keeper) resetExpiredRateLimits(ctx sdk.Context) {
 	if epochStarting, epochNumber := k.CheckHourEpochStarting(ctx); epochStarting {
+		if k.GetParams(ctx).LazyQuotaReset {
+			return
+		}
 		for _, rateLimit := range k.GetAllRateLimits(ctx) {
-			if rateLimit.Quota.DurationHours != 0 && epochNumber%rateLimit.Quota.DurationHours == 0 {
+			if rateLimit.Quota.IsResetEpoch(epochNumber) {
 				err := k.ResetRateLimit(ctx, rateLimit.Path.Denom, rateLimit.Path.ChannelId)
 				if err != nil {
 					k.Logger(ctx).Error(fmt.Sprintf("Unable to reset quota for Denom: %s, ChannelId: %s", rateLimit.Path.Denom, rateLimit.Path.ChannelId))
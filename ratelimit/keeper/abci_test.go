@@ -76,3 +76,62 @@ func (s *KeeperTestSuite) TestBeginBlocker() {
 		}
 	}
 }
+
+// When quotas are reset lazily, the BeginBlocker should start the new epoch without resetting any rate limits
+func (s *KeeperTestSuite) TestBeginBlocker_LazyQuotaReset() {
+	s.App.RatelimitKeeper.SetParams(s.Ctx, types.NewParams(types.FORWARD_POLICY_COUNT_BOTH, true))
+
+	nonZeroFlow := int64(10)
+	s.resetRateLimits(denom, []uint64{1, 2}, nonZeroFlow)
+
+	blockTime := time.Date(2024, 1, 1, 0, 0, 0, 0, time.UTC)
+	s.Ctx = s.Ctx.WithBlockTime(blockTime)
+	s.App.RatelimitKeeper.SetHourEpoch(s.Ctx, types.HourEpoch{
+		EpochNumber:    1,
+		Duration:       time.Minute,
+		EpochStartTime: blockTime.Add(-2 * time.Minute),
+	})
+	s.App.RatelimitKeeper.BeginBlocker(s.Ctx)
+
+	s.Require().Equal(uint64(2), s.App.RatelimitKeeper.GetHourEpoch(s.Ctx).EpochNumber, "epoch number")
+	for _, rateLimit := range s.App.RatelimitKeeper.GetAllRateLimits(s.Ctx) {
+		s.Require().Equal(nonZeroFlow, rateLimit.Flow.Inflow.Int64(), "inflow should not be reset - %s", rateLimit.Path.ChannelId)
+		s.Require().Equal(nonZeroFlow, rateLimit.Flow.Outflow.Int64(), "outflow should not be reset - %s", rateLimit.Path.ChannelId)
+	}
+}
+
+// Rate limits with a window offset should be reset when (epochNumber - offset) % duration is 0
+func (s *KeeperTestSuite) TestBeginBlocker_WindowOffset() {
+	nonZeroFlow := int64(10)
+	offsets := []uint64{0, 1, 2}
+	for i, offset := range offsets {
+		s.App.RatelimitKeeper.SetRateLimit(s.Ctx, types.RateLimit{
+			Path:  &types.Path{Denom: denom, ChannelId: fmt.Sprintf("channel-%d", i)},
+			Quota: &types.Quota{DurationHours: 3, WindowOffset: offset},
+			Flow: &types.Flow{
+				Inflow:       sdkmath.NewInt(nonZeroFlow),
+				Outflow:      sdkmath.NewInt(nonZeroFlow),
+				ChannelValue: sdkmath.NewInt(100),
+			},
+		})
+	}
+
+	// Epoch 7 should only reset the rate limit with an offset of 1 (since (7 - 1) % 3 == 0)
+	blockTime := time.Date(2024, 1, 1, 0, 0, 0, 0, time.UTC)
+	s.Ctx = s.Ctx.WithBlockTime(blockTime)
+	s.App.RatelimitKeeper.SetHourEpoch(s.Ctx, types.HourEpoch{
+		EpochNumber:    6,
+		Duration:       time.Minute,
+		EpochStartTime: blockTime.Add(-2 * time.Minute),
+	})
+	s.App.RatelimitKeeper.BeginBlocker(s.Ctx)
+
+	for _, rateLimit := range s.App.RatelimitKeeper.GetAllRateLimits(s.Ctx) {
+		if rateLimit.Quota.WindowOffset == 1 {
+			s.Require().Zero(rateLimit.Flow.Outflow.Int64(), "outflow should be reset - %s", rateLimit.Path.ChannelId)
+			s.Require().Equal(uint64(3), rateLimit.Flow.WindowId, "window id - %s", rateLimit.Path.ChannelId)
+		} else {
+			s.Require().Equal(nonZeroFlow, rateLimit.Flow.Outflow.Int64(), "outflow should be unchanged - %s", rateLimit.Path.ChannelId)
+		}
+	}
+}
@@ -0,0 +1,60 @@
+package keeper
+
+import (
+	"errors"
+
+	errorsmod "cosmossdk.io/errors"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	"github.com/Stride-Labs/ibc-rate-limiting/ratelimit/types"
+)
+
+// Removes, and then adds or updates, each rate limit in a batch
+// The batch is applied atomically: if any entry fails, none of the changes are written, and
+// the error reports every failed entry (each identified by its index and path)
+// If the batch is configured to skip zero channel values, new rate limits with a channel value
+// of zero are skipped instead of failing the batch, and are returned
+func (k Keeper) BatchUpdateRateLimits(ctx sdk.Context, msg *types.MsgBatchUpdateRateLimits) (skipped []types.Path, err error) {
+	cacheCtx, writeCache := ctx.CacheContext()
+
+	entryErrors := []error{}
+	for i, removal := range msg.Removals {
+		if _, found := k.GetRateLimit(cacheCtx, removal.Denom, removal.ChannelId); !found {
+			entryErrors = append(entryErrors, errorsmod.Wrapf(types.ErrRateLimitNotFound,
+				"removal %d (denom: %s, channel: %s)", i, removal.Denom, removal.ChannelId))
+			continue
+		}
+		k.RemoveRateLimit(cacheCtx, removal.Denom, removal.ChannelId)
+	}
+
+	for i, upsert := range msg.Upserts {
+		_, exists := k.GetRateLimit(cacheCtx, upsert.Denom, upsert.ChannelId)
+		err := k.upsertRateLimit(cacheCtx, msg.Authority, upsert)
+		if msg.SkipZeroChannelValue && !exists && errors.Is(err, types.ErrZeroChannelValue) {
+			skipped = append(skipped, types.Path{Denom: upsert.Denom, ChannelId: upsert.ChannelId})
+			EmitRateLimitUpsertSkippedEvent(cacheCtx, upsert.Denom, upsert.ChannelId)
+			continue
+		}
+		if err != nil {
+			entryErrors = append(entryErrors,
+				errorsmod.Wrapf(err, "upsert %d (denom: %s, channel: %s)", i, upsert.Denom, upsert.ChannelId))
+		}
+	}
+
+	if err := types.JoinBatchErrors(entryErrors); err != nil {
+		return nil, err
+	}
+
+	writeCache()
+	return skipped, nil
+}
+
+// Adds a rate limit from a batch if it does not exist, or updates it if it does
+func (k Keeper) upsertRateLimit(ctx sdk.Context, authority string, upsert types.RateLimitUpsert) error {
+	if _, found := k.GetRateLimit(ctx, upsert.Denom, upsert.ChannelId); found {
+		updateMsg := upsert.ToUpdateMsg(authority)
+		return k.UpdateRateLimit(ctx, &updateMsg)
+	}
+	addMsg := upsert.ToAddMsg(authority)
+	return k.AddRateLimit(ctx, &addMsg)
+}
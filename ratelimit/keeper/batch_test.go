@@ -0,0 +1,198 @@
+package keeper_test
+
+import (
+	sdkmath "cosmossdk.io/math"
+
+	"github.com/Stride-Labs/ibc-rate-limiting/ratelimit/keeper"
+	"github.com/Stride-Labs/ibc-rate-limiting/ratelimit/types"
+)
+
+const zeroSupplyDenom = "zero-supply-denom"
+
+// Helper function to create channel-0 through channel-2 with a supply of the test denom, and
+// add rate limits on channel-0 and channel-1
+func (s *KeeperTestSuite) setupBatchUpdateRateLimits() {
+	for _, channelId := range []string{"channel-0", "channel-1", "channel-2"} {
+		s.createChannel(channelId)
+	}
+	s.createChannelValue(denom, sdkmath.NewInt(100))
+
+	for _, channelId := range []string{"channel-0", "channel-1"} {
+		addMsg := addRateLimitMsg
+		addMsg.ChannelId = channelId
+		err := s.App.RatelimitKeeper.AddRateLimit(s.Ctx, &addMsg)
+		s.Require().NoError(err, "no error expected when adding rate limit on %s", channelId)
+	}
+}
+
+// Helper function to build an upsert for the test denom on a channel
+func newRateLimitUpsert(denom, channelId string) types.RateLimitUpsert {
+	return types.RateLimitUpsert{
+		Denom:          denom,
+		ChannelId:      channelId,
+		MaxPercentSend: sdkmath.NewInt(5),
+		MaxPercentRecv: sdkmath.NewInt(5),
+		DurationHours:  12,
+	}
+}
+
+func (s *KeeperTestSuite) TestBatchUpdateRateLimits_Successful() {
+	s.setupBatchUpdateRateLimits()
+
+	// Update the rate limit on channel-0, add a rate limit on channel-2, and remove the rate limit on channel-1
+	batchMsg := types.MsgBatchUpdateRateLimits{
+		Authority: authority,
+		Upserts: []types.RateLimitUpsert{
+			newRateLimitUpsert(denom, "channel-0"),
+			newRateLimitUpsert(denom, "channel-2"),
+		},
+		Removals: []types.Path{{Denom: denom, ChannelId: "channel-1"}},
+	}
+	skipped, err := s.App.RatelimitKeeper.BatchUpdateRateLimits(s.Ctx, &batchMsg)
+	s.Require().NoError(err, "no error expected when applying batch")
+	s.Require().Empty(skipped, "no rate limits should be skipped")
+
+	for _, channelId := range []string{"channel-0", "channel-2"} {
+		rateLimit, found := s.App.RatelimitKeeper.GetRateLimit(s.Ctx, denom, channelId)
+		s.Require().True(found, "rate limit on %s should exist", channelId)
+		s.Require().Equal(uint64(12), rateLimit.Quota.DurationHours, "duration on %s", channelId)
+		s.Require().Equal(int64(5), rateLimit.Quota.MaxPercentSend.Int64(), "max percent send on %s", channelId)
+		s.Require().Equal(int64(100), rateLimit.Flow.ChannelValue.Int64(), "channel value on %s", channelId)
+	}
+
+	_, found := s.App.RatelimitKeeper.GetRateLimit(s.Ctx, denom, "channel-1")
+	s.Require().False(found, "rate limit on channel-1 should be removed")
+}
+
+func (s *KeeperTestSuite) TestBatchUpdateRateLimits_Atomic() {
+	s.setupBatchUpdateRateLimits()
+
+	// Attempt a batch where the second upsert has no channel value, the whole batch should fail
+	batchMsg := types.MsgBatchUpdateRateLimits{
+		Authority: authority,
+		Upserts: []types.RateLimitUpsert{
+			newRateLimitUpsert(denom, "channel-2"),
+			newRateLimitUpsert(zeroSupplyDenom, "channel-0"),
+		},
+		Removals: []types.Path{{Denom: denom, ChannelId: "channel-1"}},
+	}
+	_, err := s.App.RatelimitKeeper.BatchUpdateRateLimits(s.Ctx, &batchMsg)
+	s.Require().ErrorIs(err, types.ErrZeroChannelValue)
+	s.Require().ErrorContains(err, "upsert 1 (denom: zero-supply-denom, channel: channel-0)")
+
+	// None of the entries should have been applied
+	_, found := s.App.RatelimitKeeper.GetRateLimit(s.Ctx, denom, "channel-2")
+	s.Require().False(found, "rate limit on channel-2 should not have been added")
+	_, found = s.App.RatelimitKeeper.GetRateLimit(s.Ctx, denom, "channel-1")
+	s.Require().True(found, "rate limit on channel-1 should not have been removed")
+
+	// Attempt a batch that removes a rate limit that doesn't exist
+	batchMsg = types.MsgBatchUpdateRateLimits{
+		Authority: authority,
+		Removals: []types.Path{
+			{Denom: denom, ChannelId: "channel-1"},
+			{Denom: denom, ChannelId: "channel-2"},
+		},
+	}
+	_, err = s.App.RatelimitKeeper.BatchUpdateRateLimits(s.Ctx, &batchMsg)
+	s.Require().ErrorIs(err, types.ErrRateLimitNotFound)
+	s.Require().ErrorContains(err, "removal 1 (denom: denom, channel: channel-2)")
+
+	_, found = s.App.RatelimitKeeper.GetRateLimit(s.Ctx, denom, "channel-1")
+	s.Require().True(found, "rate limit on channel-1 should not have been removed")
+}
+
+func (s *KeeperTestSuite) TestBatchUpdateRateLimits_MultipleFailedEntries() {
+	s.setupBatchUpdateRateLimits()
+
+	// Attempt a batch with a missing removal and two failed upserts, each should be reported
+	batchMsg := types.MsgBatchUpdateRateLimits{
+		Authority: authority,
+		Upserts: []types.RateLimitUpsert{
+			newRateLimitUpsert(denom, "channel-2"),
+			newRateLimitUpsert(zeroSupplyDenom, "channel-0"),
+			newRateLimitUpsert(denom, "channel-99"),
+		},
+		Removals: []types.Path{
+			{Denom: denom, ChannelId: "channel-1"},
+			{Denom: "missing-denom", ChannelId: "channel-0"},
+		},
+	}
+	_, err := s.App.RatelimitKeeper.BatchUpdateRateLimits(s.Ctx, &batchMsg)
+	s.Require().ErrorIs(err, types.ErrRateLimitNotFound)
+	s.Require().ErrorIs(err, types.ErrZeroChannelValue)
+	s.Require().ErrorIs(err, types.ErrChannelNotFound)
+	s.Require().ErrorContains(err, "removal 1 (denom: missing-denom, channel: channel-0)")
+	s.Require().ErrorContains(err, "upsert 1 (denom: zero-supply-denom, channel: channel-0)")
+	s.Require().ErrorContains(err, "upsert 2 (denom: denom, channel: channel-99)")
+	s.Require().NotContains(err.Error(), "upsert 0", "successful upsert should not be reported")
+
+	// None of the entries should have been applied
+	_, found := s.App.RatelimitKeeper.GetRateLimit(s.Ctx, denom, "channel-2")
+	s.Require().False(found, "rate limit on channel-2 should not have been added")
+	_, found = s.App.RatelimitKeeper.GetRateLimit(s.Ctx, denom, "channel-1")
+	s.Require().True(found, "rate limit on channel-1 should not have been removed")
+}
+
+func (s *KeeperTestSuite) TestBatchUpdateRateLimits_SkipZeroChannelValue() {
+	s.setupBatchUpdateRateLimits()
+
+	// Add a rate limit for a denom with supply and one without, the latter should be skipped
+	batchMsg := types.MsgBatchUpdateRateLimits{
+		Authority: authority,
+		Upserts: []types.RateLimitUpsert{
+			newRateLimitUpsert(zeroSupplyDenom, "channel-0"),
+			newRateLimitUpsert(denom, "channel-2"),
+		},
+		SkipZeroChannelValue: true,
+	}
+	skipped, err := s.App.RatelimitKeeper.BatchUpdateRateLimits(s.Ctx, &batchMsg)
+	s.Require().NoError(err, "no error expected when applying batch")
+	s.Require().Equal([]types.Path{{Denom: zeroSupplyDenom, ChannelId: "channel-0"}}, skipped, "skipped rate limits")
+
+	_, found := s.App.RatelimitKeeper.GetRateLimit(s.Ctx, zeroSupplyDenom, "channel-0")
+	s.Require().False(found, "zero supply rate limit should have been skipped")
+	_, found = s.App.RatelimitKeeper.GetRateLimit(s.Ctx, denom, "channel-2")
+	s.Require().True(found, "rate limit on channel-2 should have been added")
+
+	// An event should be emitted for the skipped rate limit
+	skippedEvents := []string{}
+	for _, event := range s.Ctx.EventManager().Events() {
+		if event.Type != types.EventRateLimitUpsertSkipped {
+			continue
+		}
+		for _, attribute := range event.Attributes {
+			if attribute.Key == types.AttributeKeyDenom {
+				skippedEvents = append(skippedEvents, attribute.Value)
+			}
+		}
+	}
+	s.Require().Equal([]string{zeroSupplyDenom}, skippedEvents, "skipped events")
+
+	// Other errors should still fail the batch
+	batchMsg.Upserts = []types.RateLimitUpsert{newRateLimitUpsert(denom, "channel-9")}
+	_, err = s.App.RatelimitKeeper.BatchUpdateRateLimits(s.Ctx, &batchMsg)
+	s.Require().ErrorIs(err, types.ErrChannelNotFound)
+}
+
+func (s *KeeperTestSuite) TestMsgServer_BatchUpdateRateLimits() {
+	s.setupBatchUpdateRateLimits()
+	msgServer := keeper.NewMsgServerImpl(s.App.RatelimitKeeper)
+
+	batchMsg := types.MsgBatchUpdateRateLimits{
+		Authority: authority,
+		Upserts: []types.RateLimitUpsert{
+			newRateLimitUpsert(denom, "channel-2"),
+			newRateLimitUpsert(zeroSupplyDenom, "channel-2"),
+		},
+		SkipZeroChannelValue: true,
+	}
+	response, err := msgServer.BatchUpdateRateLimits(s.Ctx, &batchMsg)
+	s.Require().NoError(err)
+	s.Require().Equal([]types.Path{{Denom: zeroSupplyDenom, ChannelId: "channel-2"}}, response.Skipped, "skipped rate limits")
+
+	// Attempt to submit the batch with an invalid authority
+	batchMsg.Authority = "invalid"
+	_, err = msgServer.BatchUpdateRateLimits(s.Ctx, &batchMsg)
+	s.Require().ErrorContains(err, "invalid authority")
+}
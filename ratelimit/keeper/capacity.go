@@ -0,0 +1,33 @@
+package keeper
+
+import (
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	"github.com/Stride-Labs/ibc-rate-limiting/ratelimit/types"
+)
+
+// Returns the remaining send/recv capacity and utilization of a rate limit in the current
+// window, as well as when the current window started and when the rate limit will next be reset
+func (k Keeper) GetRateLimitCapacity(ctx sdk.Context, rateLimit types.RateLimit) types.RateLimitCapacity {
+	flow := rateLimit.Flow
+	quota := *rateLimit.Quota
+
+	capacity := types.RateLimitCapacity{
+		RateLimit:       rateLimit,
+		RemainingSend:   k.GetRemainingCapacity(ctx, rateLimit, quota, types.PACKET_SEND),
+		RemainingRecv:   k.GetRemainingCapacity(ctx, rateLimit, quota, types.PACKET_RECV),
+		SendUtilization: flow.GetUtilization(types.PACKET_SEND, quota),
+		RecvUtilization: flow.GetUtilization(types.PACKET_RECV, quota),
+	}
+
+	if epochNumber, startTime, found := k.GetNextResetEpoch(ctx, quota); found {
+		capacity.NextResetEpoch = epochNumber
+		capacity.NextResetTime = &startTime
+	}
+	if epochNumber, startTime, found := k.GetWindowStartEpoch(ctx, quota); found {
+		capacity.WindowStartEpoch = epochNumber
+		capacity.WindowStartTime = &startTime
+	}
+
+	return capacity
+}
@@ -0,0 +1,108 @@
+package keeper
+
+import (
+	"github.com/cosmos/cosmos-sdk/store/prefix"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	"github.com/Stride-Labs/ibc-rate-limiting/ratelimit/types"
+)
+
+// Light client states that are associated with a chain ID (e.g. 07-tendermint)
+// Other clients, such as the solo machine, don't have a chain ID
+type chainIdClientState interface {
+	GetChainID() string
+}
+
+// Stores the counterparty chain ID of a channel
+func (k Keeper) SetChannelChainId(ctx sdk.Context, channelId string, chainId string) {
+	store := prefix.NewStore(ctx.KVStore(k.storeKey), types.ChannelChainIdKeyPrefix)
+	store.Set(types.KeyPrefix(channelId), []byte(chainId))
+}
+
+// Reads the cached counterparty chain ID of a channel
+func (k Keeper) GetChannelChainId(ctx sdk.Context, channelId string) (chainId string, found bool) {
+	store := prefix.NewStore(ctx.KVStore(k.storeKey), types.ChannelChainIdKeyPrefix)
+
+	chainIdBz := store.Get(types.KeyPrefix(channelId))
+	if len(chainIdBz) == 0 {
+		return "", false
+	}
+	return string(chainIdBz), true
+}
+
+// Removes the cached counterparty chain ID of a channel
+func (k Keeper) RemoveChannelChainId(ctx sdk.Context, channelId string) {
+	store := prefix.NewStore(ctx.KVStore(k.storeKey), types.ChannelChainIdKeyPrefix)
+	store.Delete(types.KeyPrefix(channelId))
+}
+
+// Returns the counterparty chain ID of a channel, read from the cache
+// The chain ID is only resolved from the channel's client state if it has not been cached
+func (k Keeper) ResolveChannelChainId(ctx sdk.Context, channelId string) (chainId string, found bool) {
+	if chainId, found := k.GetChannelChainId(ctx, channelId); found {
+		return chainId, true
+	}
+	return k.GetCounterpartyChainId(ctx, channelId)
+}
+
+// Determines the counterparty chain ID of a channel from its client state
+// Returns false if the channel doesn't exist, or if the client does not have a chain ID
+func (k Keeper) GetCounterpartyChainId(ctx sdk.Context, channelId string) (chainId string, found bool) {
+	portId, found := k.GetRateLimitedChannelPort(ctx, channelId)
+	if !found {
+		return "", false
+	}
+	// The client state lookup requires the channel's connection
+	channel, found := k.channelKeeper.GetChannel(ctx, portId, channelId)
+	if !found || len(channel.ConnectionHops) == 0 {
+		return "", false
+	}
+	_, clientState, err := k.channelKeeper.GetChannelClientState(ctx, portId, channelId)
+	if err != nil {
+		return "", false
+	}
+	client, ok := clientState.(chainIdClientState)
+	if !ok {
+		return "", false
+	}
+	return client.GetChainID(), true
+}
+
+// Refreshes the cached counterparty chain ID of a channel from its client state
+// This is called when a rate limit is added or updated (and for each rate limited channel in
+// genesis and the store migration) so that the chain ID does not have to be looked up from
+// the client on each query
+func (k Keeper) UpdateChannelChainId(ctx sdk.Context, channelId string) {
+	if chainId, found := k.GetCounterpartyChainId(ctx, channelId); found {
+		k.SetChannelChainId(ctx, channelId, chainId)
+	}
+}
+
+// Returns true if there is at least one rate limit on the channel, using the channel index
+func (k Keeper) HasRateLimitOnChannel(ctx sdk.Context, channelId string) bool {
+	store := k.getRateLimitChannelIndexStore(ctx, channelId)
+
+	iterator := store.Iterator(nil, nil)
+	defer iterator.Close()
+
+	return iterator.Valid()
+}
+
+// Returns each of the channels that have a rate limit, using the channel index
+func (k Keeper) GetAllRateLimitedChannels(ctx sdk.Context) []string {
+	store := prefix.NewStore(ctx.KVStore(k.storeKey), types.RateLimitChannelIndexPrefix)
+
+	iterator := store.Iterator(nil, nil)
+	defer iterator.Close()
+
+	// Since the index is sorted by channel, each channel's entries are consecutive
+	channelIds := []string{}
+	for ; iterator.Valid(); iterator.Next() {
+		channelId := types.ParseRateLimitChannelIndexKey(iterator.Key())
+		if len(channelIds) == 0 || channelIds[len(channelIds)-1] != channelId {
+			channelIds = append(channelIds, channelId)
+		}
+	}
+
+	return channelIds
+}
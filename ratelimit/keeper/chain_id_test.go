@@ -0,0 +1,175 @@
+package keeper_test
+
+import (
+	"context"
+	"time"
+
+	sdkmath "cosmossdk.io/math"
+	transfertypes "github.com/cosmos/ibc-go/v7/modules/apps/transfer/types"
+	clienttypes "github.com/cosmos/ibc-go/v7/modules/core/02-client/types"
+	connectiontypes "github.com/cosmos/ibc-go/v7/modules/core/03-connection/types"
+	channeltypes "github.com/cosmos/ibc-go/v7/modules/core/04-channel/types"
+	ibcexported "github.com/cosmos/ibc-go/v7/modules/core/exported"
+	solomachine "github.com/cosmos/ibc-go/v7/modules/light-clients/06-solomachine"
+	ibctmtypes "github.com/cosmos/ibc-go/v7/modules/light-clients/07-tendermint"
+
+	"github.com/Stride-Labs/ibc-rate-limiting/ratelimit/types"
+)
+
+// Helper function to register a transfer channel backed by the given client
+func (s *KeeperTestSuite) createChannelWithClient(channelId, connectionId, clientId string, clientState ibcexported.ClientState) {
+	connection := connectiontypes.ConnectionEnd{ClientId: clientId}
+	channel := channeltypes.Channel{ConnectionHops: []string{connectionId}}
+
+	s.App.IBCKeeper.ClientKeeper.SetClientState(s.Ctx, clientId, clientState)
+	s.App.IBCKeeper.ConnectionKeeper.SetConnection(s.Ctx, connectionId, connection)
+	s.App.IBCKeeper.ChannelKeeper.SetChannel(s.Ctx, transfertypes.PortID, channelId, channel)
+}
+
+// Helper function to build a tendermint client state with the given chain ID
+func newTendermintClientState(chainId string) *ibctmtypes.ClientState {
+	return ibctmtypes.NewClientState(
+		chainId, ibctmtypes.Fraction{}, time.Duration(0), time.Duration(0), time.Duration(0), clienttypes.Height{}, nil, nil,
+	)
+}
+
+// Helper function to build a solo machine client state (which does not have a chain ID)
+func newSoloMachineClientState() *solomachine.ClientState {
+	return solomachine.NewClientState(1, &solomachine.ConsensusState{Diversifier: "diversifier", Timestamp: 1})
+}
+
+func (s *KeeperTestSuite) TestChannelChainId() {
+	_, found := s.App.RatelimitKeeper.GetChannelChainId(s.Ctx, "channel-0")
+	s.Require().False(found, "chain ID should not be found before it's set")
+
+	s.App.RatelimitKeeper.SetChannelChainId(s.Ctx, "channel-0", "chain-0")
+	s.App.RatelimitKeeper.SetChannelChainId(s.Ctx, "channel-1", "chain-1")
+
+	chainId, found := s.App.RatelimitKeeper.GetChannelChainId(s.Ctx, "channel-0")
+	s.Require().True(found, "chain ID should be found")
+	s.Require().Equal("chain-0", chainId, "chain ID")
+}
+
+func (s *KeeperTestSuite) TestGetCounterpartyChainId() {
+	s.createChannelWithClient("channel-0", "connection-0", "07-tendermint-0", newTendermintClientState("chain-0"))
+	s.createChannelWithClient("channel-1", "connection-1", "06-solomachine-1", newSoloMachineClientState())
+	s.App.IBCKeeper.ChannelKeeper.SetChannel(s.Ctx, transfertypes.PortID, "channel-2", channeltypes.Channel{})
+
+	chainId, found := s.App.RatelimitKeeper.GetCounterpartyChainId(s.Ctx, "channel-0")
+	s.Require().True(found, "tendermint chain ID should be found")
+	s.Require().Equal("chain-0", chainId, "tendermint chain ID")
+
+	_, found = s.App.RatelimitKeeper.GetCounterpartyChainId(s.Ctx, "channel-1")
+	s.Require().False(found, "solo machine should not have a chain ID")
+
+	_, found = s.App.RatelimitKeeper.GetCounterpartyChainId(s.Ctx, "channel-2")
+	s.Require().False(found, "channel without a connection should not have a chain ID")
+
+	_, found = s.App.RatelimitKeeper.GetCounterpartyChainId(s.Ctx, "channel-99")
+	s.Require().False(found, "missing channel should not have a chain ID")
+}
+
+func (s *KeeperTestSuite) TestAddRateLimit_CachesChainId() {
+	s.createChannelWithClient(addRateLimitMsg.ChannelId, "connection-0", "07-tendermint-0", newTendermintClientState("chain-0"))
+	s.createChannelValue(addRateLimitMsg.Denom, sdkmath.NewInt(100))
+
+	msg := addRateLimitMsg
+	err := s.App.RatelimitKeeper.AddRateLimit(s.Ctx, &msg)
+	s.Require().NoError(err, "no error expected when adding rate limit")
+
+	chainId, found := s.App.RatelimitKeeper.GetChannelChainId(s.Ctx, msg.ChannelId)
+	s.Require().True(found, "chain ID should be cached after adding rate limit")
+	s.Require().Equal("chain-0", chainId, "cached chain ID")
+}
+
+func (s *KeeperTestSuite) TestGetAllRateLimitedChannels() {
+	for _, path := range []types.Path{
+		{Denom: "denom-A", ChannelId: "channel-0"},
+		{Denom: "denom-B", ChannelId: "channel-0"},
+		{Denom: "denom-A", ChannelId: "channel-1"},
+		{Denom: "denom-A", ChannelId: "channel-10"},
+	} {
+		path := path
+		s.App.RatelimitKeeper.SetRateLimit(s.Ctx, types.RateLimit{Path: &path})
+	}
+
+	channels := s.App.RatelimitKeeper.GetAllRateLimitedChannels(s.Ctx)
+	s.Require().ElementsMatch([]string{"channel-0", "channel-1", "channel-10"}, channels)
+}
+
+func (s *KeeperTestSuite) TestQueryRateLimitsByChainId_CacheAndNonTendermintClients() {
+	// channel-0 is a tendermint channel with a cached chain ID
+	// channel-1 is a tendermint channel without a cached chain ID
+	// channel-2 is a solo machine channel, which should be skipped
+	s.createChannelWithClient("channel-0", "connection-0", "07-tendermint-0", newTendermintClientState("chain-0"))
+	s.createChannelWithClient("channel-1", "connection-1", "07-tendermint-1", newTendermintClientState("chain-0"))
+	s.createChannelWithClient("channel-2", "connection-2", "06-solomachine-2", newSoloMachineClientState())
+
+	rateLimits := []types.RateLimit{}
+	for _, channelId := range []string{"channel-0", "channel-1", "channel-2"} {
+		rateLimit := types.RateLimit{Path: &types.Path{Denom: "denom", ChannelId: channelId}}
+		s.App.RatelimitKeeper.SetRateLimit(s.Ctx, rateLimit)
+		rateLimits = append(rateLimits, rateLimit)
+	}
+	s.App.RatelimitKeeper.SetChannelChainId(s.Ctx, "channel-0", "chain-0")
+
+	queryResponse, err := s.QueryClient.RateLimitsByChainId(context.Background(), &types.QueryRateLimitsByChainIdRequest{
+		ChainId: "chain-0",
+	})
+	s.Require().NoError(err, "no error expected when a solo machine channel is rate limited")
+	s.Require().Equal([]types.RateLimit{rateLimits[0], rateLimits[1]}, queryResponse.RateLimits)
+
+	// The cached chain ID should be used over the client state when it's found
+	s.App.RatelimitKeeper.SetChannelChainId(s.Ctx, "channel-0", "chain-cached")
+	queryResponse, err = s.QueryClient.RateLimitsByChainId(context.Background(), &types.QueryRateLimitsByChainIdRequest{
+		ChainId: "chain-cached",
+	})
+	s.Require().NoError(err, "no error expected when querying the cached chain ID")
+	s.Require().Equal([]types.RateLimit{rateLimits[0]}, queryResponse.RateLimits)
+
+	// And the cached chain ID should be used if the client state can't be read
+	s.App.RatelimitKeeper.SetRateLimit(s.Ctx, types.RateLimit{Path: &types.Path{Denom: "denom", ChannelId: "channel-3"}})
+	s.App.RatelimitKeeper.SetChannelChainId(s.Ctx, "channel-3", "chain-3")
+	queryResponse, err = s.QueryClient.RateLimitsByChainId(context.Background(), &types.QueryRateLimitsByChainIdRequest{
+		ChainId: "chain-3",
+	})
+	s.Require().NoError(err, "no error expected when querying the cached chain ID")
+	s.Require().Equal([]types.RateLimit{{Path: &types.Path{Denom: "denom", ChannelId: "channel-3"}}}, queryResponse.RateLimits)
+}
+
+func (s *KeeperTestSuite) TestUpdateRateLimit_RefreshesChainId() {
+	s.createChannelWithClient(addRateLimitMsg.ChannelId, "connection-0", "07-tendermint-0", newTendermintClientState("chain-0"))
+	s.createChannelValue(addRateLimitMsg.Denom, sdkmath.NewInt(100))
+
+	addMsg := addRateLimitMsg
+	err := s.App.RatelimitKeeper.AddRateLimit(s.Ctx, &addMsg)
+	s.Require().NoError(err, "no error expected when adding rate limit")
+
+	// Upgrade the counterparty chain ID, the cache should be refreshed when the rate limit is updated
+	s.App.IBCKeeper.ClientKeeper.SetClientState(s.Ctx, "07-tendermint-0", newTendermintClientState("chain-1"))
+
+	updateMsg := updateRateLimitMsg
+	err = s.App.RatelimitKeeper.UpdateRateLimit(s.Ctx, &updateMsg)
+	s.Require().NoError(err, "no error expected when updating rate limit")
+
+	chainId, found := s.App.RatelimitKeeper.GetChannelChainId(s.Ctx, addMsg.ChannelId)
+	s.Require().True(found, "chain ID should be cached after updating rate limit")
+	s.Require().Equal("chain-1", chainId, "cached chain ID")
+}
+
+func (s *KeeperTestSuite) TestRemoveRateLimit_RemovesChainId() {
+	for _, denom := range []string{"denom-A", "denom-B"} {
+		s.App.RatelimitKeeper.SetRateLimit(s.Ctx, types.RateLimit{Path: &types.Path{Denom: denom, ChannelId: "channel-0"}})
+	}
+	s.App.RatelimitKeeper.SetChannelChainId(s.Ctx, "channel-0", "chain-0")
+
+	// The chain ID should be kept while the channel still has a rate limit
+	s.App.RatelimitKeeper.RemoveRateLimit(s.Ctx, "denom-A", "channel-0")
+	_, found := s.App.RatelimitKeeper.GetChannelChainId(s.Ctx, "channel-0")
+	s.Require().True(found, "chain ID should be kept after removing the first rate limit")
+
+	// And removed with the last rate limit
+	s.App.RatelimitKeeper.RemoveRateLimit(s.Ctx, "denom-B", "channel-0")
+	_, found = s.App.RatelimitKeeper.GetChannelChainId(s.Ctx, "channel-0")
+	s.Require().False(found, "chain ID should be removed after removing the last rate limit")
+}
@@ -0,0 +1,127 @@
+package keeper
+
+import (
+	errorsmod "cosmossdk.io/errors"
+	"github.com/cosmos/cosmos-sdk/store/prefix"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	"github.com/Stride-Labs/ibc-rate-limiting/ratelimit/types"
+)
+
+// Stores/Updates a denom group, along with the group ID of each of its denoms
+// If the group already exists, denoms that were removed from the group are no longer
+// mapped to it
+func (k Keeper) SetDenomGroup(ctx sdk.Context, group types.DenomGroup) {
+	if existingGroup, found := k.GetDenomGroup(ctx, group.GroupId); found {
+		k.removeDenomGroupMembers(ctx, existingGroup)
+	}
+
+	groupStore := prefix.NewStore(ctx.KVStore(k.storeKey), types.DenomGroupKeyPrefix)
+	groupStore.Set(types.KeyPrefix(group.GroupId), k.cdc.MustMarshal(&group))
+
+	memberStore := prefix.NewStore(ctx.KVStore(k.storeKey), types.DenomGroupMemberKeyPrefix)
+	for _, denom := range group.Denoms {
+		memberStore.Set(types.KeyPrefix(denom), types.KeyPrefix(group.GroupId))
+	}
+}
+
+// Removes a denom group, and the group ID of each of its denoms, from the store
+func (k Keeper) RemoveDenomGroup(ctx sdk.Context, groupId string) {
+	group, found := k.GetDenomGroup(ctx, groupId)
+	if !found {
+		return
+	}
+	k.removeDenomGroupMembers(ctx, group)
+
+	groupStore := prefix.NewStore(ctx.KVStore(k.storeKey), types.DenomGroupKeyPrefix)
+	groupStore.Delete(types.KeyPrefix(groupId))
+}
+
+// Removes the group ID of each denom in a group
+func (k Keeper) removeDenomGroupMembers(ctx sdk.Context, group types.DenomGroup) {
+	memberStore := prefix.NewStore(ctx.KVStore(k.storeKey), types.DenomGroupMemberKeyPrefix)
+	for _, denom := range group.Denoms {
+		memberStore.Delete(types.KeyPrefix(denom))
+	}
+}
+
+// Grabs and returns a denom group from the store using the group ID
+func (k Keeper) GetDenomGroup(ctx sdk.Context, groupId string) (group types.DenomGroup, found bool) {
+	store := prefix.NewStore(ctx.KVStore(k.storeKey), types.DenomGroupKeyPrefix)
+
+	value := store.Get(types.KeyPrefix(groupId))
+	if len(value) == 0 {
+		return group, false
+	}
+
+	k.cdc.MustUnmarshal(value, &group)
+	return group, true
+}
+
+// Returns all denom groups
+func (k Keeper) GetAllDenomGroups(ctx sdk.Context) []types.DenomGroup {
+	store := prefix.NewStore(ctx.KVStore(k.storeKey), types.DenomGroupKeyPrefix)
+
+	iterator := store.Iterator(nil, nil)
+	defer iterator.Close()
+
+	allGroups := []types.DenomGroup{}
+	for ; iterator.Valid(); iterator.Next() {
+		group := types.DenomGroup{}
+		k.cdc.MustUnmarshal(iterator.Value(), &group)
+		allGroups = append(allGroups, group)
+	}
+
+	return allGroups
+}
+
+// Returns the ID of the group that a denom belongs to, if any
+func (k Keeper) GetDenomGroupId(ctx sdk.Context, denom string) (groupId string, found bool) {
+	store := prefix.NewStore(ctx.KVStore(k.storeKey), types.DenomGroupMemberKeyPrefix)
+
+	value := store.Get(types.KeyPrefix(denom))
+	if len(value) == 0 {
+		return "", false
+	}
+	return string(value), true
+}
+
+// Returns the denom that a transfer of the given denom is rate limited under
+// If the denom belongs to a group, this is the group ID, otherwise it's the denom itself
+func (k Keeper) GetRateLimitDenom(ctx sdk.Context, denom string) string {
+	if groupId, found := k.GetDenomGroupId(ctx, denom); found {
+		return groupId
+	}
+	return denom
+}
+
+// Returns the denoms that make up the denom of a rate limit
+// If the rate limit is for a denom group, these are the group's denoms, otherwise
+// it's just the denom itself
+func (k Keeper) GetRateLimitDenomMembers(ctx sdk.Context, denom string) []string {
+	if !types.IsDenomGroupId(denom) {
+		return []string{denom}
+	}
+	group, found := k.GetDenomGroup(ctx, denom)
+	if !found {
+		return []string{}
+	}
+	return group.Denoms
+}
+
+// Confirms a denom group can be stored without conflicting with the existing groups
+// and rate limits. Each denom can only belong to one group, and can't have its own
+// rate limit (since it would no longer be used once the denom is grouped)
+func (k Keeper) ValidateDenomGroupConflicts(ctx sdk.Context, group types.DenomGroup) error {
+	for _, denom := range group.Denoms {
+		if otherGroupId, found := k.GetDenomGroupId(ctx, denom); found && otherGroupId != group.GroupId {
+			return errorsmod.Wrapf(types.ErrDenomGroupConflict,
+				"denom %s already belongs to group %s", denom, otherGroupId)
+		}
+		if len(k.GetRateLimitsByDenom(ctx, denom)) > 0 {
+			return errorsmod.Wrapf(types.ErrDenomGroupConflict,
+				"denom %s has its own rate limit, which must be removed before it can be grouped", denom)
+		}
+	}
+	return nil
+}
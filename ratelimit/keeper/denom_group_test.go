@@ -0,0 +1,131 @@
+package keeper_test
+
+import (
+	sdkmath "cosmossdk.io/math"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	minttypes "github.com/cosmos/cosmos-sdk/x/mint/types"
+
+	"github.com/Stride-Labs/ibc-rate-limiting/ratelimit/keeper"
+	"github.com/Stride-Labs/ibc-rate-limiting/ratelimit/types"
+)
+
+const (
+	atomGroupId   = "group/atom"
+	atomDirect    = "ibc/atom-direct"
+	atomOsmosis   = "ibc/atom-osmosis"
+	ungroupedAtom = "ibc/atom-other"
+)
+
+func (s *KeeperTestSuite) TestDenomGroups() {
+	group := types.DenomGroup{GroupId: atomGroupId, Denoms: []string{atomDirect, atomOsmosis}}
+	s.App.RatelimitKeeper.SetDenomGroup(s.Ctx, group)
+
+	actualGroup, found := s.App.RatelimitKeeper.GetDenomGroup(s.Ctx, atomGroupId)
+	s.Require().True(found, "group should have been found")
+	s.Require().Equal(group, actualGroup)
+	s.Require().Equal([]types.DenomGroup{group}, s.App.RatelimitKeeper.GetAllDenomGroups(s.Ctx))
+
+	// Each member should resolve to the group, and all other denoms should resolve to themselves
+	s.Require().Equal(atomGroupId, s.App.RatelimitKeeper.GetRateLimitDenom(s.Ctx, atomDirect), "direct denom")
+	s.Require().Equal(atomGroupId, s.App.RatelimitKeeper.GetRateLimitDenom(s.Ctx, atomOsmosis), "osmosis denom")
+	s.Require().Equal(ungroupedAtom, s.App.RatelimitKeeper.GetRateLimitDenom(s.Ctx, ungroupedAtom), "ungrouped denom")
+	s.Require().Equal(atomGroupId, s.App.RatelimitKeeper.GetRateLimitDenom(s.Ctx, atomGroupId), "group id")
+
+	s.Require().Equal(group.Denoms, s.App.RatelimitKeeper.GetRateLimitDenomMembers(s.Ctx, atomGroupId), "group members")
+	s.Require().Equal([]string{atomDirect}, s.App.RatelimitKeeper.GetRateLimitDenomMembers(s.Ctx, atomDirect), "denom members")
+
+	// Replace one of the group's denoms
+	group.Denoms = []string{atomDirect, ungroupedAtom}
+	s.App.RatelimitKeeper.SetDenomGroup(s.Ctx, group)
+	s.Require().Equal(atomOsmosis, s.App.RatelimitKeeper.GetRateLimitDenom(s.Ctx, atomOsmosis), "removed denom")
+	s.Require().Equal(atomGroupId, s.App.RatelimitKeeper.GetRateLimitDenom(s.Ctx, ungroupedAtom), "added denom")
+
+	// Remove the group
+	s.App.RatelimitKeeper.RemoveDenomGroup(s.Ctx, atomGroupId)
+	_, found = s.App.RatelimitKeeper.GetDenomGroup(s.Ctx, atomGroupId)
+	s.Require().False(found, "group should have been removed")
+	s.Require().Equal(atomDirect, s.App.RatelimitKeeper.GetRateLimitDenom(s.Ctx, atomDirect), "denom after removal")
+}
+
+// Adds a rate limit for a group of two denoms with a 10% threshold, where each denom has a
+// supply of 500 (for a combined channel value of 1000)
+func (s *KeeperTestSuite) setupDenomGroupRateLimit() {
+	s.App.RatelimitKeeper.SetDenomGroup(s.Ctx, types.DenomGroup{GroupId: atomGroupId, Denoms: []string{atomDirect, atomOsmosis}})
+
+	for _, denom := range []string{atomDirect, atomOsmosis} {
+		err := s.App.BankKeeper.MintCoins(s.Ctx, minttypes.ModuleName, sdk.NewCoins(sdk.NewCoin(denom, sdkmath.NewInt(500))))
+		s.Require().NoError(err)
+	}
+	s.createChannel(channelId)
+
+	err := s.App.RatelimitKeeper.AddRateLimit(s.Ctx, &types.MsgAddRateLimit{
+		Denom:          atomGroupId,
+		ChannelId:      channelId,
+		MaxPercentSend: sdkmath.NewInt(10),
+		MaxPercentRecv: sdkmath.NewInt(10),
+		DurationHours:  24,
+	})
+	s.Require().NoError(err, "no error expected when adding group rate limit")
+}
+
+func (s *KeeperTestSuite) TestAddRateLimit_DenomGroup() {
+	// A rate limit can't be added for a group that doesn't exist
+	err := s.App.RatelimitKeeper.AddRateLimit(s.Ctx, &types.MsgAddRateLimit{Denom: atomGroupId, ChannelId: channelId})
+	s.Require().ErrorIs(err, types.ErrDenomGroupNotFound)
+
+	// The channel value of the group should be the combined supply of its denoms
+	s.setupDenomGroupRateLimit()
+	rateLimit, found := s.App.RatelimitKeeper.GetRateLimit(s.Ctx, atomGroupId, channelId)
+	s.Require().True(found, "group rate limit should have been found")
+	s.Require().Equal(int64(1000), rateLimit.Flow.ChannelValue.Int64(), "channel value")
+
+	// A rate limit can't be added for a denom in the group
+	err = s.App.RatelimitKeeper.AddRateLimit(s.Ctx, &types.MsgAddRateLimit{Denom: atomDirect, ChannelId: channelId})
+	s.Require().ErrorIs(err, types.ErrDenomGroupConflict)
+}
+
+func (s *KeeperTestSuite) TestCheckRateLimitAndUpdateFlow_DenomGroup() {
+	s.setupDenomGroupRateLimit()
+
+	sendPacket := func(denom string, amount int64) error {
+		_, err := s.App.RatelimitKeeper.CheckRateLimitAndUpdateFlow(s.Ctx, types.PACKET_SEND, keeper.RateLimitedPacketInfo{
+			ChannelID: channelId,
+			Denom:     denom,
+			Amount:    sdkmath.NewInt(amount),
+			Sender:    sender,
+			Receiver:  receiver,
+		})
+		return err
+	}
+
+	// Transfers of each denom in the group count towards the shared quota (10% of 1000)
+	s.Require().NoError(sendPacket(atomDirect, 60), "first send within quota")
+	s.Require().NoError(sendPacket(atomOsmosis, 40), "second send within quota")
+	s.Require().ErrorIs(sendPacket(atomDirect, 1), types.ErrQuotaExceeded, "third send exceeds quota")
+
+	rateLimit, found := s.App.RatelimitKeeper.GetRateLimit(s.Ctx, atomGroupId, channelId)
+	s.Require().True(found)
+	s.Require().Equal(int64(100), rateLimit.Flow.Outflow.Int64(), "group outflow")
+
+	// Transfers of a denom outside the group are not rate limited
+	s.Require().NoError(sendPacket(ungroupedAtom, 1000), "ungrouped send")
+}
+
+func (s *KeeperTestSuite) TestUndoSendPacket_DenomGroup() {
+	s.setupDenomGroupRateLimit()
+
+	rateLimit, found := s.App.RatelimitKeeper.GetRateLimit(s.Ctx, atomGroupId, channelId)
+	s.Require().True(found)
+	rateLimit.Flow.Outflow = sdkmath.NewInt(30)
+	s.App.RatelimitKeeper.SetRateLimit(s.Ctx, rateLimit)
+
+	// A legacy pending packet without an amount should fall back to the packet's denom,
+	// which is resolved to the group
+	s.App.RatelimitKeeper.SetPendingSendPacketRecord(s.Ctx, types.PendingSendPacket{ChannelId: channelId, Sequence: 1})
+	err := s.App.RatelimitKeeper.UndoSendPacket(s.Ctx, channelId, 1, atomOsmosis, sdkmath.NewInt(10))
+	s.Require().NoError(err)
+
+	rateLimit, found = s.App.RatelimitKeeper.GetRateLimit(s.Ctx, atomGroupId, channelId)
+	s.Require().True(found)
+	s.Require().Equal(int64(20), rateLimit.Flow.Outflow.Int64(), "group outflow after refund")
+}
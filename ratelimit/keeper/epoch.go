@@ -1,6 +1,8 @@
 package keeper
 
 import (
+	"time"
+
 	sdk "github.com/cosmos/cosmos-sdk/types"
 
 	"github.com/Stride-Labs/ibc-rate-limiting/ratelimit/types"
@@ -46,3 +48,44 @@ func (k Keeper) CheckHourEpochStarting(ctx sdk.Context) (epochStarting bool, epo
 	// Otherwise, indicate that a new epoch is not starting
 	return false, 0
 }
+
+// Returns the ID of the quota window that the current hour epoch falls in, for a rate limit
+// with the given quota (i.e. the number of times the quota has been reset)
+// Rate limits without a duration are never reset, and always stay in window 0
+func (k Keeper) GetQuotaWindowId(ctx sdk.Context, quota types.Quota) uint64 {
+	return quota.GetWindowId(k.GetHourEpoch(ctx).EpochNumber)
+}
+
+// Returns the next hour epoch at which a rate limit with the given quota will be reset,
+// as well as the time at which that epoch will start
+// A rate limit is reset at the start of each epoch where (epochNumber - WindowOffset) is
+// divisible by its duration
+// Returns false if the duration is zero, since the rate limit is never reset
+func (k Keeper) GetNextResetEpoch(ctx sdk.Context, quota types.Quota) (epochNumber uint64, startTime time.Time, found bool) {
+	hourEpoch := k.GetHourEpoch(ctx)
+	epochNumber, found = quota.GetNextResetEpoch(hourEpoch.EpochNumber)
+	if !found {
+		return 0, time.Time{}, false
+	}
+
+	epochsUntilReset := time.Duration(epochNumber - hourEpoch.EpochNumber)
+	startTime = hourEpoch.EpochStartTime.Add(epochsUntilReset * hourEpoch.Duration)
+
+	return epochNumber, startTime, true
+}
+
+// Returns the hour epoch at which the current window of a rate limit with the given quota
+// started, as well as the time at which that epoch started
+// Returns false if the duration is zero, since the rate limit is never reset
+func (k Keeper) GetWindowStartEpoch(ctx sdk.Context, quota types.Quota) (epochNumber uint64, startTime time.Time, found bool) {
+	hourEpoch := k.GetHourEpoch(ctx)
+	epochNumber, found = quota.GetWindowStartEpoch(hourEpoch.EpochNumber)
+	if !found {
+		return 0, time.Time{}, false
+	}
+
+	epochsSinceStart := time.Duration(hourEpoch.EpochNumber - epochNumber)
+	startTime = hourEpoch.EpochStartTime.Add(-epochsSinceStart * hourEpoch.Duration)
+
+	return epochNumber, startTime, true
+}
@@ -1,6 +1,7 @@
 package keeper_test
 
 import (
+	"fmt"
 	"time"
 
 	"github.com/Stride-Labs/ibc-rate-limiting/ratelimit/types"
@@ -95,3 +96,74 @@ func (s *KeeperTestSuite) TestCheckHourEpochStarting() {
 		})
 	}
 }
+
+func (s *KeeperTestSuite) TestGetNextResetEpoch() {
+	epochStartTime := time.Date(2024, 1, 1, 0, 0, 0, 0, time.UTC)
+	s.App.RatelimitKeeper.SetHourEpoch(s.Ctx, types.HourEpoch{
+		EpochNumber:    10,
+		EpochStartTime: epochStartTime,
+		Duration:       time.Hour,
+	})
+
+	testCases := []struct {
+		durationHours     uint64
+		windowOffset      uint64
+		expectedFound     bool
+		expectedEpoch     uint64
+		expectedStartTime time.Time
+	}{
+		{durationHours: 0, expectedFound: false},
+		{durationHours: 1, expectedFound: true, expectedEpoch: 11, expectedStartTime: epochStartTime.Add(time.Hour)},
+		{durationHours: 4, expectedFound: true, expectedEpoch: 12, expectedStartTime: epochStartTime.Add(2 * time.Hour)},
+		{durationHours: 5, expectedFound: true, expectedEpoch: 15, expectedStartTime: epochStartTime.Add(5 * time.Hour)},
+		{durationHours: 10, expectedFound: true, expectedEpoch: 20, expectedStartTime: epochStartTime.Add(10 * time.Hour)},
+		{durationHours: 24, expectedFound: true, expectedEpoch: 24, expectedStartTime: epochStartTime.Add(14 * time.Hour)},
+		{durationHours: 4, windowOffset: 1, expectedFound: true, expectedEpoch: 13, expectedStartTime: epochStartTime.Add(3 * time.Hour)},
+		{durationHours: 4, windowOffset: 3, expectedFound: true, expectedEpoch: 11, expectedStartTime: epochStartTime.Add(time.Hour)},
+		{durationHours: 24, windowOffset: 12, expectedFound: true, expectedEpoch: 12, expectedStartTime: epochStartTime.Add(2 * time.Hour)},
+	}
+
+	for _, tc := range testCases {
+		quota := types.Quota{DurationHours: tc.durationHours, WindowOffset: tc.windowOffset}
+		epochNumber, startTime, found := s.App.RatelimitKeeper.GetNextResetEpoch(s.Ctx, quota)
+		context := fmt.Sprintf("duration %d, offset %d", tc.durationHours, tc.windowOffset)
+		s.Require().Equal(tc.expectedFound, found, "found - %s", context)
+		s.Require().Equal(tc.expectedEpoch, epochNumber, "epoch number - %s", context)
+		s.Require().Equal(tc.expectedStartTime, startTime, "start time - %s", context)
+	}
+}
+
+func (s *KeeperTestSuite) TestGetWindowStartEpoch() {
+	epochStartTime := time.Date(2024, 1, 1, 0, 0, 0, 0, time.UTC)
+	s.App.RatelimitKeeper.SetHourEpoch(s.Ctx, types.HourEpoch{
+		EpochNumber:    10,
+		EpochStartTime: epochStartTime,
+		Duration:       time.Hour,
+	})
+
+	testCases := []struct {
+		durationHours     uint64
+		windowOffset      uint64
+		expectedFound     bool
+		expectedEpoch     uint64
+		expectedStartTime time.Time
+	}{
+		{durationHours: 0, expectedFound: false},
+		{durationHours: 1, expectedFound: true, expectedEpoch: 10, expectedStartTime: epochStartTime},
+		{durationHours: 4, expectedFound: true, expectedEpoch: 8, expectedStartTime: epochStartTime.Add(-2 * time.Hour)},
+		{durationHours: 5, expectedFound: true, expectedEpoch: 10, expectedStartTime: epochStartTime},
+		{durationHours: 4, windowOffset: 1, expectedFound: true, expectedEpoch: 9, expectedStartTime: epochStartTime.Add(-1 * time.Hour)},
+		{durationHours: 4, windowOffset: 3, expectedFound: true, expectedEpoch: 7, expectedStartTime: epochStartTime.Add(-3 * time.Hour)},
+		// The first reset epoch (12) hasn't been reached yet, so the window started at epoch 0
+		{durationHours: 24, windowOffset: 12, expectedFound: true, expectedEpoch: 0, expectedStartTime: epochStartTime.Add(-10 * time.Hour)},
+	}
+
+	for _, tc := range testCases {
+		quota := types.Quota{DurationHours: tc.durationHours, WindowOffset: tc.windowOffset}
+		epochNumber, startTime, found := s.App.RatelimitKeeper.GetWindowStartEpoch(s.Ctx, quota)
+		context := fmt.Sprintf("duration %d, offset %d", tc.durationHours, tc.windowOffset)
+		s.Require().Equal(tc.expectedFound, found, "found - %s", context)
+		s.Require().Equal(tc.expectedEpoch, epochNumber, "epoch number - %s", context)
+		s.Require().Equal(tc.expectedStartTime, startTime, "start time - %s", context)
+	}
+}
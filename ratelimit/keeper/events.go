@@ -1,6 +1,7 @@
 package keeper
 
 import (
+	"strconv"
 	"strings"
 
 	sdkmath "cosmossdk.io/math"
@@ -23,3 +24,30 @@ func EmitTransferDeniedEvent(ctx sdk.Context, reason, denom, channelId string, d
 		),
 	)
 }
+
+// Emits an event when a scheduled update is applied, or fails to be applied
+func EmitScheduledUpdateEvent(ctx sdk.Context, eventType string, scheduledUpdate types.ScheduledRateLimitUpdate, err error) {
+	attributes := []sdk.Attribute{
+		sdk.NewAttribute(sdk.AttributeKeyModule, types.ModuleName),
+		sdk.NewAttribute(types.AttributeKeyScheduleId, strconv.FormatUint(scheduledUpdate.ScheduleId, 10)),
+		sdk.NewAttribute(types.AttributeKeyDenom, scheduledUpdate.Update.Denom),
+		sdk.NewAttribute(types.AttributeKeyChannel, scheduledUpdate.Update.ChannelId),
+	}
+	if err != nil {
+		attributes = append(attributes, sdk.NewAttribute(types.AttributeKeyError, err.Error()))
+	}
+	ctx.EventManager().EmitEvent(sdk.NewEvent(eventType, attributes...))
+}
+
+// Emits an event when a rate limit in a batch is skipped because its channel value is zero
+func EmitRateLimitUpsertSkippedEvent(ctx sdk.Context, denom, channelId string) {
+	ctx.EventManager().EmitEvent(
+		sdk.NewEvent(
+			types.EventRateLimitUpsertSkipped,
+			sdk.NewAttribute(sdk.AttributeKeyModule, types.ModuleName),
+			sdk.NewAttribute(types.AttributeKeyDenom, denom),
+			sdk.NewAttribute(types.AttributeKeyChannel, channelId),
+			sdk.NewAttribute(types.AttributeKeyReason, types.ErrZeroChannelValue.Error()),
+		),
+	)
+}
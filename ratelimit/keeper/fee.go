@@ -0,0 +1,20 @@
+package keeper
+
+import (
+	ibcfeetypes "github.com/cosmos/ibc-go/v7/modules/apps/29-fee/types"
+)
+
+// Returns the underlying app version of a channel that was opened with the ICS-29 fee middleware
+// If the rate limit keeper's ICS4Wrapper is the channel keeper (i.e. core IBC -> ratelimit -> fee -> transfer),
+// the version will still be wrapped with the fee version (e.g. {"fee_version":"ics29-1","app_version":"ics20-1"})
+// If the version is not fee-wrapped, it's returned as is
+func UnwrapFeeVersion(version string) string {
+	var metadata ibcfeetypes.Metadata
+	if err := ibcfeetypes.ModuleCdc.UnmarshalJSON([]byte(version), &metadata); err != nil {
+		return version
+	}
+	if metadata.FeeVersion != ibcfeetypes.Version {
+		return version
+	}
+	return metadata.AppVersion
+}
@@ -0,0 +1,240 @@
+package keeper_test
+
+import (
+	"encoding/json"
+
+	sdkmath "cosmossdk.io/math"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	minttypes "github.com/cosmos/cosmos-sdk/x/mint/types"
+	ibcfee "github.com/cosmos/ibc-go/v7/modules/apps/29-fee"
+	ibcfeetypes "github.com/cosmos/ibc-go/v7/modules/apps/29-fee/types"
+	"github.com/cosmos/ibc-go/v7/modules/apps/transfer"
+	transfertypes "github.com/cosmos/ibc-go/v7/modules/apps/transfer/types"
+	channeltypes "github.com/cosmos/ibc-go/v7/modules/core/04-channel/types"
+	porttypes "github.com/cosmos/ibc-go/v7/modules/core/05-port/types"
+
+	"github.com/Stride-Labs/ibc-rate-limiting/ratelimit"
+	"github.com/Stride-Labs/ibc-rate-limiting/ratelimit/keeper"
+	"github.com/Stride-Labs/ibc-rate-limiting/ratelimit/types"
+)
+
+// Helper function to build a successful or failed ack, optionally wrapped by the fee middleware
+func buildAck(success bool, feeWrapped bool) []byte {
+	var ack channeltypes.Acknowledgement
+	if success {
+		ack = channeltypes.NewResultAcknowledgement([]byte{1})
+	} else {
+		ack = channeltypes.NewErrorAcknowledgement(types.ErrQuotaExceeded)
+	}
+
+	if !feeWrapped {
+		return ack.Acknowledgement()
+	}
+	return ibcfeetypes.NewIncentivizedAcknowledgement("relayer", ack.Acknowledgement(), ack.Success()).Acknowledgement()
+}
+
+func (s *KeeperTestSuite) TestUnwrapFeeVersion() {
+	feeVersion := string(ibcfeetypes.ModuleCdc.MustMarshalJSON(&ibcfeetypes.Metadata{
+		FeeVersion: ibcfeetypes.Version,
+		AppVersion: transfertypes.Version,
+	}))
+	invalidFeeVersion := string(ibcfeetypes.ModuleCdc.MustMarshalJSON(&ibcfeetypes.Metadata{
+		FeeVersion: "ics29-100",
+		AppVersion: transfertypes.Version,
+	}))
+
+	s.Require().Equal(transfertypes.Version, keeper.UnwrapFeeVersion(feeVersion), "fee wrapped version")
+	s.Require().Equal(transfertypes.Version, keeper.UnwrapFeeVersion(transfertypes.Version), "unwrapped version")
+	s.Require().Equal(invalidFeeVersion, keeper.UnwrapFeeVersion(invalidFeeVersion), "unknown fee version")
+}
+
+func (s *KeeperTestSuite) TestCheckAcknowledementSucceeded() {
+	testCases := []struct {
+		name            string
+		ack             []byte
+		expectedSuccess bool
+		expectedError   string
+	}{
+		{
+			name:            "successful ack",
+			ack:             buildAck(true, false),
+			expectedSuccess: true,
+		},
+		{
+			name:            "failed ack",
+			ack:             buildAck(false, false),
+			expectedSuccess: false,
+		},
+		{
+			name:            "fee wrapped successful ack",
+			ack:             buildAck(true, true),
+			expectedSuccess: true,
+		},
+		{
+			name:            "fee wrapped failed ack",
+			ack:             buildAck(false, true),
+			expectedSuccess: false,
+		},
+		{
+			name:          "invalid ack",
+			ack:           []byte("invalid"),
+			expectedError: "cannot unmarshal ICS-20 transfer packet acknowledgement",
+		},
+		{
+			name:          "fee wrapped invalid ack",
+			ack:           ibcfeetypes.NewIncentivizedAcknowledgement("relayer", []byte(`{}`), false).Acknowledgement(),
+			expectedError: "unsupported acknowledgement response field type",
+		},
+	}
+
+	for _, tc := range testCases {
+		s.Run(tc.name, func() {
+			success, err := s.App.RatelimitKeeper.CheckAcknowledementSucceeded(s.Ctx, tc.ack)
+			if tc.expectedError != "" {
+				s.Require().ErrorContains(err, tc.expectedError)
+				return
+			}
+			s.Require().NoError(err, "no error expected")
+			s.Require().Equal(tc.expectedSuccess, success, "ack success")
+		})
+	}
+}
+
+// Sends an acknowledgement through a full transfer stack, both for fee-enabled and regular channels
+// The stack registered in the simapp router has the fee middleware wrapped around the rate limit
+// middleware (IBC -> fee -> ratelimit -> transfer), so the ack is unwrapped before the rate limit
+// middleware receives it. The opposite ordering (IBC -> ratelimit -> fee -> transfer) is built from the
+// same keepers, in which case the rate limit middleware receives the IncentivizedAcknowledgement
+func (s *KeeperTestSuite) TestFeeMiddlewareStack_OnAcknowledgementPacket() {
+	initialOutflow := sdkmath.NewInt(100)
+	packetAmount := sdkmath.NewInt(10)
+	sequence := uint64(10)
+
+	stackOrderings := []string{"fee wrapping ratelimit", "ratelimit wrapping fee"}
+
+	testCases := []struct {
+		name            string
+		feeEnabled      bool
+		ackSuccess      bool
+		expectedOutflow sdkmath.Int
+	}{
+		{
+			name:            "successful ack on regular channel",
+			feeEnabled:      false,
+			ackSuccess:      true,
+			expectedOutflow: initialOutflow,
+		},
+		{
+			name:            "failed ack on regular channel",
+			feeEnabled:      false,
+			ackSuccess:      false,
+			expectedOutflow: initialOutflow.Sub(packetAmount),
+		},
+		{
+			name:            "successful ack on fee enabled channel",
+			feeEnabled:      true,
+			ackSuccess:      true,
+			expectedOutflow: initialOutflow,
+		},
+		{
+			name:            "failed ack on fee enabled channel",
+			feeEnabled:      true,
+			ackSuccess:      false,
+			expectedOutflow: initialOutflow.Sub(packetAmount),
+		},
+	}
+
+	for _, ordering := range stackOrderings {
+		for _, tc := range testCases {
+			s.Run(ordering+": "+tc.name, func() {
+				s.SetupTest()
+				sender := s.TestAccs[0]
+
+				// Fund the escrow account so that the transfer module can refund a failed packet
+				escrowAddress := transfertypes.GetEscrowAddress(transferPort, channelOnStride)
+				tokens := sdk.NewCoins(sdk.NewCoin(ustrd, packetAmount))
+				s.Require().NoError(s.App.BankKeeper.MintCoins(s.Ctx, minttypes.ModuleName, tokens))
+				s.Require().NoError(s.App.BankKeeper.SendCoinsFromModuleToAccount(s.Ctx, minttypes.ModuleName, escrowAddress, tokens))
+				s.App.TransferKeeper.SetTotalEscrowForDenom(s.Ctx, tokens[0])
+
+				if tc.feeEnabled {
+					s.App.IBCFeeKeeper.SetFeeEnabled(s.Ctx, transferPort, channelOnStride)
+				}
+
+				// Create a rate limit and pending packet from the original send
+				s.App.RatelimitKeeper.SetRateLimit(s.Ctx, types.RateLimit{
+					Path: &types.Path{Denom: ustrd, ChannelId: channelOnStride},
+					Flow: &types.Flow{Outflow: initialOutflow},
+				})
+				s.App.RatelimitKeeper.SetPendingSendPacketRecord(s.Ctx, types.PendingSendPacket{
+					ChannelId: channelOnStride,
+					Sequence:  sequence,
+					Amount:    tokens,
+				})
+
+				packetData, err := json.Marshal(transfertypes.FungibleTokenPacketData{
+					Denom:    ustrd,
+					Amount:   packetAmount.String(),
+					Sender:   sender.String(),
+					Receiver: receiver,
+				})
+				s.Require().NoError(err)
+				packet := channeltypes.Packet{
+					SourcePort:         transferPort,
+					SourceChannel:      channelOnStride,
+					DestinationPort:    transferPort,
+					DestinationChannel: channelOnHost,
+					Data:               packetData,
+					Sequence:           sequence,
+				}
+
+				// The counterparty only wraps the ack if the channel is fee enabled
+				ack := buildAck(tc.ackSuccess, tc.feeEnabled)
+
+				var transferStack porttypes.IBCModule
+				if ordering == "fee wrapping ratelimit" {
+					var ok bool
+					transferStack, ok = s.App.IBCKeeper.Router.GetRoute(transfertypes.ModuleName)
+					s.Require().True(ok, "transfer route should exist")
+				} else {
+					transferStack = ibcfee.NewIBCMiddleware(transfer.NewIBCModule(s.App.TransferKeeper), s.App.IBCFeeKeeper)
+					transferStack = ratelimit.NewIBCMiddleware(s.App.RatelimitKeeper, transferStack)
+				}
+
+				err = transferStack.OnAcknowledgementPacket(s.Ctx, packet, ack, s.TestAccs[1])
+				s.Require().NoError(err, "no error expected during OnAcknowledgementPacket")
+
+				// Confirm the pending packet was removed and the outflow was updated
+				_, found := s.App.RatelimitKeeper.GetPendingSendPacket(s.Ctx, channelOnStride, sequence)
+				s.Require().False(found, "pending packet should have been removed")
+
+				rateLimit, found := s.App.RatelimitKeeper.GetRateLimit(s.Ctx, ustrd, channelOnStride)
+				s.Require().True(found)
+				s.Require().Equal(tc.expectedOutflow.Int64(), rateLimit.Flow.Outflow.Int64(), "outflow")
+
+				// A failed transfer should be refunded to the sender from the escrow account
+				expectedSenderBalance := sdkmath.ZeroInt()
+				if !tc.ackSuccess {
+					expectedSenderBalance = packetAmount
+				}
+				senderBalance := s.App.BankKeeper.GetBalance(s.Ctx, sender, ustrd)
+				s.Require().Equal(expectedSenderBalance.Int64(), senderBalance.Amount.Int64(), "sender balance")
+			})
+		}
+	}
+}
+
+// Confirms the version of a fee-enabled channel is unwrapped through the transfer stack
+func (s *KeeperTestSuite) TestFeeMiddlewareStack_GetAppVersion() {
+	feeVersion := string(ibcfeetypes.ModuleCdc.MustMarshalJSON(&ibcfeetypes.Metadata{
+		FeeVersion: ibcfeetypes.Version,
+		AppVersion: transfertypes.Version,
+	}))
+	s.App.IBCKeeper.ChannelKeeper.SetChannel(s.Ctx, transferPort, channelOnStride, channeltypes.Channel{Version: feeVersion})
+	s.App.IBCFeeKeeper.SetFeeEnabled(s.Ctx, transferPort, channelOnStride)
+
+	// The rate limit keeper sits below the fee middleware in the ICS4 stack
+	version, found := s.App.RatelimitKeeper.GetAppVersion(s.Ctx, transferPort, channelOnStride)
+	s.Require().True(found, "version should be found")
+	s.Require().Equal(transfertypes.Version, version, "app version")
+}
@@ -1,11 +1,14 @@
 package keeper
 
 import (
+	"errors"
+
 	errorsmod "cosmossdk.io/errors"
 	sdkmath "cosmossdk.io/math"
 	"github.com/Stride-Labs/ibc-rate-limiting/ratelimit/types"
 	sdk "github.com/cosmos/cosmos-sdk/types"
 	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+	transfertypes "github.com/cosmos/ibc-go/v7/modules/apps/transfer/types"
 )
 
 // The total value on a given path (aka, the denominator in the percentage calculation)
@@ -14,6 +17,37 @@ func (k Keeper) GetChannelValue(ctx sdk.Context, denom string) sdkmath.Int {
 	return k.bankKeeper.GetSupply(ctx, denom).Amount
 }
 
+// Returns the balance of the denom in the ICS20 escrow account for the channel
+func (k Keeper) GetEscrowBalance(ctx sdk.Context, denom string, channelId string) sdkmath.Int {
+	escrowAddress := transfertypes.GetEscrowAddress(transfertypes.PortID, channelId)
+	return k.bankKeeper.GetBalance(ctx, escrowAddress, denom).Amount
+}
+
+// Returns the value on a given path using the rate limit's channel value strategy
+// (e.g. the total supply, the escrow balance, a fixed value, or the greater/lesser of these)
+// If no strategy is specified, the total supply of the denom is used
+// If the denom is a denom group, the supply and escrow balance are summed across the group's denoms
+func (k Keeper) GetChannelValueFromStrategy(
+	ctx sdk.Context,
+	denom string,
+	channelId string,
+	strategy *types.ChannelValueStrategy,
+) sdkmath.Int {
+	supply := sdkmath.ZeroInt()
+	escrowBalance := sdkmath.ZeroInt()
+	for _, memberDenom := range k.GetRateLimitDenomMembers(ctx, denom) {
+		supply = supply.Add(k.GetChannelValue(ctx, memberDenom))
+		if strategy != nil {
+			escrowBalance = escrowBalance.Add(k.GetEscrowBalance(ctx, memberDenom, channelId))
+		}
+	}
+
+	if strategy == nil {
+		return supply
+	}
+	return strategy.GetChannelValue(supply, escrowBalance)
+}
+
 // Adds an amount to the flow in either the SEND or RECV direction
 func (k Keeper) UpdateFlow(rateLimit types.RateLimit, direction types.PacketDirection, amount sdkmath.Int) error {
 	switch direction {
@@ -33,6 +67,18 @@ func (k Keeper) CheckRateLimitAndUpdateFlow(
 	direction types.PacketDirection,
 	packetInfo RateLimitedPacketInfo,
 ) (updatedFlow bool, err error) {
+	updatedFlow, _, err = k.checkRateLimitAndUpdateFlow(ctx, direction, packetInfo)
+	return updatedFlow, err
+}
+
+// Checks whether the given packet will exceed the rate limit, and if not, updates the flow
+// Also returns the value of the transfer that was added to the flow of the rate limit's value
+// quota (zero if it doesn't have one), so that it can be refunded if a sent packet fails
+func (k Keeper) checkRateLimitAndUpdateFlow(
+	ctx sdk.Context,
+	direction types.PacketDirection,
+	packetInfo RateLimitedPacketInfo,
+) (updatedFlow bool, value sdk.Dec, err error) {
 	denom := packetInfo.Denom
 	channelId := packetInfo.ChannelID
 	amount := packetInfo.Amount
@@ -41,49 +87,203 @@ func (k Keeper) CheckRateLimitAndUpdateFlow(
 	if k.IsDenomBlacklisted(ctx, denom) {
 		err := errorsmod.Wrapf(types.ErrDenomIsBlacklisted, "denom %s is blacklisted", denom)
 		EmitTransferDeniedEvent(ctx, types.EventBlacklistedDenom, denom, channelId, direction, amount, err)
-		return false, err
+		k.Hooks().AfterTransferDenied(ctx, types.EventBlacklistedDenom, denom, channelId, direction, amount)
+		return false, sdk.ZeroDec(), err
+	}
+
+	// Check if the transfer matches any of the governance-defined transfer rules
+	// A DENY rule rejects the transfer outright, and an EXEMPT rule skips the quota
+	rule, ruleFound := k.GetMatchingTransferRule(ctx, packetInfo)
+	if ruleFound && rule.Action == types.RULE_ACTION_DENY {
+		err := errorsmod.Wrapf(types.ErrTransferDeniedByRule, "transfer matches rule %s", rule.RuleId)
+		EmitTransferDeniedEvent(ctx, types.EventTransferRule, denom, channelId, direction, amount, err)
+		k.Hooks().AfterTransferDenied(ctx, types.EventTransferRule, denom, channelId, direction, amount)
+		return false, sdk.ZeroDec(), err
+	}
+	if ruleFound && rule.Action == types.RULE_ACTION_EXEMPT {
+		return false, sdk.ZeroDec(), nil
 	}
 
-	// If there's no rate limit yet for this denom, no action is necessary
-	rateLimit, found := k.GetRateLimit(ctx, denom, channelId)
+	// If the transfer is only passing through this chain via packet-forward-middleware,
+	// it may be excluded from the quota depending on the forwarded transfer policy
+	// This is checked before the rate limit lookup since the inbound leg must be recorded
+	// even if only the outbound channel is rate limited
+	if k.SkipForwardedTransfer(ctx, direction, packetInfo) {
+		return false, sdk.ZeroDec(), nil
+	}
+
+	// If there's no rate limit yet for this denom (or its denom group), no action is necessary
+	rateLimit, found := k.GetRateLimit(ctx, k.GetRateLimitDenom(ctx, denom), channelId)
 	if !found {
-		return false, nil
+		return false, sdk.ZeroDec(), nil
 	}
 
+	// If quotas are reset lazily and this is the first transfer in a new window, reset the flow
+	rateLimit, _ = k.ResetRateLimitIfExpired(ctx, rateLimit)
+
 	// Check if the sender/receiver pair is whitelisted
 	// If so, return a success without modifying the quota
 	if k.IsAddressPairWhitelisted(ctx, packetInfo.Sender, packetInfo.Receiver) {
-		return false, nil
+		return false, sdk.ZeroDec(), nil
+	}
+
+	// If the transfer matches a QUOTA rule, the rule's thresholds are used in place of the
+	// rate limit's quota (the flow is still shared with all other transfers on the path)
+	quotaRateLimit := rateLimit
+	if ruleFound && rule.Action == types.RULE_ACTION_QUOTA {
+		quotaRateLimit.Quota = &types.Quota{
+			MaxPercentSend: rule.Quota.MaxPercentSend,
+			MaxPercentRecv: rule.Quota.MaxPercentRecv,
+			DurationHours:  rateLimit.Quota.DurationHours,
+			ValueQuota:     rateLimit.Quota.ValueQuota,
+			WindowOffset:   rateLimit.Quota.WindowOffset,
+		}
+	}
+
+	// If the rate limit has a quota in a quote currency, check the transfer against it at the current price
+	value, err = k.CheckValueQuota(ctx, quotaRateLimit, direction, amount)
+	if err != nil {
+		reason := types.EventRateLimitExceeded
+		if errors.Is(err, types.ErrPriceNotAvailable) {
+			reason = types.EventPriceUnavailable
+		}
+		EmitTransferDeniedEvent(ctx, reason, denom, channelId, direction, amount, err)
+		k.Hooks().AfterTransferDenied(ctx, reason, denom, channelId, direction, amount)
+		return false, sdk.ZeroDec(), err
 	}
 
 	// Update the flow object with the change in amount
-	if err := k.UpdateFlow(rateLimit, direction, amount); err != nil {
+	if err := k.UpdateFlow(quotaRateLimit, direction, amount); err != nil {
 		// If the rate limit was exceeded, emit an event
 		EmitTransferDeniedEvent(ctx, types.EventRateLimitExceeded, denom, channelId, direction, amount, err)
-		return false, err
+		k.Hooks().AfterTransferDenied(ctx, types.EventRateLimitExceeded, denom, channelId, direction, amount)
+		return false, sdk.ZeroDec(), err
 	}
 
 	// If there's no quota error, update the rate limit object in the store with the new flow
+	// (including the value of the transfer if it has a value quota)
+	if value.IsPositive() {
+		rateLimit.Flow.AddValueFlow(direction, value)
+	}
 	k.SetRateLimit(ctx, rateLimit)
+	k.Hooks().AfterFlowUpdated(ctx, rateLimit, direction, amount)
 
-	return true, nil
+	return true, value, nil
+}
+
+// Checks whether a transfer would be allowed, without updating the flow
+// The same checks as CheckRateLimitAndUpdateFlow are run against a cached context that
+// is discarded afterwards, and the hooks are not invoked
+// If the transfer counts towards a rate limit, the remaining capacity (before the transfer)
+// in the given direction is also returned
+// The denom may be given as a full denom trace, in which case it's hashed (as in RateLimitsByDenom)
+func (k Keeper) CheckTransferAllowed(
+	ctx sdk.Context,
+	direction types.PacketDirection,
+	packetInfo RateLimitedPacketInfo,
+) types.QueryCheckTransferResponse {
+	packetInfo.Denom = ParseDenomFromTrace(packetInfo.Denom)
+
+	// Since the keeper has a value receiver, this only clears the hooks for this check
+	k.hooks = nil
+	cacheCtx, _ := ctx.CacheContext()
+	cacheCtx = cacheCtx.WithEventManager(sdk.NewEventManager())
+
+	updatedFlow, err := k.CheckRateLimitAndUpdateFlow(cacheCtx, direction, packetInfo)
+	response := types.QueryCheckTransferResponse{
+		Allowed:           err == nil,
+		RateLimited:       updatedFlow || errors.Is(err, types.ErrQuotaExceeded) || errors.Is(err, types.ErrPriceNotAvailable),
+		RemainingCapacity: sdkmath.ZeroInt(),
+	}
+
+	if err != nil {
+		response.Error = err.Error()
+		switch {
+		case errors.Is(err, types.ErrDenomIsBlacklisted):
+			response.Reason = types.EventBlacklistedDenom
+		case errors.Is(err, types.ErrTransferDeniedByRule):
+			response.Reason = types.EventTransferRule
+		case errors.Is(err, types.ErrQuotaExceeded):
+			response.Reason = types.EventRateLimitExceeded
+		case errors.Is(err, types.ErrPriceNotAvailable):
+			response.Reason = types.EventPriceUnavailable
+		}
+	}
+
+	if !response.RateLimited {
+		return response
+	}
+
+	// The capacity is determined from the flow before the transfer, using the quota
+	// from a matching QUOTA transfer rule if there is one
+	rateLimit, found := k.GetRateLimit(ctx, k.GetRateLimitDenom(ctx, packetInfo.Denom), packetInfo.ChannelID)
+	if !found {
+		return response
+	}
+	rateLimit = k.GetEffectiveRateLimit(ctx, rateLimit)
+	quota := *rateLimit.Quota
+	if rule, found := k.GetMatchingTransferRule(ctx, packetInfo); found && rule.Action == types.RULE_ACTION_QUOTA {
+		quota.MaxPercentSend = rule.Quota.MaxPercentSend
+		quota.MaxPercentRecv = rule.Quota.MaxPercentRecv
+	}
+	response.RemainingCapacity = k.GetRemainingCapacity(ctx, rateLimit, quota, direction)
+
+	return response
 }
 
 // If a SendPacket fails or times out, undo the outflow increment that happened during the send
+// The denom and amount parsed from the packet are only used if the amount charged was not
+// recorded with the pending packet
 func (k Keeper) UndoSendPacket(ctx sdk.Context, channelId string, sequence uint64, denom string, amount sdkmath.Int) error {
-	rateLimit, found := k.GetRateLimit(ctx, denom, channelId)
+	return k.RefundPendingSendPacket(ctx, channelId, sequence, sdk.Coins{sdk.Coin{Denom: denom, Amount: amount}})
+}
+
+// Decrements the value outflow of a rate limit by the value that was recorded when a packet was sent,
+// so that the same value is refunded regardless of the current price
+// The value outflow is floored at zero in case the value quota was changed since the packet was sent
+func (k Keeper) refundValueOutflow(flow *types.Flow, value sdk.Dec) {
+	if !value.IsPositive() || flow.ValueOutflow == nil {
+		return
+	}
+	valueOutflow := sdk.MaxDec(flow.ValueOutflow.Sub(value), sdk.ZeroDec())
+	flow.ValueOutflow = &valueOutflow
+}
+
+// Decrements the outflow of each rate limit that a pending packet was charged to, and removes the packet
+// If the packet is no longer pending (e.g. because each rate limit it was charged to has since been
+// reset), there's nothing to refund
+// Packets migrated from before the amount was stored don't have an amount, in which case the
+// fallback amount (parsed from the packet) is refunded instead
+// The outflow is floored at zero, so that a refund can never inflate the remaining capacity
+func (k Keeper) RefundPendingSendPacket(ctx sdk.Context, channelId string, sequence uint64, fallbackAmount sdk.Coins) error {
+	pendingPacket, found := k.GetPendingSendPacket(ctx, channelId, sequence)
 	if !found {
 		return nil
 	}
 
-	// If the packet was sent during this quota, decrement the outflow
-	// Otherwise, it can be ignored
-	if k.CheckPacketSentDuringCurrentQuota(ctx, channelId, sequence) {
-		rateLimit.Flow.Outflow = rateLimit.Flow.Outflow.Sub(amount)
-		k.SetRateLimit(ctx, rateLimit)
+	refundAmount := pendingPacket.Amount
+	if refundAmount.Empty() {
+		refundAmount = fallbackAmount
+	}
 
-		k.RemovePendingSendPacket(ctx, channelId, sequence)
+	// The fallback amount is parsed from the packet, so the denom may need to be
+	// resolved to its denom group
+	// If the rate limit is due for a lazy reset, the packet was sent in a previous window,
+	// and the reset flow should not be refunded
+	for _, coin := range refundAmount {
+		rateLimit, found := k.GetRateLimit(ctx, k.GetRateLimitDenom(ctx, coin.Denom), channelId)
+		if !found {
+			continue
+		}
+		if _, reset := k.ResetRateLimitIfExpired(ctx, rateLimit); reset {
+			continue
+		}
+		rateLimit.Flow.Outflow = sdkmath.MaxInt(rateLimit.Flow.Outflow.Sub(coin.Amount), sdkmath.ZeroInt())
+		k.refundValueOutflow(rateLimit.Flow, pendingPacket.GetValueOf(rateLimit.Path.Denom))
+		k.SetRateLimit(ctx, rateLimit)
 	}
 
+	k.RemovePendingSendPacket(ctx, channelId, sequence)
+
 	return nil
 }
@@ -6,6 +6,7 @@ import (
 	"github.com/Stride-Labs/ibc-rate-limiting/ratelimit/types"
 	sdk "github.com/cosmos/cosmos-sdk/types"
 	minttypes "github.com/cosmos/cosmos-sdk/x/mint/types"
+	transfertypes "github.com/cosmos/ibc-go/v7/modules/apps/transfer/types"
 )
 
 func (s *KeeperTestSuite) TestGetChannelValue() {
@@ -20,6 +21,142 @@ func (s *KeeperTestSuite) TestGetChannelValue() {
 	s.Require().Equal(expected, actual)
 }
 
+func (s *KeeperTestSuite) TestGetChannelValueFromStrategy() {
+	supply := sdkmath.NewInt(1000)
+	escrowBalance := sdkmath.NewInt(200)
+
+	// Mint the supply and send part of it to the channel's escrow account
+	err := s.App.BankKeeper.MintCoins(s.Ctx, minttypes.ModuleName, sdk.NewCoins(sdk.NewCoin(denom, supply)))
+	s.Require().NoError(err)
+
+	escrowAddress := transfertypes.GetEscrowAddress(transfertypes.PortID, channelId)
+	escrowCoins := sdk.NewCoins(sdk.NewCoin(denom, escrowBalance))
+	err = s.App.BankKeeper.SendCoinsFromModuleToAccount(s.Ctx, minttypes.ModuleName, escrowAddress, escrowCoins)
+	s.Require().NoError(err)
+
+	s.Require().Equal(escrowBalance.Int64(), s.App.RatelimitKeeper.GetEscrowBalance(s.Ctx, denom, channelId).Int64(),
+		"escrow balance")
+	s.Require().Zero(s.App.RatelimitKeeper.GetEscrowBalance(s.Ctx, denom, "channel-1").Int64(),
+		"escrow balance on other channel")
+
+	testCases := []struct {
+		name     string
+		strategy *types.ChannelValueStrategy
+		expected sdkmath.Int
+	}{
+		{
+			name:     "no strategy",
+			strategy: nil,
+			expected: supply,
+		},
+		{
+			name:     "escrow",
+			strategy: &types.ChannelValueStrategy{Source: types.CHANNEL_VALUE_ESCROW},
+			expected: escrowBalance,
+		},
+		{
+			name:     "fixed",
+			strategy: &types.ChannelValueStrategy{Source: types.CHANNEL_VALUE_FIXED, FixedValue: sdkmath.NewInt(500)},
+			expected: sdkmath.NewInt(500),
+		},
+		{
+			name:     "max",
+			strategy: &types.ChannelValueStrategy{Source: types.CHANNEL_VALUE_MAX},
+			expected: supply,
+		},
+		{
+			name:     "min",
+			strategy: &types.ChannelValueStrategy{Source: types.CHANNEL_VALUE_MIN},
+			expected: escrowBalance,
+		},
+	}
+
+	for _, tc := range testCases {
+		s.Run(tc.name, func() {
+			actual := s.App.RatelimitKeeper.GetChannelValueFromStrategy(s.Ctx, denom, channelId, tc.strategy)
+			s.Require().Equal(tc.expected.Int64(), actual.Int64())
+		})
+	}
+}
+
+func (s *KeeperTestSuite) TestAddRateLimit_ChannelValueStrategy() {
+	s.createChannel(addRateLimitMsg.ChannelId)
+	s.createChannelValue(addRateLimitMsg.Denom, sdkmath.NewInt(100))
+
+	// There is nothing in the escrow account, so the channel value would be zero
+	msg := addRateLimitMsg
+	msg.ChannelValueStrategy = &types.ChannelValueStrategy{Source: types.CHANNEL_VALUE_ESCROW}
+	err := s.App.RatelimitKeeper.AddRateLimit(s.Ctx, &msg)
+	s.Require().ErrorIs(err, types.ErrZeroChannelValue, "zero escrow balance")
+
+	// With a fixed value, the rate limit should be added with the fixed channel value
+	fixedValue := sdkmath.NewInt(5000)
+	msg.ChannelValueStrategy = &types.ChannelValueStrategy{Source: types.CHANNEL_VALUE_FIXED, FixedValue: fixedValue}
+	err = s.App.RatelimitKeeper.AddRateLimit(s.Ctx, &msg)
+	s.Require().NoError(err, "no error expected with fixed channel value")
+
+	rateLimit, found := s.App.RatelimitKeeper.GetRateLimit(s.Ctx, msg.Denom, msg.ChannelId)
+	s.Require().True(found)
+	s.Require().Equal(msg.ChannelValueStrategy, rateLimit.ChannelValueStrategy, "stored strategy")
+	s.Require().Equal(fixedValue.Int64(), rateLimit.Flow.ChannelValue.Int64(), "channel value")
+
+	// The strategy should be used again when the rate limit is reset
+	err = s.App.RatelimitKeeper.ResetRateLimit(s.Ctx, msg.Denom, msg.ChannelId)
+	s.Require().NoError(err)
+
+	rateLimit, found = s.App.RatelimitKeeper.GetRateLimit(s.Ctx, msg.Denom, msg.ChannelId)
+	s.Require().True(found)
+	s.Require().Equal(fixedValue.Int64(), rateLimit.Flow.ChannelValue.Int64(), "channel value after reset")
+}
+
+func (s *KeeperTestSuite) TestZeroChannelValue_UpdateAndReset() {
+	s.createChannel(addRateLimitMsg.ChannelId)
+
+	// Fund the escrow account so the rate limit can be added with the escrow strategy
+	escrowAddress := transfertypes.GetEscrowAddress(transfertypes.PortID, addRateLimitMsg.ChannelId)
+	escrowCoins := sdk.NewCoins(sdk.NewCoin(addRateLimitMsg.Denom, sdkmath.NewInt(100)))
+	s.Require().NoError(s.App.BankKeeper.MintCoins(s.Ctx, minttypes.ModuleName, escrowCoins))
+	s.Require().NoError(s.App.BankKeeper.SendCoinsFromModuleToAccount(s.Ctx, minttypes.ModuleName, escrowAddress, escrowCoins))
+
+	escrowStrategy := &types.ChannelValueStrategy{Source: types.CHANNEL_VALUE_ESCROW}
+	addMsg := addRateLimitMsg
+	addMsg.ChannelValueStrategy = escrowStrategy
+	err := s.App.RatelimitKeeper.AddRateLimit(s.Ctx, &addMsg)
+	s.Require().NoError(err, "no error expected when adding rate limit")
+
+	// Drain the escrow account so the channel value from the strategy is zero
+	s.Require().NoError(s.App.BankKeeper.SendCoins(s.Ctx, escrowAddress, s.TestAccs[0], escrowCoins))
+
+	// Updating the rate limit with a zero channel value should fail, unless the flow is preserved
+	updateMsg := updateRateLimitMsg
+	updateMsg.ChannelValueStrategy = escrowStrategy
+	for _, mode := range []types.FlowUpdateMode{types.FLOW_UPDATE_RESET, types.FLOW_UPDATE_PROPORTIONAL} {
+		updateMsg.FlowUpdateMode = mode
+		err = s.App.RatelimitKeeper.UpdateRateLimit(s.Ctx, &updateMsg)
+		s.Require().ErrorIs(err, types.ErrZeroChannelValue, "update with mode %s", mode)
+	}
+
+	updateMsg.FlowUpdateMode = types.FLOW_UPDATE_PRESERVE
+	err = s.App.RatelimitKeeper.UpdateRateLimit(s.Ctx, &updateMsg)
+	s.Require().NoError(err, "no error expected when preserving the flow")
+
+	// When the rate limit is reset, the channel value should be re-calculated as zero,
+	// and the quota should no longer be enforced
+	err = s.App.RatelimitKeeper.ResetRateLimit(s.Ctx, addMsg.Denom, addMsg.ChannelId)
+	s.Require().NoError(err, "no error expected when resetting rate limit")
+
+	rateLimit, found := s.App.RatelimitKeeper.GetRateLimit(s.Ctx, addMsg.Denom, addMsg.ChannelId)
+	s.Require().True(found)
+	s.Require().True(rateLimit.Flow.ChannelValue.IsZero(), "channel value after reset")
+
+	err = s.App.RatelimitKeeper.UpdateFlow(rateLimit, types.PACKET_SEND, sdkmath.NewInt(1000))
+	s.Require().NoError(err, "transfer with a zero channel value should not be limited")
+
+	// Now that the current channel value is zero, the update should fail even if the flow is preserved
+	err = s.App.RatelimitKeeper.UpdateRateLimit(s.Ctx, &updateMsg)
+	s.Require().ErrorIs(err, types.ErrZeroChannelValue, "update preserving a zero channel value")
+}
+
 // Adds a rate limit object to the store in preparation for the check rate limit tests
 func (s *KeeperTestSuite) SetupCheckRateLimitAndUpdateFlowTest() {
 	channelValue := sdkmath.NewInt(100)
@@ -394,7 +531,11 @@ func (s *KeeperTestSuite) TestUndoSendPacket() {
 	s.App.RatelimitKeeper.SetRateLimit(s.Ctx, rateLimit2)
 
 	// Store a pending packet sequence number of 2 for the first rate limit
-	s.App.RatelimitKeeper.SetPendingSendPacket(s.Ctx, channelId, 2)
+	s.App.RatelimitKeeper.SetPendingSendPacketRecord(s.Ctx, types.PendingSendPacket{
+		ChannelId: channelId,
+		Sequence:  2,
+		Amount:    sdk.Coins{},
+	})
 
 	// Undo a send of 10 from the first rate limit, with sequence 1
 	// If should NOT modify the outflow since sequence 1 was not sent in the current quota
@@ -414,6 +555,220 @@ func (s *KeeperTestSuite) TestUndoSendPacket() {
 	checkOutflow("different-channel", "different-denom", initialOutflow)
 
 	// Confirm sequence number was removed
-	found := s.App.RatelimitKeeper.CheckPacketSentDuringCurrentQuota(s.Ctx, channelId, 2)
+	found := s.isPendingSendPacket(channelId, 2)
+	s.Require().False(found, "packet sequence number should have been removed")
+}
+
+// The amount recorded with the pending packet should be refunded to each rate limit it was
+// charged to, rather than the amount parsed from the packet
+func (s *KeeperTestSuite) TestUndoSendPacket_RecordedAmount() {
+	initialOutflow := sdkmath.NewInt(100)
+	for _, denom := range []string{ustrd, uosmo} {
+		s.App.RatelimitKeeper.SetRateLimit(s.Ctx, types.RateLimit{
+			Path: &types.Path{Denom: denom, ChannelId: channelId},
+			Flow: &types.Flow{Outflow: initialOutflow},
+		})
+	}
+
+	// Store a pending packet that was charged to both rate limits
+	s.App.RatelimitKeeper.SetPendingSendPacketRecord(s.Ctx, types.PendingSendPacket{
+		ChannelId: channelId,
+		Sequence:  1,
+		Amount:    sdk.NewCoins(sdk.NewCoin(ustrd, sdkmath.NewInt(7)), sdk.NewCoin(uosmo, sdkmath.NewInt(3))),
+	})
+
+	// Undo the packet with a different amount - the recorded amount should be refunded instead
+	err := s.App.RatelimitKeeper.UndoSendPacket(s.Ctx, channelId, 1, ustrd, sdkmath.NewInt(50))
+	s.Require().NoError(err, "no error expected when undoing send packet")
+
+	for denom, expectedOutflow := range map[string]int64{ustrd: 93, uosmo: 97} {
+		rateLimit, found := s.App.RatelimitKeeper.GetRateLimit(s.Ctx, denom, channelId)
+		s.Require().True(found, "rate limit should have been found")
+		s.Require().Equal(expectedOutflow, rateLimit.Flow.Outflow.Int64(), "outflow for %s", denom)
+	}
+
+	found := s.isPendingSendPacket(channelId, 1)
 	s.Require().False(found, "packet sequence number should have been removed")
 }
+
+// A refund should never decrement the outflow below zero
+func (s *KeeperTestSuite) TestUndoSendPacket_FlooredAtZero() {
+	s.App.RatelimitKeeper.SetRateLimit(s.Ctx, types.RateLimit{
+		Path: &types.Path{Denom: denom, ChannelId: channelId},
+		Flow: &types.Flow{Outflow: sdkmath.NewInt(4)},
+	})
+	s.App.RatelimitKeeper.SetPendingSendPacketRecord(s.Ctx, types.PendingSendPacket{
+		ChannelId: channelId,
+		Sequence:  1,
+		Amount:    sdk.NewCoins(sdk.NewCoin(denom, sdkmath.NewInt(10))),
+	})
+
+	err := s.App.RatelimitKeeper.UndoSendPacket(s.Ctx, channelId, 1, denom, sdkmath.NewInt(10))
+	s.Require().NoError(err, "no error expected when undoing send packet")
+
+	rateLimit, found := s.App.RatelimitKeeper.GetRateLimit(s.Ctx, denom, channelId)
+	s.Require().True(found)
+	s.Require().Equal(int64(0), rateLimit.Flow.Outflow.Int64(), "outflow should be floored at zero")
+}
+
+func (s *KeeperTestSuite) TestCheckTransferAllowed() {
+	testCases := []struct {
+		name              string
+		direction         types.PacketDirection
+		channelId         string
+		amount            int64
+		initialOutflow    int64
+		blacklist         bool
+		whitelist         bool
+		expectedAllowed   bool
+		expectedReason    string
+		expectedLimited   bool
+		expectedRemaining int64
+	}{
+		{
+			name:              "send within quota",
+			direction:         types.PACKET_SEND,
+			channelId:         channelId,
+			amount:            5,
+			expectedAllowed:   true,
+			expectedLimited:   true,
+			expectedRemaining: 10,
+		},
+		{
+			name:              "send within quota with existing outflow",
+			direction:         types.PACKET_SEND,
+			channelId:         channelId,
+			amount:            2,
+			initialOutflow:    8,
+			expectedAllowed:   true,
+			expectedLimited:   true,
+			expectedRemaining: 2,
+		},
+		{
+			name:              "recv within quota with existing outflow",
+			direction:         types.PACKET_RECV,
+			channelId:         channelId,
+			amount:            15,
+			initialOutflow:    8,
+			expectedAllowed:   true,
+			expectedLimited:   true,
+			expectedRemaining: 18,
+		},
+		{
+			name:              "send exceeds quota",
+			direction:         types.PACKET_SEND,
+			channelId:         channelId,
+			amount:            3,
+			initialOutflow:    8,
+			expectedAllowed:   false,
+			expectedReason:    types.EventRateLimitExceeded,
+			expectedLimited:   true,
+			expectedRemaining: 2,
+		},
+		{
+			name:            "blacklisted denom",
+			direction:       types.PACKET_SEND,
+			channelId:       channelId,
+			amount:          1,
+			blacklist:       true,
+			expectedAllowed: false,
+			expectedReason:  types.EventBlacklistedDenom,
+		},
+		{
+			name:            "whitelisted address pair",
+			direction:       types.PACKET_SEND,
+			channelId:       channelId,
+			amount:          100,
+			whitelist:       true,
+			expectedAllowed: true,
+		},
+		{
+			name:            "no rate limit",
+			direction:       types.PACKET_SEND,
+			channelId:       "channel-99",
+			amount:          100,
+			expectedAllowed: true,
+		},
+	}
+
+	for _, tc := range testCases {
+		s.Run(tc.name, func() {
+			s.SetupTest()
+			s.SetupCheckRateLimitAndUpdateFlowTest()
+			hooks := s.setupMockHooks()
+
+			rateLimit, found := s.App.RatelimitKeeper.GetRateLimit(s.Ctx, denom, channelId)
+			s.Require().True(found)
+			rateLimit.Flow.Outflow = sdkmath.NewInt(tc.initialOutflow)
+			s.App.RatelimitKeeper.SetRateLimit(s.Ctx, rateLimit)
+
+			if tc.blacklist {
+				s.App.RatelimitKeeper.AddDenomToBlacklist(s.Ctx, denom)
+			}
+			if tc.whitelist {
+				s.App.RatelimitKeeper.SetWhitelistedAddressPair(s.Ctx, types.WhitelistedAddressPair{
+					Sender:   sender,
+					Receiver: receiver,
+				})
+			}
+
+			packetInfo := keeper.RateLimitedPacketInfo{
+				ChannelID: tc.channelId,
+				Denom:     denom,
+				Amount:    sdkmath.NewInt(tc.amount),
+				Sender:    sender,
+				Receiver:  receiver,
+			}
+			response := s.App.RatelimitKeeper.CheckTransferAllowed(s.Ctx, tc.direction, packetInfo)
+
+			s.Require().Equal(tc.expectedAllowed, response.Allowed, "allowed")
+			s.Require().Equal(tc.expectedReason, response.Reason, "reason")
+			s.Require().Equal(tc.expectedAllowed, response.Error == "", "error")
+			s.Require().Equal(tc.expectedLimited, response.RateLimited, "rate limited")
+			s.Require().Equal(tc.expectedRemaining, response.RemainingCapacity.Int64(), "remaining capacity")
+
+			// Confirm the flow was not modified, and no events or hooks were triggered
+			rateLimitAfter, found := s.App.RatelimitKeeper.GetRateLimit(s.Ctx, denom, channelId)
+			s.Require().True(found)
+			s.Require().Equal(rateLimit, rateLimitAfter, "rate limit should not be modified")
+			s.Require().Empty(s.Ctx.EventManager().Events(), "no events should be emitted")
+			s.Require().Empty(hooks.updatedFlows, "flow updated hook should not be called")
+			s.Require().Empty(hooks.deniedTransfers, "transfer denied hook should not be called")
+		})
+	}
+}
+
+func (s *KeeperTestSuite) TestCheckTransferAllowed_TracedDenom() {
+	// Add a rate limit on the hash of the traced denom
+	denomTrace := "transfer/channel-0/uatom"
+	ibcDenom := transfertypes.ParseDenomTrace(denomTrace).IBCDenom()
+	s.App.RatelimitKeeper.SetRateLimit(s.Ctx, types.RateLimit{
+		Path:  &types.Path{Denom: ibcDenom, ChannelId: channelId},
+		Quota: &types.Quota{MaxPercentSend: sdkmath.NewInt(10), MaxPercentRecv: sdkmath.NewInt(10), DurationHours: 1},
+		Flow:  &types.Flow{Inflow: sdkmath.NewInt(3), Outflow: sdkmath.ZeroInt(), ChannelValue: sdkmath.NewInt(100)},
+	})
+
+	// Check a receive with the denom trace, it should be resolved to the rate limit on the hash
+	packetInfo := keeper.RateLimitedPacketInfo{
+		ChannelID: channelId,
+		Denom:     denomTrace,
+		Amount:    sdkmath.NewInt(5),
+		Sender:    sender,
+		Receiver:  receiver,
+	}
+	response := s.App.RatelimitKeeper.CheckTransferAllowed(s.Ctx, types.PACKET_RECV, packetInfo)
+	s.Require().True(response.Allowed, "transfer within quota should be allowed")
+	s.Require().True(response.RateLimited, "traced denom should be rate limited")
+	s.Require().Equal(int64(7), response.RemainingCapacity.Int64(), "remaining capacity")
+
+	// A receive that exceeds the quota should be denied
+	packetInfo.Amount = sdkmath.NewInt(8)
+	response = s.App.RatelimitKeeper.CheckTransferAllowed(s.Ctx, types.PACKET_RECV, packetInfo)
+	s.Require().False(response.Allowed, "transfer exceeding quota should be denied")
+	s.Require().Equal(types.EventRateLimitExceeded, response.Reason, "denial reason")
+
+	// The flow should not be modified
+	rateLimit, found := s.App.RatelimitKeeper.GetRateLimit(s.Ctx, ibcDenom, channelId)
+	s.Require().True(found)
+	s.Require().Equal(int64(3), rateLimit.Flow.Inflow.Int64(), "inflow should not be modified")
+}
@@ -0,0 +1,104 @@
+package keeper
+
+import (
+	sdkmath "cosmossdk.io/math"
+	"github.com/cosmos/cosmos-sdk/store/prefix"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	channeltypes "github.com/cosmos/ibc-go/v7/modules/core/04-channel/types"
+
+	"github.com/Stride-Labs/ibc-rate-limiting/ratelimit/types"
+)
+
+// Records that a received packet is about to be forwarded out of the given channel by
+// packet-forward-middleware, so that the outbound leg can be identified when it's sent
+func (k Keeper) SetForwardedTransfer(ctx sdk.Context, denom string, channelId string, amount sdkmath.Int) {
+	store := prefix.NewStore(ctx.KVStore(k.storeKey), types.ForwardedTransferPrefix)
+	key := types.GetForwardedTransferKey(denom, channelId)
+	store.Set(key, []byte(amount.String()))
+}
+
+// Removes a forwarded transfer record after the outbound leg has been sent
+func (k Keeper) RemoveForwardedTransfer(ctx sdk.Context, denom string, channelId string) {
+	store := prefix.NewStore(ctx.KVStore(k.storeKey), types.ForwardedTransferPrefix)
+	key := types.GetForwardedTransferKey(denom, channelId)
+	store.Delete(key)
+}
+
+// Reads the amount of an in-progress forwarded transfer
+func (k Keeper) GetForwardedTransfer(ctx sdk.Context, denom string, channelId string) (amount sdkmath.Int, found bool) {
+	store := prefix.NewStore(ctx.KVStore(k.storeKey), types.ForwardedTransferPrefix)
+
+	key := types.GetForwardedTransferKey(denom, channelId)
+	value := store.Get(key)
+	if len(value) == 0 {
+		return amount, false
+	}
+
+	amount, ok := sdkmath.NewIntFromString(string(value))
+	if !ok {
+		return amount, false
+	}
+	return amount, true
+}
+
+// Determines whether a transfer should be excluded from the flow calculation because
+// it's only passing through this chain via packet-forward-middleware
+//
+// For the inbound leg, the forward metadata is read from the packet memo and, unless the
+// ForwardedTransferPolicy param is COUNT_BOTH, the inflow is skipped and the outbound hop (channel,
+// denom and amount) is recorded. The inflow is only skipped provisionally: if the outbound hop
+// is not sent by the time the rest of the stack has processed the packet, the inflow is counted
+// in SettleForwardedTransfer. This prevents a forward memo from bypassing the inflow quota on a
+// chain without packet-forward-middleware
+//
+// For the outbound leg, the send (which has no indication that it was forwarded) is matched
+// against the record and consumes it. Under the EXEMPT policy, the matched send is also skipped
+func (k Keeper) SkipForwardedTransfer(ctx sdk.Context, direction types.PacketDirection, packetInfo RateLimitedPacketInfo) bool {
+	policy := k.GetParams(ctx).ForwardedTransferPolicy
+	if policy == types.FORWARD_POLICY_COUNT_BOTH {
+		return false
+	}
+
+	if direction == types.PACKET_RECV {
+		if packetInfo.Forward == nil {
+			return false
+		}
+		k.SetForwardedTransfer(ctx, packetInfo.Denom, packetInfo.Forward.Channel, packetInfo.Amount)
+		return true
+	}
+
+	// The outbound leg is only matched if it corresponds to a transfer that was just received
+	// The forwarded amount can be less than the received amount if a forwarding fee was charged
+	forwardedAmount, found := k.GetForwardedTransfer(ctx, packetInfo.Denom, packetInfo.ChannelID)
+	if !found || packetInfo.Amount.GT(forwardedAmount) {
+		return false
+	}
+	k.RemoveForwardedTransfer(ctx, packetInfo.Denom, packetInfo.ChannelID)
+
+	return policy == types.FORWARD_POLICY_EXEMPT
+}
+
+// Called after a received packet has been processed by the rest of the stack
+// If the inflow of the packet was skipped because it had a forward memo, but the outbound
+// leg was never sent (e.g. because packet-forward-middleware is not in the stack), the record
+// is removed and the inflow is counted against the rate limit after all
+func (k Keeper) SettleForwardedTransfer(ctx sdk.Context, packet channeltypes.Packet) error {
+	if IsIcaControllerPort(packet.GetDestPort()) {
+		return nil
+	}
+
+	packetInfo, err := ParsePacketInfo(packet, types.PACKET_RECV)
+	if err != nil || packetInfo.Forward == nil {
+		return nil
+	}
+
+	if _, found := k.GetForwardedTransfer(ctx, packetInfo.Denom, packetInfo.Forward.Channel); !found {
+		return nil
+	}
+	k.RemoveForwardedTransfer(ctx, packetInfo.Denom, packetInfo.Forward.Channel)
+
+	// Without the forward metadata, the transfer is treated as a regular receive
+	packetInfo.Forward = nil
+	_, err = k.CheckRateLimitAndUpdateFlow(ctx, types.PACKET_RECV, packetInfo)
+	return err
+}
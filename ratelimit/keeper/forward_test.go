@@ -0,0 +1,279 @@
+package keeper_test
+
+import (
+	"encoding/json"
+
+	sdkmath "cosmossdk.io/math"
+	transfertypes "github.com/cosmos/ibc-go/v7/modules/apps/transfer/types"
+	channeltypes "github.com/cosmos/ibc-go/v7/modules/core/04-channel/types"
+
+	"github.com/Stride-Labs/ibc-rate-limiting/ratelimit/keeper"
+	"github.com/Stride-Labs/ibc-rate-limiting/ratelimit/types"
+)
+
+func (s *KeeperTestSuite) TestForwardedTransferStore() {
+	forwardChannelId := "channel-1"
+	amount := sdkmath.NewInt(10)
+
+	// Confirm the record is not found before it's set
+	_, found := s.App.RatelimitKeeper.GetForwardedTransfer(s.Ctx, denom, forwardChannelId)
+	s.Require().False(found, "forwarded transfer should not be found before it's set")
+
+	// Set and read back the record
+	s.App.RatelimitKeeper.SetForwardedTransfer(s.Ctx, denom, forwardChannelId, amount)
+	actualAmount, found := s.App.RatelimitKeeper.GetForwardedTransfer(s.Ctx, denom, forwardChannelId)
+	s.Require().True(found, "forwarded transfer should have been found")
+	s.Require().Equal(amount.Int64(), actualAmount.Int64(), "forwarded transfer amount")
+
+	// The record should be specific to the denom and channel
+	_, found = s.App.RatelimitKeeper.GetForwardedTransfer(s.Ctx, "other-denom", forwardChannelId)
+	s.Require().False(found, "forwarded transfer should not be found for a different denom")
+	_, found = s.App.RatelimitKeeper.GetForwardedTransfer(s.Ctx, denom, "channel-2")
+	s.Require().False(found, "forwarded transfer should not be found for a different channel")
+
+	// Remove the record
+	s.App.RatelimitKeeper.RemoveForwardedTransfer(s.Ctx, denom, forwardChannelId)
+	_, found = s.App.RatelimitKeeper.GetForwardedTransfer(s.Ctx, denom, forwardChannelId)
+	s.Require().False(found, "forwarded transfer should have been removed")
+}
+
+func (s *KeeperTestSuite) TestCheckRateLimitAndUpdateFlow_ForwardedTransfer() {
+	inboundChannelId := "channel-0"
+	outboundChannelId := "channel-1"
+	transferAmount := sdkmath.NewInt(5)
+
+	testCases := []struct {
+		name                 string
+		policy               types.ForwardedTransferPolicy
+		sendAmount           sdkmath.Int
+		expectedInflow       int64
+		expectedOutflow      int64
+		expectedRecvUpdated  bool
+		expectedSendUpdated  bool
+		expectedRecordExists bool
+	}{
+		{
+			name:                "count both",
+			policy:              types.FORWARD_POLICY_COUNT_BOTH,
+			sendAmount:          transferAmount,
+			expectedInflow:      5,
+			expectedOutflow:     5,
+			expectedRecvUpdated: true,
+			expectedSendUpdated: true,
+		},
+		{
+			name:                "net hop",
+			policy:              types.FORWARD_POLICY_NET_HOP,
+			sendAmount:          transferAmount,
+			expectedInflow:      0,
+			expectedOutflow:     5,
+			expectedRecvUpdated: false,
+			expectedSendUpdated: true,
+		},
+		{
+			name:                "exempt",
+			policy:              types.FORWARD_POLICY_EXEMPT,
+			sendAmount:          transferAmount,
+			expectedInflow:      0,
+			expectedOutflow:     0,
+			expectedRecvUpdated: false,
+			expectedSendUpdated: false,
+		},
+		{
+			name:                "exempt with forwarding fee",
+			policy:              types.FORWARD_POLICY_EXEMPT,
+			sendAmount:          transferAmount.Sub(sdkmath.OneInt()),
+			expectedInflow:      0,
+			expectedOutflow:     0,
+			expectedRecvUpdated: false,
+			expectedSendUpdated: false,
+		},
+		{
+			name:                 "exempt but send amount larger than forwarded amount",
+			policy:               types.FORWARD_POLICY_EXEMPT,
+			sendAmount:           transferAmount.Add(sdkmath.OneInt()),
+			expectedInflow:       0,
+			expectedOutflow:      6,
+			expectedRecvUpdated:  false,
+			expectedSendUpdated:  true,
+			expectedRecordExists: true,
+		},
+	}
+
+	for _, tc := range testCases {
+		s.Run(tc.name, func() {
+			s.SetupTest()
+			s.App.RatelimitKeeper.SetParams(s.Ctx, types.NewParams(tc.policy, false))
+
+			// Create a rate limit on both the inbound and outbound channel
+			for _, channelId := range []string{inboundChannelId, outboundChannelId} {
+				s.App.RatelimitKeeper.SetRateLimit(s.Ctx, types.RateLimit{
+					Path:  &types.Path{Denom: denom, ChannelId: channelId},
+					Quota: &types.Quota{MaxPercentSend: sdkmath.NewInt(10), MaxPercentRecv: sdkmath.NewInt(10), DurationHours: 1},
+					Flow:  &types.Flow{Inflow: sdkmath.ZeroInt(), Outflow: sdkmath.ZeroInt(), ChannelValue: sdkmath.NewInt(100)},
+				})
+			}
+
+			// Receive a packet with a forward memo on the inbound channel
+			recvPacketInfo := keeper.RateLimitedPacketInfo{
+				ChannelID: inboundChannelId,
+				Denom:     denom,
+				Amount:    transferAmount,
+				Sender:    sender,
+				Receiver:  receiver,
+				Forward:   &types.ForwardMetadata{Receiver: "forward-receiver", Port: transferPort, Channel: outboundChannelId},
+			}
+			updated, err := s.App.RatelimitKeeper.CheckRateLimitAndUpdateFlow(s.Ctx, types.PACKET_RECV, recvPacketInfo)
+			s.Require().NoError(err, "no error expected on recv")
+			s.Require().Equal(tc.expectedRecvUpdated, updated, "recv flow updated")
+
+			// Send the forwarded packet out the outbound channel
+			sendPacketInfo := keeper.RateLimitedPacketInfo{
+				ChannelID: outboundChannelId,
+				Denom:     denom,
+				Amount:    tc.sendAmount,
+				Sender:    receiver,
+				Receiver:  "forward-receiver",
+			}
+			updated, err = s.App.RatelimitKeeper.CheckRateLimitAndUpdateFlow(s.Ctx, types.PACKET_SEND, sendPacketInfo)
+			s.Require().NoError(err, "no error expected on send")
+			s.Require().Equal(tc.expectedSendUpdated, updated, "send flow updated")
+
+			// Check the flow on each rate limit
+			inboundRateLimit, found := s.App.RatelimitKeeper.GetRateLimit(s.Ctx, denom, inboundChannelId)
+			s.Require().True(found)
+			s.Require().Equal(tc.expectedInflow, inboundRateLimit.Flow.Inflow.Int64(), "inflow")
+
+			outboundRateLimit, found := s.App.RatelimitKeeper.GetRateLimit(s.Ctx, denom, outboundChannelId)
+			s.Require().True(found)
+			s.Require().Equal(tc.expectedOutflow, outboundRateLimit.Flow.Outflow.Int64(), "outflow")
+
+			// Confirm the forward record was consumed (or left if the send didn't match)
+			_, found = s.App.RatelimitKeeper.GetForwardedTransfer(s.Ctx, denom, outboundChannelId)
+			s.Require().Equal(tc.expectedRecordExists, found, "forwarded transfer record exists")
+		})
+	}
+}
+
+func (s *KeeperTestSuite) TestSettleForwardedTransfer() {
+	inboundChannelId := "channel-0"
+	outboundChannelId := "channel-1"
+	packetDenom := "transfer/channel-100/" + denom
+
+	testCases := []struct {
+		name            string
+		policy          types.ForwardedTransferPolicy
+		forwardSent     bool
+		recvAmount      string
+		expectedInflow  int64
+		expectedOutflow int64
+		expectedError   string
+	}{
+		{
+			name:            "forward sent under net hop",
+			policy:          types.FORWARD_POLICY_NET_HOP,
+			forwardSent:     true,
+			recvAmount:      "5",
+			expectedInflow:  0,
+			expectedOutflow: 5,
+		},
+		{
+			name:            "forward sent under exempt",
+			policy:          types.FORWARD_POLICY_EXEMPT,
+			forwardSent:     true,
+			recvAmount:      "5",
+			expectedInflow:  0,
+			expectedOutflow: 0,
+		},
+		{
+			name:           "forward not sent under net hop",
+			policy:         types.FORWARD_POLICY_NET_HOP,
+			forwardSent:    false,
+			recvAmount:     "5",
+			expectedInflow: 5,
+		},
+		{
+			name:           "forward not sent under exempt",
+			policy:         types.FORWARD_POLICY_EXEMPT,
+			forwardSent:    false,
+			recvAmount:     "5",
+			expectedInflow: 5,
+		},
+		{
+			name:          "forward not sent and quota exceeded",
+			policy:        types.FORWARD_POLICY_EXEMPT,
+			forwardSent:   false,
+			recvAmount:    "11",
+			expectedError: "Inflow exceeds quota",
+		},
+	}
+
+	for _, tc := range testCases {
+		s.Run(tc.name, func() {
+			s.SetupTest()
+			s.App.RatelimitKeeper.SetParams(s.Ctx, types.NewParams(tc.policy, false))
+
+			// Create a rate limit on both the inbound and outbound channel
+			for _, channelId := range []string{inboundChannelId, outboundChannelId} {
+				s.App.RatelimitKeeper.SetRateLimit(s.Ctx, types.RateLimit{
+					Path:  &types.Path{Denom: denom, ChannelId: channelId},
+					Quota: &types.Quota{MaxPercentSend: sdkmath.NewInt(10), MaxPercentRecv: sdkmath.NewInt(10), DurationHours: 1},
+					Flow:  &types.Flow{Inflow: sdkmath.ZeroInt(), Outflow: sdkmath.ZeroInt(), ChannelValue: sdkmath.NewInt(100)},
+				})
+			}
+
+			// The denom of the recv packet is unwound since the token is returning to its source
+			forwardMemo := `{"forward": {"receiver": "receiver", "port": "transfer", "channel": "channel-1"}}`
+			packetData, err := json.Marshal(transfertypes.FungibleTokenPacketData{
+				Denom:  packetDenom,
+				Amount: tc.recvAmount,
+				Memo:   forwardMemo,
+			})
+			s.Require().NoError(err)
+			packet := channeltypes.Packet{
+				SourcePort:         transferPort,
+				SourceChannel:      "channel-100",
+				DestinationPort:    transferPort,
+				DestinationChannel: inboundChannelId,
+				Data:               packetData,
+			}
+
+			// Receive the packet, which records the forward and skips the inflow
+			err = s.App.RatelimitKeeper.ReceiveRateLimitedPacket(s.Ctx, packet)
+			s.Require().NoError(err, "no error expected on recv")
+
+			// Optionally send the outbound leg, as packet-forward-middleware would
+			if tc.forwardSent {
+				sendPacketInfo := keeper.RateLimitedPacketInfo{
+					ChannelID: outboundChannelId,
+					Denom:     denom,
+					Amount:    sdkmath.NewInt(5),
+					Sender:    receiver,
+					Receiver:  "receiver",
+				}
+				_, err = s.App.RatelimitKeeper.CheckRateLimitAndUpdateFlow(s.Ctx, types.PACKET_SEND, sendPacketInfo)
+				s.Require().NoError(err, "no error expected on send")
+			}
+
+			// Settle the forward after the rest of the stack has processed the packet
+			err = s.App.RatelimitKeeper.SettleForwardedTransfer(s.Ctx, packet)
+			if tc.expectedError != "" {
+				s.Require().ErrorContains(err, tc.expectedError)
+				return
+			}
+			s.Require().NoError(err, "no error expected when settling")
+
+			// The record should always be removed once the packet is settled
+			_, found := s.App.RatelimitKeeper.GetForwardedTransfer(s.Ctx, denom, outboundChannelId)
+			s.Require().False(found, "forwarded transfer record should have been removed")
+
+			inboundRateLimit, found := s.App.RatelimitKeeper.GetRateLimit(s.Ctx, denom, inboundChannelId)
+			s.Require().True(found)
+			s.Require().Equal(tc.expectedInflow, inboundRateLimit.Flow.Inflow.Int64(), "inflow")
+
+			outboundRateLimit, found := s.App.RatelimitKeeper.GetRateLimit(s.Ctx, denom, outboundChannelId)
+			s.Require().True(found)
+			s.Require().Equal(tc.expectedOutflow, outboundRateLimit.Flow.Outflow.Int64(), "outflow")
+		})
+	}
+}
@@ -17,14 +17,35 @@ func (k Keeper) InitGenesis(ctx sdk.Context, genState types.GenesisState) {
 	for _, rateLimit := range genState.RateLimits {
 		k.SetRateLimit(ctx, rateLimit)
 	}
+	for _, channelId := range k.GetAllRateLimitedChannels(ctx) {
+		k.UpdateChannelChainId(ctx, channelId)
+	}
 	for _, denom := range genState.BlacklistedDenoms {
 		k.AddDenomToBlacklist(ctx, denom)
 	}
 	for _, addressPair := range genState.WhitelistedAddressPairs {
 		k.SetWhitelistedAddressPair(ctx, addressPair)
 	}
+	for _, rule := range genState.TransferRules {
+		k.SetTransferRule(ctx, rule)
+	}
+	for _, group := range genState.DenomGroups {
+		k.SetDenomGroup(ctx, group)
+	}
+
+	// Set the scheduled updates, and start the next schedule ID after the last one
+	nextScheduleId := uint64(1)
+	for _, scheduledUpdate := range genState.ScheduledUpdates {
+		k.SetScheduledUpdate(ctx, scheduledUpdate)
+		if scheduledUpdate.ScheduleId >= nextScheduleId {
+			nextScheduleId = scheduledUpdate.ScheduleId + 1
+		}
+	}
+	k.SetNextScheduleId(ctx, nextScheduleId)
 
 	// Set pending sequence numbers - validating that they're in right format of {channelId}/{sequenceNumber}
+	// These were exported before the amount was stored with each packet, so the amount is left
+	// empty (and the amount from the packet will be used if it's refunded)
 	for _, pendingPacketId := range genState.PendingSendPacketSequenceNumbers {
 		channelId, sequence, err := types.ParsePendingPacketId(pendingPacketId)
 		if err != nil {
@@ -32,6 +53,9 @@ func (k Keeper) InitGenesis(ctx sdk.Context, genState types.GenesisState) {
 		}
 		k.SetPendingSendPacket(ctx, channelId, sequence)
 	}
+	for _, pendingPacket := range genState.PendingSendPackets {
+		k.SetPendingSendPacketRecord(ctx, pendingPacket)
+	}
 
 	// If the hour epoch has been initialized already (epoch number != 0), validate and then use it
 	if genState.HourEpoch.EpochNumber > 0 {
@@ -54,8 +78,11 @@ func (k Keeper) ExportGenesis(ctx sdk.Context) *types.GenesisState {
 	genesis.RateLimits = k.GetAllRateLimits(ctx)
 	genesis.BlacklistedDenoms = k.GetAllBlacklistedDenoms(ctx)
 	genesis.WhitelistedAddressPairs = k.GetAllWhitelistedAddressPairs(ctx)
-	genesis.PendingSendPacketSequenceNumbers = k.GetAllPendingSendPackets(ctx)
+	genesis.PendingSendPackets = k.GetAllPendingSendPackets(ctx)
 	genesis.HourEpoch = k.GetHourEpoch(ctx)
+	genesis.TransferRules = k.GetAllTransferRules(ctx)
+	genesis.DenomGroups = k.GetAllDenomGroups(ctx)
+	genesis.ScheduledUpdates = k.GetAllScheduledUpdates(ctx)
 
 	return genesis
 }
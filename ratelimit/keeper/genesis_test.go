@@ -5,6 +5,7 @@ import (
 	"time"
 
 	sdkmath "cosmossdk.io/math"
+	sdk "github.com/cosmos/cosmos-sdk/types"
 
 	"github.com/Stride-Labs/ibc-rate-limiting/ratelimit/types"
 )
@@ -50,13 +51,28 @@ func (s *KeeperTestSuite) TestGenesis() {
 					{Sender: "senderB", Receiver: "receiverB"},
 				},
 				BlacklistedDenoms:                []string{"denomA", "denomB"},
-				PendingSendPacketSequenceNumbers: []string{"channel-0/1", "channel-2/3"},
+				PendingSendPacketSequenceNumbers: []string{},
+				PendingSendPackets: []types.PendingSendPacket{
+					{ChannelId: "channel-0", Sequence: 1, Amount: sdk.NewCoins(sdk.NewCoin("denomA", sdkmath.NewInt(10))), EpochNumber: 1},
+					{ChannelId: "channel-2", Sequence: 3, Amount: sdk.NewCoins(sdk.NewCoin("denomB", sdkmath.NewInt(5))), EpochNumber: 1},
+				},
 				HourEpoch: types.HourEpoch{
 					EpochNumber:      1,
 					EpochStartTime:   blockTime,
 					Duration:         time.Minute,
 					EpochStartHeight: 1,
 				},
+				TransferRules: []types.TransferRule{
+					{RuleId: "ruleA", MemoKey: "wasm", Action: types.RULE_ACTION_DENY},
+					{RuleId: "ruleB", Receiver: "receiverA", Action: types.RULE_ACTION_EXEMPT},
+				},
+				DenomGroups: []types.DenomGroup{
+					{GroupId: "group/atom", Denoms: []string{"ibc/atom-direct", "ibc/atom-osmosis"}},
+				},
+				ScheduledUpdates: []types.ScheduledRateLimitUpdate{
+					{ScheduleId: 1, ExecuteEpoch: 10, Update: updateRateLimitMsg},
+					{ScheduleId: 4, ExecuteHeight: 100, Update: updateRateLimitMsg},
+				},
 			},
 			firstEpoch: false,
 		},
@@ -98,3 +114,38 @@ func (s *KeeperTestSuite) TestGenesis() {
 		})
 	}
 }
+
+// Pending packets exported before the amount was stored should be imported without an amount
+func (s *KeeperTestSuite) TestGenesis_PendingSendPacketSequenceNumbers() {
+	genesisState := types.DefaultGenesis()
+	genesisState.PendingSendPacketSequenceNumbers = []string{"channel-0/1", "channel-2/3"}
+	s.App.RatelimitKeeper.InitGenesis(s.Ctx, *genesisState)
+
+	exportedState := s.App.RatelimitKeeper.ExportGenesis(s.Ctx)
+	s.Require().Empty(exportedState.PendingSendPacketSequenceNumbers, "sequence numbers should no longer be exported")
+	s.Require().Equal([]types.PendingSendPacket{
+		{ChannelId: "channel-0", Sequence: 1, Direction: types.PACKET_SEND},
+		{ChannelId: "channel-2", Sequence: 3, Direction: types.PACKET_SEND},
+	}, exportedState.PendingSendPackets, "exported pending packets")
+}
+
+func (s *KeeperTestSuite) TestGenesis_ChannelChainIds() {
+	// channel-0 is a tendermint channel, and channel-1 is a solo machine channel without a chain ID
+	s.createChannelWithClient("channel-0", "connection-0", "07-tendermint-0", newTendermintClientState("chain-0"))
+	s.createChannelWithClient("channel-1", "connection-1", "06-solomachine-1", newSoloMachineClientState())
+
+	genesisState := types.DefaultGenesis()
+	genesisState.RateLimits = []types.RateLimit{
+		{Path: &types.Path{Denom: "denom", ChannelId: "channel-0"}},
+		{Path: &types.Path{Denom: "denom", ChannelId: "channel-1"}},
+	}
+	s.App.RatelimitKeeper.InitGenesis(s.Ctx, *genesisState)
+
+	// The chain ID cache should be rebuilt from the client states
+	chainId, found := s.App.RatelimitKeeper.GetChannelChainId(s.Ctx, "channel-0")
+	s.Require().True(found, "chain ID should be cached for the tendermint channel")
+	s.Require().Equal("chain-0", chainId, "cached chain ID")
+
+	_, found = s.App.RatelimitKeeper.GetChannelChainId(s.Ctx, "channel-1")
+	s.Require().False(found, "chain ID should not be cached for the solo machine channel")
+}
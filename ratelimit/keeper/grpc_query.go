@@ -2,23 +2,97 @@ package keeper
 
 import (
 	"context"
+	"math"
 
 	errorsmod "cosmossdk.io/errors"
+	sdkmath "cosmossdk.io/math"
+	"github.com/cosmos/cosmos-sdk/store/prefix"
 	sdk "github.com/cosmos/cosmos-sdk/types"
-
-	transfertypes "github.com/cosmos/ibc-go/v7/modules/apps/transfer/types"
-	ibctmtypes "github.com/cosmos/ibc-go/v7/modules/light-clients/07-tendermint"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+	"github.com/cosmos/cosmos-sdk/types/query"
 
 	"github.com/Stride-Labs/ibc-rate-limiting/ratelimit/types"
 )
 
 var _ types.QueryServer = Keeper{}
 
-// Query all rate limits
+// Returns the page request for a list query
+// If no pagination is provided, every entry is returned (rather than the SDK's default page
+// size), so that clients that don't paginate continue to receive the full list
+// The limit is MaxInt64 rather than MaxUint64, since the SDK's pagination adds to the limit
+func getPageRequest(pagination *query.PageRequest) *query.PageRequest {
+	if pagination == nil {
+		return &query.PageRequest{Limit: math.MaxInt64}
+	}
+	return pagination
+}
+
+// Query all rate limits, optionally filtered by denom, channel and utilization
+// If the denom or channel filter is provided, the corresponding index is used so that
+// the full list of rate limits doesn't have to be scanned
+// If quotas are reset lazily, each rate limit is returned with its effective flow in the current window
 func (k Keeper) AllRateLimits(c context.Context, req *types.QueryAllRateLimitsRequest) (*types.QueryAllRateLimitsResponse, error) {
 	ctx := sdk.UnwrapSDKContext(c)
-	rateLimits := k.GetAllRateLimits(ctx)
-	return &types.QueryAllRateLimitsResponse{RateLimits: rateLimits}, nil
+
+	var minUtilization *sdk.Dec
+	if req.MinUtilization != "" {
+		utilization, err := sdk.NewDecFromStr(req.MinUtilization)
+		if err != nil || utilization.IsNegative() {
+			return nil, errorsmod.Wrapf(sdkerrors.ErrInvalidRequest, "invalid min utilization (%s)", req.MinUtilization)
+		}
+		minUtilization = &utilization
+	}
+
+	// Determine which store to paginate over, and how to lookup the rate limit from each entry
+	var store prefix.Store
+	var lookupRateLimit func(key, value []byte) (types.RateLimit, bool)
+	switch {
+	case req.ChannelId != "":
+		store = k.getRateLimitChannelIndexStore(ctx, req.ChannelId)
+		lookupRateLimit = func(key, _ []byte) (types.RateLimit, bool) {
+			return k.GetRateLimit(ctx, string(key), req.ChannelId)
+		}
+	case req.Denom != "":
+		store = k.getRateLimitDenomIndexStore(ctx, req.Denom)
+		lookupRateLimit = func(key, _ []byte) (types.RateLimit, bool) {
+			return k.GetRateLimit(ctx, req.Denom, string(key))
+		}
+	default:
+		store = prefix.NewStore(ctx.KVStore(k.storeKey), types.RateLimitKeyPrefix)
+		lookupRateLimit = func(_, value []byte) (rateLimit types.RateLimit, found bool) {
+			k.cdc.MustUnmarshal(value, &rateLimit)
+			return rateLimit, true
+		}
+	}
+
+	rateLimits := []types.RateLimit{}
+	pageRes, err := query.FilteredPaginate(store, getPageRequest(req.Pagination), func(key, value []byte, accumulate bool) (bool, error) {
+		rateLimit, found := lookupRateLimit(key, value)
+		if !found {
+			return false, nil
+		}
+		rateLimit = k.GetEffectiveRateLimit(ctx, rateLimit)
+		if req.Denom != "" && rateLimit.Path.Denom != req.Denom {
+			return false, nil
+		}
+		if minUtilization != nil {
+			sendUtilization := rateLimit.Flow.GetUtilization(types.PACKET_SEND, *rateLimit.Quota)
+			recvUtilization := rateLimit.Flow.GetUtilization(types.PACKET_RECV, *rateLimit.Quota)
+			if sendUtilization.LT(*minUtilization) && recvUtilization.LT(*minUtilization) {
+				return false, nil
+			}
+		}
+
+		if accumulate {
+			rateLimits = append(rateLimits, rateLimit)
+		}
+		return true, nil
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	return &types.QueryAllRateLimitsResponse{RateLimits: rateLimits, Pagination: pageRes}, nil
 }
 
 // Query a rate limit by denom and channelId
@@ -28,29 +102,26 @@ func (k Keeper) RateLimit(c context.Context, req *types.QueryRateLimitRequest) (
 	if !found {
 		return &types.QueryRateLimitResponse{}, nil
 	}
+	rateLimit = k.GetEffectiveRateLimit(ctx, rateLimit)
 	return &types.QueryRateLimitResponse{RateLimit: &rateLimit}, nil
 }
 
 // Query all rate limits for a given chain
+// The counterparty chain ID of each rate limited channel is read from the cache, and only
+// resolved from the channel's client state if it has not been cached
+// Channels with a client that doesn't have a chain ID (e.g. solo machine) are skipped
 func (k Keeper) RateLimitsByChainId(c context.Context, req *types.QueryRateLimitsByChainIdRequest) (*types.QueryRateLimitsByChainIdResponse, error) {
 	ctx := sdk.UnwrapSDKContext(c)
 
 	rateLimits := []types.RateLimit{}
-	for _, rateLimit := range k.GetAllRateLimits(ctx) {
+	for _, channelId := range k.GetAllRateLimitedChannels(ctx) {
+		chainId, found := k.ResolveChannelChainId(ctx, channelId)
 
-		// Determine the client state from the channel Id
-		_, clientState, err := k.channelKeeper.GetChannelClientState(ctx, transfertypes.PortID, rateLimit.Path.ChannelId)
-		if err != nil {
-			return &types.QueryRateLimitsByChainIdResponse{}, errorsmod.Wrapf(types.ErrInvalidClientState, "Unable to fetch client state from channelId")
-		}
-		client, ok := clientState.(*ibctmtypes.ClientState)
-		if !ok {
-			return &types.QueryRateLimitsByChainIdResponse{}, errorsmod.Wrapf(types.ErrInvalidClientState, "Client state is not tendermint")
-		}
-
-		// If the chain ID matches, add the rate limit to the returned list
-		if client.ChainId == req.ChainId {
-			rateLimits = append(rateLimits, rateLimit)
+		// If the chain ID matches, add the channel's rate limits to the returned list
+		if found && chainId == req.ChainId {
+			for _, rateLimit := range k.GetRateLimitsByChannelId(ctx, channelId) {
+				rateLimits = append(rateLimits, k.GetEffectiveRateLimit(ctx, rateLimit))
+			}
 		}
 	}
 
@@ -61,27 +132,262 @@ func (k Keeper) RateLimitsByChainId(c context.Context, req *types.QueryRateLimit
 func (k Keeper) RateLimitsByChannelId(c context.Context, req *types.QueryRateLimitsByChannelIdRequest) (*types.QueryRateLimitsByChannelIdResponse, error) {
 	ctx := sdk.UnwrapSDKContext(c)
 
+	// The channel index is keyed by denom
 	rateLimits := []types.RateLimit{}
-	for _, rateLimit := range k.GetAllRateLimits(ctx) {
-		// If the channel ID matches, add the rate limit to the returned list
-		if rateLimit.Path.ChannelId == req.ChannelId {
-			rateLimits = append(rateLimits, rateLimit)
+	store := k.getRateLimitChannelIndexStore(ctx, req.ChannelId)
+	pageRes, err := query.Paginate(store, getPageRequest(req.Pagination), func(key, _ []byte) error {
+		if rateLimit, found := k.GetRateLimit(ctx, string(key), req.ChannelId); found {
+			rateLimits = append(rateLimits, k.GetEffectiveRateLimit(ctx, rateLimit))
 		}
+		return nil
+	})
+	if err != nil {
+		return nil, err
 	}
 
-	return &types.QueryRateLimitsByChannelIdResponse{RateLimits: rateLimits}, nil
+	return &types.QueryRateLimitsByChannelIdResponse{RateLimits: rateLimits, Pagination: pageRes}, nil
+}
+
+// Query all rate limits for a given denom
+// The denom can be provided as a trace (e.g. transfer/channel-X/uatom), in which case it's hashed
+// If the denom belongs to a denom group, the group's rate limits are returned
+func (k Keeper) RateLimitsByDenom(c context.Context, req *types.QueryRateLimitsByDenomRequest) (*types.QueryRateLimitsByDenomResponse, error) {
+	ctx := sdk.UnwrapSDKContext(c)
+
+	// The denom index is keyed by channel ID
+	denom := k.GetRateLimitDenom(ctx, ParseDenomFromTrace(req.Denom))
+	rateLimits := []types.RateLimit{}
+	store := k.getRateLimitDenomIndexStore(ctx, denom)
+	pageRes, err := query.Paginate(store, getPageRequest(req.Pagination), func(key, _ []byte) error {
+		if rateLimit, found := k.GetRateLimit(ctx, denom, string(key)); found {
+			rateLimits = append(rateLimits, k.GetEffectiveRateLimit(ctx, rateLimit))
+		}
+		return nil
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	return &types.QueryRateLimitsByDenomResponse{RateLimits: rateLimits, Pagination: pageRes}, nil
 }
 
 // Query all blacklisted denoms
 func (k Keeper) AllBlacklistedDenoms(c context.Context, req *types.QueryAllBlacklistedDenomsRequest) (*types.QueryAllBlacklistedDenomsResponse, error) {
 	ctx := sdk.UnwrapSDKContext(c)
-	blacklistedDenoms := k.GetAllBlacklistedDenoms(ctx)
-	return &types.QueryAllBlacklistedDenomsResponse{Denoms: blacklistedDenoms}, nil
+
+	blacklistedDenoms := []string{}
+	store := prefix.NewStore(ctx.KVStore(k.storeKey), types.DenomBlacklistKeyPrefix)
+	pageRes, err := query.Paginate(store, getPageRequest(req.Pagination), func(key, _ []byte) error {
+		blacklistedDenoms = append(blacklistedDenoms, string(key))
+		return nil
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	return &types.QueryAllBlacklistedDenomsResponse{Denoms: blacklistedDenoms, Pagination: pageRes}, nil
 }
 
 // Query all whitelisted addresses
 func (k Keeper) AllWhitelistedAddresses(c context.Context, req *types.QueryAllWhitelistedAddressesRequest) (*types.QueryAllWhitelistedAddressesResponse, error) {
 	ctx := sdk.UnwrapSDKContext(c)
-	whitelistedAddresses := k.GetAllWhitelistedAddressPairs(ctx)
-	return &types.QueryAllWhitelistedAddressesResponse{AddressPairs: whitelistedAddresses}, nil
+
+	whitelistedAddresses := []types.WhitelistedAddressPair{}
+	store := prefix.NewStore(ctx.KVStore(k.storeKey), types.AddressWhitelistKeyPrefix)
+	pageRes, err := query.Paginate(store, getPageRequest(req.Pagination), func(_, value []byte) error {
+		addressPair := types.WhitelistedAddressPair{}
+		k.cdc.MustUnmarshal(value, &addressPair)
+		whitelistedAddresses = append(whitelistedAddresses, addressPair)
+		return nil
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	return &types.QueryAllWhitelistedAddressesResponse{AddressPairs: whitelistedAddresses, Pagination: pageRes}, nil
+}
+
+// Query all transfer rules
+func (k Keeper) AllTransferRules(c context.Context, req *types.QueryAllTransferRulesRequest) (*types.QueryAllTransferRulesResponse, error) {
+	ctx := sdk.UnwrapSDKContext(c)
+
+	transferRules := []types.TransferRule{}
+	store := prefix.NewStore(ctx.KVStore(k.storeKey), types.TransferRuleKeyPrefix)
+	pageRes, err := query.Paginate(store, getPageRequest(req.Pagination), func(_, value []byte) error {
+		rule := types.TransferRule{}
+		if err := k.cdc.Unmarshal(value, &rule); err != nil {
+			return err
+		}
+		transferRules = append(transferRules, rule)
+		return nil
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	return &types.QueryAllTransferRulesResponse{TransferRules: transferRules, Pagination: pageRes}, nil
+}
+
+// Query all denom groups
+func (k Keeper) AllDenomGroups(c context.Context, req *types.QueryAllDenomGroupsRequest) (*types.QueryAllDenomGroupsResponse, error) {
+	ctx := sdk.UnwrapSDKContext(c)
+
+	denomGroups := []types.DenomGroup{}
+	store := prefix.NewStore(ctx.KVStore(k.storeKey), types.DenomGroupKeyPrefix)
+	pageRes, err := query.Paginate(store, getPageRequest(req.Pagination), func(_, value []byte) error {
+		group := types.DenomGroup{}
+		if err := k.cdc.Unmarshal(value, &group); err != nil {
+			return err
+		}
+		denomGroups = append(denomGroups, group)
+		return nil
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	return &types.QueryAllDenomGroupsResponse{DenomGroups: denomGroups, Pagination: pageRes}, nil
+}
+
+// Query the upcoming scheduled rate limit updates, optionally filtered by denom and channel
+func (k Keeper) ScheduledRateLimitUpdates(c context.Context, req *types.QueryScheduledRateLimitUpdatesRequest) (*types.QueryScheduledRateLimitUpdatesResponse, error) {
+	ctx := sdk.UnwrapSDKContext(c)
+
+	scheduledUpdates := []types.ScheduledRateLimitUpdate{}
+	updateStore := prefix.NewStore(ctx.KVStore(k.storeKey), types.ScheduledUpdateKeyPrefix)
+	indexStore := prefix.NewStore(ctx.KVStore(k.storeKey), types.ScheduledUpdateIdIndexPrefix)
+
+	// Paginate over the schedule ID index so that the updates are returned in the order they were scheduled
+	pageRes, err := query.FilteredPaginate(indexStore, getPageRequest(req.Pagination), func(_, updateKey []byte, accumulate bool) (bool, error) {
+		scheduledUpdate := types.ScheduledRateLimitUpdate{}
+		if err := k.cdc.Unmarshal(updateStore.Get(updateKey), &scheduledUpdate); err != nil {
+			return false, err
+		}
+		if req.Denom != "" && scheduledUpdate.Update.Denom != req.Denom {
+			return false, nil
+		}
+		if req.ChannelId != "" && scheduledUpdate.Update.ChannelId != req.ChannelId {
+			return false, nil
+		}
+
+		if accumulate {
+			scheduledUpdates = append(scheduledUpdates, scheduledUpdate)
+		}
+		return true, nil
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	return &types.QueryScheduledRateLimitUpdatesResponse{ScheduledUpdates: scheduledUpdates, Pagination: pageRes}, nil
+}
+
+// Query the rate limits on channels that do not exist or have been closed
+func (k Keeper) RateLimitsOnClosedChannels(c context.Context, req *types.QueryRateLimitsOnClosedChannelsRequest) (*types.QueryRateLimitsOnClosedChannelsResponse, error) {
+	ctx := sdk.UnwrapSDKContext(c)
+
+	rateLimits := []types.RateLimit{}
+	store := prefix.NewStore(ctx.KVStore(k.storeKey), types.RateLimitKeyPrefix)
+	pageRes, err := query.FilteredPaginate(store, getPageRequest(req.Pagination), func(_, value []byte, accumulate bool) (bool, error) {
+		rateLimit := types.RateLimit{}
+		if err := k.cdc.Unmarshal(value, &rateLimit); err != nil {
+			return false, err
+		}
+		if !k.IsRateLimitedChannelClosed(ctx, rateLimit.Path.ChannelId) {
+			return false, nil
+		}
+
+		if accumulate {
+			rateLimits = append(rateLimits, rateLimit)
+		}
+		return true, nil
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	return &types.QueryRateLimitsOnClosedChannelsResponse{RateLimits: rateLimits, Pagination: pageRes}, nil
+}
+
+// Query whether a transfer would be allowed, without updating the flow
+func (k Keeper) CheckTransfer(c context.Context, req *types.QueryCheckTransferRequest) (*types.QueryCheckTransferResponse, error) {
+	ctx := sdk.UnwrapSDKContext(c)
+
+	amount, ok := sdkmath.NewIntFromString(req.Amount)
+	if !ok || amount.IsNegative() {
+		return nil, errorsmod.Wrapf(sdkerrors.ErrInvalidRequest, "invalid amount (%s)", req.Amount)
+	}
+
+	packetInfo := RateLimitedPacketInfo{
+		ChannelID: req.ChannelId,
+		Denom:     req.Denom,
+		Amount:    amount,
+		Sender:    req.Sender,
+		Receiver:  req.Receiver,
+		Memo:      req.Memo,
+		Forward:   types.ParseForwardMetadata(req.Memo),
+	}
+	response := k.CheckTransferAllowed(ctx, req.Direction, packetInfo)
+
+	return &response, nil
+}
+
+// Query the remaining capacity of all rate limits
+func (k Keeper) AllRateLimitCapacities(c context.Context, req *types.QueryAllRateLimitCapacitiesRequest) (*types.QueryAllRateLimitCapacitiesResponse, error) {
+	ctx := sdk.UnwrapSDKContext(c)
+
+	capacities := []types.RateLimitCapacity{}
+	store := prefix.NewStore(ctx.KVStore(k.storeKey), types.RateLimitKeyPrefix)
+	pageRes, err := query.Paginate(store, getPageRequest(req.Pagination), func(_, value []byte) error {
+		rateLimit := types.RateLimit{}
+		if err := k.cdc.Unmarshal(value, &rateLimit); err != nil {
+			return err
+		}
+		capacities = append(capacities, k.GetRateLimitCapacity(ctx, k.GetEffectiveRateLimit(ctx, rateLimit)))
+		return nil
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	return &types.QueryAllRateLimitCapacitiesResponse{Capacities: capacities, Pagination: pageRes}, nil
+}
+
+// Query the remaining capacity of a rate limit by denom and channelId
+func (k Keeper) RateLimitCapacity(c context.Context, req *types.QueryRateLimitCapacityRequest) (*types.QueryRateLimitCapacityResponse, error) {
+	ctx := sdk.UnwrapSDKContext(c)
+	rateLimit, found := k.GetRateLimit(ctx, req.Denom, req.ChannelId)
+	if !found {
+		return &types.QueryRateLimitCapacityResponse{}, nil
+	}
+	capacity := k.GetRateLimitCapacity(ctx, k.GetEffectiveRateLimit(ctx, rateLimit))
+	return &types.QueryRateLimitCapacityResponse{Capacity: &capacity}, nil
+}
+
+// Query all pending send packets, optionally filtered by channel ID
+func (k Keeper) PendingSendPackets(c context.Context, req *types.QueryPendingSendPacketsRequest) (*types.QueryPendingSendPacketsResponse, error) {
+	ctx := sdk.UnwrapSDKContext(c)
+
+	// If a channel is provided, only iterate the keys on that channel
+	channelPrefix := []byte{}
+	if req.ChannelId != "" {
+		channelPrefix = types.GetPendingSendPacketChannelPrefix(req.ChannelId)
+	}
+	pendingSendPacketStore := prefix.NewStore(ctx.KVStore(k.storeKey), types.PendingSendPacketPrefix)
+	store := prefix.NewStore(pendingSendPacketStore, channelPrefix)
+
+	pendingPackets := []types.PendingSendPacket{}
+	pageRes, err := query.Paginate(store, getPageRequest(req.Pagination), func(key, value []byte) error {
+		pendingPacket := types.PendingSendPacket{}
+		if err := k.cdc.Unmarshal(value, &pendingPacket); err != nil {
+			return err
+		}
+		pendingPackets = append(pendingPackets, pendingPacket)
+		return nil
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	return &types.QueryPendingSendPacketsResponse{PendingSendPackets: pendingPackets, Pagination: pageRes}, nil
 }
@@ -5,6 +5,9 @@ import (
 	"fmt"
 	"time"
 
+	sdkmath "cosmossdk.io/math"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/types/query"
 	transfertypes "github.com/cosmos/ibc-go/v7/modules/apps/transfer/types"
 	clienttypes "github.com/cosmos/ibc-go/v7/modules/core/02-client/types"
 	connectiontypes "github.com/cosmos/ibc-go/v7/modules/core/03-connection/types"
@@ -91,6 +94,107 @@ func (s *KeeperTestSuite) TestQueryRateLimitsByChannelId() {
 	}
 }
 
+func (s *KeeperTestSuite) TestQueryRateLimitsByDenom() {
+	ibcDenom := transfertypes.ParseDenomTrace("transfer/channel-0/uatom").IBCDenom()
+
+	// Add rate limits for a native denom and an ibc denom across multiple channels
+	expectedNativeRateLimits := []types.RateLimit{}
+	expectedIbcRateLimits := []types.RateLimit{}
+	for i := 0; i < 3; i++ {
+		channelId := fmt.Sprintf("channel-%d", i)
+
+		nativeRateLimit := types.RateLimit{Path: &types.Path{Denom: "denom", ChannelId: channelId}}
+		s.App.RatelimitKeeper.SetRateLimit(s.Ctx, nativeRateLimit)
+		expectedNativeRateLimits = append(expectedNativeRateLimits, nativeRateLimit)
+
+		if i > 0 {
+			ibcRateLimit := types.RateLimit{Path: &types.Path{Denom: ibcDenom, ChannelId: channelId}}
+			s.App.RatelimitKeeper.SetRateLimit(s.Ctx, ibcRateLimit)
+			expectedIbcRateLimits = append(expectedIbcRateLimits, ibcRateLimit)
+		}
+	}
+
+	testCases := []struct {
+		name               string
+		denom              string
+		expectedRateLimits []types.RateLimit
+	}{
+		{name: "base denom", denom: "denom", expectedRateLimits: expectedNativeRateLimits},
+		{name: "ibc hash", denom: ibcDenom, expectedRateLimits: expectedIbcRateLimits},
+		{name: "denom trace", denom: "transfer/channel-0/uatom", expectedRateLimits: expectedIbcRateLimits},
+		{name: "no rate limits", denom: "other", expectedRateLimits: []types.RateLimit{}},
+	}
+
+	for _, tc := range testCases {
+		s.Run(tc.name, func() {
+			queryResponse, err := s.QueryClient.RateLimitsByDenom(context.Background(), &types.QueryRateLimitsByDenomRequest{
+				Denom: tc.denom,
+			})
+			s.Require().NoError(err, "no error expected when querying rate limits for denom: %s", tc.denom)
+			s.Require().ElementsMatch(tc.expectedRateLimits, queryResponse.RateLimits)
+		})
+	}
+
+	// Check pagination
+	queryResponse, err := s.QueryClient.RateLimitsByDenom(context.Background(), &types.QueryRateLimitsByDenomRequest{
+		Denom:      "denom",
+		Pagination: &query.PageRequest{Limit: 2, CountTotal: true},
+	})
+	s.Require().NoError(err, "no error expected when querying with pagination")
+	s.Require().Equal(expectedNativeRateLimits[:2], queryResponse.RateLimits, "first page")
+	s.Require().Equal(uint64(3), queryResponse.Pagination.Total, "total")
+
+	queryResponse, err = s.QueryClient.RateLimitsByDenom(context.Background(), &types.QueryRateLimitsByDenomRequest{
+		Denom:      "denom",
+		Pagination: &query.PageRequest{Key: queryResponse.Pagination.NextKey},
+	})
+	s.Require().NoError(err, "no error expected when querying second page")
+	s.Require().Equal(expectedNativeRateLimits[2:], queryResponse.RateLimits, "second page")
+}
+
+func (s *KeeperTestSuite) TestQueryRateLimitsOnClosedChannels() {
+	s.setupInvariantRateLimits("channel-0", "channel-1", "channel-2")
+
+	// A rate limit on a channel that's still in the handshake should not be returned
+	s.App.IBCKeeper.ChannelKeeper.SetChannel(s.Ctx, transfertypes.PortID, "channel-1", channeltypes.Channel{State: channeltypes.INIT})
+
+	queryResponse, err := s.QueryClient.RateLimitsOnClosedChannels(context.Background(), &types.QueryRateLimitsOnClosedChannelsRequest{})
+	s.Require().NoError(err, "no error expected when querying rate limits on closed channels")
+	s.Require().Empty(queryResponse.RateLimits, "no rate limits should be on closed channels")
+
+	// Close two of the channels, and add a rate limit on a channel that does not exist
+	for _, channelId := range []string{"channel-0", "channel-2"} {
+		s.App.IBCKeeper.ChannelKeeper.SetChannel(s.Ctx, transfertypes.PortID, channelId, channeltypes.Channel{State: channeltypes.CLOSED})
+	}
+	missingChannelRateLimit := types.RateLimit{Path: &types.Path{Denom: denom, ChannelId: "channel-9"}}
+	s.App.RatelimitKeeper.SetRateLimit(s.Ctx, missingChannelRateLimit)
+
+	expectedRateLimits := []types.RateLimit{}
+	for _, channelId := range []string{"channel-0", "channel-2", "channel-9"} {
+		rateLimit, found := s.App.RatelimitKeeper.GetRateLimit(s.Ctx, denom, channelId)
+		s.Require().True(found, "rate limit on %s should be found", channelId)
+		expectedRateLimits = append(expectedRateLimits, rateLimit)
+	}
+
+	queryResponse, err = s.QueryClient.RateLimitsOnClosedChannels(context.Background(), &types.QueryRateLimitsOnClosedChannelsRequest{})
+	s.Require().NoError(err, "no error expected when querying rate limits on closed channels")
+	s.Require().Equal(expectedRateLimits, queryResponse.RateLimits, "rate limits on closed channels")
+
+	// Check pagination
+	queryResponse, err = s.QueryClient.RateLimitsOnClosedChannels(context.Background(), &types.QueryRateLimitsOnClosedChannelsRequest{
+		Pagination: &query.PageRequest{Limit: 2, CountTotal: true},
+	})
+	s.Require().NoError(err, "no error expected when querying with pagination")
+	s.Require().Equal(expectedRateLimits[:2], queryResponse.RateLimits, "first page")
+	s.Require().Equal(uint64(3), queryResponse.Pagination.Total, "total")
+
+	queryResponse, err = s.QueryClient.RateLimitsOnClosedChannels(context.Background(), &types.QueryRateLimitsOnClosedChannelsRequest{
+		Pagination: &query.PageRequest{Key: queryResponse.Pagination.NextKey},
+	})
+	s.Require().NoError(err, "no error expected when querying second page")
+	s.Require().Equal(expectedRateLimits[2:], queryResponse.RateLimits, "second page")
+}
+
 func (s *KeeperTestSuite) TestQueryAllBlacklistedDenoms() {
 	s.App.RatelimitKeeper.AddDenomToBlacklist(s.Ctx, "denom-A")
 	s.App.RatelimitKeeper.AddDenomToBlacklist(s.Ctx, "denom-B")
@@ -118,3 +222,319 @@ func (s *KeeperTestSuite) TestQueryAllWhitelistedAddresses() {
 	}
 	s.Require().Equal(expectedWhitelist, queryResponse.AddressPairs)
 }
+
+func (s *KeeperTestSuite) TestQueryAllTransferRules() {
+	expectedRules := []types.TransferRule{
+		{RuleId: "rule-A", MemoKey: "wasm", Action: types.RULE_ACTION_DENY},
+		{RuleId: "rule-B", Receiver: "address-A", Action: types.RULE_ACTION_EXEMPT},
+	}
+	for _, rule := range expectedRules {
+		s.App.RatelimitKeeper.SetTransferRule(s.Ctx, rule)
+	}
+
+	queryResponse, err := s.QueryClient.AllTransferRules(context.Background(), &types.QueryAllTransferRulesRequest{})
+	s.Require().NoError(err, "no error expected when querying transfer rules")
+	s.Require().Equal(expectedRules, queryResponse.TransferRules)
+}
+
+func (s *KeeperTestSuite) TestQueryAllDenomGroups() {
+	expectedGroups := []types.DenomGroup{
+		{GroupId: "group/atom", Denoms: []string{"ibc/atom-direct", "ibc/atom-osmosis"}},
+		{GroupId: "group/osmo", Denoms: []string{"ibc/osmo"}},
+	}
+	for _, group := range expectedGroups {
+		s.App.RatelimitKeeper.SetDenomGroup(s.Ctx, group)
+	}
+
+	queryResponse, err := s.QueryClient.AllDenomGroups(context.Background(), &types.QueryAllDenomGroupsRequest{})
+	s.Require().NoError(err, "no error expected when querying denom groups")
+	s.Require().Equal(expectedGroups, queryResponse.DenomGroups)
+}
+
+func (s *KeeperTestSuite) TestQueryCheckTransfer() {
+	s.SetupCheckRateLimitAndUpdateFlowTest()
+
+	// Transfer within the quota
+	queryResponse, err := s.QueryClient.CheckTransfer(context.Background(), &types.QueryCheckTransferRequest{
+		Denom:     denom,
+		ChannelId: channelId,
+		Direction: types.PACKET_SEND,
+		Amount:    "10",
+		Sender:    sender,
+		Receiver:  receiver,
+	})
+	s.Require().NoError(err, "no error expected when checking transfer within quota")
+	s.Require().True(queryResponse.Allowed, "transfer within quota should be allowed")
+	s.Require().True(queryResponse.RateLimited, "transfer within quota should be rate limited")
+	s.Require().Equal(int64(10), queryResponse.RemainingCapacity.Int64(), "remaining capacity")
+
+	// Transfer that exceeds the quota
+	queryResponse, err = s.QueryClient.CheckTransfer(context.Background(), &types.QueryCheckTransferRequest{
+		Denom:     denom,
+		ChannelId: channelId,
+		Direction: types.PACKET_RECV,
+		Amount:    "11",
+	})
+	s.Require().NoError(err, "no error expected when checking transfer that exceeds quota")
+	s.Require().False(queryResponse.Allowed, "transfer exceeding quota should be denied")
+	s.Require().Equal(types.EventRateLimitExceeded, queryResponse.Reason, "denial reason")
+	s.Require().Contains(queryResponse.Error, "Inflow exceeds quota", "denial error")
+
+	// Invalid amount
+	_, err = s.QueryClient.CheckTransfer(context.Background(), &types.QueryCheckTransferRequest{
+		Denom:     denom,
+		ChannelId: channelId,
+		Amount:    "invalid",
+	})
+	s.Require().ErrorContains(err, "invalid amount")
+}
+
+func (s *KeeperTestSuite) TestQueryRateLimitCapacity() {
+	epochStartTime := time.Date(2024, 1, 1, 0, 0, 0, 0, time.UTC)
+	s.App.RatelimitKeeper.SetHourEpoch(s.Ctx, types.HourEpoch{
+		EpochNumber:    10,
+		EpochStartTime: epochStartTime,
+		Duration:       time.Hour,
+	})
+
+	// Channel value 100, and 10% send/recv threshold, with a net outflow of 4
+	s.SetupCheckRateLimitAndUpdateFlowTest()
+	rateLimit, found := s.App.RatelimitKeeper.GetRateLimit(s.Ctx, denom, channelId)
+	s.Require().True(found)
+	rateLimit.Quota.DurationHours = 4
+	rateLimit.Flow.Inflow = sdkmath.NewInt(2)
+	rateLimit.Flow.Outflow = sdkmath.NewInt(6)
+	s.App.RatelimitKeeper.SetRateLimit(s.Ctx, rateLimit)
+
+	expectedResetTime := epochStartTime.Add(2 * time.Hour)
+	expectedWindowStartTime := epochStartTime.Add(-2 * time.Hour)
+	expectedCapacity := types.RateLimitCapacity{
+		RateLimit:        rateLimit,
+		RemainingSend:    sdkmath.NewInt(6),
+		RemainingRecv:    sdkmath.NewInt(14),
+		SendUtilization:  sdk.NewDec(40),
+		RecvUtilization:  sdk.ZeroDec(),
+		NextResetEpoch:   12,
+		NextResetTime:    &expectedResetTime,
+		WindowStartEpoch: 8,
+		WindowStartTime:  &expectedWindowStartTime,
+	}
+
+	queryResponse, err := s.QueryClient.RateLimitCapacity(context.Background(), &types.QueryRateLimitCapacityRequest{
+		Denom:     denom,
+		ChannelId: channelId,
+	})
+	s.Require().NoError(err, "no error expected when querying capacity")
+	s.Require().Equal(expectedCapacity, *queryResponse.Capacity, "capacity")
+
+	allResponse, err := s.QueryClient.AllRateLimitCapacities(context.Background(), &types.QueryAllRateLimitCapacitiesRequest{})
+	s.Require().NoError(err, "no error expected when querying all capacities")
+	s.Require().Equal([]types.RateLimitCapacity{expectedCapacity}, allResponse.Capacities, "all capacities")
+
+	// Query a rate limit that doesn't exist
+	queryResponse, err = s.QueryClient.RateLimitCapacity(context.Background(), &types.QueryRateLimitCapacityRequest{
+		Denom:     denom,
+		ChannelId: "channel-99",
+	})
+	s.Require().NoError(err, "no error expected when querying capacity of missing rate limit")
+	s.Require().Nil(queryResponse.Capacity, "capacity of missing rate limit")
+}
+
+func (s *KeeperTestSuite) TestQueryAllRateLimits_FiltersAndPagination() {
+	// Channel value of 100 and a threshold of 10 in each direction
+	// The utilization of each rate limit is determined by its outflow
+	rateLimits := []types.RateLimit{}
+	for i, path := range []types.Path{
+		{Denom: "denom-A", ChannelId: "channel-0"},
+		{Denom: "denom-A", ChannelId: "channel-1"},
+		{Denom: "denom-B", ChannelId: "channel-0"},
+		{Denom: "denom-B", ChannelId: "channel-1"},
+	} {
+		path := path
+		rateLimit := types.RateLimit{
+			Path:  &path,
+			Quota: &types.Quota{MaxPercentSend: sdkmath.NewInt(10), MaxPercentRecv: sdkmath.NewInt(10)},
+			Flow: &types.Flow{
+				Inflow:       sdkmath.ZeroInt(),
+				Outflow:      sdkmath.NewInt(int64(3 * i)), // 0%, 30%, 60%, 90% utilization
+				ChannelValue: sdkmath.NewInt(100),
+			},
+		}
+		s.App.RatelimitKeeper.SetRateLimit(s.Ctx, rateLimit)
+		rateLimits = append(rateLimits, rateLimit)
+	}
+
+	testCases := []struct {
+		name     string
+		request  types.QueryAllRateLimitsRequest
+		expected []types.RateLimit
+	}{
+		{
+			name:     "no filters",
+			request:  types.QueryAllRateLimitsRequest{},
+			expected: rateLimits,
+		},
+		{
+			name:     "filter by denom",
+			request:  types.QueryAllRateLimitsRequest{Denom: "denom-B"},
+			expected: []types.RateLimit{rateLimits[2], rateLimits[3]},
+		},
+		{
+			name:     "filter by channel",
+			request:  types.QueryAllRateLimitsRequest{ChannelId: "channel-1"},
+			expected: []types.RateLimit{rateLimits[1], rateLimits[3]},
+		},
+		{
+			name:     "filter by denom and channel",
+			request:  types.QueryAllRateLimitsRequest{Denom: "denom-A", ChannelId: "channel-1"},
+			expected: []types.RateLimit{rateLimits[1]},
+		},
+		{
+			name:     "filter by utilization",
+			request:  types.QueryAllRateLimitsRequest{MinUtilization: "60"},
+			expected: []types.RateLimit{rateLimits[2], rateLimits[3]},
+		},
+		{
+			name:     "filter by channel and utilization",
+			request:  types.QueryAllRateLimitsRequest{ChannelId: "channel-0", MinUtilization: "50"},
+			expected: []types.RateLimit{rateLimits[2]},
+		},
+		{
+			name:     "no matches",
+			request:  types.QueryAllRateLimitsRequest{Denom: "denom-C"},
+			expected: []types.RateLimit{},
+		},
+	}
+
+	for _, tc := range testCases {
+		s.Run(tc.name, func() {
+			queryResponse, err := s.QueryClient.AllRateLimits(context.Background(), &tc.request)
+			s.Require().NoError(err, "no error expected when querying rate limits")
+			s.Require().ElementsMatch(tc.expected, queryResponse.RateLimits)
+		})
+	}
+
+	// Paginate through the rate limits with utilization above 30%, one at a time
+	request := &types.QueryAllRateLimitsRequest{
+		MinUtilization: "30",
+		Pagination:     &query.PageRequest{Limit: 1, CountTotal: true},
+	}
+	queryResponse, err := s.QueryClient.AllRateLimits(context.Background(), request)
+	s.Require().NoError(err, "no error expected when querying the first page")
+	s.Require().Equal([]types.RateLimit{rateLimits[1]}, queryResponse.RateLimits, "first page")
+	s.Require().Equal(uint64(3), queryResponse.Pagination.Total, "total")
+
+	request.Pagination = &query.PageRequest{Limit: 2, Key: queryResponse.Pagination.NextKey}
+	queryResponse, err = s.QueryClient.AllRateLimits(context.Background(), request)
+	s.Require().NoError(err, "no error expected when querying the second page")
+	s.Require().Equal([]types.RateLimit{rateLimits[2], rateLimits[3]}, queryResponse.RateLimits, "second page")
+	s.Require().Nil(queryResponse.Pagination.NextKey, "no more pages")
+
+	// Invalid utilization
+	_, err = s.QueryClient.AllRateLimits(context.Background(), &types.QueryAllRateLimitsRequest{MinUtilization: "-1"})
+	s.Require().ErrorContains(err, "invalid min utilization")
+}
+
+func (s *KeeperTestSuite) TestQueryPagination() {
+	s.createRateLimits()
+	for _, path := range []types.Path{{Denom: "denom-A", ChannelId: "channel-1"}, {Denom: "denom-B", ChannelId: "channel-1"}} {
+		path := path
+		s.App.RatelimitKeeper.SetRateLimit(s.Ctx, types.RateLimit{Path: &path})
+	}
+	for _, denom := range []string{"denom-A", "denom-B", "denom-C"} {
+		s.App.RatelimitKeeper.AddDenomToBlacklist(s.Ctx, denom)
+	}
+	for _, sender := range []string{"address-A", "address-B", "address-C"} {
+		s.App.RatelimitKeeper.SetWhitelistedAddressPair(s.Ctx, types.WhitelistedAddressPair{Sender: sender, Receiver: "receiver"})
+	}
+	for _, ruleId := range []string{"rule-A", "rule-B", "rule-C"} {
+		s.App.RatelimitKeeper.SetTransferRule(s.Ctx, types.TransferRule{RuleId: ruleId, Action: types.RULE_ACTION_DENY})
+	}
+	for _, groupId := range []string{"group-A", "group-B", "group-C"} {
+		s.App.RatelimitKeeper.SetDenomGroup(s.Ctx, types.DenomGroup{GroupId: groupId, Denoms: []string{groupId + "-denom"}})
+	}
+	pagination := &query.PageRequest{Offset: 1, Limit: 2, CountTotal: true}
+
+	// Rate limits by channel (channel-1 has denom-1, denom-A, and denom-B)
+	channelResponse, err := s.QueryClient.RateLimitsByChannelId(context.Background(), &types.QueryRateLimitsByChannelIdRequest{
+		ChannelId:  "channel-1",
+		Pagination: pagination,
+	})
+	s.Require().NoError(err, "no error expected when querying rate limits by channel")
+	s.Require().Len(channelResponse.RateLimits, 2, "rate limits by channel")
+	s.Require().Equal("denom-A", channelResponse.RateLimits[0].Path.Denom, "first rate limit by channel")
+	s.Require().Equal("denom-B", channelResponse.RateLimits[1].Path.Denom, "second rate limit by channel")
+	s.Require().Equal(uint64(3), channelResponse.Pagination.Total, "total rate limits by channel")
+
+	// Blacklisted denoms
+	blacklistResponse, err := s.QueryClient.AllBlacklistedDenoms(context.Background(), &types.QueryAllBlacklistedDenomsRequest{
+		Pagination: pagination,
+	})
+	s.Require().NoError(err, "no error expected when querying blacklisted denoms")
+	s.Require().Equal([]string{"denom-B", "denom-C"}, blacklistResponse.Denoms, "blacklisted denoms")
+	s.Require().Equal(uint64(3), blacklistResponse.Pagination.Total, "total blacklisted denoms")
+
+	// Whitelisted addresses
+	whitelistResponse, err := s.QueryClient.AllWhitelistedAddresses(context.Background(), &types.QueryAllWhitelistedAddressesRequest{
+		Pagination: pagination,
+	})
+	s.Require().NoError(err, "no error expected when querying whitelisted addresses")
+	s.Require().Equal([]types.WhitelistedAddressPair{
+		{Sender: "address-B", Receiver: "receiver"},
+		{Sender: "address-C", Receiver: "receiver"},
+	}, whitelistResponse.AddressPairs, "whitelisted addresses")
+	s.Require().Equal(uint64(3), whitelistResponse.Pagination.Total, "total whitelisted addresses")
+
+	// Transfer rules
+	rulesResponse, err := s.QueryClient.AllTransferRules(context.Background(), &types.QueryAllTransferRulesRequest{
+		Pagination: pagination,
+	})
+	s.Require().NoError(err, "no error expected when querying transfer rules")
+	s.Require().Len(rulesResponse.TransferRules, 2, "transfer rules")
+	s.Require().Equal("rule-B", rulesResponse.TransferRules[0].RuleId, "first transfer rule")
+	s.Require().Equal("rule-C", rulesResponse.TransferRules[1].RuleId, "second transfer rule")
+	s.Require().Equal(uint64(3), rulesResponse.Pagination.Total, "total transfer rules")
+
+	// Denom groups
+	groupsResponse, err := s.QueryClient.AllDenomGroups(context.Background(), &types.QueryAllDenomGroupsRequest{
+		Pagination: pagination,
+	})
+	s.Require().NoError(err, "no error expected when querying denom groups")
+	s.Require().Len(groupsResponse.DenomGroups, 2, "denom groups")
+	s.Require().Equal("group-B", groupsResponse.DenomGroups[0].GroupId, "first denom group")
+	s.Require().Equal("group-C", groupsResponse.DenomGroups[1].GroupId, "second denom group")
+	s.Require().Equal(uint64(3), groupsResponse.Pagination.Total, "total denom groups")
+
+}
+
+func (s *KeeperTestSuite) TestQueryAllRateLimitCapacities_Pagination() {
+	for _, denom := range []string{"denom-A", "denom-B", "denom-C"} {
+		s.App.RatelimitKeeper.SetRateLimit(s.Ctx, types.RateLimit{
+			Path:  &types.Path{Denom: denom, ChannelId: channelId},
+			Quota: &types.Quota{MaxPercentSend: sdkmath.NewInt(10), MaxPercentRecv: sdkmath.NewInt(10), DurationHours: 1},
+			Flow:  &types.Flow{Inflow: sdkmath.ZeroInt(), Outflow: sdkmath.ZeroInt(), ChannelValue: sdkmath.NewInt(100)},
+		})
+	}
+
+	queryResponse, err := s.QueryClient.AllRateLimitCapacities(context.Background(), &types.QueryAllRateLimitCapacitiesRequest{
+		Pagination: &query.PageRequest{Offset: 1, Limit: 2, CountTotal: true},
+	})
+	s.Require().NoError(err, "no error expected when querying capacities")
+	s.Require().Len(queryResponse.Capacities, 2, "capacities")
+	s.Require().Equal("denom-B", queryResponse.Capacities[0].RateLimit.Path.Denom, "first capacity")
+	s.Require().Equal("denom-C", queryResponse.Capacities[1].RateLimit.Path.Denom, "second capacity")
+	s.Require().Equal(uint64(3), queryResponse.Pagination.Total, "total capacities")
+}
+
+func (s *KeeperTestSuite) TestQueryWithoutPagination_ReturnsAllEntries() {
+	// More entries than the default page size of 100
+	numDenoms := 150
+	for i := 0; i < numDenoms; i++ {
+		s.App.RatelimitKeeper.AddDenomToBlacklist(s.Ctx, fmt.Sprintf("denom-%03d", i))
+	}
+
+	queryResponse, err := s.QueryClient.AllBlacklistedDenoms(context.Background(), &types.QueryAllBlacklistedDenomsRequest{})
+	s.Require().NoError(err, "no error expected when querying blacklisted denoms")
+	s.Require().Len(queryResponse.Denoms, numDenoms, "all blacklisted denoms should be returned")
+	s.Require().Nil(queryResponse.Pagination.NextKey, "there should be no next page")
+}
@@ -0,0 +1,34 @@
+package keeper
+
+import (
+	"github.com/Stride-Labs/ibc-rate-limiting/ratelimit/types"
+)
+
+// Holds the registered rate limit hooks
+// Unlike the keeper's other dependencies, the hooks are stored behind a pointer that's shared by
+// every copy of the keeper, so that hooks registered after the keeper has been copied (e.g. by the
+// depinject invoker, after the keeper was passed to the module) are visible to each copy
+type hooksRef struct {
+	hooks types.RateLimitHooks
+}
+
+// Registers the rate limit hooks
+// The hooks are shared by every copy of the keeper, so this can be called at any point during app wiring
+func (k *Keeper) SetHooks(hooks types.RateLimitHooks) *Keeper {
+	if k.hooks == nil {
+		k.hooks = &hooksRef{}
+	}
+	if k.hooks.hooks != nil {
+		panic("cannot set rate limit hooks twice")
+	}
+	k.hooks.hooks = hooks
+	return k
+}
+
+// Returns the registered hooks, or an empty set of hooks if none were registered
+func (k Keeper) Hooks() types.RateLimitHooks {
+	if k.hooks == nil || k.hooks.hooks == nil {
+		return types.MultiRateLimitHooks{}
+	}
+	return k.hooks.hooks
+}
@@ -0,0 +1,150 @@
+package keeper_test
+
+import (
+	"time"
+
+	sdkmath "cosmossdk.io/math"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	"github.com/Stride-Labs/ibc-rate-limiting/ratelimit/keeper"
+	"github.com/Stride-Labs/ibc-rate-limiting/ratelimit/types"
+)
+
+type deniedTransfer struct {
+	reason    string
+	denom     string
+	channelId string
+	direction types.PacketDirection
+	amount    sdkmath.Int
+}
+
+type updatedFlow struct {
+	rateLimit types.RateLimit
+	direction types.PacketDirection
+	amount    sdkmath.Int
+}
+
+// Mock hooks that record each invocation
+type mockRateLimitHooks struct {
+	deniedTransfers []deniedTransfer
+	updatedFlows    []updatedFlow
+	resetRateLimits []types.RateLimit
+}
+
+func (h *mockRateLimitHooks) AfterTransferDenied(ctx sdk.Context, reason, denom, channelId string, direction types.PacketDirection, amount sdkmath.Int) {
+	h.deniedTransfers = append(h.deniedTransfers, deniedTransfer{reason, denom, channelId, direction, amount})
+}
+
+func (h *mockRateLimitHooks) AfterFlowUpdated(ctx sdk.Context, rateLimit types.RateLimit, direction types.PacketDirection, amount sdkmath.Int) {
+	h.updatedFlows = append(h.updatedFlows, updatedFlow{rateLimit, direction, amount})
+}
+
+func (h *mockRateLimitHooks) AfterQuotaReset(ctx sdk.Context, rateLimit types.RateLimit) {
+	h.resetRateLimits = append(h.resetRateLimits, rateLimit)
+}
+
+// Registers a mock hook on the keeper and returns it so the invocations can be checked
+func (s *KeeperTestSuite) setupMockHooks() *mockRateLimitHooks {
+	hooks := &mockRateLimitHooks{}
+	s.App.RatelimitKeeper.SetHooks(hooks)
+	return hooks
+}
+
+func (s *KeeperTestSuite) TestSetHooks() {
+	s.Require().NotNil(s.App.RatelimitKeeper.Hooks(), "hooks should default to an empty set")
+
+	hooks := s.setupMockHooks()
+	s.Require().Equal(hooks, s.App.RatelimitKeeper.Hooks(), "registered hooks")
+
+	s.Require().Panics(func() { s.App.RatelimitKeeper.SetHooks(hooks) }, "hooks cannot be set twice")
+}
+
+func (s *KeeperTestSuite) TestMultiRateLimitHooks() {
+	hooksA := &mockRateLimitHooks{}
+	hooksB := &mockRateLimitHooks{}
+	s.App.RatelimitKeeper.SetHooks(types.NewMultiRateLimitHooks(hooksA, hooksB))
+
+	rateLimit := types.RateLimit{Path: &types.Path{Denom: denom, ChannelId: channelId}}
+	s.App.RatelimitKeeper.Hooks().AfterQuotaReset(s.Ctx, rateLimit)
+
+	s.Require().Equal([]types.RateLimit{rateLimit}, hooksA.resetRateLimits, "first hook")
+	s.Require().Equal([]types.RateLimit{rateLimit}, hooksB.resetRateLimits, "second hook")
+}
+
+func (s *KeeperTestSuite) TestHooks_CheckRateLimitAndUpdateFlow() {
+	s.SetupCheckRateLimitAndUpdateFlowTest()
+	hooks := s.setupMockHooks()
+
+	packetInfo := keeper.RateLimitedPacketInfo{
+		ChannelID: channelId,
+		Denom:     denom,
+		Amount:    sdkmath.NewInt(5),
+		Sender:    sender,
+		Receiver:  receiver,
+	}
+
+	// A successful transfer should update the flow
+	updated, err := s.App.RatelimitKeeper.CheckRateLimitAndUpdateFlow(s.Ctx, types.PACKET_SEND, packetInfo)
+	s.Require().NoError(err, "no error expected on first transfer")
+	s.Require().True(updated, "flow should be updated")
+
+	s.Require().Len(hooks.updatedFlows, 1, "number of flow updates")
+	s.Require().Equal(types.PACKET_SEND, hooks.updatedFlows[0].direction, "flow update direction")
+	s.Require().Equal(int64(5), hooks.updatedFlows[0].amount.Int64(), "flow update amount")
+	s.Require().Equal(int64(5), hooks.updatedFlows[0].rateLimit.Flow.Outflow.Int64(), "flow update outflow")
+	s.Require().Empty(hooks.deniedTransfers, "no transfers should be denied yet")
+
+	// Exceeding the quota should trigger the denied hook
+	packetInfo.Amount = sdkmath.NewInt(6)
+	_, err = s.App.RatelimitKeeper.CheckRateLimitAndUpdateFlow(s.Ctx, types.PACKET_SEND, packetInfo)
+	s.Require().ErrorIs(err, types.ErrQuotaExceeded)
+
+	s.Require().Len(hooks.updatedFlows, 1, "flow should not be updated after denial")
+	s.Require().Equal([]deniedTransfer{{
+		reason:    types.EventRateLimitExceeded,
+		denom:     denom,
+		channelId: channelId,
+		direction: types.PACKET_SEND,
+		amount:    sdkmath.NewInt(6),
+	}}, hooks.deniedTransfers, "denied transfers after quota exceeded")
+
+	// A blacklisted denom should also trigger the denied hook
+	s.App.RatelimitKeeper.AddDenomToBlacklist(s.Ctx, denom)
+	_, err = s.App.RatelimitKeeper.CheckRateLimitAndUpdateFlow(s.Ctx, types.PACKET_RECV, packetInfo)
+	s.Require().ErrorIs(err, types.ErrDenomIsBlacklisted)
+
+	s.Require().Len(hooks.deniedTransfers, 2, "denied transfers after blacklist")
+	s.Require().Equal(types.EventBlacklistedDenom, hooks.deniedTransfers[1].reason, "blacklist reason")
+	s.Require().Equal(types.PACKET_RECV, hooks.deniedTransfers[1].direction, "blacklist direction")
+}
+
+func (s *KeeperTestSuite) TestHooks_ResetRateLimit() {
+	s.resetRateLimits(denom, []uint64{2, 3}, 10)
+	hooks := s.setupMockHooks()
+
+	// Resetting from governance should trigger the hook
+	err := s.App.RatelimitKeeper.ResetRateLimit(s.Ctx, denom, "channel-0")
+	s.Require().NoError(err)
+	s.Require().Len(hooks.resetRateLimits, 1, "number of resets after manual reset")
+	s.Require().Equal("channel-0", hooks.resetRateLimits[0].Path.ChannelId, "reset channel")
+	s.Require().Zero(hooks.resetRateLimits[0].Flow.Outflow.Int64(), "reset outflow")
+
+	// The epoch reset in the BeginBlocker should also trigger the hook
+	// The epoch number is divisible by 3, so only channel-1 is reset
+	blockTime := time.Date(2024, 1, 1, 0, 0, 0, 0, time.UTC)
+	s.Ctx = s.Ctx.WithBlockTime(blockTime)
+	s.App.RatelimitKeeper.SetHourEpoch(s.Ctx, types.HourEpoch{
+		EpochNumber:    2,
+		Duration:       time.Minute,
+		EpochStartTime: blockTime.Add(-2 * time.Minute),
+	})
+	s.App.RatelimitKeeper.BeginBlocker(s.Ctx)
+
+	s.Require().Len(hooks.resetRateLimits, 2, "number of resets after begin blocker")
+	s.Require().Equal("channel-1", hooks.resetRateLimits[1].Path.ChannelId, "begin blocker reset channel")
+
+	// A failed reset should not trigger the hook
+	err = s.App.RatelimitKeeper.ResetRateLimit(s.Ctx, denom, "channel-99")
+	s.Require().ErrorIs(err, types.ErrRateLimitNotFound)
+	s.Require().Len(hooks.resetRateLimits, 2, "number of resets after failed reset")
+}
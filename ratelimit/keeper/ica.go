@@ -0,0 +1,301 @@
+package keeper
+
+import (
+	"encoding/json"
+	"errors"
+	"fmt"
+	"strings"
+
+	errorsmod "cosmossdk.io/errors"
+	"github.com/cosmos/cosmos-sdk/codec"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+	banktypes "github.com/cosmos/cosmos-sdk/x/bank/types"
+	icatypes "github.com/cosmos/ibc-go/v7/modules/apps/27-interchain-accounts/types"
+	transfertypes "github.com/cosmos/ibc-go/v7/modules/apps/transfer/types"
+	channeltypes "github.com/cosmos/ibc-go/v7/modules/core/04-channel/types"
+
+	"github.com/Stride-Labs/ibc-rate-limiting/ratelimit/types"
+)
+
+// Checks whether the port belongs to the interchain accounts controller submodule
+func IsIcaControllerPort(portId string) bool {
+	return strings.HasPrefix(portId, icatypes.ControllerPortPrefix)
+}
+
+// Returns the port that a rate limited channel is bound to. Rate limits can be added to
+// either transfer channels or interchain account controller channels
+func (k Keeper) GetRateLimitedChannelPort(ctx sdk.Context, channelId string) (portId string, found bool) {
+	if _, found := k.channelKeeper.GetChannel(ctx, transfertypes.PortID, channelId); found {
+		return transfertypes.PortID, true
+	}
+	for _, channel := range k.channelKeeper.GetAllChannelsWithPortPrefix(ctx, icatypes.ControllerPortPrefix) {
+		if channel.ChannelId == channelId {
+			return channel.PortId, true
+		}
+	}
+	return "", false
+}
+
+// Checks whether a rate limited channel does not exist or has been closed
+// Channels that are still in the handshake are considered open, since a rate limit can be
+// added before the channel is open
+// Rate limits are not removed when their channel closes (e.g. an ordered ICA channel that
+// times out), so these are surfaced through a query for governance to remove
+func (k Keeper) IsRateLimitedChannelClosed(ctx sdk.Context, channelId string) bool {
+	portId, found := k.GetRateLimitedChannelPort(ctx, channelId)
+	if !found {
+		return true
+	}
+	channel, _ := k.channelKeeper.GetChannel(ctx, portId, channelId)
+	return channel.State == channeltypes.CLOSED
+}
+
+// Determines the encoding of the CosmosTx in an ICA packet from the channel version
+// Falls back to protobuf if the version can't be parsed
+func (k Keeper) getIcaChannelEncoding(ctx sdk.Context, portId, channelId string) string {
+	version, found := k.ics4Wrapper.GetAppVersion(ctx, portId, channelId)
+	if !found {
+		return icatypes.EncodingProtobuf
+	}
+	version = UnwrapFeeVersion(version)
+
+	var metadata icatypes.Metadata
+	if err := icatypes.ModuleCdc.UnmarshalJSON([]byte(version), &metadata); err != nil || metadata.Encoding == "" {
+		return icatypes.EncodingProtobuf
+	}
+	return metadata.Encoding
+}
+
+// Returns an empty token transfer message (MsgTransfer or MsgSend) for a message type URL,
+// or nil if the message is not a token transfer
+func newIcaTransferMsg(typeUrl string) codec.ProtoMarshaler {
+	switch typeUrl {
+	case sdk.MsgTypeURL(&transfertypes.MsgTransfer{}):
+		return &transfertypes.MsgTransfer{}
+	case sdk.MsgTypeURL(&banktypes.MsgSend{}):
+		return &banktypes.MsgSend{}
+	default:
+		return nil
+	}
+}
+
+// Decodes the messages in the CosmosTx of an ICA packet
+// Each message is decoded from its type URL directly (rather than through the interface registry),
+// so that a tx containing message types that are not registered on the controller can still be
+// decoded. Messages that aren't token transfers are returned as nil
+// If a token transfer can't be decoded, ErrInvalidIcaTransfer is returned so that the packet
+// is rejected rather than sent without being rate limited
+func (k Keeper) deserializeIcaMsgs(data []byte, encoding string) ([]sdk.Msg, error) {
+	switch encoding {
+	case icatypes.EncodingProtobuf:
+		return k.deserializeIcaProtoMsgs(data)
+	case icatypes.EncodingProto3JSON:
+		return k.deserializeIcaJSONMsgs(data)
+	default:
+		return nil, errorsmod.Wrapf(icatypes.ErrInvalidCodec, "unsupported encoding format %s", encoding)
+	}
+}
+
+// Decodes the messages in a protobuf encoded CosmosTx
+func (k Keeper) deserializeIcaProtoMsgs(data []byte) ([]sdk.Msg, error) {
+	var cosmosTx icatypes.CosmosTx
+	if err := cosmosTx.Unmarshal(data); err != nil {
+		return nil, err
+	}
+
+	msgs := make([]sdk.Msg, len(cosmosTx.Messages))
+	for i, msgAny := range cosmosTx.Messages {
+		msg := newIcaTransferMsg(msgAny.TypeUrl)
+		if msg == nil {
+			continue
+		}
+		if err := k.cdc.Unmarshal(msgAny.Value, msg); err != nil {
+			return nil, errorsmod.Wrapf(types.ErrInvalidIcaTransfer, "message %d (%s): %s", i, msgAny.TypeUrl, err.Error())
+		}
+		msgs[i] = msg.(sdk.Msg)
+	}
+
+	return msgs, nil
+}
+
+// Decodes the messages in a proto3 JSON encoded CosmosTx
+// Each message is an object with its type URL under "@type", alongside the message's fields
+func (k Keeper) deserializeIcaJSONMsgs(data []byte) ([]sdk.Msg, error) {
+	jsonCdc, ok := k.cdc.(codec.JSONCodec)
+	if !ok {
+		return nil, errorsmod.Wrap(icatypes.ErrInvalidCodec, "codec does not support JSON")
+	}
+
+	var cosmosTx struct {
+		Messages []map[string]json.RawMessage `json:"messages"`
+	}
+	if err := json.Unmarshal(data, &cosmosTx); err != nil {
+		return nil, err
+	}
+
+	msgs := make([]sdk.Msg, len(cosmosTx.Messages))
+	for i, msgFields := range cosmosTx.Messages {
+		// A message without a readable type can't be executed on the host
+		var typeUrl string
+		if err := json.Unmarshal(msgFields["@type"], &typeUrl); err != nil {
+			continue
+		}
+		msg := newIcaTransferMsg(typeUrl)
+		if msg == nil {
+			continue
+		}
+
+		delete(msgFields, "@type")
+		msgJSON, err := json.Marshal(msgFields)
+		if err == nil {
+			err = jsonCdc.UnmarshalJSON(msgJSON, msg)
+		}
+		if err != nil {
+			return nil, errorsmod.Wrapf(types.ErrInvalidIcaTransfer, "message %d (%s): %s", i, typeUrl, err.Error())
+		}
+		msgs[i] = msg.(sdk.Msg)
+	}
+
+	return msgs, nil
+}
+
+// Parses the token transfers out of an interchain account packet sent from the controller
+// Each MsgTransfer, and each coin in a MsgSend, is returned as a separate transfer, attributed
+// to the controller channel (since the tokens leave the host account, which is controlled
+// over that channel). Any other message types in the tx are ignored
+func (k Keeper) ParseIcaPacketInfo(ctx sdk.Context, packet channeltypes.Packet) ([]RateLimitedPacketInfo, error) {
+	var packetData icatypes.InterchainAccountPacketData
+	if err := icatypes.ModuleCdc.UnmarshalJSON(packet.GetData(), &packetData); err != nil {
+		return nil, errorsmod.Wrapf(sdkerrors.ErrInvalidRequest, "cannot unmarshal ICA packet data: %s", err.Error())
+	}
+	if packetData.Type != icatypes.EXECUTE_TX {
+		return nil, nil
+	}
+
+	encoding := k.getIcaChannelEncoding(ctx, packet.GetSourcePort(), packet.GetSourceChannel())
+	msgs, err := k.deserializeIcaMsgs(packetData.Data, encoding)
+	if errors.Is(err, types.ErrInvalidIcaTransfer) {
+		return nil, err
+	}
+	if err != nil {
+		return nil, errorsmod.Wrapf(sdkerrors.ErrInvalidRequest, "cannot deserialize ICA tx: %s", err.Error())
+	}
+
+	transfers := []RateLimitedPacketInfo{}
+	for _, msg := range msgs {
+		switch msg := msg.(type) {
+		case *transfertypes.MsgTransfer:
+			transfers = append(transfers, RateLimitedPacketInfo{
+				ChannelID: packet.GetSourceChannel(),
+				Denom:     msg.Token.Denom,
+				Amount:    msg.Token.Amount,
+				Sender:    msg.Sender,
+				Receiver:  msg.Receiver,
+				Memo:      msg.Memo,
+			})
+		case *banktypes.MsgSend:
+			for _, coin := range msg.Amount {
+				transfers = append(transfers, RateLimitedPacketInfo{
+					ChannelID: packet.GetSourceChannel(),
+					Denom:     coin.Denom,
+					Amount:    coin.Amount,
+					Sender:    msg.FromAddress,
+					Receiver:  msg.ToAddress,
+				})
+			}
+		}
+	}
+
+	return transfers, nil
+}
+
+// Checks that a rate limit on an interchain account controller channel has a fixed channel value
+// The denoms in an ICA tx live on the host chain, so their supply and escrow balance on this chain
+// can't be used as the channel value
+func (k Keeper) ValidateIcaChannelValueStrategy(ctx sdk.Context, channelId string, strategy *types.ChannelValueStrategy) error {
+	portId, found := k.GetRateLimitedChannelPort(ctx, channelId)
+	if !found || !IsIcaControllerPort(portId) {
+		return nil
+	}
+	if strategy == nil || strategy.Source != types.CHANNEL_VALUE_FIXED {
+		return errorsmod.Wrapf(types.ErrInvalidChannelValueStrategy,
+			"rate limits on interchain account channel %s must use a fixed channel value", channelId)
+	}
+	return nil
+}
+
+// Middleware implementation for SendPacket on an interchain account controller channel
+// Each transfer in the ICA tx is checked against the rate limit for its denom on the controller channel
+func (k Keeper) SendRateLimitedIcaPacket(ctx sdk.Context, packet channeltypes.Packet) error {
+	// If the tx can't be decoded, it's not rate limited rather than rejected, since the
+	// host chain may support encodings that this chain doesn't
+	// However, if a token transfer in the tx can't be decoded, the packet is rejected, since
+	// the transfer would otherwise bypass the rate limit
+	transfers, err := k.ParseIcaPacketInfo(ctx, packet)
+	if errors.Is(err, types.ErrInvalidIcaTransfer) {
+		return err
+	}
+	if err != nil {
+		k.Logger(ctx).Info(fmt.Sprintf("ICA packet %s/%d is not rate limited: %s", packet.GetSourceChannel(), packet.Sequence, err.Error()))
+		return nil
+	}
+
+	// If any of the transfers exceed the quota, the error will revert the whole tx
+	updatedFlow := false
+	chargedAmount := sdk.Coins{}
+	var chargedValue sdk.DecCoins
+	for _, transfer := range transfers {
+		updated, value, err := k.checkRateLimitAndUpdateFlow(ctx, types.PACKET_SEND, transfer)
+		if err != nil {
+			return err
+		}
+		if updated {
+			updatedFlow = true
+			rateLimitDenom := k.GetRateLimitDenom(ctx, transfer.Denom)
+			chargedAmount = chargedAmount.Add(sdk.Coin{Denom: rateLimitDenom, Amount: transfer.Amount})
+			if value.IsPositive() {
+				chargedValue = chargedValue.Add(sdk.DecCoin{Denom: rateLimitDenom, Amount: value})
+			}
+		}
+	}
+
+	// Store the sequence number of the packet and the amount and value charged so that if the
+	// ICA tx fails, we can identify if it was sent during this quota and can revert the outflow
+	if updatedFlow {
+		k.SetPendingSendPacketRecord(ctx, types.PendingSendPacket{
+			ChannelId:   packet.GetSourceChannel(),
+			Sequence:    packet.Sequence,
+			Amount:      chargedAmount,
+			EpochNumber: k.GetHourEpoch(ctx).EpochNumber,
+			Direction:   types.PACKET_SEND,
+			Value:       chargedValue,
+		})
+	}
+
+	return nil
+}
+
+// Middleware implementation for OnAckPacket on an interchain account controller channel
+// If the ICA tx failed, the outflow from each transfer is decremented
+func (k Keeper) AcknowledgeRateLimitedIcaPacket(ctx sdk.Context, packet channeltypes.Packet, acknowledgement []byte) error {
+	ackSuccess, err := k.CheckAcknowledementSucceeded(ctx, acknowledgement)
+	if err != nil {
+		return err
+	}
+
+	if ackSuccess {
+		k.RemovePendingSendPacket(ctx, packet.GetSourceChannel(), packet.Sequence)
+		return nil
+	}
+
+	return k.TimeoutRateLimitedIcaPacket(ctx, packet)
+}
+
+// Middleware implementation for OnTimeoutPacket on an interchain account controller channel
+// The outflow charged for the timed out ICA tx is decremented
+// The refund is based on the amount stored with the pending packet, so the packet data does not need
+// to be decoded (and a packet that was not rate limited when it was sent has nothing to refund)
+func (k Keeper) TimeoutRateLimitedIcaPacket(ctx sdk.Context, packet channeltypes.Packet) error {
+	return k.RefundPendingSendPacket(ctx, packet.GetSourceChannel(), packet.Sequence, sdk.Coins{})
+}
@@ -0,0 +1,403 @@
+package keeper_test
+
+import (
+	"strings"
+
+	sdkmath "cosmossdk.io/math"
+	codectypes "github.com/cosmos/cosmos-sdk/codec/types"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	banktypes "github.com/cosmos/cosmos-sdk/x/bank/types"
+	"github.com/cosmos/gogoproto/proto"
+	icatypes "github.com/cosmos/ibc-go/v7/modules/apps/27-interchain-accounts/types"
+	transfertypes "github.com/cosmos/ibc-go/v7/modules/apps/transfer/types"
+	channeltypes "github.com/cosmos/ibc-go/v7/modules/core/04-channel/types"
+
+	"github.com/Stride-Labs/ibc-rate-limiting/ratelimit/keeper"
+	"github.com/Stride-Labs/ibc-rate-limiting/ratelimit/types"
+)
+
+const (
+	icaControllerPort = "icacontroller-owner"
+	icaHostPort       = "icahost"
+	icaChannelId      = "channel-5"
+	icaAddress        = "ica-address"
+)
+
+// Helper function to build an ICA packet with the given messages
+func (s *KeeperTestSuite) createIcaPacket(sequence uint64, msgs ...proto.Message) channeltypes.Packet {
+	data, err := icatypes.SerializeCosmosTx(s.App.AppCodec(), msgs)
+	s.Require().NoError(err, "no error expected when serializing ICA tx")
+
+	packetData := icatypes.InterchainAccountPacketData{
+		Type: icatypes.EXECUTE_TX,
+		Data: data,
+	}
+
+	return channeltypes.Packet{
+		SourcePort:         icaControllerPort,
+		SourceChannel:      icaChannelId,
+		DestinationPort:    icaHostPort,
+		DestinationChannel: channelOnHost,
+		Data:               packetData.GetBytes(),
+		Sequence:           sequence,
+	}
+}
+
+func (s *KeeperTestSuite) TestIsIcaControllerPort() {
+	s.Require().True(keeper.IsIcaControllerPort(icaControllerPort), "controller port")
+	s.Require().False(keeper.IsIcaControllerPort(icaHostPort), "host port")
+	s.Require().False(keeper.IsIcaControllerPort(transferPort), "transfer port")
+}
+
+func (s *KeeperTestSuite) TestGetRateLimitedChannelPort() {
+	s.App.IBCKeeper.ChannelKeeper.SetChannel(s.Ctx, transferPort, channelOnStride, channeltypes.Channel{})
+	s.App.IBCKeeper.ChannelKeeper.SetChannel(s.Ctx, icaControllerPort, icaChannelId, channeltypes.Channel{})
+
+	portId, found := s.App.RatelimitKeeper.GetRateLimitedChannelPort(s.Ctx, channelOnStride)
+	s.Require().True(found, "transfer channel should be found")
+	s.Require().Equal(transferPort, portId, "transfer port")
+
+	portId, found = s.App.RatelimitKeeper.GetRateLimitedChannelPort(s.Ctx, icaChannelId)
+	s.Require().True(found, "ica channel should be found")
+	s.Require().Equal(icaControllerPort, portId, "ica controller port")
+
+	_, found = s.App.RatelimitKeeper.GetRateLimitedChannelPort(s.Ctx, "channel-100")
+	s.Require().False(found, "non-existent channel should not be found")
+}
+
+func (s *KeeperTestSuite) TestParseIcaPacketInfo() {
+	packet := s.createIcaPacket(1,
+		&transfertypes.MsgTransfer{
+			SourcePort:    transferPort,
+			SourceChannel: channelOnHost,
+			Token:         sdk.NewCoin(ustrd, sdkmath.NewInt(10)),
+			Sender:        icaAddress,
+			Receiver:      receiver,
+			Memo:          "memo",
+		},
+		&banktypes.MsgSend{
+			FromAddress: icaAddress,
+			ToAddress:   receiver,
+			Amount:      sdk.NewCoins(sdk.NewCoin(ujuno, sdkmath.NewInt(20)), sdk.NewCoin(uosmo, sdkmath.NewInt(30))),
+		},
+		&banktypes.MsgMultiSend{},
+	)
+
+	expectedTransfers := []keeper.RateLimitedPacketInfo{
+		{ChannelID: icaChannelId, Denom: ustrd, Amount: sdkmath.NewInt(10), Sender: icaAddress, Receiver: receiver, Memo: "memo"},
+		{ChannelID: icaChannelId, Denom: ujuno, Amount: sdkmath.NewInt(20), Sender: icaAddress, Receiver: receiver},
+		{ChannelID: icaChannelId, Denom: uosmo, Amount: sdkmath.NewInt(30), Sender: icaAddress, Receiver: receiver},
+	}
+
+	actualTransfers, err := s.App.RatelimitKeeper.ParseIcaPacketInfo(s.Ctx, packet)
+	s.Require().NoError(err, "no error expected when parsing ICA packet")
+	s.Require().Equal(expectedTransfers, actualTransfers, "transfers")
+
+	// Invalid packet data should error
+	packet.Data = []byte("invalid")
+	_, err = s.App.RatelimitKeeper.ParseIcaPacketInfo(s.Ctx, packet)
+	s.Require().ErrorContains(err, "cannot unmarshal ICA packet data")
+}
+
+func (s *KeeperTestSuite) TestSendRateLimitedIcaPacket() {
+	sequence := uint64(10)
+
+	// Create a rate limit on the controller channel that's close to the quota (9/10)
+	s.createRateLimitCloseToQuota(ustrd, icaChannelId, types.PACKET_SEND)
+
+	transferMsg := &transfertypes.MsgTransfer{
+		SourcePort:    transferPort,
+		SourceChannel: channelOnHost,
+		Token:         sdk.NewCoin(ustrd, sdkmath.NewInt(5)),
+		Sender:        icaAddress,
+		Receiver:      receiver,
+	}
+	sendMsg := &banktypes.MsgSend{
+		FromAddress: icaAddress,
+		ToAddress:   receiver,
+		Amount:      sdk.NewCoins(sdk.NewCoin(ujuno, sdkmath.NewInt(5))),
+	}
+
+	// A transfer of an untracked denom should not be rate limited or stored as pending
+	err := s.App.RatelimitKeeper.SendRateLimitedPacket(s.Ctx, s.createIcaPacket(sequence, sendMsg))
+	s.Require().NoError(err, "no error expected for untracked denom")
+	s.Require().False(s.isPendingSendPacket(icaChannelId, sequence))
+
+	// The MsgTransfer should cause the quota to be exceeded
+	err = s.App.RatelimitKeeper.SendRateLimitedPacket(s.Ctx, s.createIcaPacket(sequence, sendMsg, transferMsg))
+	s.Require().ErrorIs(err, types.ErrQuotaExceeded, "error type")
+	s.Require().ErrorContains(err, "Outflow exceeds quota", "error text")
+
+	// Reset the rate limit and try again
+	err = s.App.RatelimitKeeper.ResetRateLimit(s.Ctx, ustrd, icaChannelId)
+	s.Require().NoError(err, "no error expected when resetting rate limit")
+
+	err = s.App.RatelimitKeeper.SendRateLimitedPacket(s.Ctx, s.createIcaPacket(sequence, sendMsg, transferMsg))
+	s.Require().NoError(err, "no error expected when sending packet after reset")
+
+	// Check that the outflow was updated and the pending packet was stored
+	rateLimit, found := s.App.RatelimitKeeper.GetRateLimit(s.Ctx, ustrd, icaChannelId)
+	s.Require().True(found)
+	s.Require().Equal(int64(5), rateLimit.Flow.Outflow.Int64(), "outflow")
+
+	// Only the rate limited transfer should be included in the pending packet's amount
+	pendingPacket, found := s.App.RatelimitKeeper.GetPendingSendPacket(s.Ctx, icaChannelId, sequence)
+	s.Require().True(found, "pending send packet")
+	s.Require().Equal(sdk.NewCoins(sdk.NewCoin(ustrd, sdkmath.NewInt(5))), pendingPacket.Amount, "pending packet amount")
+}
+
+func (s *KeeperTestSuite) TestAcknowledgeRateLimitedIcaPacket() {
+	initialOutflow := sdkmath.NewInt(100)
+	sequence := uint64(10)
+
+	packet := s.createIcaPacket(sequence,
+		&transfertypes.MsgTransfer{Token: sdk.NewCoin(ustrd, sdkmath.NewInt(10)), Sender: icaAddress, Receiver: receiver},
+		&banktypes.MsgSend{FromAddress: icaAddress, ToAddress: receiver, Amount: sdk.NewCoins(sdk.NewCoin(ustrd, sdkmath.NewInt(5)))},
+	)
+	ackSuccess := transfertypes.ModuleCdc.MustMarshalJSON(&channeltypes.Acknowledgement{
+		Response: &channeltypes.Acknowledgement_Result{Result: []byte{1}},
+	})
+	ackFailure := transfertypes.ModuleCdc.MustMarshalJSON(&channeltypes.Acknowledgement{
+		Response: &channeltypes.Acknowledgement_Error{Error: "error"},
+	})
+
+	s.App.RatelimitKeeper.SetRateLimit(s.Ctx, types.RateLimit{
+		Path: &types.Path{Denom: ustrd, ChannelId: icaChannelId},
+		Flow: &types.Flow{Outflow: initialOutflow},
+	})
+
+	// A successful ack should only remove the pending packet
+	s.App.RatelimitKeeper.SetPendingSendPacketRecord(s.Ctx, types.PendingSendPacket{
+		ChannelId: icaChannelId,
+		Sequence:  sequence,
+		Amount:    sdk.NewCoins(sdk.NewCoin(ustrd, sdkmath.NewInt(15))),
+	})
+	err := s.App.RatelimitKeeper.AcknowledgeRateLimitedPacket(s.Ctx, packet, ackSuccess)
+	s.Require().NoError(err, "no error expected during successful ack")
+
+	s.Require().False(s.isPendingSendPacket(icaChannelId, sequence))
+	rateLimit, found := s.App.RatelimitKeeper.GetRateLimit(s.Ctx, ustrd, icaChannelId)
+	s.Require().True(found)
+	s.Require().Equal(initialOutflow.Int64(), rateLimit.Flow.Outflow.Int64(), "outflow after successful ack")
+
+	// A failed ack should decrement the outflow from both transfers
+	s.App.RatelimitKeeper.SetPendingSendPacketRecord(s.Ctx, types.PendingSendPacket{
+		ChannelId: icaChannelId,
+		Sequence:  sequence,
+		Amount:    sdk.NewCoins(sdk.NewCoin(ustrd, sdkmath.NewInt(15))),
+	})
+	err = s.App.RatelimitKeeper.AcknowledgeRateLimitedPacket(s.Ctx, packet, ackFailure)
+	s.Require().NoError(err, "no error expected during failed ack")
+
+	s.Require().False(s.isPendingSendPacket(icaChannelId, sequence))
+	rateLimit, found = s.App.RatelimitKeeper.GetRateLimit(s.Ctx, ustrd, icaChannelId)
+	s.Require().True(found)
+	s.Require().Equal(int64(85), rateLimit.Flow.Outflow.Int64(), "outflow after failed ack")
+}
+
+func (s *KeeperTestSuite) TestTimeoutRateLimitedIcaPacket() {
+	initialOutflow := sdkmath.NewInt(100)
+	sequence := uint64(10)
+
+	packet := s.createIcaPacket(sequence,
+		&transfertypes.MsgTransfer{Token: sdk.NewCoin(ustrd, sdkmath.NewInt(10)), Sender: icaAddress, Receiver: receiver},
+	)
+
+	s.App.RatelimitKeeper.SetRateLimit(s.Ctx, types.RateLimit{
+		Path: &types.Path{Denom: ustrd, ChannelId: icaChannelId},
+		Flow: &types.Flow{Outflow: initialOutflow},
+	})
+	s.App.RatelimitKeeper.SetPendingSendPacketRecord(s.Ctx, types.PendingSendPacket{
+		ChannelId: icaChannelId,
+		Sequence:  sequence,
+		Amount:    sdk.NewCoins(sdk.NewCoin(ustrd, sdkmath.NewInt(10))),
+	})
+
+	// Call OnTimeoutPacket - the outflow should get decremented
+	err := s.App.RatelimitKeeper.TimeoutRateLimitedPacket(s.Ctx, packet)
+	s.Require().NoError(err, "no error expected when calling timeout packet")
+
+	rateLimit, found := s.App.RatelimitKeeper.GetRateLimit(s.Ctx, ustrd, icaChannelId)
+	s.Require().True(found)
+	s.Require().Equal(int64(90), rateLimit.Flow.Outflow.Int64(), "outflow decremented")
+	s.Require().False(s.isPendingSendPacket(icaChannelId, sequence))
+
+	// Calling timeout again (from a packet sent in a previous quota) should not change the outflow
+	err = s.App.RatelimitKeeper.TimeoutRateLimitedPacket(s.Ctx, packet)
+	s.Require().NoError(err, "no error expected when calling timeout packet again")
+
+	rateLimit, found = s.App.RatelimitKeeper.GetRateLimit(s.Ctx, ustrd, icaChannelId)
+	s.Require().True(found)
+	s.Require().Equal(int64(90), rateLimit.Flow.Outflow.Int64(), "outflow unchanged")
+}
+
+func (s *KeeperTestSuite) TestSendRateLimitedIcaPacket_UndecodableTx() {
+	sequence := uint64(10)
+	s.createRateLimitCloseToQuota(ustrd, icaChannelId, types.PACKET_SEND)
+
+	// A tx with a message type that's not registered on the controller should still be decoded,
+	// and the MsgTransfer alongside it should be rate limited
+	transferMsg := &transfertypes.MsgTransfer{Token: sdk.NewCoin(ustrd, sdkmath.NewInt(5)), Sender: icaAddress, Receiver: receiver}
+	transferAny, err := codectypes.NewAnyWithValue(transferMsg)
+	s.Require().NoError(err)
+	cosmosTx := icatypes.CosmosTx{Messages: []*codectypes.Any{
+		{TypeUrl: "/host.only.v1.MsgUnknown", Value: []byte{1, 2, 3}},
+		transferAny,
+	}}
+	txData, err := cosmosTx.Marshal()
+	s.Require().NoError(err)
+
+	packet := s.createIcaPacket(sequence)
+	packet.Data = icatypes.InterchainAccountPacketData{Type: icatypes.EXECUTE_TX, Data: txData}.GetBytes()
+
+	err = s.App.RatelimitKeeper.SendRateLimitedPacket(s.Ctx, packet)
+	s.Require().ErrorIs(err, types.ErrQuotaExceeded, "transfer alongside unknown message should be rate limited")
+
+	// A packet that can't be decoded at all should not be rate limited
+	packet.Data = []byte("invalid")
+	err = s.App.RatelimitKeeper.SendRateLimitedPacket(s.Ctx, packet)
+	s.Require().NoError(err, "no error expected for undecodable packet")
+
+	_, found := s.App.RatelimitKeeper.GetPendingSendPacket(s.Ctx, icaChannelId, sequence)
+	s.Require().False(found, "undecodable packet should not be stored as pending")
+}
+
+func (s *KeeperTestSuite) TestSendRateLimitedIcaPacket_Proto3JSON() {
+	sequence := uint64(10)
+	s.createRateLimitCloseToQuota(ustrd, icaChannelId, types.PACKET_SEND)
+
+	// Open the controller channel with proto3 JSON encoding
+	version := string(icatypes.ModuleCdc.MustMarshalJSON(&icatypes.Metadata{
+		Version:  icatypes.Version,
+		Encoding: icatypes.EncodingProto3JSON,
+		TxType:   icatypes.TxTypeSDKMultiMsg,
+	}))
+	s.App.IBCKeeper.ChannelKeeper.SetChannel(s.Ctx, icaControllerPort, icaChannelId, channeltypes.Channel{
+		State:   channeltypes.OPEN,
+		Version: version,
+	})
+
+	// Helper function to build a packet from the JSON messages in a CosmosTx
+	createJSONPacket := func(msgs ...string) channeltypes.Packet {
+		packet := s.createIcaPacket(sequence)
+		txData := []byte(`{"messages": [` + strings.Join(msgs, ",") + `]}`)
+		packet.Data = icatypes.InterchainAccountPacketData{Type: icatypes.EXECUTE_TX, Data: txData}.GetBytes()
+		return packet
+	}
+
+	transferMsg := &transfertypes.MsgTransfer{Token: sdk.NewCoin(ustrd, sdkmath.NewInt(5)), Sender: icaAddress, Receiver: receiver}
+	transferTx, err := icatypes.SerializeCosmosTxWithEncoding(s.App.AppCodec(), []proto.Message{transferMsg}, icatypes.EncodingProto3JSON)
+	s.Require().NoError(err)
+
+	// An over-quota transfer should be rejected
+	packet := s.createIcaPacket(sequence)
+	packet.Data = icatypes.InterchainAccountPacketData{Type: icatypes.EXECUTE_TX, Data: transferTx}.GetBytes()
+	err = s.App.RatelimitKeeper.SendRateLimitedPacket(s.Ctx, packet)
+	s.Require().ErrorIs(err, types.ErrQuotaExceeded, "over-quota transfer should be rejected")
+
+	// The same transfer alongside a message type that isn't registered should still be rejected
+	transferJSON := `{
+		"@type": "/ibc.applications.transfer.v1.MsgTransfer",
+		"token": {"denom": "ustrd", "amount": "5"},
+		"sender": "ica-address",
+		"receiver": "receiver"
+	}`
+	unknownJSON := `{"@type": "/not.registered.MsgFoo"}`
+	err = s.App.RatelimitKeeper.SendRateLimitedPacket(s.Ctx, createJSONPacket(unknownJSON, transferJSON))
+	s.Require().ErrorIs(err, types.ErrQuotaExceeded, "transfer alongside unknown message should be rejected")
+
+	// A transfer that can't be decoded should be rejected rather than sent without a rate limit
+	invalidTransferJSON := `{"@type": "/ibc.applications.transfer.v1.MsgTransfer", "token": "invalid"}`
+	err = s.App.RatelimitKeeper.SendRateLimitedPacket(s.Ctx, createJSONPacket(invalidTransferJSON))
+	s.Require().ErrorIs(err, types.ErrInvalidIcaTransfer, "undecodable transfer should be rejected")
+
+	invalidSendJSON := `{"@type": "/cosmos.bank.v1beta1.MsgSend", "amount": [{"denom": "ustrd", "amount": "abc"}]}`
+	err = s.App.RatelimitKeeper.SendRateLimitedPacket(s.Ctx, createJSONPacket(unknownJSON, invalidSendJSON))
+	s.Require().ErrorIs(err, types.ErrInvalidIcaTransfer, "undecodable send should be rejected")
+
+	// A tx without any transfers should not be rate limited
+	err = s.App.RatelimitKeeper.SendRateLimitedPacket(s.Ctx, createJSONPacket(unknownJSON))
+	s.Require().NoError(err, "no error expected for a tx without transfers")
+
+	_, found := s.App.RatelimitKeeper.GetPendingSendPacket(s.Ctx, icaChannelId, sequence)
+	s.Require().False(found, "packet should not be stored as pending")
+}
+
+func (s *KeeperTestSuite) TestTimeoutRateLimitedIcaPacket_UndecodableTx() {
+	sequence := uint64(10)
+
+	s.App.RatelimitKeeper.SetRateLimit(s.Ctx, types.RateLimit{
+		Path: &types.Path{Denom: ustrd, ChannelId: icaChannelId},
+		Flow: &types.Flow{Outflow: sdkmath.NewInt(100)},
+	})
+	s.App.RatelimitKeeper.SetPendingSendPacketRecord(s.Ctx, types.PendingSendPacket{
+		ChannelId: icaChannelId,
+		Sequence:  sequence,
+		Amount:    sdk.NewCoins(sdk.NewCoin(ustrd, sdkmath.NewInt(10))),
+	})
+
+	// The refund is based on the pending packet, so the packet data is never decoded
+	packet := s.createIcaPacket(sequence)
+	packet.Data = []byte("invalid")
+
+	err := s.App.RatelimitKeeper.TimeoutRateLimitedPacket(s.Ctx, packet)
+	s.Require().NoError(err, "no error expected on timeout")
+
+	ackFailure := transfertypes.ModuleCdc.MustMarshalJSON(&channeltypes.Acknowledgement{
+		Response: &channeltypes.Acknowledgement_Error{Error: "error"},
+	})
+	err = s.App.RatelimitKeeper.AcknowledgeRateLimitedPacket(s.Ctx, packet, ackFailure)
+	s.Require().NoError(err, "no error expected on failed ack")
+
+	rateLimit, found := s.App.RatelimitKeeper.GetRateLimit(s.Ctx, ustrd, icaChannelId)
+	s.Require().True(found)
+	s.Require().Equal(int64(90), rateLimit.Flow.Outflow.Int64(), "outflow should only be refunded once")
+}
+
+func (s *KeeperTestSuite) TestAddRateLimit_IcaControllerChannel() {
+	// The denom only exists on the host chain, so it has no supply on this chain
+	hostDenom := "uhost"
+	s.App.IBCKeeper.ChannelKeeper.SetChannel(s.Ctx, icaControllerPort, icaChannelId, channeltypes.Channel{})
+
+	msg := addRateLimitMsg
+	msg.Denom = hostDenom
+	msg.ChannelId = icaChannelId
+
+	// Without a channel value strategy, the rate limit should be rejected
+	err := s.App.RatelimitKeeper.AddRateLimit(s.Ctx, &msg)
+	s.Require().ErrorIs(err, types.ErrInvalidChannelValueStrategy, "no strategy")
+
+	// The supply and escrow based strategies can't be used either
+	msg.ChannelValueStrategy = &types.ChannelValueStrategy{Source: types.CHANNEL_VALUE_MAX, FixedValue: sdkmath.NewInt(1000)}
+	err = s.App.RatelimitKeeper.AddRateLimit(s.Ctx, &msg)
+	s.Require().ErrorIs(err, types.ErrInvalidChannelValueStrategy, "max strategy")
+
+	// With a fixed channel value, the rate limit should be added
+	msg.ChannelValueStrategy = &types.ChannelValueStrategy{Source: types.CHANNEL_VALUE_FIXED, FixedValue: sdkmath.NewInt(1000)}
+	err = s.App.RatelimitKeeper.AddRateLimit(s.Ctx, &msg)
+	s.Require().NoError(err, "no error expected when adding rate limit on ICA channel")
+
+	rateLimit, found := s.App.RatelimitKeeper.GetRateLimit(s.Ctx, hostDenom, icaChannelId)
+	s.Require().True(found, "rate limit should have been added")
+	s.Require().Equal(int64(1000), rateLimit.Flow.ChannelValue.Int64(), "channel value")
+
+	// A transfer of the host denom should be rate limited against the fixed channel value (20% of 1000)
+	transferMsg := &transfertypes.MsgTransfer{Token: sdk.NewCoin(hostDenom, sdkmath.NewInt(201)), Sender: icaAddress, Receiver: receiver}
+	err = s.App.RatelimitKeeper.SendRateLimitedPacket(s.Ctx, s.createIcaPacket(1, transferMsg))
+	s.Require().ErrorIs(err, types.ErrQuotaExceeded, "transfer above quota")
+
+	transferMsg.Token = sdk.NewCoin(hostDenom, sdkmath.NewInt(200))
+	err = s.App.RatelimitKeeper.SendRateLimitedPacket(s.Ctx, s.createIcaPacket(1, transferMsg))
+	s.Require().NoError(err, "transfer within quota")
+
+	// Updating the rate limit without a fixed channel value should also be rejected
+	updateMsg := updateRateLimitMsg
+	updateMsg.Denom = hostDenom
+	updateMsg.ChannelId = icaChannelId
+	err = s.App.RatelimitKeeper.UpdateRateLimit(s.Ctx, &updateMsg)
+	s.Require().ErrorIs(err, types.ErrInvalidChannelValueStrategy, "update without strategy")
+
+	updateMsg.ChannelValueStrategy = &types.ChannelValueStrategy{Source: types.CHANNEL_VALUE_FIXED, FixedValue: sdkmath.NewInt(2000)}
+	err = s.App.RatelimitKeeper.UpdateRateLimit(s.Ctx, &updateMsg)
+	s.Require().NoError(err, "update with fixed strategy")
+}
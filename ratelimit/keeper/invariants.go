@@ -0,0 +1,214 @@
+package keeper
+
+import (
+	"bytes"
+	"fmt"
+
+	"github.com/cosmos/cosmos-sdk/store/prefix"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	channeltypes "github.com/cosmos/ibc-go/v7/modules/core/04-channel/types"
+
+	"github.com/Stride-Labs/ibc-rate-limiting/ratelimit/types"
+)
+
+const (
+	nonNegativeFlowsInvariant   = "non-negative-flows"
+	pendingSendPacketsInvariant = "pending-send-packets"
+	rateLimitChannelsInvariant  = "rate-limit-channels"
+	rateLimitKeysInvariant      = "rate-limit-keys"
+)
+
+// Registers each of the module's invariants with the crisis module
+func RegisterInvariants(ir sdk.InvariantRegistry, k Keeper) {
+	ir.RegisterRoute(types.ModuleName, nonNegativeFlowsInvariant, NonNegativeFlowsInvariant(k))
+	ir.RegisterRoute(types.ModuleName, pendingSendPacketsInvariant, PendingSendPacketsInvariant(k))
+	ir.RegisterRoute(types.ModuleName, rateLimitChannelsInvariant, RateLimitChannelsInvariant(k))
+	ir.RegisterRoute(types.ModuleName, rateLimitKeysInvariant, RateLimitKeysInvariant(k))
+}
+
+// Runs all of the module's invariants, and returns the first one that's broken
+func AllInvariants(k Keeper) sdk.Invariant {
+	return func(ctx sdk.Context) (string, bool) {
+		for _, invariant := range []sdk.Invariant{
+			NonNegativeFlowsInvariant(k),
+			PendingSendPacketsInvariant(k),
+			RateLimitChannelsInvariant(k),
+			RateLimitKeysInvariant(k),
+		} {
+			if msg, broken := invariant(ctx); broken {
+				return msg, broken
+			}
+		}
+		return "", false
+	}
+}
+
+// Formats the result of an invariant from the list of violations
+func formatInvariant(name string, violations []string) (string, bool) {
+	msg := fmt.Sprintf("found %d violation(s)\n", len(violations))
+	for _, violation := range violations {
+		msg += fmt.Sprintf("\t%s\n", violation)
+	}
+	return sdk.FormatInvariant(types.ModuleName, name, msg), len(violations) > 0
+}
+
+// Checks that the inflow, outflow and channel value of each rate limit are not negative
+// A refund that decrements more than was charged (e.g. if the pending packets diverge
+// from the flow) would otherwise leave the outflow negative, inflating the remaining capacity
+func NonNegativeFlowsInvariant(k Keeper) sdk.Invariant {
+	return func(ctx sdk.Context) (string, bool) {
+		violations := []string{}
+		for _, rateLimit := range k.GetAllRateLimits(ctx) {
+			path := rateLimit.GetPath()
+			if rateLimit.Flow == nil {
+				violations = append(violations, fmt.Sprintf("rate limit %s/%s has no flow", path.GetDenom(), path.GetChannelId()))
+				continue
+			}
+			if err := rateLimit.Flow.Validate(); err != nil {
+				violations = append(violations, fmt.Sprintf("rate limit %s/%s: %s", path.GetDenom(), path.GetChannelId(), err.Error()))
+			}
+		}
+		return formatInvariant(nonNegativeFlowsInvariant, violations)
+	}
+}
+
+// Checks that each pending send packet is stored under the key of its channel and sequence,
+// and that it maps to a rate limited channel and to an existing rate limit for each denom
+// it was charged to (packets without a recorded amount must have at least one rate limit on
+// their channel)
+func PendingSendPacketsInvariant(k Keeper) sdk.Invariant {
+	return func(ctx sdk.Context) (string, bool) {
+		store := prefix.NewStore(ctx.KVStore(k.storeKey), types.PendingSendPacketPrefix)
+		iterator := store.Iterator(nil, nil)
+		defer iterator.Close()
+
+		violations := []string{}
+		for ; iterator.Valid(); iterator.Next() {
+			pendingPacket := types.PendingSendPacket{}
+			if err := k.cdc.Unmarshal(iterator.Value(), &pendingPacket); err != nil {
+				violations = append(violations, fmt.Sprintf("pending packet with key %x can not be decoded: %s", iterator.Key(), err.Error()))
+				continue
+			}
+			packetId := fmt.Sprintf("%s/%d", pendingPacket.ChannelId, pendingPacket.Sequence)
+
+			expectedKey := types.GetPendingSendPacketKey(pendingPacket.ChannelId, pendingPacket.Sequence)
+			if !bytes.Equal(iterator.Key(), expectedKey) {
+				violations = append(violations, fmt.Sprintf("pending packet %s is stored under key %x", packetId, iterator.Key()))
+			}
+
+			if _, found := k.GetRateLimitedChannelPort(ctx, pendingPacket.ChannelId); !found {
+				violations = append(violations, fmt.Sprintf("pending packet %s is on a channel that does not exist", packetId))
+			}
+
+			if pendingPacket.Amount.Empty() {
+				if len(k.GetRateLimitsByChannelId(ctx, pendingPacket.ChannelId)) == 0 {
+					violations = append(violations, fmt.Sprintf("pending packet %s is on a channel without rate limits", packetId))
+				}
+				continue
+			}
+			for _, coin := range pendingPacket.Amount {
+				if _, found := k.GetRateLimit(ctx, coin.Denom, pendingPacket.ChannelId); !found {
+					violations = append(violations, fmt.Sprintf("pending packet %s was charged to rate limit %s/%s, which does not exist",
+						packetId, coin.Denom, pendingPacket.ChannelId))
+				}
+			}
+		}
+		return formatInvariant(pendingSendPacketsInvariant, violations)
+	}
+}
+
+// Checks that each rate limit is on a channel that exists and has not been closed
+// Channels that are still in the handshake are allowed, since a rate limit can be added
+// before the channel is open
+// This invariant is report-only: the violations are logged, but it's never reported as broken.
+// Since a channel can be closed without governance (e.g. an ordered ICA channel that times out,
+// or a channel closed by the counterparty), anyone could otherwise halt the chain with
+// MsgVerifyInvariant. The same rate limits are returned by the RateLimitsOnClosedChannels query
+func RateLimitChannelsInvariant(k Keeper) sdk.Invariant {
+	return func(ctx sdk.Context) (string, bool) {
+		violations := []string{}
+		for _, rateLimit := range k.GetAllRateLimits(ctx) {
+			if rateLimit.Path == nil {
+				continue // reported by the key invariant
+			}
+			denom, channelId := rateLimit.Path.Denom, rateLimit.Path.ChannelId
+
+			portId, found := k.GetRateLimitedChannelPort(ctx, channelId)
+			if !found {
+				violations = append(violations, fmt.Sprintf("rate limit %s/%s is on a channel that does not exist", denom, channelId))
+				continue
+			}
+			channel, _ := k.channelKeeper.GetChannel(ctx, portId, channelId)
+			if channel.State == channeltypes.CLOSED {
+				violations = append(violations, fmt.Sprintf("rate limit %s/%s is on a closed channel", denom, channelId))
+			}
+		}
+
+		msg, found := formatInvariant(rateLimitChannelsInvariant, violations)
+		if found {
+			k.Logger(ctx).Error(msg)
+		}
+		return msg, false
+	}
+}
+
+// Checks that each rate limit is stored under the key built from its path, and that the channel
+// and denom index keys decode back to the path of an existing rate limit (and vice versa)
+func RateLimitKeysInvariant(k Keeper) sdk.Invariant {
+	return func(ctx sdk.Context) (string, bool) {
+		channelIndexStore := prefix.NewStore(ctx.KVStore(k.storeKey), types.RateLimitChannelIndexPrefix)
+		denomIndexStore := prefix.NewStore(ctx.KVStore(k.storeKey), types.RateLimitDenomIndexPrefix)
+
+		violations := []string{}
+
+		// Confirm each rate limit is stored under the key from its path, and is included in each index
+		rateLimitStore := prefix.NewStore(ctx.KVStore(k.storeKey), types.RateLimitKeyPrefix)
+		rateLimitIterator := rateLimitStore.Iterator(nil, nil)
+		defer rateLimitIterator.Close()
+
+		for ; rateLimitIterator.Valid(); rateLimitIterator.Next() {
+			rateLimit := types.RateLimit{}
+			if err := k.cdc.Unmarshal(rateLimitIterator.Value(), &rateLimit); err != nil {
+				violations = append(violations, fmt.Sprintf("rate limit with key %x can not be decoded: %s", rateLimitIterator.Key(), err.Error()))
+				continue
+			}
+			if rateLimit.Path == nil {
+				violations = append(violations, fmt.Sprintf("rate limit with key %x has no path", rateLimitIterator.Key()))
+				continue
+			}
+			denom, channelId := rateLimit.Path.Denom, rateLimit.Path.ChannelId
+
+			if !bytes.Equal(rateLimitIterator.Key(), types.GetRateLimitItemKey(denom, channelId)) {
+				violations = append(violations, fmt.Sprintf("rate limit %s/%s is stored under key %x", denom, channelId, rateLimitIterator.Key()))
+			}
+			if !channelIndexStore.Has(types.GetRateLimitChannelIndexKey(channelId, denom)) {
+				violations = append(violations, fmt.Sprintf("rate limit %s/%s is missing from the channel index", denom, channelId))
+			}
+			if !denomIndexStore.Has(types.GetRateLimitDenomIndexKey(denom, channelId)) {
+				violations = append(violations, fmt.Sprintf("rate limit %s/%s is missing from the denom index", denom, channelId))
+			}
+		}
+
+		// Confirm each index key decodes to the path of an existing rate limit
+		checkIndex := func(indexName string, indexStore prefix.Store, parsePath func(prefixedField, remainder string) (denom, channelId string)) {
+			iterator := indexStore.Iterator(nil, nil)
+			defer iterator.Close()
+
+			for ; iterator.Valid(); iterator.Next() {
+				prefixedField, remainder, err := types.ParseRateLimitIndexKey(iterator.Key())
+				if err != nil {
+					violations = append(violations, fmt.Sprintf("%s index: %s", indexName, err.Error()))
+					continue
+				}
+				denom, channelId := parsePath(prefixedField, remainder)
+				if _, found := k.GetRateLimit(ctx, denom, channelId); !found {
+					violations = append(violations, fmt.Sprintf("%s index references rate limit %s/%s, which does not exist", indexName, denom, channelId))
+				}
+			}
+		}
+		checkIndex("channel", channelIndexStore, func(channelId, denom string) (string, string) { return denom, channelId })
+		checkIndex("denom", denomIndexStore, func(denom, channelId string) (string, string) { return denom, channelId })
+
+		return formatInvariant(rateLimitKeysInvariant, violations)
+	}
+}
@@ -0,0 +1,174 @@
+package keeper_test
+
+import (
+	sdkmath "cosmossdk.io/math"
+	"github.com/cosmos/cosmos-sdk/store/prefix"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	transfertypes "github.com/cosmos/ibc-go/v7/modules/apps/transfer/types"
+	channeltypes "github.com/cosmos/ibc-go/v7/modules/core/04-channel/types"
+
+	"github.com/Stride-Labs/ibc-rate-limiting/ratelimit/keeper"
+	"github.com/Stride-Labs/ibc-rate-limiting/ratelimit/types"
+)
+
+// Helper function to store an open channel and a rate limit on it for each channel ID
+func (s *KeeperTestSuite) setupInvariantRateLimits(channelIds ...string) {
+	for _, channelId := range channelIds {
+		s.App.IBCKeeper.ChannelKeeper.SetChannel(s.Ctx, transfertypes.PortID, channelId, channeltypes.Channel{State: channeltypes.OPEN})
+		s.App.RatelimitKeeper.SetRateLimit(s.Ctx, types.RateLimit{
+			Path:  &types.Path{Denom: denom, ChannelId: channelId},
+			Quota: &types.Quota{MaxPercentSend: sdkmath.NewInt(10), MaxPercentRecv: sdkmath.NewInt(10), DurationHours: 24},
+			Flow:  &types.Flow{Inflow: sdkmath.NewInt(5), Outflow: sdkmath.NewInt(5), ChannelValue: sdkmath.NewInt(100)},
+		})
+	}
+}
+
+func (s *KeeperTestSuite) TestNonNegativeFlowsInvariant() {
+	s.setupInvariantRateLimits("channel-0", "channel-1")
+
+	_, broken := keeper.NonNegativeFlowsInvariant(s.App.RatelimitKeeper)(s.Ctx)
+	s.Require().False(broken, "invariant should not be broken with non-negative flows")
+
+	// Drive the outflow of one of the rate limits negative
+	rateLimit, found := s.App.RatelimitKeeper.GetRateLimit(s.Ctx, denom, "channel-1")
+	s.Require().True(found)
+	rateLimit.Flow.Outflow = sdkmath.NewInt(-1)
+	s.App.RatelimitKeeper.SetRateLimit(s.Ctx, rateLimit)
+
+	msg, broken := keeper.NonNegativeFlowsInvariant(s.App.RatelimitKeeper)(s.Ctx)
+	s.Require().True(broken, "invariant should be broken with a negative outflow")
+	s.Require().Contains(msg, "found 1 violation(s)")
+	s.Require().Contains(msg, "rate limit denom/channel-1: outflow (-1) can not be negative")
+}
+
+func (s *KeeperTestSuite) TestPendingSendPacketsInvariant() {
+	s.setupInvariantRateLimits("channel-0", "channel-1")
+
+	// Store packets charged to a rate limit, and without an amount on a rate limited channel
+	s.App.RatelimitKeeper.SetPendingSendPacketRecord(s.Ctx, types.PendingSendPacket{
+		ChannelId: "channel-0",
+		Sequence:  1,
+		Amount:    sdk.NewCoins(sdk.NewCoin(denom, sdkmath.NewInt(10))),
+	})
+	s.App.RatelimitKeeper.SetPendingSendPacketRecord(s.Ctx, types.PendingSendPacket{ChannelId: "channel-1", Sequence: 1})
+
+	_, broken := keeper.PendingSendPacketsInvariant(s.App.RatelimitKeeper)(s.Ctx)
+	s.Require().False(broken, "invariant should not be broken when each packet maps to a rate limit")
+
+	// Removing the rate limits should also remove the pending packets, so the invariant still holds
+	s.App.RatelimitKeeper.RemoveRateLimit(s.Ctx, denom, "channel-0")
+	s.App.RatelimitKeeper.RemoveRateLimit(s.Ctx, denom, "channel-1")
+	s.Require().Empty(s.App.RatelimitKeeper.GetAllPendingSendPackets(s.Ctx), "pending packets should be removed")
+
+	_, broken = keeper.PendingSendPacketsInvariant(s.App.RatelimitKeeper)(s.Ctx)
+	s.Require().False(broken, "invariant should not be broken after rate limits are removed")
+
+	// Store a packet charged to a rate limit that does not exist, one on a channel without rate limits,
+	// one on a channel that does not exist, and one under the key of another sequence
+	s.App.RatelimitKeeper.SetPendingSendPacketRecord(s.Ctx, types.PendingSendPacket{
+		ChannelId: "channel-0",
+		Sequence:  2,
+		Amount:    sdk.NewCoins(sdk.NewCoin(ustrd, sdkmath.NewInt(10))),
+	})
+	s.App.RatelimitKeeper.SetPendingSendPacketRecord(s.Ctx, types.PendingSendPacket{ChannelId: "channel-1", Sequence: 2})
+	s.App.RatelimitKeeper.SetPendingSendPacketRecord(s.Ctx, types.PendingSendPacket{ChannelId: "channel-9", Sequence: 1})
+
+	misplacedPacket := types.PendingSendPacket{
+		ChannelId: "channel-0",
+		Sequence:  3,
+		Amount:    sdk.NewCoins(sdk.NewCoin(ustrd, sdkmath.NewInt(10))),
+	}
+	pendingPacketStore := prefix.NewStore(s.Ctx.KVStore(s.App.GetKey(types.StoreKey)), types.PendingSendPacketPrefix)
+	pendingPacketStore.Set(types.GetPendingSendPacketKey("channel-0", 4), s.App.AppCodec().MustMarshal(&misplacedPacket))
+
+	msg, broken := keeper.PendingSendPacketsInvariant(s.App.RatelimitKeeper)(s.Ctx)
+	s.Require().True(broken, "invariant should be broken")
+	s.Require().Contains(msg, "found 6 violation(s)")
+	s.Require().Contains(msg, "pending packet channel-0/2 was charged to rate limit ustrd/channel-0, which does not exist")
+	s.Require().Contains(msg, "pending packet channel-1/2 is on a channel without rate limits")
+	s.Require().Contains(msg, "pending packet channel-9/1 is on a channel that does not exist")
+	s.Require().Contains(msg, "pending packet channel-9/1 is on a channel without rate limits")
+	s.Require().Contains(msg, "pending packet channel-0/3 is stored under key")
+	s.Require().Contains(msg, "pending packet channel-0/3 was charged to rate limit ustrd/channel-0, which does not exist")
+}
+
+func (s *KeeperTestSuite) TestRateLimitChannelsInvariant() {
+	s.setupInvariantRateLimits("channel-0", "channel-1")
+
+	// A rate limit on a channel that's still in the handshake should be allowed
+	s.App.IBCKeeper.ChannelKeeper.SetChannel(s.Ctx, transfertypes.PortID, "channel-1", channeltypes.Channel{State: channeltypes.INIT})
+
+	msg, broken := keeper.RateLimitChannelsInvariant(s.App.RatelimitKeeper)(s.Ctx)
+	s.Require().False(broken, "invariant should not be broken when each channel exists and is not closed")
+	s.Require().Contains(msg, "found 0 violation(s)")
+
+	// Close one of the channels, and add a rate limit on a channel that does not exist
+	s.App.IBCKeeper.ChannelKeeper.SetChannel(s.Ctx, transfertypes.PortID, "channel-0", channeltypes.Channel{State: channeltypes.CLOSED})
+	s.App.RatelimitKeeper.SetRateLimit(s.Ctx, types.RateLimit{
+		Path:  &types.Path{Denom: denom, ChannelId: "channel-9"},
+		Quota: &types.Quota{},
+		Flow:  &types.Flow{},
+	})
+
+	// The violations should be reported, but the invariant is report-only, so it's never broken
+	msg, broken = keeper.RateLimitChannelsInvariant(s.App.RatelimitKeeper)(s.Ctx)
+	s.Require().False(broken, "report-only invariant should not be broken")
+	s.Require().Contains(msg, "found 2 violation(s)")
+	s.Require().Contains(msg, "rate limit denom/channel-0 is on a closed channel")
+	s.Require().Contains(msg, "rate limit denom/channel-9 is on a channel that does not exist")
+}
+
+func (s *KeeperTestSuite) TestRateLimitKeysInvariant() {
+	s.setupInvariantRateLimits("channel-0", "channel-1")
+
+	_, broken := keeper.RateLimitKeysInvariant(s.App.RatelimitKeeper)(s.Ctx)
+	s.Require().False(broken, "invariant should not be broken when each key maps to its path")
+
+	storeKey := s.App.GetKey(types.StoreKey)
+
+	// Store a rate limit under a key that doesn't match its path
+	rateLimit, found := s.App.RatelimitKeeper.GetRateLimit(s.Ctx, denom, "channel-0")
+	s.Require().True(found)
+	rateLimitStore := prefix.NewStore(s.Ctx.KVStore(storeKey), types.RateLimitKeyPrefix)
+	rateLimitStore.Set(types.GetRateLimitItemKey("other-denom", "channel-0"), s.App.AppCodec().MustMarshal(&rateLimit))
+
+	// Store an index key for a rate limit that does not exist, and a malformed index key
+	channelIndexStore := prefix.NewStore(s.Ctx.KVStore(storeKey), types.RateLimitChannelIndexPrefix)
+	channelIndexStore.Set(types.GetRateLimitChannelIndexKey("channel-5", denom), []byte{1})
+	denomIndexStore := prefix.NewStore(s.Ctx.KVStore(storeKey), types.RateLimitDenomIndexPrefix)
+	denomIndexStore.Set([]byte{10, 'd'}, []byte{1})
+
+	// Remove a rate limit from the denom index
+	denomIndexStore.Delete(types.GetRateLimitDenomIndexKey(denom, "channel-1"))
+
+	msg, broken := keeper.RateLimitKeysInvariant(s.App.RatelimitKeeper)(s.Ctx)
+	s.Require().True(broken, "invariant should be broken")
+	s.Require().Contains(msg, "found 4 violation(s)")
+	s.Require().Contains(msg, "rate limit denom/channel-0 is stored under key")
+	s.Require().Contains(msg, "rate limit denom/channel-1 is missing from the denom index")
+	s.Require().Contains(msg, "channel index references rate limit denom/channel-5, which does not exist")
+	s.Require().Contains(msg, "denom index: rate limit index key (0a64) has an invalid length prefix")
+}
+
+func (s *KeeperTestSuite) TestAllInvariants() {
+	s.setupInvariantRateLimits("channel-0")
+
+	_, broken := keeper.AllInvariants(s.App.RatelimitKeeper)(s.Ctx)
+	s.Require().False(broken, "invariants should not be broken")
+
+	// A closed channel should not break any invariant, since it can be closed without governance
+	// (it's only reported by the report-only channel invariant)
+	s.App.IBCKeeper.ChannelKeeper.SetChannel(s.Ctx, transfertypes.PortID, "channel-0", channeltypes.Channel{State: channeltypes.CLOSED})
+
+	_, broken = keeper.AllInvariants(s.App.RatelimitKeeper)(s.Ctx)
+	s.Require().False(broken, "invariants should not be broken after the channel is closed")
+
+	rateLimit, found := s.App.RatelimitKeeper.GetRateLimit(s.Ctx, denom, "channel-0")
+	s.Require().True(found)
+	rateLimit.Flow.Inflow = sdkmath.NewInt(-1)
+	s.App.RatelimitKeeper.SetRateLimit(s.Ctx, rateLimit)
+
+	msg, broken := keeper.AllInvariants(s.App.RatelimitKeeper)(s.Ctx)
+	s.Require().True(broken, "invariants should be broken")
+	s.Require().Contains(msg, "non-negative-flows")
+}
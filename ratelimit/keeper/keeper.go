@@ -23,6 +23,9 @@ type (
 		bankKeeper    types.BankKeeper
 		channelKeeper types.ChannelKeeper
 		ics4Wrapper   types.ICS4Wrapper
+		oracleKeeper  types.OracleKeeper
+
+		hooks *hooksRef
 	}
 )
 
@@ -35,6 +38,11 @@ func NewKeeper(
 	channelKeeper types.ChannelKeeper,
 	ics4Wrapper types.ICS4Wrapper,
 ) *Keeper {
+	// set KeyTable if it has not already been set
+	if !ps.HasKeyTable() {
+		ps = ps.WithKeyTable(types.ParamKeyTable())
+	}
+
 	return &Keeper{
 		cdc:           cdc,
 		storeKey:      key,
@@ -43,6 +51,7 @@ func NewKeeper(
 		bankKeeper:    bankKeeper,
 		channelKeeper: channelKeeper,
 		ics4Wrapper:   ics4Wrapper,
+		hooks:         &hooksRef{},
 	}
 }
 
@@ -0,0 +1,44 @@
+package keeper
+
+import (
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	v2 "github.com/Stride-Labs/ibc-rate-limiting/ratelimit/migrations/v2"
+	v3 "github.com/Stride-Labs/ibc-rate-limiting/ratelimit/migrations/v3"
+	v4 "github.com/Stride-Labs/ibc-rate-limiting/ratelimit/migrations/v4"
+)
+
+// Migrator is a struct for handling in-place store migrations
+type Migrator struct {
+	keeper Keeper
+}
+
+// NewMigrator returns a new Migrator
+func NewMigrator(keeper Keeper) Migrator {
+	return Migrator{keeper: keeper}
+}
+
+// Migrate1to2 migrates the store from v1 to v2 (adds the rate limit channel and denom indexes,
+// and caches the counterparty chain ID of each rate limited channel)
+func (m Migrator) Migrate1to2(ctx sdk.Context) error {
+	if err := v2.MigrateStore(ctx, m.keeper.storeKey, m.keeper.cdc); err != nil {
+		return err
+	}
+
+	// The chain ID cache requires the channel keeper, so it's populated from the keeper
+	// (using the channel index added above)
+	for _, channelId := range m.keeper.GetAllRateLimitedChannels(ctx) {
+		m.keeper.UpdateChannelChainId(ctx, channelId)
+	}
+	return nil
+}
+
+// Migrate2to3 migrates the store from v2 to v3 (stores pending send packets as structured records)
+func (m Migrator) Migrate2to3(ctx sdk.Context) error {
+	return v3.MigrateStore(ctx, m.keeper.storeKey, m.keeper.cdc)
+}
+
+// Migrate3to4 migrates the store from v3 to v4 (records the quota window ID of each flow)
+func (m Migrator) Migrate3to4(ctx sdk.Context) error {
+	return v4.MigrateStore(ctx, m.keeper.storeKey, m.keeper.cdc)
+}
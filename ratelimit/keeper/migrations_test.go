@@ -0,0 +1,106 @@
+package keeper_test
+
+import (
+	sdkmath "cosmossdk.io/math"
+	"github.com/cosmos/cosmos-sdk/store/prefix"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	"github.com/Stride-Labs/ibc-rate-limiting/ratelimit/keeper"
+	"github.com/Stride-Labs/ibc-rate-limiting/ratelimit/types"
+)
+
+func (s *KeeperTestSuite) TestMigrate1to2() {
+	// channel-0 is a tendermint channel, and channel-1 is a solo machine channel without a chain ID
+	s.createChannelWithClient("channel-0", "connection-0", "07-tendermint-0", newTendermintClientState("chain-0"))
+	s.createChannelWithClient("channel-1", "connection-1", "06-solomachine-1", newSoloMachineClientState())
+
+	// Store the rate limits directly, without the indexes or cached chain IDs, as they would have been in v1
+	paths := []types.Path{
+		{Denom: "denom-A", ChannelId: "channel-0"},
+		{Denom: "denom-A", ChannelId: "channel-1"},
+		{Denom: "denom-B", ChannelId: "channel-0"},
+	}
+	rateLimitStore := prefix.NewStore(s.Ctx.KVStore(s.App.GetKey(types.StoreKey)), types.RateLimitKeyPrefix)
+	for _, path := range paths {
+		path := path
+		rateLimit := types.RateLimit{Path: &path}
+		rateLimitStore.Set(types.GetRateLimitItemKey(path.Denom, path.ChannelId), s.App.AppCodec().MustMarshal(&rateLimit))
+	}
+	s.Require().Empty(s.App.RatelimitKeeper.GetRateLimitsByChannelId(s.Ctx, "channel-0"), "no index before migration")
+
+	err := keeper.NewMigrator(s.App.RatelimitKeeper).Migrate1to2(s.Ctx)
+	s.Require().NoError(err, "no error expected during migration")
+
+	byChannel := s.App.RatelimitKeeper.GetRateLimitsByChannelId(s.Ctx, "channel-0")
+	s.Require().Len(byChannel, 2, "rate limits on channel-0")
+	s.Require().Equal(paths[0], *byChannel[0].Path, "first rate limit on channel-0")
+	s.Require().Equal(paths[2], *byChannel[1].Path, "second rate limit on channel-0")
+
+	byDenom := s.App.RatelimitKeeper.GetRateLimitsByDenom(s.Ctx, "denom-A")
+	s.Require().Len(byDenom, 2, "rate limits for denom-A")
+	s.Require().Equal(paths[0], *byDenom[0].Path, "first rate limit for denom-A")
+	s.Require().Equal(paths[1], *byDenom[1].Path, "second rate limit for denom-A")
+
+	// The chain ID should be cached for the tendermint channel only
+	chainId, found := s.App.RatelimitKeeper.GetChannelChainId(s.Ctx, "channel-0")
+	s.Require().True(found, "chain ID should be cached for the tendermint channel")
+	s.Require().Equal("chain-0", chainId, "cached chain ID")
+
+	_, found = s.App.RatelimitKeeper.GetChannelChainId(s.Ctx, "channel-1")
+	s.Require().False(found, "chain ID should not be cached for the solo machine channel")
+}
+
+func (s *KeeperTestSuite) TestMigrate2to3() {
+	s.App.RatelimitKeeper.SetHourEpoch(s.Ctx, types.HourEpoch{EpochNumber: 5})
+
+	// Store pending packets with the placeholder value, as they would have been in v2,
+	// as well as a packet that was already stored as a record
+	pendingPacketStore := prefix.NewStore(s.Ctx.KVStore(s.App.GetKey(types.StoreKey)), types.PendingSendPacketPrefix)
+	pendingPacketStore.Set(types.GetPendingSendPacketKey("channel-0", 1), []byte{1})
+	pendingPacketStore.Set(types.GetPendingSendPacketKey("channel-10", 2), []byte{1})
+
+	existingPacket := types.PendingSendPacket{
+		ChannelId:   "channel-1",
+		Sequence:    3,
+		Amount:      sdk.NewCoins(sdk.NewCoin("denom", sdkmath.NewInt(10))),
+		EpochNumber: 4,
+	}
+	s.App.RatelimitKeeper.SetPendingSendPacketRecord(s.Ctx, existingPacket)
+
+	err := keeper.NewMigrator(s.App.RatelimitKeeper).Migrate2to3(s.Ctx)
+	s.Require().NoError(err, "no error expected during migration")
+
+	// The placeholder packets should be converted to records without an amount
+	expectedPackets := []types.PendingSendPacket{
+		{ChannelId: "channel-0", Sequence: 1, EpochNumber: 5, Direction: types.PACKET_SEND},
+		{ChannelId: "channel-1", Sequence: 3, Amount: existingPacket.Amount, EpochNumber: 4},
+		{ChannelId: "channel-10", Sequence: 2, EpochNumber: 5, Direction: types.PACKET_SEND},
+	}
+	s.Require().Equal(expectedPackets, s.App.RatelimitKeeper.GetAllPendingSendPackets(s.Ctx), "pending packets after migration")
+}
+
+func (s *KeeperTestSuite) TestMigrate3to4() {
+	s.App.RatelimitKeeper.SetHourEpoch(s.Ctx, types.HourEpoch{EpochNumber: 7})
+
+	// Store rate limits without a window ID, as they would have been in v3
+	durations := map[string]uint64{"channel-0": 0, "channel-1": 1, "channel-2": 3}
+	for channelId, duration := range durations {
+		s.App.RatelimitKeeper.SetRateLimit(s.Ctx, types.RateLimit{
+			Path:  &types.Path{Denom: "denom", ChannelId: channelId},
+			Quota: &types.Quota{DurationHours: duration},
+			Flow:  &types.Flow{Inflow: sdkmath.NewInt(10), Outflow: sdkmath.NewInt(10), ChannelValue: sdkmath.NewInt(100)},
+		})
+	}
+
+	err := keeper.NewMigrator(s.App.RatelimitKeeper).Migrate3to4(s.Ctx)
+	s.Require().NoError(err, "no error expected during migration")
+
+	// The window ID should be the epoch number divided by the duration, and the flow unchanged
+	expectedWindowIds := map[string]uint64{"channel-0": 0, "channel-1": 7, "channel-2": 2}
+	for channelId, expectedWindowId := range expectedWindowIds {
+		rateLimit, found := s.App.RatelimitKeeper.GetRateLimit(s.Ctx, "denom", channelId)
+		s.Require().True(found, "rate limit should be found - %s", channelId)
+		s.Require().Equal(expectedWindowId, rateLimit.Flow.WindowId, "window id - %s", channelId)
+		s.Require().Equal(int64(10), rateLimit.Flow.Outflow.Int64(), "outflow - %s", channelId)
+	}
+}
@@ -78,3 +78,115 @@ func (k msgServer) ResetRateLimit(goCtx context.Context, msg *types.MsgResetRate
 
 	return &types.MsgResetRateLimitResponse{}, nil
 }
+
+// Adds or replaces a transfer rule
+func (k msgServer) SetTransferRule(goCtx context.Context, msg *types.MsgSetTransferRule) (*types.MsgSetTransferRuleResponse, error) {
+	ctx := sdk.UnwrapSDKContext(goCtx)
+	if k.authority != msg.Authority {
+		return nil, errorsmod.Wrapf(govtypes.ErrInvalidSigner, "invalid authority; expected %s, got %s", k.authority, msg.Authority)
+	}
+
+	k.Keeper.SetTransferRule(ctx, msg.Rule)
+	return &types.MsgSetTransferRuleResponse{}, nil
+}
+
+// Removes a transfer rule. Fails if the rule doesn't exist
+func (k msgServer) RemoveTransferRule(goCtx context.Context, msg *types.MsgRemoveTransferRule) (*types.MsgRemoveTransferRuleResponse, error) {
+	ctx := sdk.UnwrapSDKContext(goCtx)
+	if k.authority != msg.Authority {
+		return nil, errorsmod.Wrapf(govtypes.ErrInvalidSigner, "invalid authority; expected %s, got %s", k.authority, msg.Authority)
+	}
+
+	_, found := k.Keeper.GetTransferRule(ctx, msg.RuleId)
+	if !found {
+		return nil, types.ErrTransferRuleNotFound
+	}
+
+	k.Keeper.RemoveTransferRule(ctx, msg.RuleId)
+	return &types.MsgRemoveTransferRuleResponse{}, nil
+}
+
+// Adds or replaces a denom group
+// Fails if any of the denoms belong to another group or have their own rate limit
+func (k msgServer) SetDenomGroup(goCtx context.Context, msg *types.MsgSetDenomGroup) (*types.MsgSetDenomGroupResponse, error) {
+	ctx := sdk.UnwrapSDKContext(goCtx)
+	if k.authority != msg.Authority {
+		return nil, errorsmod.Wrapf(govtypes.ErrInvalidSigner, "invalid authority; expected %s, got %s", k.authority, msg.Authority)
+	}
+
+	if err := k.Keeper.ValidateDenomGroupConflicts(ctx, msg.Group); err != nil {
+		return nil, err
+	}
+
+	k.Keeper.SetDenomGroup(ctx, msg.Group)
+	return &types.MsgSetDenomGroupResponse{}, nil
+}
+
+// Removes a denom group. Fails if the group doesn't exist or still has rate limits
+func (k msgServer) RemoveDenomGroup(goCtx context.Context, msg *types.MsgRemoveDenomGroup) (*types.MsgRemoveDenomGroupResponse, error) {
+	ctx := sdk.UnwrapSDKContext(goCtx)
+	if k.authority != msg.Authority {
+		return nil, errorsmod.Wrapf(govtypes.ErrInvalidSigner, "invalid authority; expected %s, got %s", k.authority, msg.Authority)
+	}
+
+	_, found := k.Keeper.GetDenomGroup(ctx, msg.GroupId)
+	if !found {
+		return nil, types.ErrDenomGroupNotFound
+	}
+
+	if len(k.Keeper.GetRateLimitsByDenom(ctx, msg.GroupId)) > 0 {
+		return nil, errorsmod.Wrapf(types.ErrDenomGroupConflict,
+			"group %s has rate limits, which must be removed before the group can be removed", msg.GroupId)
+	}
+
+	k.Keeper.RemoveDenomGroup(ctx, msg.GroupId)
+	return &types.MsgRemoveDenomGroupResponse{}, nil
+}
+
+// Schedules an update to a rate limit at a future epoch or height
+// Fails if the rate limit doesn't exist or the execution epoch/height has already passed
+func (k msgServer) ScheduleRateLimitUpdate(goCtx context.Context, msg *types.MsgScheduleRateLimitUpdate) (*types.MsgScheduleRateLimitUpdateResponse, error) {
+	ctx := sdk.UnwrapSDKContext(goCtx)
+	if k.authority != msg.Authority {
+		return nil, errorsmod.Wrapf(govtypes.ErrInvalidSigner, "invalid authority; expected %s, got %s", k.authority, msg.Authority)
+	}
+
+	scheduleId, err := k.Keeper.ScheduleRateLimitUpdate(ctx, msg)
+	if err != nil {
+		return nil, err
+	}
+
+	return &types.MsgScheduleRateLimitUpdateResponse{ScheduleId: scheduleId}, nil
+}
+
+// Cancels a scheduled rate limit update. Fails if the scheduled update doesn't exist
+func (k msgServer) CancelScheduledRateLimitUpdate(goCtx context.Context, msg *types.MsgCancelScheduledRateLimitUpdate) (*types.MsgCancelScheduledRateLimitUpdateResponse, error) {
+	ctx := sdk.UnwrapSDKContext(goCtx)
+	if k.authority != msg.Authority {
+		return nil, errorsmod.Wrapf(govtypes.ErrInvalidSigner, "invalid authority; expected %s, got %s", k.authority, msg.Authority)
+	}
+
+	_, found := k.Keeper.GetScheduledUpdate(ctx, msg.ScheduleId)
+	if !found {
+		return nil, types.ErrScheduledUpdateNotFound
+	}
+
+	k.Keeper.RemoveScheduledUpdate(ctx, msg.ScheduleId)
+	return &types.MsgCancelScheduledRateLimitUpdateResponse{}, nil
+}
+
+// Adds, updates and removes many rate limits at once. Fails if any entry fails, unless
+// it's a new rate limit with a zero channel value and the batch is set to skip them
+func (k msgServer) BatchUpdateRateLimits(goCtx context.Context, msg *types.MsgBatchUpdateRateLimits) (*types.MsgBatchUpdateRateLimitsResponse, error) {
+	ctx := sdk.UnwrapSDKContext(goCtx)
+	if k.authority != msg.Authority {
+		return nil, errorsmod.Wrapf(govtypes.ErrInvalidSigner, "invalid authority; expected %s, got %s", k.authority, msg.Authority)
+	}
+
+	skipped, err := k.Keeper.BatchUpdateRateLimits(ctx, msg)
+	if err != nil {
+		return nil, err
+	}
+
+	return &types.MsgBatchUpdateRateLimitsResponse{Skipped: skipped}, nil
+}
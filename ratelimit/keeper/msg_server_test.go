@@ -199,3 +199,136 @@ func (s *KeeperTestSuite) TestMsgServer_ResetRateLimit() {
 		ChannelValue: channelValue,
 	})
 }
+
+func (s *KeeperTestSuite) TestMsgServer_SetTransferRule() {
+	msgServer := keeper.NewMsgServerImpl(s.App.RatelimitKeeper)
+
+	setTransferRuleMsg := types.MsgSetTransferRule{
+		Authority: authority,
+		Rule:      types.TransferRule{RuleId: "rule", MemoKey: "wasm", Action: types.RULE_ACTION_DENY},
+	}
+
+	// Attempt to set a rule with an invalid authority
+	invalidMsg := setTransferRuleMsg
+	invalidMsg.Authority = "invalid"
+	_, err := msgServer.SetTransferRule(s.Ctx, &invalidMsg)
+	s.Require().ErrorContains(err, "invalid authority")
+
+	// Set the rule successfully
+	_, err = msgServer.SetTransferRule(s.Ctx, &setTransferRuleMsg)
+	s.Require().NoError(err)
+
+	rule, found := s.App.RatelimitKeeper.GetTransferRule(s.Ctx, "rule")
+	s.Require().True(found)
+	s.Require().Equal(setTransferRuleMsg.Rule, rule)
+
+	// Update the rule's action
+	setTransferRuleMsg.Rule.Action = types.RULE_ACTION_EXEMPT
+	_, err = msgServer.SetTransferRule(s.Ctx, &setTransferRuleMsg)
+	s.Require().NoError(err)
+
+	rule, found = s.App.RatelimitKeeper.GetTransferRule(s.Ctx, "rule")
+	s.Require().True(found)
+	s.Require().Equal(types.RULE_ACTION_EXEMPT, rule.Action)
+}
+
+func (s *KeeperTestSuite) TestMsgServer_RemoveTransferRule() {
+	msgServer := keeper.NewMsgServerImpl(s.App.RatelimitKeeper)
+
+	removeTransferRuleMsg := types.MsgRemoveTransferRule{
+		Authority: authority,
+		RuleId:    "rule",
+	}
+
+	// Attempt to remove a rule that does not exist
+	_, err := msgServer.RemoveTransferRule(s.Ctx, &removeTransferRuleMsg)
+	s.Require().Equal(err, types.ErrTransferRuleNotFound)
+
+	// Add the rule and then remove it successfully
+	s.App.RatelimitKeeper.SetTransferRule(s.Ctx, types.TransferRule{RuleId: "rule", MemoKey: "wasm"})
+
+	_, err = msgServer.RemoveTransferRule(s.Ctx, &removeTransferRuleMsg)
+	s.Require().NoError(err)
+
+	_, found := s.App.RatelimitKeeper.GetTransferRule(s.Ctx, "rule")
+	s.Require().False(found)
+}
+
+func (s *KeeperTestSuite) TestMsgServer_SetDenomGroup() {
+	msgServer := keeper.NewMsgServerImpl(s.App.RatelimitKeeper)
+
+	setDenomGroupMsg := types.MsgSetDenomGroup{
+		Authority: authority,
+		Group:     types.DenomGroup{GroupId: "group/atom", Denoms: []string{"ibc/atom-direct", "ibc/atom-osmosis"}},
+	}
+
+	// Attempt to set a group with an invalid authority
+	invalidMsg := setDenomGroupMsg
+	invalidMsg.Authority = "invalid"
+	_, err := msgServer.SetDenomGroup(s.Ctx, &invalidMsg)
+	s.Require().ErrorContains(err, "invalid authority")
+
+	// Attempt to set a group with a denom that has its own rate limit
+	s.App.RatelimitKeeper.SetRateLimit(s.Ctx, types.RateLimit{
+		Path: &types.Path{Denom: "ibc/atom-osmosis", ChannelId: "channel-0"},
+	})
+	_, err = msgServer.SetDenomGroup(s.Ctx, &setDenomGroupMsg)
+	s.Require().ErrorIs(err, types.ErrDenomGroupConflict)
+	s.Require().ErrorContains(err, "denom ibc/atom-osmosis has its own rate limit")
+
+	// Remove the rate limit and set the group successfully
+	s.App.RatelimitKeeper.RemoveRateLimit(s.Ctx, "ibc/atom-osmosis", "channel-0")
+	_, err = msgServer.SetDenomGroup(s.Ctx, &setDenomGroupMsg)
+	s.Require().NoError(err)
+
+	group, found := s.App.RatelimitKeeper.GetDenomGroup(s.Ctx, "group/atom")
+	s.Require().True(found)
+	s.Require().Equal(setDenomGroupMsg.Group, group)
+
+	// Attempt to add one of the denoms to another group
+	_, err = msgServer.SetDenomGroup(s.Ctx, &types.MsgSetDenomGroup{
+		Authority: authority,
+		Group:     types.DenomGroup{GroupId: "group/cosmos", Denoms: []string{"ibc/atom-direct"}},
+	})
+	s.Require().ErrorIs(err, types.ErrDenomGroupConflict)
+	s.Require().ErrorContains(err, "denom ibc/atom-direct already belongs to group group/atom")
+
+	// Replace the group's denoms
+	setDenomGroupMsg.Group.Denoms = []string{"ibc/atom-direct"}
+	_, err = msgServer.SetDenomGroup(s.Ctx, &setDenomGroupMsg)
+	s.Require().NoError(err)
+
+	_, found = s.App.RatelimitKeeper.GetDenomGroupId(s.Ctx, "ibc/atom-osmosis")
+	s.Require().False(found, "removed denom should no longer be grouped")
+}
+
+func (s *KeeperTestSuite) TestMsgServer_RemoveDenomGroup() {
+	msgServer := keeper.NewMsgServerImpl(s.App.RatelimitKeeper)
+
+	removeDenomGroupMsg := types.MsgRemoveDenomGroup{
+		Authority: authority,
+		GroupId:   "group/atom",
+	}
+
+	// Attempt to remove a group that does not exist
+	_, err := msgServer.RemoveDenomGroup(s.Ctx, &removeDenomGroupMsg)
+	s.Require().Equal(err, types.ErrDenomGroupNotFound)
+
+	// Add the group with a rate limit, the group can't be removed until the rate limit is removed
+	s.App.RatelimitKeeper.SetDenomGroup(s.Ctx, types.DenomGroup{GroupId: "group/atom", Denoms: []string{"ibc/atom-direct"}})
+	s.App.RatelimitKeeper.SetRateLimit(s.Ctx, types.RateLimit{
+		Path: &types.Path{Denom: "group/atom", ChannelId: "channel-0"},
+	})
+
+	_, err = msgServer.RemoveDenomGroup(s.Ctx, &removeDenomGroupMsg)
+	s.Require().ErrorIs(err, types.ErrDenomGroupConflict)
+
+	s.App.RatelimitKeeper.RemoveRateLimit(s.Ctx, "group/atom", "channel-0")
+	_, err = msgServer.RemoveDenomGroup(s.Ctx, &removeDenomGroupMsg)
+	s.Require().NoError(err)
+
+	_, found := s.App.RatelimitKeeper.GetDenomGroup(s.Ctx, "group/atom")
+	s.Require().False(found)
+	_, found = s.App.RatelimitKeeper.GetDenomGroupId(s.Ctx, "ibc/atom-direct")
+	s.Require().False(found)
+}
@@ -0,0 +1,110 @@
+package keeper
+
+import (
+	errorsmod "cosmossdk.io/errors"
+	sdkmath "cosmossdk.io/math"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	"github.com/Stride-Labs/ibc-rate-limiting/ratelimit/types"
+)
+
+// Registers the price oracle used to enforce quotas denominated in a quote currency
+// Since the keeper is passed around by value, this must be called before the keeper is
+// copied into the IBC middleware, the module, or any other keepers
+func (k *Keeper) SetOracleKeeper(oracleKeeper types.OracleKeeper) *Keeper {
+	if k.oracleKeeper != nil {
+		panic("cannot set rate limit oracle keeper twice")
+	}
+	k.oracleKeeper = oracleKeeper
+	return k
+}
+
+// Returns the price of one unit of the denom in units of the quote denom
+// A price is only considered available if an oracle is registered and the price is positive
+func (k Keeper) GetQuotePrice(ctx sdk.Context, denom string, quoteDenom string) (price sdk.Dec, found bool) {
+	if k.oracleKeeper == nil {
+		return sdk.ZeroDec(), false
+	}
+	price, found = k.oracleKeeper.GetPrice(ctx, denom, quoteDenom)
+	if !found || price.IsNil() || !price.IsPositive() {
+		return sdk.ZeroDec(), false
+	}
+	return price, true
+}
+
+// Returns the price of the denom of a rate limit in units of the quote denom
+// Since the denoms in a denom group represent the same underlying asset, a group
+// is priced using its first denom
+func (k Keeper) getRateLimitPrice(ctx sdk.Context, rateLimitDenom string, quoteDenom string) (price sdk.Dec, found bool) {
+	members := k.GetRateLimitDenomMembers(ctx, rateLimitDenom)
+	if len(members) == 0 {
+		return sdk.ZeroDec(), false
+	}
+	return k.GetQuotePrice(ctx, members[0], quoteDenom)
+}
+
+// Checks whether a transfer would exceed the rate limit's quota in its quote currency
+// The transfer is converted at the current oracle price and added to the net value of the
+// flow, which holds the value of each prior transfer in the window at the price it was
+// processed at. Returns the value of the transfer, which should be added to the flow
+// If no price is available, the transfer is either only subject to the percentage
+// thresholds (and has no value), or rejected, depending on the quota's price fallback
+func (k Keeper) CheckValueQuota(
+	ctx sdk.Context,
+	rateLimit types.RateLimit,
+	direction types.PacketDirection,
+	amount sdkmath.Int,
+) (value sdk.Dec, err error) {
+	valueQuota := rateLimit.Quota.ValueQuota
+	if valueQuota == nil {
+		return sdk.ZeroDec(), nil
+	}
+	denom := rateLimit.Path.Denom
+
+	price, found := k.getRateLimitPrice(ctx, denom, valueQuota.QuoteDenom)
+	if !found {
+		if valueQuota.PriceFallback == types.PRICE_FALLBACK_DENY {
+			return sdk.ZeroDec(), errorsmod.Wrapf(types.ErrPriceNotAvailable, "no %s price for %s", valueQuota.QuoteDenom, denom)
+		}
+		k.Logger(ctx).Info("no price available for value quota, falling back to percentage thresholds",
+			"denom", denom, "channel", rateLimit.Path.ChannelId, "quote_denom", valueQuota.QuoteDenom)
+		return sdk.ZeroDec(), nil
+	}
+
+	value = types.ConvertToQuoteValue(amount, price)
+	netValue := rateLimit.Flow.GetNetValueFlow(direction).Add(value)
+	if valueQuota.CheckExceedsQuota(direction, netValue) {
+		return sdk.ZeroDec(), errorsmod.Wrapf(types.ErrQuotaExceeded,
+			"Net %s value exceeds quota - Net Value: %v%s, Price: %v, Threshold: %v%s",
+			direction.String(), netValue, valueQuota.QuoteDenom,
+			price, valueQuota.GetMaxValue(direction), valueQuota.QuoteDenom)
+	}
+
+	return value, nil
+}
+
+// Returns the amount that can still be transferred in the given direction before either the
+// percentage threshold, or the value quota (if transferred at the current price) is exceeded
+func (k Keeper) GetRemainingCapacity(
+	ctx sdk.Context,
+	rateLimit types.RateLimit,
+	quota types.Quota,
+	direction types.PacketDirection,
+) sdkmath.Int {
+	remaining := rateLimit.Flow.GetRemainingCapacity(direction, quota)
+
+	valueQuota := quota.ValueQuota
+	if valueQuota == nil {
+		return remaining
+	}
+	price, found := k.getRateLimitPrice(ctx, rateLimit.Path.Denom, valueQuota.QuoteDenom)
+	if !found {
+		if valueQuota.PriceFallback == types.PRICE_FALLBACK_DENY {
+			return sdkmath.ZeroInt()
+		}
+		return remaining
+	}
+
+	netValue := rateLimit.Flow.GetNetValueFlow(direction)
+	return sdkmath.MinInt(remaining, valueQuota.GetRemainingCapacity(direction, netValue, price))
+}
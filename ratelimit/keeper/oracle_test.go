@@ -0,0 +1,283 @@
+package keeper_test
+
+import (
+	"encoding/json"
+
+	sdkmath "cosmossdk.io/math"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	transfertypes "github.com/cosmos/ibc-go/v7/modules/apps/transfer/types"
+	channeltypes "github.com/cosmos/ibc-go/v7/modules/core/04-channel/types"
+
+	"github.com/Stride-Labs/ibc-rate-limiting/ratelimit/keeper"
+	"github.com/Stride-Labs/ibc-rate-limiting/ratelimit/types"
+)
+
+const quoteDenom = "usd"
+
+// Helper function to store a rate limit with a 10% percentage threshold on a channel value of 1000
+// (i.e. 100 tokens), and a value quota of 50usd in each direction
+func (s *KeeperTestSuite) setupValueQuotaRateLimit(fallback types.PriceFallback) types.RateLimit {
+	rateLimit := types.RateLimit{
+		Path: &types.Path{Denom: denom, ChannelId: channelId},
+		Quota: &types.Quota{
+			MaxPercentSend: sdkmath.NewInt(10),
+			MaxPercentRecv: sdkmath.NewInt(10),
+			DurationHours:  1,
+			ValueQuota: &types.ValueQuota{
+				QuoteDenom:    quoteDenom,
+				MaxValueSend:  sdkmath.NewInt(50),
+				MaxValueRecv:  sdkmath.NewInt(50),
+				PriceFallback: fallback,
+			},
+		},
+		Flow: &types.Flow{
+			Inflow:       sdkmath.ZeroInt(),
+			Outflow:      sdkmath.ZeroInt(),
+			ChannelValue: sdkmath.NewInt(1000),
+		},
+	}
+	s.App.RatelimitKeeper.SetRateLimit(s.Ctx, rateLimit)
+	return rateLimit
+}
+
+func (s *KeeperTestSuite) TestGetQuotePrice() {
+	_, found := s.App.RatelimitKeeper.GetQuotePrice(s.Ctx, denom, quoteDenom)
+	s.Require().False(found, "price should not be found before it's set")
+
+	s.App.OracleKeeper.SetPrice(denom, quoteDenom, sdk.MustNewDecFromStr("1.5"))
+	price, found := s.App.RatelimitKeeper.GetQuotePrice(s.Ctx, denom, quoteDenom)
+	s.Require().True(found, "price should be found")
+	s.Require().Equal(sdk.MustNewDecFromStr("1.5"), price, "price")
+
+	s.App.OracleKeeper.SetPrice(denom, quoteDenom, sdk.ZeroDec())
+	_, found = s.App.RatelimitKeeper.GetQuotePrice(s.Ctx, denom, quoteDenom)
+	s.Require().False(found, "zero price should not be considered available")
+
+	s.Require().Panics(func() {
+		s.App.RatelimitKeeper.SetOracleKeeper(s.App.OracleKeeper)
+	}, "oracle keeper should not be set twice")
+}
+
+func (s *KeeperTestSuite) TestCheckRateLimitAndUpdateFlow_ValueQuota() {
+	testCases := []struct {
+		name          string
+		fallback      types.PriceFallback
+		price         string
+		direction     types.PacketDirection
+		amount        int64
+		expectedError string
+	}{
+		{
+			name:      "send within value quota",
+			price:     "2",
+			direction: types.PACKET_SEND,
+			amount:    25, // 50usd
+		},
+		{
+			name:          "send exceeds value quota",
+			price:         "2",
+			direction:     types.PACKET_SEND,
+			amount:        26, // 52usd
+			expectedError: "Net PACKET_SEND value exceeds quota",
+		},
+		{
+			name:          "recv exceeds value quota",
+			price:         "2",
+			direction:     types.PACKET_RECV,
+			amount:        30, // 60usd
+			expectedError: "Net PACKET_RECV value exceeds quota",
+		},
+		{
+			name:          "value quota within, percentage threshold exceeded",
+			price:         "0.1",
+			direction:     types.PACKET_SEND,
+			amount:        101, // 10.1usd, but more than 10% of the channel value
+			expectedError: "Outflow exceeds quota",
+		},
+		{
+			name:      "no price with percentage fallback",
+			fallback:  types.PRICE_FALLBACK_PERCENT,
+			direction: types.PACKET_SEND,
+			amount:    100,
+		},
+		{
+			name:          "no price with percentage fallback, percentage threshold exceeded",
+			fallback:      types.PRICE_FALLBACK_PERCENT,
+			direction:     types.PACKET_SEND,
+			amount:        101,
+			expectedError: "Outflow exceeds quota",
+		},
+		{
+			name:          "no price with deny fallback",
+			fallback:      types.PRICE_FALLBACK_DENY,
+			direction:     types.PACKET_SEND,
+			amount:        1,
+			expectedError: "no usd price for denom: price not available",
+		},
+	}
+
+	for _, tc := range testCases {
+		s.Run(tc.name, func() {
+			s.SetupTest()
+			s.setupValueQuotaRateLimit(tc.fallback)
+			if tc.price != "" {
+				s.App.OracleKeeper.SetPrice(denom, quoteDenom, sdk.MustNewDecFromStr(tc.price))
+			}
+
+			amount := sdkmath.NewInt(tc.amount)
+			updated, err := s.App.RatelimitKeeper.CheckRateLimitAndUpdateFlow(s.Ctx, tc.direction, keeper.RateLimitedPacketInfo{
+				ChannelID: channelId,
+				Denom:     denom,
+				Amount:    amount,
+				Sender:    sender,
+				Receiver:  receiver,
+			})
+
+			rateLimit, found := s.App.RatelimitKeeper.GetRateLimit(s.Ctx, denom, channelId)
+			s.Require().True(found)
+
+			if tc.expectedError != "" {
+				s.Require().ErrorContains(err, tc.expectedError)
+				s.Require().False(updated, "flow should not have been updated")
+				s.Require().Zero(rateLimit.Flow.GetNetFlow(tc.direction).Int64(), "net flow")
+				return
+			}
+			s.Require().NoError(err, "no error expected")
+			s.Require().True(updated, "flow should have been updated")
+			s.Require().Equal(tc.amount, rateLimit.Flow.GetNetFlow(tc.direction).Int64(), "net flow")
+		})
+	}
+}
+
+func (s *KeeperTestSuite) TestGetRemainingCapacity_ValueQuota() {
+	rateLimit := s.setupValueQuotaRateLimit(types.PRICE_FALLBACK_PERCENT)
+	rateLimit.Flow.Outflow = sdkmath.NewInt(10)
+	valueOutflow := sdk.NewDec(20) // sent at a price of 2
+	rateLimit.Flow.ValueOutflow = &valueOutflow
+	quota := *rateLimit.Quota
+
+	// Without a price, only the percentage threshold applies (100 - 10)
+	remaining := s.App.RatelimitKeeper.GetRemainingCapacity(s.Ctx, rateLimit, quota, types.PACKET_SEND)
+	s.Require().Equal(int64(90), remaining.Int64(), "remaining without price")
+
+	// At a price of 2, the value quota is more restrictive ((50usd - 20usd) / 2)
+	s.App.OracleKeeper.SetPrice(denom, quoteDenom, sdk.NewDec(2))
+	remaining = s.App.RatelimitKeeper.GetRemainingCapacity(s.Ctx, rateLimit, quota, types.PACKET_SEND)
+	s.Require().Equal(int64(15), remaining.Int64(), "remaining with price")
+
+	// At a low enough price, the percentage threshold is more restrictive again
+	s.App.OracleKeeper.SetPrice(denom, quoteDenom, sdk.MustNewDecFromStr("0.01"))
+	remaining = s.App.RatelimitKeeper.GetRemainingCapacity(s.Ctx, rateLimit, quota, types.PACKET_SEND)
+	s.Require().Equal(int64(90), remaining.Int64(), "remaining with low price")
+
+	// With the deny fallback, there's no capacity without a price
+	s.App.OracleKeeper.RemovePrice(denom, quoteDenom)
+	quota.ValueQuota.PriceFallback = types.PRICE_FALLBACK_DENY
+	remaining = s.App.RatelimitKeeper.GetRemainingCapacity(s.Ctx, rateLimit, quota, types.PACKET_SEND)
+	s.Require().Zero(remaining.Int64(), "remaining with deny fallback")
+}
+
+func (s *KeeperTestSuite) TestCheckRateLimitAndUpdateFlow_ValueQuotaPriceChange() {
+	s.setupValueQuotaRateLimit(types.PRICE_FALLBACK_PERCENT)
+	checkSend := func(amount int64) error {
+		_, err := s.App.RatelimitKeeper.CheckRateLimitAndUpdateFlow(s.Ctx, types.PACKET_SEND, keeper.RateLimitedPacketInfo{
+			ChannelID: channelId,
+			Denom:     denom,
+			Amount:    sdkmath.NewInt(amount),
+		})
+		return err
+	}
+
+	// Send 20 tokens at a price of 2 (40usd)
+	s.App.OracleKeeper.SetPrice(denom, quoteDenom, sdk.NewDec(2))
+	s.Require().NoError(checkSend(20), "no error expected for first send")
+
+	// After the price rises to 10, the earlier send still only counts as 40usd, so one more
+	// token (10usd) can be sent, but not two
+	s.App.OracleKeeper.SetPrice(denom, quoteDenom, sdk.NewDec(10))
+	s.Require().NoError(checkSend(1), "no error expected at the new price")
+	s.Require().ErrorContains(checkSend(1), "Net PACKET_SEND value exceeds quota", "send over the value quota")
+
+	rateLimit, found := s.App.RatelimitKeeper.GetRateLimit(s.Ctx, denom, channelId)
+	s.Require().True(found)
+	s.Require().Equal(sdk.NewDec(50), rateLimit.Flow.GetNetValueFlow(types.PACKET_SEND), "net value outflow")
+	s.Require().Equal(int64(21), rateLimit.Flow.GetNetFlow(types.PACKET_SEND).Int64(), "net outflow")
+}
+
+func (s *KeeperTestSuite) TestSendRateLimitedPacket_ValueQuotaRefund() {
+	s.setupValueQuotaRateLimit(types.PRICE_FALLBACK_PERCENT)
+	s.App.OracleKeeper.SetPrice(denom, quoteDenom, sdk.MustNewDecFromStr("1.5"))
+
+	// Send 10 tokens at a price of 1.5 (15usd)
+	sequence := uint64(1)
+	packetData, err := json.Marshal(transfertypes.FungibleTokenPacketData{Denom: denom, Amount: "10", Sender: sender, Receiver: receiver})
+	s.Require().NoError(err)
+	packet := channeltypes.Packet{
+		SourcePort:         transferPort,
+		SourceChannel:      channelId,
+		DestinationPort:    transferPort,
+		DestinationChannel: channelOnHost,
+		Data:               packetData,
+		Sequence:           sequence,
+	}
+	err = s.App.RatelimitKeeper.SendRateLimitedPacket(s.Ctx, packet)
+	s.Require().NoError(err, "no error expected when sending packet")
+
+	// The value charged should be recorded with the pending packet
+	pendingPacket, found := s.App.RatelimitKeeper.GetPendingSendPacket(s.Ctx, channelId, sequence)
+	s.Require().True(found, "pending packet should have been stored")
+	s.Require().Equal(sdk.DecCoins{{Denom: denom, Amount: sdk.MustNewDecFromStr("15")}}, pendingPacket.Value, "pending packet value")
+
+	// After the price changes, the refund should decrement the same value that was charged
+	s.App.OracleKeeper.SetPrice(denom, quoteDenom, sdk.NewDec(4))
+	err = s.App.RatelimitKeeper.RefundPendingSendPacket(s.Ctx, channelId, sequence, sdk.Coins{})
+	s.Require().NoError(err, "no error expected when refunding packet")
+
+	rateLimit, found := s.App.RatelimitKeeper.GetRateLimit(s.Ctx, denom, channelId)
+	s.Require().True(found)
+	s.Require().True(rateLimit.Flow.GetNetValueFlow(types.PACKET_SEND).IsZero(), "net value outflow after refund")
+	s.Require().Zero(rateLimit.Flow.GetNetFlow(types.PACKET_SEND).Int64(), "net outflow after refund")
+}
+
+func (s *KeeperTestSuite) TestUpdateRateLimit_ValueQuotaDenomChange() {
+	rateLimit := s.setupValueQuotaRateLimit(types.PRICE_FALLBACK_PERCENT)
+	valueOutflow := sdk.NewDec(20)
+	rateLimit.Flow.Outflow = sdkmath.NewInt(10)
+	rateLimit.Flow.ValueOutflow = &valueOutflow
+	s.App.RatelimitKeeper.SetRateLimit(s.Ctx, rateLimit)
+	s.App.RatelimitKeeper.SetPendingSendPacketRecord(s.Ctx, types.PendingSendPacket{
+		ChannelId: channelId,
+		Sequence:  1,
+		Amount:    sdk.NewCoins(sdk.NewCoin(denom, sdkmath.NewInt(10))),
+		Value:     sdk.DecCoins{{Denom: denom, Amount: valueOutflow}},
+		Direction: types.PACKET_SEND,
+	})
+	s.createChannel(channelId)
+
+	// Preserving the flow with the same quote denom should keep the value
+	msg := updateRateLimitMsg
+	msg.FlowUpdateMode = types.FLOW_UPDATE_PRESERVE
+	msg.ValueQuota = rateLimit.Quota.ValueQuota
+	err := s.App.RatelimitKeeper.UpdateRateLimit(s.Ctx, &msg)
+	s.Require().NoError(err, "no error expected when updating with the same quote denom")
+
+	rateLimit, found := s.App.RatelimitKeeper.GetRateLimit(s.Ctx, denom, channelId)
+	s.Require().True(found)
+	s.Require().Equal(valueOutflow, rateLimit.Flow.GetNetValueFlow(types.PACKET_SEND), "value outflow with the same quote denom")
+
+	// Changing the quote denom should clear the value from the flow and pending packets
+	valueQuota := *rateLimit.Quota.ValueQuota
+	valueQuota.QuoteDenom = "eur"
+	msg.ValueQuota = &valueQuota
+	err = s.App.RatelimitKeeper.UpdateRateLimit(s.Ctx, &msg)
+	s.Require().NoError(err, "no error expected when updating the quote denom")
+
+	rateLimit, found = s.App.RatelimitKeeper.GetRateLimit(s.Ctx, denom, channelId)
+	s.Require().True(found)
+	s.Require().True(rateLimit.Flow.GetNetValueFlow(types.PACKET_SEND).IsZero(), "value outflow with a new quote denom")
+	s.Require().Equal(int64(10), rateLimit.Flow.Outflow.Int64(), "outflow should be preserved")
+
+	pendingPacket, found := s.App.RatelimitKeeper.GetPendingSendPacket(s.Ctx, channelId, 1)
+	s.Require().True(found, "pending packet should be kept")
+	s.Require().Empty(pendingPacket.Value, "pending packet value should be removed")
+}
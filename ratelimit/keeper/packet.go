@@ -9,6 +9,7 @@ import (
 	sdk "github.com/cosmos/cosmos-sdk/types"
 	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
 	capabilitytypes "github.com/cosmos/cosmos-sdk/x/capability/types"
+	ibcfeetypes "github.com/cosmos/ibc-go/v7/modules/apps/29-fee/types"
 	transfertypes "github.com/cosmos/ibc-go/v7/modules/apps/transfer/types"
 	clienttypes "github.com/cosmos/ibc-go/v7/modules/core/02-client/types"
 	channeltypes "github.com/cosmos/ibc-go/v7/modules/core/04-channel/types"
@@ -23,11 +24,25 @@ type RateLimitedPacketInfo struct {
 	Amount    sdkmath.Int
 	Sender    string
 	Receiver  string
+	Memo      string
+	// Forward holds the packet-forward-middleware metadata from the memo, if present
+	// For a RECV packet, this indicates the transfer will be forwarded out of Forward.Channel
+	Forward *types.ForwardMetadata
 }
 
 // CheckAcknowledementSucceeded unmarshals IBC Acknowledgements, and determines
 // whether the tx was successful
+//
+// If the rate limit middleware is wrapped around the ICS-29 fee middleware (i.e. core IBC -> ratelimit
+// -> fee -> transfer), core IBC passes the ack to the rate limit middleware before the fee middleware
+// has unwrapped it, so the ack on a fee-enabled channel will be an IncentivizedAcknowledgement. In that
+// case, the underlying app acknowledgement is unwrapped before checking the result
 func (k Keeper) CheckAcknowledementSucceeded(ctx sdk.Context, ack []byte) (success bool, err error) {
+	var incentivizedAck ibcfeetypes.IncentivizedAcknowledgement
+	if err := ibcfeetypes.ModuleCdc.UnmarshalJSON(ack, &incentivizedAck); err == nil && len(incentivizedAck.AppAcknowledgement) > 0 {
+		return k.CheckAcknowledementSucceeded(ctx, incentivizedAck.AppAcknowledgement)
+	}
+
 	// Unmarshal the raw ack response
 	var acknowledgement channeltypes.Acknowledgement
 	if err := transfertypes.ModuleCdc.UnmarshalJSON(ack, &acknowledgement); err != nil {
@@ -63,12 +78,18 @@ func (k Keeper) CheckAcknowledementSucceeded(ctx sdk.Context, ack []byte) (succe
 // For NATIVE denoms, return as is (e.g. ustrd)
 // For NON-NATIVE denoms, take the ibc hash (e.g. hash "transfer/channel-2/usoms" into "ibc/...")
 func ParseDenomFromSendPacket(packet transfertypes.FungibleTokenPacketData) (denom string) {
+	return ParseDenomFromTrace(packet.Denom)
+}
+
+// Parse the denom that's used by the rate limit module from a denom that may include a trace
+// (e.g. "transfer/channel-2/uosmo" is hashed into "ibc/...", while "ustrd" and "ibc/..." are returned as is)
+func ParseDenomFromTrace(denomWithTrace string) (denom string) {
 	// Determine the denom by looking at the denom trace path
-	denomTrace := transfertypes.ParseDenomTrace(packet.Denom)
+	denomTrace := transfertypes.ParseDenomTrace(denomWithTrace)
 
 	// Native assets will have an empty trace path and can be returned as is
 	if denomTrace.Path == "" {
-		denom = packet.Denom
+		denom = denomWithTrace
 	} else {
 		// Non-native assets should be hashed
 		denom = denomTrace.IBCDenom()
@@ -149,6 +170,9 @@ func ParseDenomFromRecvPacket(packet channeltypes.Packet, packetData transfertyp
 // and the "Destination" will be the Host Channel
 // And, when a receive packet lands on a Stride, the "Source" will be the host zone's channel,
 // and the "Destination" will be the Stride Channel
+//
+// If the memo contains packet-forward-middleware metadata, it is also parsed so that
+// multi-hop transfers can be identified
 func ParsePacketInfo(packet channeltypes.Packet, direction types.PacketDirection) (RateLimitedPacketInfo, error) {
 	var packetData transfertypes.FungibleTokenPacketData
 	if err := json.Unmarshal(packet.GetData(), &packetData); err != nil {
@@ -176,6 +200,8 @@ func ParsePacketInfo(packet channeltypes.Packet, direction types.PacketDirection
 		Amount:    amount,
 		Sender:    packetData.Sender,
 		Receiver:  packetData.Receiver,
+		Memo:      packetData.Memo,
+		Forward:   types.ParseForwardMetadata(packetData.Memo),
 	}
 
 	return packetInfo, nil
@@ -184,21 +210,36 @@ func ParsePacketInfo(packet channeltypes.Packet, direction types.PacketDirection
 // Middleware implementation for SendPacket with rate limiting
 // Checks whether the rate limit has been exceeded - and if it hasn't, sends the packet
 func (k Keeper) SendRateLimitedPacket(ctx sdk.Context, packet channeltypes.Packet) error {
+	if IsIcaControllerPort(packet.GetSourcePort()) {
+		return k.SendRateLimitedIcaPacket(ctx, packet)
+	}
+
 	packetInfo, err := ParsePacketInfo(packet, types.PACKET_SEND)
 	if err != nil {
 		return err
 	}
 
 	// Check if the packet would exceed the outflow rate limit
-	updatedFlow, err := k.CheckRateLimitAndUpdateFlow(ctx, types.PACKET_SEND, packetInfo)
+	updatedFlow, value, err := k.checkRateLimitAndUpdateFlow(ctx, types.PACKET_SEND, packetInfo)
 	if err != nil {
 		return err
 	}
 
-	// Store the sequence number of the packet so that if the transfer fails,
+	// Store the sequence number, amount and value of the packet so that if the transfer fails,
 	// we can identify if it was sent during this quota and can revert the outflow
 	if updatedFlow {
-		k.SetPendingSendPacket(ctx, packetInfo.ChannelID, packet.Sequence)
+		rateLimitDenom := k.GetRateLimitDenom(ctx, packetInfo.Denom)
+		pendingPacket := types.PendingSendPacket{
+			ChannelId:   packetInfo.ChannelID,
+			Sequence:    packet.Sequence,
+			Amount:      sdk.Coins{sdk.Coin{Denom: rateLimitDenom, Amount: packetInfo.Amount}},
+			EpochNumber: k.GetHourEpoch(ctx).EpochNumber,
+			Direction:   types.PACKET_SEND,
+		}
+		if value.IsPositive() {
+			pendingPacket.Value = sdk.DecCoins{sdk.DecCoin{Denom: rateLimitDenom, Amount: value}}
+		}
+		k.SetPendingSendPacketRecord(ctx, pendingPacket)
 	}
 
 	return nil
@@ -207,6 +248,11 @@ func (k Keeper) SendRateLimitedPacket(ctx sdk.Context, packet channeltypes.Packe
 // Middleware implementation for RecvPacket with rate limiting
 // Checks whether the rate limit has been exceeded - and if it hasn't, allows the packet
 func (k Keeper) ReceiveRateLimitedPacket(ctx sdk.Context, packet channeltypes.Packet) error {
+	// Interchain account controllers do not receive packets, so there's nothing to rate limit
+	if IsIcaControllerPort(packet.GetDestPort()) {
+		return nil
+	}
+
 	packetInfo, err := ParsePacketInfo(packet, types.PACKET_RECV)
 	if err != nil {
 		return err
@@ -219,6 +265,10 @@ func (k Keeper) ReceiveRateLimitedPacket(ctx sdk.Context, packet channeltypes.Pa
 // Middleware implementation for OnAckPacket with rate limiting
 // If the packet failed, we should decrement the Outflow
 func (k Keeper) AcknowledgeRateLimitedPacket(ctx sdk.Context, packet channeltypes.Packet, acknowledgement []byte) error {
+	if IsIcaControllerPort(packet.GetSourcePort()) {
+		return k.AcknowledgeRateLimitedIcaPacket(ctx, packet, acknowledgement)
+	}
+
 	// Check whether the ack was a success or error
 	ackSuccess, err := k.CheckAcknowledementSucceeded(ctx, acknowledgement)
 	if err != nil {
@@ -244,6 +294,10 @@ func (k Keeper) AcknowledgeRateLimitedPacket(ctx sdk.Context, packet channeltype
 // Middleware implementation for OnAckPacket with rate limiting
 // The Outflow should be decremented from the failed packet
 func (k Keeper) TimeoutRateLimitedPacket(ctx sdk.Context, packet channeltypes.Packet) error {
+	if IsIcaControllerPort(packet.GetSourcePort()) {
+		return k.TimeoutRateLimitedIcaPacket(ctx, packet)
+	}
+
 	packetInfo, err := ParsePacketInfo(packet, types.PACKET_SEND)
 	if err != nil {
 		return err
@@ -286,7 +340,7 @@ func (k Keeper) SendPacket(
 		Data:             data,
 	})
 	if err != nil {
-		k.Logger(ctx).Error(fmt.Sprintf("ICS20 packet send was denied: %s", err.Error()))
+		k.Logger(ctx).Error(fmt.Sprintf("Packet send was denied: %s", err.Error()))
 		return 0, err
 	}
 	return sequence, err
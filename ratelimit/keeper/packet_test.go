@@ -7,6 +7,7 @@ import (
 	"testing"
 
 	sdkmath "cosmossdk.io/math"
+	sdk "github.com/cosmos/cosmos-sdk/types"
 	transfertypes "github.com/cosmos/ibc-go/v7/modules/apps/transfer/types"
 	channeltypes "github.com/cosmos/ibc-go/v7/modules/core/04-channel/types"
 	"github.com/stretchr/testify/require"
@@ -50,6 +51,12 @@ func TestParseDenomFromSendPacket(t *testing.T) {
 			packetDenomTrace: stuatom,
 			expectedDenom:    stuatom,
 		},
+		// Already hashed assets stay as is
+		{
+			name:             "ibc_hash",
+			packetDenomTrace: hashDenomTrace("transfer/channel-0/usomo"),
+			expectedDenom:    hashDenomTrace("transfer/channel-0/usomo"),
+		},
 		// Non-native assets are hashed
 		{
 			name:             "uosmo_one_hop",
@@ -71,6 +78,9 @@ func TestParseDenomFromSendPacket(t *testing.T) {
 
 			parsedDenom := keeper.ParseDenomFromSendPacket(packet)
 			require.Equal(t, tc.expectedDenom, parsedDenom, tc.name)
+
+			parsedDenom = keeper.ParseDenomFromTrace(tc.packetDenomTrace)
+			require.Equal(t, tc.expectedDenom, parsedDenom, "%s - from trace", tc.name)
 		})
 	}
 }
@@ -267,9 +277,55 @@ func (s *KeeperTestSuite) TestSendRateLimitedPacket() {
 	err = s.App.RatelimitKeeper.SendRateLimitedPacket(s.Ctx, packet)
 	s.Require().NoError(err, "no error expected when sending packet after reset")
 
-	// Check that the pending packet was stored
-	found := s.App.RatelimitKeeper.CheckPacketSentDuringCurrentQuota(s.Ctx, sourceChannel, sequence)
+	// Check that the pending packet was stored with the amount
+	pendingPacket, found := s.App.RatelimitKeeper.GetPendingSendPacket(s.Ctx, sourceChannel, sequence)
 	s.Require().True(found, "pending send packet")
+	s.Require().Equal(sdk.NewCoins(sdk.NewCoin(denom, sdkmath.NewInt(5))), pendingPacket.Amount, "pending packet amount")
+}
+
+// If a rate limit is removed and re-added while a packet is pending, the packet should
+// not be refunded against the new rate limit's flow when it times out
+func (s *KeeperTestSuite) TestTimeoutRateLimitedPacket_RateLimitReAdded() {
+	sequence := uint64(10)
+	s.createChannel(channelOnStride)
+	s.createChannelValue(denom, sdkmath.NewInt(100))
+
+	addMsg := addRateLimitMsg
+	addMsg.ChannelId = channelOnStride
+	err := s.App.RatelimitKeeper.AddRateLimit(s.Ctx, &addMsg)
+	s.Require().NoError(err, "no error expected when adding rate limit")
+
+	// Send 10 tokens
+	packetData, err := json.Marshal(transfertypes.FungibleTokenPacketData{Denom: denom, Amount: "10"})
+	s.Require().NoError(err)
+	packet := channeltypes.Packet{
+		SourcePort:         transferPort,
+		SourceChannel:      channelOnStride,
+		DestinationPort:    transferPort,
+		DestinationChannel: channelOnHost,
+		Data:               packetData,
+		Sequence:           sequence,
+	}
+	err = s.App.RatelimitKeeper.SendRateLimitedPacket(s.Ctx, packet)
+	s.Require().NoError(err, "no error expected when sending packet")
+
+	// Remove the rate limit, which should also remove the charge from the pending packet
+	s.App.RatelimitKeeper.RemoveRateLimit(s.Ctx, denom, channelOnStride)
+	s.Require().False(s.isPendingSendPacket(channelOnStride, sequence), "pending packet should be removed")
+
+	// Re-add the rate limit and time out the packet - the new flow should not be refunded
+	err = s.App.RatelimitKeeper.AddRateLimit(s.Ctx, &addMsg)
+	s.Require().NoError(err, "no error expected when re-adding rate limit")
+
+	err = s.App.RatelimitKeeper.TimeoutRateLimitedPacket(s.Ctx, packet)
+	s.Require().NoError(err, "no error expected when timing out packet")
+
+	rateLimit, found := s.App.RatelimitKeeper.GetRateLimit(s.Ctx, denom, channelOnStride)
+	s.Require().True(found)
+	s.Require().Equal(int64(0), rateLimit.Flow.Outflow.Int64(), "outflow should not be decremented")
+
+	_, broken := keeper.AllInvariants(s.App.RatelimitKeeper)(s.Ctx)
+	s.Require().False(broken, "invariants should not be broken")
 }
 
 func (s *KeeperTestSuite) TestReceiveRateLimitedPacket() {
@@ -317,7 +373,11 @@ func (s *KeeperTestSuite) TestAcknowledgeRateLimitedPacket_AckSuccess() {
 	})
 
 	// Store the pending packet for this sequence number
-	s.App.RatelimitKeeper.SetPendingSendPacket(s.Ctx, sourceChannel, sequence)
+	s.App.RatelimitKeeper.SetPendingSendPacketRecord(s.Ctx, types.PendingSendPacket{
+		ChannelId: sourceChannel,
+		Sequence:  sequence,
+		Amount:    sdk.Coins{},
+	})
 
 	// Build the ack packet
 	packetData, err := json.Marshal(transfertypes.FungibleTokenPacketData{Denom: denom, Amount: "10"})
@@ -339,7 +399,7 @@ func (s *KeeperTestSuite) TestAcknowledgeRateLimitedPacket_AckSuccess() {
 	s.Require().NoError(err, "no error expected during AckPacket")
 
 	// Confirm the pending packet was removed
-	found := s.App.RatelimitKeeper.CheckPacketSentDuringCurrentQuota(s.Ctx, sourceChannel, sequence)
+	found := s.isPendingSendPacket(sourceChannel, sequence)
 	s.Require().False(found, "send packet should have been removed")
 }
 
@@ -359,7 +419,11 @@ func (s *KeeperTestSuite) TestAcknowledgeRateLimitedPacket_AckFailure() {
 	})
 
 	// Store the pending packet for this sequence number
-	s.App.RatelimitKeeper.SetPendingSendPacket(s.Ctx, sourceChannel, sequence)
+	s.App.RatelimitKeeper.SetPendingSendPacketRecord(s.Ctx, types.PendingSendPacket{
+		ChannelId: sourceChannel,
+		Sequence:  sequence,
+		Amount:    sdk.NewCoins(sdk.NewCoin(denom, packetAmount)),
+	})
 
 	// Build the ack packet
 	packetData, err := json.Marshal(transfertypes.FungibleTokenPacketData{Denom: denom, Amount: packetAmount.String()})
@@ -381,7 +445,7 @@ func (s *KeeperTestSuite) TestAcknowledgeRateLimitedPacket_AckFailure() {
 	s.Require().NoError(err, "no error expected during AckPacket")
 
 	// Confirm the pending packet was removed
-	found := s.App.RatelimitKeeper.CheckPacketSentDuringCurrentQuota(s.Ctx, sourceChannel, sequence)
+	found := s.isPendingSendPacket(sourceChannel, sequence)
 	s.Require().False(found, "send packet should have been removed")
 
 	// Confirm the flow was adjusted
@@ -406,7 +470,11 @@ func (s *KeeperTestSuite) TestTimeoutRateLimitedPacket() {
 	})
 
 	// Store the pending packet for this sequence number
-	s.App.RatelimitKeeper.SetPendingSendPacket(s.Ctx, sourceChannel, sequence)
+	s.App.RatelimitKeeper.SetPendingSendPacketRecord(s.Ctx, types.PendingSendPacket{
+		ChannelId: sourceChannel,
+		Sequence:  sequence,
+		Amount:    sdk.NewCoins(sdk.NewCoin(denom, packetAmount)),
+	})
 
 	// Build the timeout packet
 	packetData, err := json.Marshal(transfertypes.FungibleTokenPacketData{Denom: denom, Amount: packetAmount.String()})
@@ -430,7 +498,7 @@ func (s *KeeperTestSuite) TestTimeoutRateLimitedPacket() {
 	s.Require().Equal(expectedOutflow.Int64(), rateLimit.Flow.Outflow.Int64(), "outflow decremented")
 
 	// Check that the pending packet has been removed
-	found = s.App.RatelimitKeeper.CheckPacketSentDuringCurrentQuota(s.Ctx, channelId, sequence)
+	found = s.isPendingSendPacket(channelId, sequence)
 	s.Require().False(found, "pending packet should have been removed")
 
 	// Call OnTimeoutPacket again with a different sequence number
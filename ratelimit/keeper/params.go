@@ -1,17 +1,69 @@
 package keeper
 
 import (
+	"fmt"
+
 	sdk "github.com/cosmos/cosmos-sdk/types"
 
 	"github.com/Stride-Labs/ibc-rate-limiting/ratelimit/types"
 )
 
 // GetParams get all parameters as types.Params
-func (k Keeper) GetParams(ctx sdk.Context) types.Params {
-	return types.NewParams()
+func (k Keeper) GetParams(ctx sdk.Context) (params types.Params) {
+	k.paramstore.GetParamSetIfExists(ctx, &params)
+	return params
 }
 
 // SetParams set the params
 func (k Keeper) SetParams(ctx sdk.Context, params types.Params) {
 	k.paramstore.SetParamSet(ctx, &params)
+	k.ProcessParamsUpdate(ctx)
+}
+
+// Stores the LazyQuotaReset param value that was last applied
+func (k Keeper) setAppliedLazyQuotaReset(ctx sdk.Context, lazyQuotaReset bool) {
+	store := ctx.KVStore(k.storeKey)
+	if lazyQuotaReset {
+		store.Set(types.LazyQuotaResetKey, []byte{1})
+	} else {
+		store.Delete(types.LazyQuotaResetKey)
+	}
+}
+
+// Returns the LazyQuotaReset param value that was last applied
+func (k Keeper) getAppliedLazyQuotaReset(ctx sdk.Context) bool {
+	store := ctx.KVStore(k.storeKey)
+	return store.Has(types.LazyQuotaResetKey)
+}
+
+// Handles a change to the LazyQuotaReset param
+// This is called from SetParams, as well as from the BeginBlocker, since the param can also be
+// changed directly through the params subspace (e.g. from a param change proposal)
+// When lazy resets are disabled, the BeginBlocker only resets each rate limit at the start of
+// its next window, so any rate limit that wasn't touched in the current window while resets
+// were lazy would keep its stale flow until then. Those rate limits are reset immediately instead
+func (k Keeper) ProcessParamsUpdate(ctx sdk.Context) {
+	lazyQuotaReset := k.GetParams(ctx).LazyQuotaReset
+	if lazyQuotaReset == k.getAppliedLazyQuotaReset(ctx) {
+		return
+	}
+
+	if !lazyQuotaReset {
+		k.ResetStaleRateLimits(ctx)
+	}
+	k.setAppliedLazyQuotaReset(ctx, lazyQuotaReset)
+}
+
+// Resets each rate limit whose flow is from a window before the current one
+func (k Keeper) ResetStaleRateLimits(ctx sdk.Context) {
+	epochNumber := k.GetHourEpoch(ctx).EpochNumber
+	for _, rateLimit := range k.GetAllRateLimits(ctx) {
+		if rateLimit.Flow.GetWindowId() >= rateLimit.Quota.GetWindowId(epochNumber) {
+			continue
+		}
+		err := k.ResetRateLimit(ctx, rateLimit.Path.Denom, rateLimit.Path.ChannelId)
+		if err != nil {
+			k.Logger(ctx).Error(fmt.Sprintf("Unable to reset quota for Denom: %s, ChannelId: %s", rateLimit.Path.Denom, rateLimit.Path.ChannelId))
+		}
+	}
 }
@@ -1,21 +1,31 @@
 package keeper
 
 import (
-	"encoding/binary"
-	"fmt"
-	"strings"
-
+	sdkmath "cosmossdk.io/math"
 	"github.com/cosmos/cosmos-sdk/store/prefix"
 	sdk "github.com/cosmos/cosmos-sdk/types"
 
 	"github.com/Stride-Labs/ibc-rate-limiting/ratelimit/types"
 )
 
+// Stores a packet that was just sent, along with the amount that was charged to each rate limit
+func (k Keeper) SetPendingSendPacketRecord(ctx sdk.Context, pendingPacket types.PendingSendPacket) {
+	store := prefix.NewStore(ctx.KVStore(k.storeKey), types.PendingSendPacketPrefix)
+	key := types.GetPendingSendPacketKey(pendingPacket.ChannelId, pendingPacket.Sequence)
+	value := k.cdc.MustMarshal(&pendingPacket)
+	store.Set(key, value)
+}
+
 // Sets the sequence number of a packet that was just sent
+// The packet is stored without the amount charged, so if it's refunded, the amount
+// will be parsed from the packet instead
 func (k Keeper) SetPendingSendPacket(ctx sdk.Context, channelId string, sequence uint64) {
-	store := prefix.NewStore(ctx.KVStore(k.storeKey), types.PendingSendPacketPrefix)
-	key := types.GetPendingSendPacketKey(channelId, sequence)
-	store.Set(key, []byte{1})
+	k.SetPendingSendPacketRecord(ctx, types.PendingSendPacket{
+		ChannelId: channelId,
+		Sequence:  sequence,
+		Amount:    sdk.Coins{},
+		Direction: types.PACKET_SEND,
+	})
 }
 
 // Remove a pending packet sequence number from the store
@@ -26,47 +36,160 @@ func (k Keeper) RemovePendingSendPacket(ctx sdk.Context, channelId string, seque
 	store.Delete(key)
 }
 
-// Checks whether the packet sequence number is in the store - indicating that it was
-// sent during the current quota
-func (k Keeper) CheckPacketSentDuringCurrentQuota(ctx sdk.Context, channelId string, sequence uint64) bool {
+// Returns a pending send packet from the channel ID and sequence number
+func (k Keeper) GetPendingSendPacket(ctx sdk.Context, channelId string, sequence uint64) (pendingPacket types.PendingSendPacket, found bool) {
 	store := prefix.NewStore(ctx.KVStore(k.storeKey), types.PendingSendPacketPrefix)
 	key := types.GetPendingSendPacketKey(channelId, sequence)
 	valueBz := store.Get(key)
-	found := len(valueBz) != 0
+	if len(valueBz) == 0 {
+		return pendingPacket, false
+	}
+
+	k.cdc.MustUnmarshal(valueBz, &pendingPacket)
+	return pendingPacket, true
+}
+
+// Checks whether the packet sequence number is in the store - indicating that it was
+// sent during the current quota
+func (k Keeper) CheckPacketSentDuringCurrentQuota(ctx sdk.Context, channelId string, sequence uint64) bool {
+	_, found := k.GetPendingSendPacket(ctx, channelId, sequence)
 	return found
 }
 
-// Get all pending packet sequence numbers
-func (k Keeper) GetAllPendingSendPackets(ctx sdk.Context) []string {
+// Get all pending send packets
+func (k Keeper) GetAllPendingSendPackets(ctx sdk.Context) []types.PendingSendPacket {
 	store := prefix.NewStore(ctx.KVStore(k.storeKey), types.PendingSendPacketPrefix)
 
 	iterator := store.Iterator(nil, nil)
 	defer iterator.Close()
 
-	pendingPackets := []string{}
+	pendingPackets := []types.PendingSendPacket{}
 	for ; iterator.Valid(); iterator.Next() {
-		key := iterator.Key()
+		pendingPacket := types.PendingSendPacket{}
+		k.cdc.MustUnmarshal(iterator.Value(), &pendingPacket)
+		pendingPackets = append(pendingPackets, pendingPacket)
+	}
+
+	return pendingPackets
+}
 
-		channelId := string(key[:types.PendingSendPacketChannelLength])
-		channelId = strings.TrimRight(channelId, "\x00") // removes null bytes from suffix
-		sequence := binary.BigEndian.Uint64(key[types.PendingSendPacketChannelLength:])
+// Returns all pending send packets on a channel
+func (k Keeper) GetAllChannelPendingSendPackets(ctx sdk.Context, channelId string) []types.PendingSendPacket {
+	store := prefix.NewStore(ctx.KVStore(k.storeKey), types.PendingSendPacketPrefix)
 
-		packetId := fmt.Sprintf("%s/%d", channelId, sequence)
-		pendingPackets = append(pendingPackets, packetId)
+	iterator := sdk.KVStorePrefixIterator(store, types.GetPendingSendPacketChannelPrefix(channelId))
+	defer iterator.Close()
+
+	pendingPackets := []types.PendingSendPacket{}
+	for ; iterator.Valid(); iterator.Next() {
+		pendingPacket := types.PendingSendPacket{}
+		k.cdc.MustUnmarshal(iterator.Value(), &pendingPacket)
+		pendingPackets = append(pendingPackets, pendingPacket)
 	}
 
 	return pendingPackets
 }
 
-// Remove all pending sequence numbers from the store
-// This is executed when the quota resets
+// Remove all pending packets on a channel from the store, regardless of the rate limits
+// they were charged to
 func (k Keeper) RemoveAllChannelPendingSendPackets(ctx sdk.Context, channelId string) {
 	store := prefix.NewStore(ctx.KVStore(k.storeKey), types.PendingSendPacketPrefix)
 
-	iterator := sdk.KVStorePrefixIterator(store, types.KeyPrefix(channelId))
+	iterator := sdk.KVStorePrefixIterator(store, types.GetPendingSendPacketChannelPrefix(channelId))
 	defer iterator.Close()
 
 	for ; iterator.Valid(); iterator.Next() {
 		store.Delete(iterator.Key())
 	}
 }
+
+// Removes the amount charged to a rate limit from each of the pending packets on its channel
+// This is executed when the rate limit's quota resets, since a refund should no longer
+// be applied to the new window. Packets that were only charged to this rate limit are removed,
+// while packets that were also charged to other rate limits on the channel are kept
+// Packets without a recorded amount (migrated from before the amount was stored) can't be
+// attributed to a denom, and are removed when any rate limit on the channel is reset
+func (k Keeper) RemovePathFromPendingSendPackets(ctx sdk.Context, denom string, channelId string) {
+	for _, pendingPacket := range k.GetAllChannelPendingSendPackets(ctx, channelId) {
+		remainingAmount := sdk.Coins{}
+		for _, coin := range pendingPacket.Amount {
+			if coin.Denom != denom {
+				remainingAmount = append(remainingAmount, coin)
+			}
+		}
+
+		if remainingAmount.Empty() {
+			k.RemovePendingSendPacket(ctx, channelId, pendingPacket.Sequence)
+			continue
+		}
+		if len(remainingAmount) != len(pendingPacket.Amount) {
+			pendingPacket.Amount = remainingAmount
+			pendingPacket.Value = removeDenomFromValue(pendingPacket.Value, denom)
+			k.SetPendingSendPacketRecord(ctx, pendingPacket)
+		}
+	}
+}
+
+// Removes the value charged to a rate limit from each of the pending packets on its channel
+// This is executed when the rate limit's value quota is changed to a different quote denom,
+// since the value that was charged is no longer comparable to the new quota
+func (k Keeper) RemovePathValueFromPendingSendPackets(ctx sdk.Context, denom string, channelId string) {
+	for _, pendingPacket := range k.GetAllChannelPendingSendPackets(ctx, channelId) {
+		remainingValue := removeDenomFromValue(pendingPacket.Value, denom)
+		if len(remainingValue) != len(pendingPacket.Value) {
+			pendingPacket.Value = remainingValue
+			k.SetPendingSendPacketRecord(ctx, pendingPacket)
+		}
+	}
+}
+
+// Returns the value charged to each rate limit on a pending packet, without the given denom
+func removeDenomFromValue(value sdk.DecCoins, denom string) sdk.DecCoins {
+	var remainingValue sdk.DecCoins
+	for _, coin := range value {
+		if coin.Denom != denom {
+			remainingValue = append(remainingValue, coin)
+		}
+	}
+	return remainingValue
+}
+
+// Scales the amount charged to a rate limit on each of the pending packets on its channel
+// This is executed when the rate limit's flow is carried over proportionally to a new channel
+// value, so that a refund decrements the outflow by the scaled amount that remains charged
+// Packets whose scaled amount rounds down to zero are removed if they were not also charged
+// to another rate limit on the channel. As with RemovePathFromPendingSendPackets, packets
+// without a recorded amount can't be scaled, and are removed
+func (k Keeper) ScalePathInPendingSendPackets(
+	ctx sdk.Context,
+	denom string,
+	channelId string,
+	oldChannelValue sdkmath.Int,
+	newChannelValue sdkmath.Int,
+) {
+	for _, pendingPacket := range k.GetAllChannelPendingSendPackets(ctx, channelId) {
+		scaledAmount := sdk.Coins{}
+		charged := pendingPacket.Amount.Empty()
+		for _, coin := range pendingPacket.Amount {
+			if coin.Denom != denom {
+				scaledAmount = append(scaledAmount, coin)
+				continue
+			}
+			charged = true
+			coin.Amount = types.ScaleFlowAmount(coin.Amount, oldChannelValue, newChannelValue)
+			if coin.Amount.IsPositive() {
+				scaledAmount = append(scaledAmount, coin)
+			}
+		}
+
+		if !charged {
+			continue
+		}
+		if scaledAmount.Empty() {
+			k.RemovePendingSendPacket(ctx, channelId, pendingPacket.Sequence)
+			continue
+		}
+		pendingPacket.Amount = scaledAmount
+		k.SetPendingSendPacketRecord(ctx, pendingPacket)
+	}
+}
@@ -1,29 +1,86 @@
 package keeper_test
 
-import "fmt"
+import (
+	"context"
+
+	sdkmath "cosmossdk.io/math"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/types/query"
+
+	"github.com/Stride-Labs/ibc-rate-limiting/ratelimit/types"
+)
+
+// Helper function to check whether a packet is still pending
+func (s *KeeperTestSuite) isPendingSendPacket(channelId string, sequence uint64) bool {
+	_, found := s.App.RatelimitKeeper.GetPendingSendPacket(s.Ctx, channelId, sequence)
+	return found
+}
 
 func (s *KeeperTestSuite) TestPendingSendPacketPrefix() {
 	// Store 5 packets across two channels
-	sendPackets := []string{}
+	sendPackets := []types.PendingSendPacket{}
 	for _, channelId := range []string{"channel-0", "channel-1"} {
 		for sequence := uint64(0); sequence < 5; sequence++ {
-			s.App.RatelimitKeeper.SetPendingSendPacket(s.Ctx, channelId, sequence)
-			sendPackets = append(sendPackets, fmt.Sprintf("%s/%d", channelId, sequence))
+			pendingPacket := types.PendingSendPacket{
+				ChannelId:   channelId,
+				Sequence:    sequence,
+				Amount:      sdk.NewCoins(sdk.NewCoin(ustrd, sdkmath.NewInt(10))),
+				EpochNumber: 1,
+			}
+			s.App.RatelimitKeeper.SetPendingSendPacketRecord(s.Ctx, pendingPacket)
+			sendPackets = append(sendPackets, pendingPacket)
 		}
 	}
 
 	// Check that they each sequence number is found
 	for _, channelId := range []string{"channel-0", "channel-1"} {
 		for sequence := uint64(0); sequence < 5; sequence++ {
-			found := s.App.RatelimitKeeper.CheckPacketSentDuringCurrentQuota(s.Ctx, channelId, sequence)
+			found := s.isPendingSendPacket(channelId, sequence)
 			s.Require().True(found, "send packet should have been found - channel %s, sequence: %d", channelId, sequence)
 		}
 	}
 
-	// Check lookup of all sequence numbers
+	// Check lookup of all pending packets
 	actualSendPackets := s.App.RatelimitKeeper.GetAllPendingSendPackets(s.Ctx)
 	s.Require().Equal(sendPackets, actualSendPackets, "all send packets")
 
+	// Check lookup of the pending packets on each channel
+	s.Require().Equal(sendPackets[:5], s.App.RatelimitKeeper.GetAllChannelPendingSendPackets(s.Ctx, "channel-0"), "channel-0 packets")
+	s.Require().Equal(sendPackets[5:], s.App.RatelimitKeeper.GetAllChannelPendingSendPackets(s.Ctx, "channel-1"), "channel-1 packets")
+
+	// Remove 0 sequence numbers from each channel
+	s.App.RatelimitKeeper.RemovePendingSendPacket(s.Ctx, "channel-0", 0)
+	s.App.RatelimitKeeper.RemovePendingSendPacket(s.Ctx, "channel-1", 0)
+
+	// Check that only the remaining sequences are found
+	for _, channelId := range []string{"channel-0", "channel-1"} {
+		for sequence := uint64(0); sequence < 5; sequence++ {
+			expected := sequence != 0
+			actual := s.isPendingSendPacket(channelId, sequence)
+			s.Require().Equal(expected, actual, "send packet after removal - channel: %s, sequence: %d", channelId, sequence)
+		}
+	}
+}
+
+func (s *KeeperTestSuite) TestPendingSendPacketSequenceNumbers() {
+	// Store 5 sequence numbers across two channels, without the amount charged
+	for _, channelId := range []string{"channel-0", "channel-1"} {
+		for sequence := uint64(0); sequence < 5; sequence++ {
+			s.App.RatelimitKeeper.SetPendingSendPacket(s.Ctx, channelId, sequence)
+		}
+	}
+
+	// Check that each sequence number is found, and was stored without an amount
+	for _, channelId := range []string{"channel-0", "channel-1"} {
+		for sequence := uint64(0); sequence < 5; sequence++ {
+			found := s.App.RatelimitKeeper.CheckPacketSentDuringCurrentQuota(s.Ctx, channelId, sequence)
+			s.Require().True(found, "send packet should have been found - channel %s, sequence: %d", channelId, sequence)
+
+			pendingPacket, _ := s.App.RatelimitKeeper.GetPendingSendPacket(s.Ctx, channelId, sequence)
+			s.Require().Empty(pendingPacket.Amount, "amount - channel %s, sequence: %d", channelId, sequence)
+		}
+	}
+
 	// Remove 0 sequence numbers and all sequence numbers from channel-0
 	s.App.RatelimitKeeper.RemovePendingSendPacket(s.Ctx, "channel-0", 0)
 	s.App.RatelimitKeeper.RemovePendingSendPacket(s.Ctx, "channel-1", 0)
@@ -38,3 +95,127 @@ func (s *KeeperTestSuite) TestPendingSendPacketPrefix() {
 		}
 	}
 }
+
+func (s *KeeperTestSuite) TestGetPendingSendPacket() {
+	amount := sdk.NewCoins(sdk.NewCoin(ustrd, sdkmath.NewInt(10)), sdk.NewCoin(uosmo, sdkmath.NewInt(5)))
+	s.App.RatelimitKeeper.SetPendingSendPacketRecord(s.Ctx, types.PendingSendPacket{
+		ChannelId: "channel-0",
+		Sequence:  1,
+		Amount:    amount,
+	})
+
+	pendingPacket, found := s.App.RatelimitKeeper.GetPendingSendPacket(s.Ctx, "channel-0", 1)
+	s.Require().True(found, "pending packet should have been found")
+	s.Require().Equal(types.PendingSendPacket{ChannelId: "channel-0", Sequence: 1, Amount: amount}, pendingPacket)
+
+	_, found = s.App.RatelimitKeeper.GetPendingSendPacket(s.Ctx, "channel-0", 2)
+	s.Require().False(found, "pending packet should not have been found with a different sequence")
+}
+
+func (s *KeeperTestSuite) TestRemovePathFromPendingSendPackets() {
+	// Store packets on channel-1 charged to ustrd only, to ustrd and uosmo, and without an
+	// amount (as if migrated), as well as a ustrd packet on channel-10
+	ustrdAmount := sdk.NewCoin(ustrd, sdkmath.NewInt(10))
+	uosmoAmount := sdk.NewCoin(uosmo, sdkmath.NewInt(5))
+	pendingPackets := []types.PendingSendPacket{
+		{ChannelId: "channel-1", Sequence: 1, Amount: sdk.NewCoins(ustrdAmount)},
+		{ChannelId: "channel-1", Sequence: 2, Amount: sdk.NewCoins(ustrdAmount, uosmoAmount)},
+		{ChannelId: "channel-1", Sequence: 3, Amount: sdk.NewCoins(uosmoAmount)},
+		{ChannelId: "channel-1", Sequence: 4},
+		{ChannelId: "channel-10", Sequence: 1, Amount: sdk.NewCoins(ustrdAmount)},
+	}
+	for _, pendingPacket := range pendingPackets {
+		s.App.RatelimitKeeper.SetPendingSendPacketRecord(s.Ctx, pendingPacket)
+	}
+
+	// Remove the ustrd rate limit on channel-1
+	s.App.RatelimitKeeper.RemovePathFromPendingSendPackets(s.Ctx, ustrd, "channel-1")
+
+	// The packet only charged to ustrd and the packet without an amount should be removed,
+	// the packet charged to both should only have the uosmo amount, and the rest are untouched
+	expectedPackets := []types.PendingSendPacket{
+		{ChannelId: "channel-1", Sequence: 2, Amount: sdk.NewCoins(uosmoAmount)},
+		{ChannelId: "channel-1", Sequence: 3, Amount: sdk.NewCoins(uosmoAmount)},
+		{ChannelId: "channel-10", Sequence: 1, Amount: sdk.NewCoins(ustrdAmount)},
+	}
+	s.Require().Equal(expectedPackets, s.App.RatelimitKeeper.GetAllPendingSendPackets(s.Ctx), "pending packets after removal")
+}
+
+func (s *KeeperTestSuite) TestScalePathInPendingSendPackets() {
+	// Store packets on channel-1 charged to ustrd only (with an amount that scales to zero),
+	// to ustrd and uosmo, to uosmo only, and without an amount (as if migrated), as well as a
+	// ustrd packet on channel-10
+	pendingPackets := []types.PendingSendPacket{
+		{ChannelId: "channel-1", Sequence: 1, Amount: sdk.NewCoins(sdk.NewCoin(ustrd, sdkmath.NewInt(1)))},
+		{ChannelId: "channel-1", Sequence: 2, Amount: sdk.NewCoins(sdk.NewCoin(ustrd, sdkmath.NewInt(10)), sdk.NewCoin(uosmo, sdkmath.NewInt(5)))},
+		{ChannelId: "channel-1", Sequence: 3, Amount: sdk.NewCoins(sdk.NewCoin(uosmo, sdkmath.NewInt(5)))},
+		{ChannelId: "channel-1", Sequence: 4},
+		{ChannelId: "channel-10", Sequence: 1, Amount: sdk.NewCoins(sdk.NewCoin(ustrd, sdkmath.NewInt(10)))},
+	}
+	for _, pendingPacket := range pendingPackets {
+		s.App.RatelimitKeeper.SetPendingSendPacketRecord(s.Ctx, pendingPacket)
+	}
+
+	// Scale the ustrd rate limit on channel-1 from a channel value of 100 to 40
+	s.App.RatelimitKeeper.ScalePathInPendingSendPackets(s.Ctx, ustrd, "channel-1", sdkmath.NewInt(100), sdkmath.NewInt(40))
+
+	// The packet that scaled to zero and the packet without an amount should be removed, the ustrd
+	// amount should be scaled on the packet charged to both, and the rest are untouched
+	expectedPackets := []types.PendingSendPacket{
+		{ChannelId: "channel-1", Sequence: 2, Amount: sdk.NewCoins(sdk.NewCoin(ustrd, sdkmath.NewInt(4)), sdk.NewCoin(uosmo, sdkmath.NewInt(5)))},
+		{ChannelId: "channel-1", Sequence: 3, Amount: sdk.NewCoins(sdk.NewCoin(uosmo, sdkmath.NewInt(5)))},
+		{ChannelId: "channel-10", Sequence: 1, Amount: sdk.NewCoins(sdk.NewCoin(ustrd, sdkmath.NewInt(10)))},
+	}
+	s.Require().Equal(expectedPackets, s.App.RatelimitKeeper.GetAllPendingSendPackets(s.Ctx), "pending packets after scaling")
+}
+
+func (s *KeeperTestSuite) TestQueryPendingSendPackets() {
+	// Store packets on channel-1 and channel-10 to confirm the channel filter doesn't match on prefix
+	expectedPackets := map[string][]types.PendingSendPacket{}
+	for _, channelId := range []string{"channel-1", "channel-10"} {
+		for sequence := uint64(1); sequence <= 3; sequence++ {
+			amount := sdk.NewCoins(sdk.NewCoin(ustrd, sdkmath.NewIntFromUint64(sequence*10)))
+			s.App.RatelimitKeeper.SetPendingSendPacketRecord(s.Ctx, types.PendingSendPacket{
+				ChannelId: channelId,
+				Sequence:  sequence,
+				Amount:    amount,
+			})
+
+			expectedPackets[channelId] = append(expectedPackets[channelId], types.PendingSendPacket{
+				ChannelId: channelId,
+				Sequence:  sequence,
+				Amount:    amount,
+			})
+		}
+	}
+
+	// Query all packets
+	queryResponse, err := s.QueryClient.PendingSendPackets(context.Background(), &types.QueryPendingSendPacketsRequest{})
+	s.Require().NoError(err, "no error expected when querying all pending packets")
+	s.Require().Equal(append(expectedPackets["channel-1"], expectedPackets["channel-10"]...), queryResponse.PendingSendPackets)
+
+	// Query by channel
+	for _, channelId := range []string{"channel-1", "channel-10"} {
+		queryResponse, err := s.QueryClient.PendingSendPackets(context.Background(), &types.QueryPendingSendPacketsRequest{
+			ChannelId: channelId,
+		})
+		s.Require().NoError(err, "no error expected when querying pending packets on %s", channelId)
+		s.Require().Equal(expectedPackets[channelId], queryResponse.PendingSendPackets, "pending packets on %s", channelId)
+	}
+
+	// Query by channel with pagination
+	queryResponse, err = s.QueryClient.PendingSendPackets(context.Background(), &types.QueryPendingSendPacketsRequest{
+		ChannelId:  "channel-10",
+		Pagination: &query.PageRequest{Limit: 2, CountTotal: true},
+	})
+	s.Require().NoError(err, "no error expected when querying with pagination")
+	s.Require().Equal(expectedPackets["channel-10"][:2], queryResponse.PendingSendPackets, "first page")
+	s.Require().Equal(uint64(3), queryResponse.Pagination.Total, "total")
+
+	queryResponse, err = s.QueryClient.PendingSendPackets(context.Background(), &types.QueryPendingSendPacketsRequest{
+		ChannelId:  "channel-10",
+		Pagination: &query.PageRequest{Key: queryResponse.Pagination.NextKey},
+	})
+	s.Require().NoError(err, "no error expected when querying second page")
+	s.Require().Equal(expectedPackets["channel-10"][2:], queryResponse.PendingSendPackets, "second page")
+}
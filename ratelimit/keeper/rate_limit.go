@@ -1,10 +1,10 @@
 package keeper
 
 import (
+	errorsmod "cosmossdk.io/errors"
 	sdkmath "cosmossdk.io/math"
 	"github.com/cosmos/cosmos-sdk/store/prefix"
 	sdk "github.com/cosmos/cosmos-sdk/types"
-	transfertypes "github.com/cosmos/ibc-go/v7/modules/apps/transfer/types"
 
 	"github.com/Stride-Labs/ibc-rate-limiting/ratelimit/types"
 )
@@ -17,13 +17,44 @@ func (k Keeper) SetRateLimit(ctx sdk.Context, rateLimit types.RateLimit) {
 	rateLimitValue := k.cdc.MustMarshal(&rateLimit)
 
 	store.Set(rateLimitKey, rateLimitValue)
+
+	k.setRateLimitIndexes(ctx, rateLimit.Path.Denom, rateLimit.Path.ChannelId)
 }
 
 // Removes a rate limit object from the store using denom and channel-id
+// The rate limit is also removed from the pending send packets on the channel, since
+// there's no longer a flow to refund (and a rate limit added later on the same path
+// starts with a new flow that the packets were not charged to)
 func (k Keeper) RemoveRateLimit(ctx sdk.Context, denom string, channelId string) {
 	store := prefix.NewStore(ctx.KVStore(k.storeKey), types.RateLimitKeyPrefix)
 	rateLimitKey := types.GetRateLimitItemKey(denom, channelId)
 	store.Delete(rateLimitKey)
+
+	k.removeRateLimitIndexes(ctx, denom, channelId)
+	k.RemovePathFromPendingSendPackets(ctx, denom, channelId)
+
+	// The cached chain ID is only needed while the channel has a rate limit
+	if !k.HasRateLimitOnChannel(ctx, channelId) {
+		k.RemoveChannelChainId(ctx, channelId)
+	}
+}
+
+// Adds a rate limit to the channel and denom indexes
+func (k Keeper) setRateLimitIndexes(ctx sdk.Context, denom string, channelId string) {
+	channelIndexStore := prefix.NewStore(ctx.KVStore(k.storeKey), types.RateLimitChannelIndexPrefix)
+	channelIndexStore.Set(types.GetRateLimitChannelIndexKey(channelId, denom), []byte{1})
+
+	denomIndexStore := prefix.NewStore(ctx.KVStore(k.storeKey), types.RateLimitDenomIndexPrefix)
+	denomIndexStore.Set(types.GetRateLimitDenomIndexKey(denom, channelId), []byte{1})
+}
+
+// Removes a rate limit from the channel and denom indexes
+func (k Keeper) removeRateLimitIndexes(ctx sdk.Context, denom string, channelId string) {
+	channelIndexStore := prefix.NewStore(ctx.KVStore(k.storeKey), types.RateLimitChannelIndexPrefix)
+	channelIndexStore.Delete(types.GetRateLimitChannelIndexKey(channelId, denom))
+
+	denomIndexStore := prefix.NewStore(ctx.KVStore(k.storeKey), types.RateLimitDenomIndexPrefix)
+	denomIndexStore.Delete(types.GetRateLimitDenomIndexKey(denom, channelId))
 }
 
 // Grabs and returns a rate limit object from the store using denom and channel-id
@@ -59,10 +90,70 @@ func (k Keeper) GetAllRateLimits(ctx sdk.Context) []types.RateLimit {
 	return allRateLimits
 }
 
+// Returns the store of rate limits on a given channel, keyed by denom
+func (k Keeper) getRateLimitChannelIndexStore(ctx sdk.Context, channelId string) prefix.Store {
+	indexStore := prefix.NewStore(ctx.KVStore(k.storeKey), types.RateLimitChannelIndexPrefix)
+	return prefix.NewStore(indexStore, types.GetRateLimitChannelIndexPrefix(channelId))
+}
+
+// Returns the store of rate limits for a given denom, keyed by channel ID
+func (k Keeper) getRateLimitDenomIndexStore(ctx sdk.Context, denom string) prefix.Store {
+	indexStore := prefix.NewStore(ctx.KVStore(k.storeKey), types.RateLimitDenomIndexPrefix)
+	return prefix.NewStore(indexStore, types.GetRateLimitDenomIndexPrefix(denom))
+}
+
+// Returns all rate limits on a given channel, using the channel index
+func (k Keeper) GetRateLimitsByChannelId(ctx sdk.Context, channelId string) []types.RateLimit {
+	iterator := k.getRateLimitChannelIndexStore(ctx, channelId).Iterator(nil, nil)
+	defer iterator.Close()
+
+	rateLimits := []types.RateLimit{}
+	for ; iterator.Valid(); iterator.Next() {
+		denom := string(iterator.Key())
+		if rateLimit, found := k.GetRateLimit(ctx, denom, channelId); found {
+			rateLimits = append(rateLimits, rateLimit)
+		}
+	}
+
+	return rateLimits
+}
+
+// Returns all rate limits for a given denom, using the denom index
+func (k Keeper) GetRateLimitsByDenom(ctx sdk.Context, denom string) []types.RateLimit {
+	iterator := k.getRateLimitDenomIndexStore(ctx, denom).Iterator(nil, nil)
+	defer iterator.Close()
+
+	rateLimits := []types.RateLimit{}
+	for ; iterator.Valid(); iterator.Next() {
+		channelId := string(iterator.Key())
+		if rateLimit, found := k.GetRateLimit(ctx, denom, channelId); found {
+			rateLimits = append(rateLimits, rateLimit)
+		}
+	}
+
+	return rateLimits
+}
+
 // Adds a new rate limit. Fails if the rate limit already exists or the channel value is 0
 func (k Keeper) AddRateLimit(ctx sdk.Context, msg *types.MsgAddRateLimit) error {
+	// Confirm the denom group exists if the rate limit is for a group, or otherwise that
+	// the denom does not belong to a group (in which case the group's rate limit would be used)
+	if types.IsDenomGroupId(msg.Denom) {
+		if _, found := k.GetDenomGroup(ctx, msg.Denom); !found {
+			return types.ErrDenomGroupNotFound
+		}
+	} else if groupId, found := k.GetDenomGroupId(ctx, msg.Denom); found {
+		return errorsmod.Wrapf(types.ErrDenomGroupConflict,
+			"denom %s belongs to group %s, the rate limit should be added for the group", msg.Denom, groupId)
+	}
+
+	// Confirm a fixed channel value was specified if the rate limit is on an ICA channel
+	if err := k.ValidateIcaChannelValueStrategy(ctx, msg.ChannelId, msg.ChannelValueStrategy); err != nil {
+		return err
+	}
+
 	// Confirm the channel value is not zero
-	channelValue := k.GetChannelValue(ctx, msg.Denom)
+	channelValue := k.GetChannelValueFromStrategy(ctx, msg.Denom, msg.ChannelId, msg.ChannelValueStrategy)
 	if channelValue.IsZero() {
 		return types.ErrZeroChannelValue
 	}
@@ -74,7 +165,8 @@ func (k Keeper) AddRateLimit(ctx sdk.Context, msg *types.MsgAddRateLimit) error
 	}
 
 	// Confirm the channel exists
-	_, found = k.channelKeeper.GetChannel(ctx, transfertypes.PortID, msg.ChannelId)
+	// This can be either a transfer channel or an interchain account controller channel
+	_, found = k.GetRateLimitedChannelPort(ctx, msg.ChannelId)
 	if !found {
 		return types.ErrChannelNotFound
 	}
@@ -88,18 +180,23 @@ func (k Keeper) AddRateLimit(ctx sdk.Context, msg *types.MsgAddRateLimit) error
 		MaxPercentSend: msg.MaxPercentSend,
 		MaxPercentRecv: msg.MaxPercentRecv,
 		DurationHours:  msg.DurationHours,
+		ValueQuota:     msg.ValueQuota,
+		WindowOffset:   msg.WindowOffset,
 	}
 	flow := types.Flow{
 		Inflow:       sdkmath.ZeroInt(),
 		Outflow:      sdkmath.ZeroInt(),
 		ChannelValue: channelValue,
+		WindowId:     k.GetQuotaWindowId(ctx, quota),
 	}
 
 	k.SetRateLimit(ctx, types.RateLimit{
-		Path:  &path,
-		Quota: &quota,
-		Flow:  &flow,
+		Path:                 &path,
+		Quota:                &quota,
+		Flow:                 &flow,
+		ChannelValueStrategy: msg.ChannelValueStrategy,
 	})
+	k.UpdateChannelChainId(ctx, msg.ChannelId)
 
 	return nil
 }
@@ -107,13 +204,27 @@ func (k Keeper) AddRateLimit(ctx sdk.Context, msg *types.MsgAddRateLimit) error
 // Updates an existing rate limit. Fails if the rate limit doesn't exist
 func (k Keeper) UpdateRateLimit(ctx sdk.Context, msg *types.MsgUpdateRateLimit) error {
 	// Confirm the rate limit exists
-	_, found := k.GetRateLimit(ctx, msg.Denom, msg.ChannelId)
+	rateLimit, found := k.GetRateLimit(ctx, msg.Denom, msg.ChannelId)
 	if !found {
 		return types.ErrRateLimitNotFound
 	}
 
+	// Confirm a fixed channel value was specified if the rate limit is on an ICA channel
+	if err := k.ValidateIcaChannelValueStrategy(ctx, msg.ChannelId, msg.ChannelValueStrategy); err != nil {
+		return err
+	}
+
+	// Confirm the channel value after the update is not zero (when the flow is preserved,
+	// the current channel value is kept, which may be zero if it was reset with a zero value)
+	channelValue := rateLimit.Flow.ChannelValue
+	if msg.FlowUpdateMode != types.FLOW_UPDATE_PRESERVE {
+		channelValue = k.GetChannelValueFromStrategy(ctx, msg.Denom, msg.ChannelId, msg.ChannelValueStrategy)
+	}
+	if channelValue.IsZero() {
+		return types.ErrZeroChannelValue
+	}
+
 	// Update the rate limit object with the new quota information
-	// The flow should also get reset to 0
 	path := types.Path{
 		Denom:     msg.Denom,
 		ChannelId: msg.ChannelId,
@@ -122,39 +233,120 @@ func (k Keeper) UpdateRateLimit(ctx sdk.Context, msg *types.MsgUpdateRateLimit)
 		MaxPercentSend: msg.MaxPercentSend,
 		MaxPercentRecv: msg.MaxPercentRecv,
 		DurationHours:  msg.DurationHours,
+		ValueQuota:     msg.ValueQuota,
+		WindowOffset:   msg.WindowOffset,
 	}
-	flow := types.Flow{
-		Inflow:       sdkmath.ZeroInt(),
-		Outflow:      sdkmath.ZeroInt(),
-		ChannelValue: k.GetChannelValue(ctx, msg.Denom),
+	flow := k.getUpdatedFlow(ctx, rateLimit, msg)
+	flow.WindowId = k.GetQuotaWindowId(ctx, quota)
+
+	// The value of the flow is only carried over if it's still in the same quote denom
+	if rateLimit.Quota.ValueQuota.GetQuoteDenom() != quota.ValueQuota.GetQuoteDenom() {
+		flow.ValueInflow = nil
+		flow.ValueOutflow = nil
+		k.RemovePathValueFromPendingSendPackets(ctx, msg.Denom, msg.ChannelId)
 	}
 
 	k.SetRateLimit(ctx, types.RateLimit{
-		Path:  &path,
-		Quota: &quota,
-		Flow:  &flow,
+		Path:                 &path,
+		Quota:                &quota,
+		Flow:                 &flow,
+		ChannelValueStrategy: msg.ChannelValueStrategy,
 	})
+	k.UpdateChannelChainId(ctx, msg.ChannelId)
 
 	return nil
 }
 
+// Returns the flow of a rate limit after it's updated, based on the update's flow mode:
+//   - Reset: the inflow and outflow are reset to 0, and the channel value is re-calculated
+//   - Preserve: the inflow, outflow and channel value are kept as is
+//   - Proportional: the channel value is re-calculated, and the inflow and outflow are scaled
+//     so that they remain the same proportion of the channel value
+//
+// The pending send packets on the channel are kept consistent with the flow, so that a refund
+// never decrements more than was charged: they're cleared for the rate limit when the flow is
+// reset (as with ResetRateLimit), and scaled alongside the outflow when it's carried over
+func (k Keeper) getUpdatedFlow(ctx sdk.Context, rateLimit types.RateLimit, msg *types.MsgUpdateRateLimit) types.Flow {
+	if msg.FlowUpdateMode == types.FLOW_UPDATE_RESET {
+		k.RemovePathFromPendingSendPackets(ctx, msg.Denom, msg.ChannelId)
+		channelValue := k.GetChannelValueFromStrategy(ctx, msg.Denom, msg.ChannelId, msg.ChannelValueStrategy)
+		return types.NewFlow(channelValue)
+	}
+
+	// If the rate limit is due for a lazy reset, the flow is from a previous window,
+	// and it's reset before being carried over
+	rateLimit, _ = k.ResetRateLimitIfExpired(ctx, rateLimit)
+
+	if msg.FlowUpdateMode == types.FLOW_UPDATE_PRESERVE {
+		return *rateLimit.Flow
+	}
+
+	channelValue := k.GetChannelValueFromStrategy(ctx, msg.Denom, msg.ChannelId, msg.ChannelValueStrategy)
+	k.ScalePathInPendingSendPackets(ctx, msg.Denom, msg.ChannelId, rateLimit.Flow.ChannelValue, channelValue)
+	return rateLimit.Flow.ScaleToChannelValue(channelValue)
+}
+
 // Reset the rate limit after expiration
 // The inflow and outflow should get reset to 0, the channelValue should be updated,
-// and all pending send packet sequence numbers should be removed
+// and the rate limit should be removed from each pending send packet on the channel
 func (k Keeper) ResetRateLimit(ctx sdk.Context, denom string, channelId string) error {
 	rateLimit, found := k.GetRateLimit(ctx, denom, channelId)
 	if !found {
 		return types.ErrRateLimitNotFound
 	}
 
-	flow := types.Flow{
+	flow := k.getResetFlow(ctx, rateLimit)
+	rateLimit.Flow = &flow
+
+	k.SetRateLimit(ctx, rateLimit)
+	k.RemovePathFromPendingSendPackets(ctx, denom, channelId)
+	k.Hooks().AfterQuotaReset(ctx, rateLimit)
+	return nil
+}
+
+// Returns the flow of a rate limit at the start of the current quota window
+// The inflow and outflow are zero, and the channel value is re-calculated
+func (k Keeper) getResetFlow(ctx sdk.Context, rateLimit types.RateLimit) types.Flow {
+	return types.Flow{
 		Inflow:       sdkmath.ZeroInt(),
 		Outflow:      sdkmath.ZeroInt(),
-		ChannelValue: k.GetChannelValue(ctx, denom),
+		ChannelValue: k.GetChannelValueFromStrategy(ctx, rateLimit.Path.Denom, rateLimit.Path.ChannelId, rateLimit.ChannelValueStrategy),
+		WindowId:     rateLimit.Quota.GetWindowId(k.GetHourEpoch(ctx).EpochNumber),
+	}
+}
+
+// Checks whether a rate limit's flow is from a previous quota window and is due to be reset
+// This only applies when quotas are reset lazily, since the flow is otherwise reset in the
+// BeginBlocker at the start of each window
+func (k Keeper) IsRateLimitExpired(ctx sdk.Context, rateLimit types.RateLimit) bool {
+	if !k.GetParams(ctx).LazyQuotaReset {
+		return false
+	}
+	return rateLimit.Flow.GetWindowId() != rateLimit.Quota.GetWindowId(k.GetHourEpoch(ctx).EpochNumber)
+}
+
+// Returns the rate limit with the flow it would have after being reset, if it's due for
+// a lazy reset. This is used by the queries so that the effective flow is reported, even
+// for rate limits that haven't been touched in the current window
+func (k Keeper) GetEffectiveRateLimit(ctx sdk.Context, rateLimit types.RateLimit) types.RateLimit {
+	if !k.IsRateLimitExpired(ctx, rateLimit) {
+		return rateLimit
 	}
+	flow := k.getResetFlow(ctx, rateLimit)
 	rateLimit.Flow = &flow
+	return rateLimit
+}
 
-	k.SetRateLimit(ctx, rateLimit)
-	k.RemoveAllChannelPendingSendPackets(ctx, channelId)
-	return nil
+// Resets a rate limit if it's due for a lazy reset, and returns the up-to-date rate limit,
+// as well as whether it was reset
+// Called the first time a rate limit is touched in a new quota window
+func (k Keeper) ResetRateLimitIfExpired(ctx sdk.Context, rateLimit types.RateLimit) (types.RateLimit, bool) {
+	if !k.IsRateLimitExpired(ctx, rateLimit) {
+		return rateLimit, false
+	}
+	if err := k.ResetRateLimit(ctx, rateLimit.Path.Denom, rateLimit.Path.ChannelId); err != nil {
+		return rateLimit, false
+	}
+	rateLimit, _ = k.GetRateLimit(ctx, rateLimit.Path.Denom, rateLimit.Path.ChannelId)
+	return rateLimit, true
 }
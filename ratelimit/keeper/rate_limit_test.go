@@ -1,10 +1,14 @@
 package keeper_test
 
 import (
+	"context"
 	"strconv"
+	"time"
 
 	sdkmath "cosmossdk.io/math"
+	sdk "github.com/cosmos/cosmos-sdk/types"
 
+	"github.com/Stride-Labs/ibc-rate-limiting/ratelimit/keeper"
 	"github.com/Stride-Labs/ibc-rate-limiting/ratelimit/types"
 )
 
@@ -87,9 +91,341 @@ func (s *KeeperTestSuite) TestResetRateLimit() {
 	s.Require().Zero(rateLimit.Flow.Outflow.Int64(), "Outflow should have been reset to 0")
 }
 
+// Resetting a rate limit should only clear the pending packets charged to that rate limit
+func (s *KeeperTestSuite) TestResetRateLimit_PendingSendPackets() {
+	for _, denom := range []string{ustrd, uosmo} {
+		s.App.RatelimitKeeper.SetRateLimit(s.Ctx, types.RateLimit{
+			Path:  &types.Path{Denom: denom, ChannelId: channelId},
+			Quota: &types.Quota{},
+			Flow:  &types.Flow{Inflow: sdkmath.ZeroInt(), Outflow: sdkmath.ZeroInt(), ChannelValue: sdkmath.ZeroInt()},
+		})
+	}
+
+	ustrdPacket := types.PendingSendPacket{ChannelId: channelId, Sequence: 1, Amount: sdk.NewCoins(sdk.NewCoin(ustrd, sdkmath.NewInt(10)))}
+	uosmoPacket := types.PendingSendPacket{ChannelId: channelId, Sequence: 2, Amount: sdk.NewCoins(sdk.NewCoin(uosmo, sdkmath.NewInt(10)))}
+	s.App.RatelimitKeeper.SetPendingSendPacketRecord(s.Ctx, ustrdPacket)
+	s.App.RatelimitKeeper.SetPendingSendPacketRecord(s.Ctx, uosmoPacket)
+
+	err := s.App.RatelimitKeeper.ResetRateLimit(s.Ctx, ustrd, channelId)
+	s.Require().NoError(err, "no error expected when resetting rate limit")
+
+	s.Require().False(s.isPendingSendPacket(channelId, 1), "ustrd packet should be removed")
+	s.Require().True(s.isPendingSendPacket(channelId, 2), "uosmo packet should remain")
+}
+
+func (s *KeeperTestSuite) TestUpdateRateLimit_FlowUpdateMode() {
+	testCases := []struct {
+		name                 string
+		mode                 types.FlowUpdateMode
+		expectedFlow         types.Flow
+		expectedPacketAmount sdk.Coins
+	}{
+		{
+			name: "reset",
+			mode: types.FLOW_UPDATE_RESET,
+			expectedFlow: types.Flow{
+				Inflow:       sdkmath.ZeroInt(),
+				Outflow:      sdkmath.ZeroInt(),
+				ChannelValue: sdkmath.NewInt(200),
+			},
+		},
+		{
+			name: "preserve",
+			mode: types.FLOW_UPDATE_PRESERVE,
+			expectedFlow: types.Flow{
+				Inflow:       sdkmath.NewInt(5),
+				Outflow:      sdkmath.NewInt(15),
+				ChannelValue: sdkmath.NewInt(100),
+			},
+			expectedPacketAmount: sdk.NewCoins(sdk.NewCoin(denom, sdkmath.NewInt(15)), sdk.NewCoin(ustrd, sdkmath.NewInt(10))),
+		},
+		{
+			name: "proportional",
+			mode: types.FLOW_UPDATE_PROPORTIONAL,
+			expectedFlow: types.Flow{
+				Inflow:       sdkmath.NewInt(10),
+				Outflow:      sdkmath.NewInt(30),
+				ChannelValue: sdkmath.NewInt(200),
+			},
+			expectedPacketAmount: sdk.NewCoins(sdk.NewCoin(denom, sdkmath.NewInt(30)), sdk.NewCoin(ustrd, sdkmath.NewInt(10))),
+		},
+	}
+
+	for _, tc := range testCases {
+		s.Run(tc.name, func() {
+			s.SetupTest()
+
+			// Add a rate limit with a channel value of 100, and charge a flow to it
+			s.createChannel(channelId)
+			s.createChannelValue(denom, sdkmath.NewInt(100))
+			s.addRateLimitSuccessful()
+
+			rateLimit, found := s.App.RatelimitKeeper.GetRateLimit(s.Ctx, denom, channelId)
+			s.Require().True(found)
+			rateLimit.Flow.Inflow = sdkmath.NewInt(5)
+			rateLimit.Flow.Outflow = sdkmath.NewInt(15)
+			s.App.RatelimitKeeper.SetRateLimit(s.Ctx, rateLimit)
+
+			// Store a pending packet that was charged to both this rate limit and another rate limit
+			pendingPacket := types.PendingSendPacket{
+				ChannelId: channelId,
+				Sequence:  1,
+				Amount:    sdk.NewCoins(sdk.NewCoin(denom, sdkmath.NewInt(15)), sdk.NewCoin(ustrd, sdkmath.NewInt(10))),
+			}
+			s.App.RatelimitKeeper.SetPendingSendPacketRecord(s.Ctx, pendingPacket)
+
+			// Double the supply, and then update the rate limit
+			s.createChannelValue(denom, sdkmath.NewInt(100))
+
+			updateMsg := updateRateLimitMsg
+			updateMsg.FlowUpdateMode = tc.mode
+			err := s.App.RatelimitKeeper.UpdateRateLimit(s.Ctx, &updateMsg)
+			s.Require().NoError(err, "no error expected when updating rate limit")
+
+			// Check the flow was updated according to the mode
+			rateLimit, found = s.App.RatelimitKeeper.GetRateLimit(s.Ctx, denom, channelId)
+			s.Require().True(found)
+			s.Require().Equal(tc.expectedFlow.Inflow.Int64(), rateLimit.Flow.Inflow.Int64(), "inflow")
+			s.Require().Equal(tc.expectedFlow.Outflow.Int64(), rateLimit.Flow.Outflow.Int64(), "outflow")
+			s.Require().Equal(tc.expectedFlow.ChannelValue.Int64(), rateLimit.Flow.ChannelValue.Int64(), "channel value")
+			s.Require().Equal(updateMsg.DurationHours, rateLimit.Quota.DurationHours, "duration")
+
+			// Check the amount charged to the rate limit on the pending packet is consistent with the flow
+			// When the flow is reset, only the amount charged to the other rate limit should remain
+			if tc.expectedPacketAmount == nil {
+				tc.expectedPacketAmount = sdk.NewCoins(sdk.NewCoin(ustrd, sdkmath.NewInt(10)))
+			}
+			actualPacket, found := s.App.RatelimitKeeper.GetPendingSendPacket(s.Ctx, channelId, 1)
+			s.Require().True(found, "pending packet should still be stored")
+			s.Require().Equal(tc.expectedPacketAmount, actualPacket.Amount, "pending packet amount")
+
+			// Refunding the packet should decrement the outflow by the amount that remains charged
+			err = s.App.RatelimitKeeper.RefundPendingSendPacket(s.Ctx, channelId, 1, sdk.Coins{})
+			s.Require().NoError(err, "no error expected when refunding packet")
+
+			rateLimit, found = s.App.RatelimitKeeper.GetRateLimit(s.Ctx, denom, channelId)
+			s.Require().True(found)
+			s.Require().Zero(rateLimit.Flow.Outflow.Int64(), "outflow after refund")
+		})
+	}
+}
+
 func (s *KeeperTestSuite) TestGetAllRateLimits() {
 	expectedRateLimits := s.createRateLimits()
 	actualRateLimits := s.App.RatelimitKeeper.GetAllRateLimits(s.Ctx)
 	s.Require().Len(actualRateLimits, len(expectedRateLimits))
 	s.Require().ElementsMatch(expectedRateLimits, actualRateLimits, "all rate limits")
 }
+
+func (s *KeeperTestSuite) TestGetRateLimitsByChannelIdAndDenom() {
+	// Includes denoms and channels that are prefixes of one another, to confirm the
+	// index lookups only return exact matches
+	paths := []types.Path{
+		{Denom: "denom", ChannelId: "channel-1"},
+		{Denom: "denom", ChannelId: "channel-10"},
+		{Denom: "denom-2", ChannelId: "channel-1"},
+		{Denom: "denom-2", ChannelId: "channel-2"},
+	}
+	for _, path := range paths {
+		path := path
+		s.App.RatelimitKeeper.SetRateLimit(s.Ctx, types.RateLimit{Path: &path})
+	}
+
+	getPaths := func(rateLimits []types.RateLimit) []types.Path {
+		paths := []types.Path{}
+		for _, rateLimit := range rateLimits {
+			paths = append(paths, *rateLimit.Path)
+		}
+		return paths
+	}
+
+	byChannel := s.App.RatelimitKeeper.GetRateLimitsByChannelId(s.Ctx, "channel-1")
+	s.Require().Equal([]types.Path{paths[0], paths[2]}, getPaths(byChannel), "rate limits on channel-1")
+
+	byDenom := s.App.RatelimitKeeper.GetRateLimitsByDenom(s.Ctx, "denom")
+	s.Require().Equal([]types.Path{paths[0], paths[1]}, getPaths(byDenom), "rate limits for denom")
+
+	// Removing a rate limit should remove it from both indexes
+	s.App.RatelimitKeeper.RemoveRateLimit(s.Ctx, "denom", "channel-1")
+
+	byChannel = s.App.RatelimitKeeper.GetRateLimitsByChannelId(s.Ctx, "channel-1")
+	s.Require().Equal([]types.Path{paths[2]}, getPaths(byChannel), "rate limits on channel-1 after removal")
+
+	byDenom = s.App.RatelimitKeeper.GetRateLimitsByDenom(s.Ctx, "denom")
+	s.Require().Equal([]types.Path{paths[1]}, getPaths(byDenom), "rate limits for denom after removal")
+
+	s.Require().Empty(s.App.RatelimitKeeper.GetRateLimitsByChannelId(s.Ctx, "channel-99"), "rate limits on unknown channel")
+}
+
+// Stores a rate limit with a 2 hour quota, whose flow is from the given window,
+// as well as a pending packet that was charged to it
+func (s *KeeperTestSuite) setupLazyQuotaReset(windowId uint64) {
+	s.App.RatelimitKeeper.SetParams(s.Ctx, types.NewParams(types.FORWARD_POLICY_COUNT_BOTH, true))
+
+	s.App.RatelimitKeeper.SetRateLimit(s.Ctx, types.RateLimit{
+		Path: &types.Path{Denom: denom, ChannelId: channelId},
+		Quota: &types.Quota{
+			MaxPercentSend: sdkmath.NewInt(50),
+			MaxPercentRecv: sdkmath.NewInt(50),
+			DurationHours:  2,
+		},
+		Flow: &types.Flow{
+			Inflow:       sdkmath.NewInt(10),
+			Outflow:      sdkmath.NewInt(10),
+			ChannelValue: sdkmath.NewInt(50),
+			WindowId:     windowId,
+		},
+		ChannelValueStrategy: &types.ChannelValueStrategy{
+			Source:     types.CHANNEL_VALUE_FIXED,
+			FixedValue: sdkmath.NewInt(100),
+		},
+	})
+	s.App.RatelimitKeeper.SetPendingSendPacketRecord(s.Ctx, types.PendingSendPacket{
+		ChannelId: channelId,
+		Sequence:  1,
+		Amount:    sdk.NewCoins(sdk.NewCoin(denom, sdkmath.NewInt(10))),
+	})
+}
+
+func (s *KeeperTestSuite) TestLazyQuotaReset() {
+	// Epoch 5 is in window 2 for a 2 hour quota, so the flow from window 1 is stale
+	s.App.RatelimitKeeper.SetHourEpoch(s.Ctx, types.HourEpoch{EpochNumber: 5})
+	s.setupLazyQuotaReset(1)
+
+	// The query should report the effective flow, without modifying the store
+	expectedResetFlow := types.Flow{
+		Inflow:       sdkmath.ZeroInt(),
+		Outflow:      sdkmath.ZeroInt(),
+		ChannelValue: sdkmath.NewInt(100),
+		WindowId:     2,
+	}
+	queryResponse, err := s.QueryClient.RateLimit(context.Background(), &types.QueryRateLimitRequest{
+		Denom:     denom,
+		ChannelId: channelId,
+	})
+	s.Require().NoError(err, "no error expected when querying rate limit")
+	s.Require().Equal(expectedResetFlow, *queryResponse.RateLimit.Flow, "queried flow")
+
+	rateLimit, found := s.App.RatelimitKeeper.GetRateLimit(s.Ctx, denom, channelId)
+	s.Require().True(found)
+	s.Require().Equal(int64(10), rateLimit.Flow.Outflow.Int64(), "stored outflow should not be modified by the query")
+
+	// The first transfer in the new window should reset the flow before it's charged
+	packetInfo := keeper.RateLimitedPacketInfo{
+		ChannelID: channelId,
+		Denom:     denom,
+		Amount:    sdkmath.NewInt(5),
+		Sender:    sender,
+		Receiver:  receiver,
+	}
+	updated, err := s.App.RatelimitKeeper.CheckRateLimitAndUpdateFlow(s.Ctx, types.PACKET_SEND, packetInfo)
+	s.Require().NoError(err, "no error expected on first transfer")
+	s.Require().True(updated, "flow should be updated")
+
+	rateLimit, found = s.App.RatelimitKeeper.GetRateLimit(s.Ctx, denom, channelId)
+	s.Require().True(found)
+	s.Require().Equal(int64(0), rateLimit.Flow.Inflow.Int64(), "inflow after reset")
+	s.Require().Equal(int64(5), rateLimit.Flow.Outflow.Int64(), "outflow after reset")
+	s.Require().Equal(int64(100), rateLimit.Flow.ChannelValue.Int64(), "channel value after reset")
+	s.Require().Equal(uint64(2), rateLimit.Flow.WindowId, "window id after reset")
+
+	// The pending packet from the previous window should be removed
+	found = s.isPendingSendPacket(channelId, 1)
+	s.Require().False(found, "pending packet from previous window should be removed")
+
+	// A second transfer in the same window should not reset the flow
+	_, err = s.App.RatelimitKeeper.CheckRateLimitAndUpdateFlow(s.Ctx, types.PACKET_SEND, packetInfo)
+	s.Require().NoError(err, "no error expected on second transfer")
+
+	rateLimit, found = s.App.RatelimitKeeper.GetRateLimit(s.Ctx, denom, channelId)
+	s.Require().True(found)
+	s.Require().Equal(int64(10), rateLimit.Flow.Outflow.Int64(), "outflow after second transfer")
+}
+
+// When lazy resets are disabled, the rate limits with a stale flow should be reset right away
+func (s *KeeperTestSuite) TestLazyQuotaReset_Disabled() {
+	// Epoch 5 is in window 2 for a 2 hour quota, so the flow from window 1 is stale
+	s.App.RatelimitKeeper.SetHourEpoch(s.Ctx, types.HourEpoch{EpochNumber: 5})
+	s.setupLazyQuotaReset(1)
+
+	// Add a second rate limit whose flow is from the current window
+	currentRateLimit := types.RateLimit{
+		Path:  &types.Path{Denom: denom, ChannelId: "channel-1"},
+		Quota: &types.Quota{MaxPercentSend: sdkmath.NewInt(50), MaxPercentRecv: sdkmath.NewInt(50), DurationHours: 2},
+		Flow:  &types.Flow{Inflow: sdkmath.NewInt(10), Outflow: sdkmath.NewInt(10), ChannelValue: sdkmath.NewInt(50), WindowId: 2},
+	}
+	s.App.RatelimitKeeper.SetRateLimit(s.Ctx, currentRateLimit)
+
+	s.App.RatelimitKeeper.SetParams(s.Ctx, types.NewParams(types.FORWARD_POLICY_COUNT_BOTH, false))
+
+	// The stale rate limit should be reset, along with its pending packet
+	rateLimit, found := s.App.RatelimitKeeper.GetRateLimit(s.Ctx, denom, channelId)
+	s.Require().True(found)
+	s.Require().Equal(int64(0), rateLimit.Flow.Outflow.Int64(), "outflow after reset")
+	s.Require().Equal(int64(100), rateLimit.Flow.ChannelValue.Int64(), "channel value after reset")
+	s.Require().Equal(uint64(2), rateLimit.Flow.WindowId, "window id after reset")
+	s.Require().False(s.isPendingSendPacket(channelId, 1), "pending packet from previous window should be removed")
+
+	// The rate limit from the current window should not be modified
+	rateLimit, found = s.App.RatelimitKeeper.GetRateLimit(s.Ctx, denom, "channel-1")
+	s.Require().True(found)
+	s.Require().Equal(currentRateLimit, rateLimit, "current rate limit should not be reset")
+}
+
+// If lazy resets are disabled through the params subspace (e.g. with a param change proposal),
+// the stale rate limits should be reset in the next BeginBlocker
+func (s *KeeperTestSuite) TestLazyQuotaReset_DisabledThroughSubspace() {
+	blockTime := time.Date(2024, 1, 1, 0, 0, 0, 0, time.UTC)
+	s.Ctx = s.Ctx.WithBlockTime(blockTime)
+	s.App.RatelimitKeeper.SetHourEpoch(s.Ctx, types.HourEpoch{
+		EpochNumber:    5,
+		Duration:       time.Hour,
+		EpochStartTime: blockTime,
+	})
+	s.setupLazyQuotaReset(1)
+
+	s.App.GetSubspace(types.ModuleName).Set(s.Ctx, types.KeyLazyQuotaReset, false)
+
+	rateLimit, found := s.App.RatelimitKeeper.GetRateLimit(s.Ctx, denom, channelId)
+	s.Require().True(found)
+	s.Require().Equal(int64(10), rateLimit.Flow.Outflow.Int64(), "outflow before begin blocker")
+
+	s.App.RatelimitKeeper.BeginBlocker(s.Ctx)
+
+	rateLimit, found = s.App.RatelimitKeeper.GetRateLimit(s.Ctx, denom, channelId)
+	s.Require().True(found)
+	s.Require().Equal(int64(0), rateLimit.Flow.Outflow.Int64(), "outflow after begin blocker")
+	s.Require().Equal(uint64(2), rateLimit.Flow.WindowId, "window id after begin blocker")
+}
+
+// A packet sent in a previous window should not be refunded against a lazily reset flow
+func (s *KeeperTestSuite) TestLazyQuotaReset_RefundFromPreviousWindow() {
+	s.App.RatelimitKeeper.SetHourEpoch(s.Ctx, types.HourEpoch{EpochNumber: 5})
+	s.setupLazyQuotaReset(1)
+
+	err := s.App.RatelimitKeeper.UndoSendPacket(s.Ctx, channelId, 1, denom, sdkmath.NewInt(10))
+	s.Require().NoError(err, "no error expected when undoing send packet")
+
+	rateLimit, found := s.App.RatelimitKeeper.GetRateLimit(s.Ctx, denom, channelId)
+	s.Require().True(found)
+	s.Require().Equal(int64(0), rateLimit.Flow.Outflow.Int64(), "outflow should be reset, and not refunded")
+	s.Require().Equal(uint64(2), rateLimit.Flow.WindowId, "window id")
+
+	found = s.isPendingSendPacket(channelId, 1)
+	s.Require().False(found, "pending packet should be removed")
+}
+
+// A packet sent in the current window should still be refunded when quotas are reset lazily
+func (s *KeeperTestSuite) TestLazyQuotaReset_RefundFromCurrentWindow() {
+	s.App.RatelimitKeeper.SetHourEpoch(s.Ctx, types.HourEpoch{EpochNumber: 5})
+	s.setupLazyQuotaReset(2)
+
+	err := s.App.RatelimitKeeper.UndoSendPacket(s.Ctx, channelId, 1, denom, sdkmath.NewInt(10))
+	s.Require().NoError(err, "no error expected when undoing send packet")
+
+	rateLimit, found := s.App.RatelimitKeeper.GetRateLimit(s.Ctx, denom, channelId)
+	s.Require().True(found)
+	s.Require().Equal(int64(0), rateLimit.Flow.Outflow.Int64(), "outflow should be refunded")
+	s.Require().Equal(int64(10), rateLimit.Flow.Inflow.Int64(), "inflow should be unchanged")
+	s.Require().Equal(int64(50), rateLimit.Flow.ChannelValue.Int64(), "channel value should be unchanged")
+}
@@ -0,0 +1,177 @@
+package keeper
+
+import (
+	"fmt"
+	"sort"
+
+	errorsmod "cosmossdk.io/errors"
+	"github.com/cosmos/cosmos-sdk/store/prefix"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+
+	"github.com/Stride-Labs/ibc-rate-limiting/ratelimit/types"
+)
+
+// Stores/Updates a scheduled rate limit update in the store
+// The update is keyed by its execution height or epoch (so that only the due updates need to be
+// iterated each block), and is indexed by schedule ID
+func (k Keeper) SetScheduledUpdate(ctx sdk.Context, scheduledUpdate types.ScheduledRateLimitUpdate) {
+	k.RemoveScheduledUpdate(ctx, scheduledUpdate.ScheduleId)
+
+	updateStore := prefix.NewStore(ctx.KVStore(k.storeKey), types.ScheduledUpdateKeyPrefix)
+	indexStore := prefix.NewStore(ctx.KVStore(k.storeKey), types.ScheduledUpdateIdIndexPrefix)
+
+	key := types.GetScheduledUpdateKey(scheduledUpdate)
+	value := k.cdc.MustMarshal(&scheduledUpdate)
+	updateStore.Set(key, value)
+	indexStore.Set(types.GetScheduledUpdateIdKey(scheduledUpdate.ScheduleId), key)
+}
+
+// Removes a scheduled rate limit update and its schedule ID index from the store
+func (k Keeper) RemoveScheduledUpdate(ctx sdk.Context, scheduleId uint64) {
+	updateStore := prefix.NewStore(ctx.KVStore(k.storeKey), types.ScheduledUpdateKeyPrefix)
+	indexStore := prefix.NewStore(ctx.KVStore(k.storeKey), types.ScheduledUpdateIdIndexPrefix)
+
+	indexKey := types.GetScheduledUpdateIdKey(scheduleId)
+	key := indexStore.Get(indexKey)
+	if len(key) == 0 {
+		return
+	}
+
+	updateStore.Delete(key)
+	indexStore.Delete(indexKey)
+}
+
+// Grabs and returns a scheduled rate limit update from the store using the schedule ID
+func (k Keeper) GetScheduledUpdate(ctx sdk.Context, scheduleId uint64) (scheduledUpdate types.ScheduledRateLimitUpdate, found bool) {
+	updateStore := prefix.NewStore(ctx.KVStore(k.storeKey), types.ScheduledUpdateKeyPrefix)
+	indexStore := prefix.NewStore(ctx.KVStore(k.storeKey), types.ScheduledUpdateIdIndexPrefix)
+
+	key := indexStore.Get(types.GetScheduledUpdateIdKey(scheduleId))
+	if len(key) == 0 {
+		return scheduledUpdate, false
+	}
+
+	value := updateStore.Get(key)
+	if len(value) == 0 {
+		return scheduledUpdate, false
+	}
+
+	k.cdc.MustUnmarshal(value, &scheduledUpdate)
+	return scheduledUpdate, true
+}
+
+// Returns all scheduled rate limit updates, ordered by schedule ID
+func (k Keeper) GetAllScheduledUpdates(ctx sdk.Context) []types.ScheduledRateLimitUpdate {
+	updateStore := prefix.NewStore(ctx.KVStore(k.storeKey), types.ScheduledUpdateKeyPrefix)
+	indexStore := prefix.NewStore(ctx.KVStore(k.storeKey), types.ScheduledUpdateIdIndexPrefix)
+
+	iterator := indexStore.Iterator(nil, nil)
+	defer iterator.Close()
+
+	allScheduledUpdates := []types.ScheduledRateLimitUpdate{}
+	for ; iterator.Valid(); iterator.Next() {
+		scheduledUpdate := types.ScheduledRateLimitUpdate{}
+		k.cdc.MustUnmarshal(updateStore.Get(iterator.Value()), &scheduledUpdate)
+		allScheduledUpdates = append(allScheduledUpdates, scheduledUpdate)
+	}
+
+	return allScheduledUpdates
+}
+
+// Returns the scheduled updates that are due at the given epoch or block height, ordered by schedule ID
+// Only the updates with an execution height or epoch at or before the current one are iterated
+func (k Keeper) GetDueScheduledUpdates(ctx sdk.Context, epochNumber uint64, blockHeight int64) []types.ScheduledRateLimitUpdate {
+	updateStore := prefix.NewStore(ctx.KVStore(k.storeKey), types.ScheduledUpdateKeyPrefix)
+
+	dueScheduledUpdates := []types.ScheduledRateLimitUpdate{}
+	appendDueUpdates := func(triggerPrefix []byte, current uint64) {
+		iterator := updateStore.Iterator(triggerPrefix, types.GetScheduledUpdateDueEndKey(triggerPrefix, current))
+		defer iterator.Close()
+
+		for ; iterator.Valid(); iterator.Next() {
+			scheduledUpdate := types.ScheduledRateLimitUpdate{}
+			k.cdc.MustUnmarshal(iterator.Value(), &scheduledUpdate)
+			dueScheduledUpdates = append(dueScheduledUpdates, scheduledUpdate)
+		}
+	}
+	if blockHeight > 0 {
+		appendDueUpdates(types.ScheduledUpdateHeightPrefix, uint64(blockHeight))
+	}
+	appendDueUpdates(types.ScheduledUpdateEpochPrefix, epochNumber)
+
+	// Updates are applied in the order they were scheduled, regardless of their trigger
+	sort.Slice(dueScheduledUpdates, func(i, j int) bool {
+		return dueScheduledUpdates[i].ScheduleId < dueScheduledUpdates[j].ScheduleId
+	})
+
+	return dueScheduledUpdates
+}
+
+// Stores the ID that will be assigned to the next scheduled update
+func (k Keeper) SetNextScheduleId(ctx sdk.Context, scheduleId uint64) {
+	store := ctx.KVStore(k.storeKey)
+	store.Set(types.NextScheduleIdKey, sdk.Uint64ToBigEndian(scheduleId))
+}
+
+// Returns the ID that will be assigned to the next scheduled update (IDs start at 1)
+func (k Keeper) GetNextScheduleId(ctx sdk.Context) uint64 {
+	store := ctx.KVStore(k.storeKey)
+	scheduleIdBz := store.Get(types.NextScheduleIdKey)
+	if len(scheduleIdBz) == 0 {
+		return 1
+	}
+	return sdk.BigEndianToUint64(scheduleIdBz)
+}
+
+// Schedules an update to an existing rate limit at a future epoch or block height, and
+// returns the ID of the scheduled update
+// Fails if the rate limit doesn't exist or if the execution epoch/height has already passed
+func (k Keeper) ScheduleRateLimitUpdate(ctx sdk.Context, msg *types.MsgScheduleRateLimitUpdate) (uint64, error) {
+	if _, found := k.GetRateLimit(ctx, msg.Update.Denom, msg.Update.ChannelId); !found {
+		return 0, types.ErrRateLimitNotFound
+	}
+
+	if msg.ExecuteHeight != 0 && msg.ExecuteHeight <= ctx.BlockHeight() {
+		return 0, errorsmod.Wrapf(sdkerrors.ErrInvalidRequest,
+			"execute height (%d) must be after the current height (%d)", msg.ExecuteHeight, ctx.BlockHeight())
+	}
+	if currentEpoch := k.GetHourEpoch(ctx).EpochNumber; msg.ExecuteEpoch != 0 && msg.ExecuteEpoch <= currentEpoch {
+		return 0, errorsmod.Wrapf(sdkerrors.ErrInvalidRequest,
+			"execute epoch (%d) must be after the current epoch (%d)", msg.ExecuteEpoch, currentEpoch)
+	}
+
+	scheduleId := k.GetNextScheduleId(ctx)
+	k.SetScheduledUpdate(ctx, types.ScheduledRateLimitUpdate{
+		ScheduleId:    scheduleId,
+		ExecuteEpoch:  msg.ExecuteEpoch,
+		ExecuteHeight: msg.ExecuteHeight,
+		Update:        msg.Update,
+	})
+	k.SetNextScheduleId(ctx, scheduleId+1)
+
+	return scheduleId, nil
+}
+
+// Applies each scheduled update whose execution epoch or height has been reached, and
+// removes it from the store
+// If an update fails (e.g. because the rate limit has since been removed), it's dropped
+// without modifying the rate limit
+func (k Keeper) ProcessScheduledUpdates(ctx sdk.Context) {
+	epochNumber := k.GetHourEpoch(ctx).EpochNumber
+
+	for _, scheduledUpdate := range k.GetDueScheduledUpdates(ctx, epochNumber, ctx.BlockHeight()) {
+		k.RemoveScheduledUpdate(ctx, scheduledUpdate.ScheduleId)
+
+		update := scheduledUpdate.Update
+		cacheCtx, writeCache := ctx.CacheContext()
+		if err := k.UpdateRateLimit(cacheCtx, &update); err != nil {
+			k.Logger(ctx).Error(fmt.Sprintf("Unable to apply scheduled update %d for Denom: %s, ChannelId: %s: %s",
+				scheduledUpdate.ScheduleId, update.Denom, update.ChannelId, err.Error()))
+			EmitScheduledUpdateEvent(ctx, types.EventScheduledUpdateFailed, scheduledUpdate, err)
+			continue
+		}
+		writeCache()
+		EmitScheduledUpdateEvent(ctx, types.EventScheduledUpdateApplied, scheduledUpdate, nil)
+	}
+}
@@ -0,0 +1,320 @@
+package keeper_test
+
+import (
+	"context"
+	"time"
+
+	sdkmath "cosmossdk.io/math"
+	"github.com/cosmos/cosmos-sdk/types/query"
+
+	"github.com/Stride-Labs/ibc-rate-limiting/ratelimit/keeper"
+	"github.com/Stride-Labs/ibc-rate-limiting/ratelimit/types"
+)
+
+// Helper function to store a scheduled update for the rate limit in updateRateLimitMsg
+func (s *KeeperTestSuite) setScheduledUpdate(scheduleId uint64, executeEpoch uint64, executeHeight int64) types.ScheduledRateLimitUpdate {
+	scheduledUpdate := types.ScheduledRateLimitUpdate{
+		ScheduleId:    scheduleId,
+		ExecuteEpoch:  executeEpoch,
+		ExecuteHeight: executeHeight,
+		Update:        updateRateLimitMsg,
+	}
+	s.App.RatelimitKeeper.SetScheduledUpdate(s.Ctx, scheduledUpdate)
+	return scheduledUpdate
+}
+
+func (s *KeeperTestSuite) TestGetScheduledUpdates() {
+	expectedUpdates := []types.ScheduledRateLimitUpdate{
+		s.setScheduledUpdate(1, 10, 0),
+		s.setScheduledUpdate(2, 0, 100),
+		s.setScheduledUpdate(300, 20, 0),
+	}
+
+	scheduledUpdate, found := s.App.RatelimitKeeper.GetScheduledUpdate(s.Ctx, 2)
+	s.Require().True(found, "scheduled update should be found")
+	s.Require().Equal(expectedUpdates[1], scheduledUpdate, "scheduled update")
+
+	s.Require().Equal(expectedUpdates, s.App.RatelimitKeeper.GetAllScheduledUpdates(s.Ctx), "all scheduled updates")
+
+	// Moving an update to a different trigger should replace it rather than store a second copy
+	movedUpdate := s.setScheduledUpdate(2, 30, 0)
+	expectedUpdates[1] = movedUpdate
+	s.Require().Equal(expectedUpdates, s.App.RatelimitKeeper.GetAllScheduledUpdates(s.Ctx), "all scheduled updates after move")
+
+	s.App.RatelimitKeeper.RemoveScheduledUpdate(s.Ctx, 2)
+	_, found = s.App.RatelimitKeeper.GetScheduledUpdate(s.Ctx, 2)
+	s.Require().False(found, "scheduled update should have been removed")
+	s.Require().Equal([]types.ScheduledRateLimitUpdate{expectedUpdates[0], expectedUpdates[2]},
+		s.App.RatelimitKeeper.GetAllScheduledUpdates(s.Ctx), "all scheduled updates after removal")
+}
+
+func (s *KeeperTestSuite) TestGetDueScheduledUpdates() {
+	// Updates are stored out of schedule ID order, and span both triggers
+	dueByEpoch := s.setScheduledUpdate(1, 9, 0)
+	notDueByHeight := s.setScheduledUpdate(2, 0, 101)
+	dueByHeight := s.setScheduledUpdate(3, 0, 99)
+	dueAtEpoch := s.setScheduledUpdate(4, 10, 0)
+	dueAtHeight := s.setScheduledUpdate(5, 0, 100)
+	s.setScheduledUpdate(6, 11, 0)
+	s.setScheduledUpdate(7, 0, 1_000_000)
+
+	// The due updates should be returned in schedule ID order
+	dueUpdates := s.App.RatelimitKeeper.GetDueScheduledUpdates(s.Ctx, 10, 100)
+	s.Require().Equal([]types.ScheduledRateLimitUpdate{dueByEpoch, dueByHeight, dueAtEpoch, dueAtHeight},
+		dueUpdates, "due scheduled updates")
+
+	// At an earlier epoch and height, only the earliest updates should be due
+	dueUpdates = s.App.RatelimitKeeper.GetDueScheduledUpdates(s.Ctx, 9, 99)
+	s.Require().Equal([]types.ScheduledRateLimitUpdate{dueByEpoch, dueByHeight}, dueUpdates, "earlier due scheduled updates")
+
+	// A height update should not be due at an epoch with the same number, and vice versa
+	dueUpdates = s.App.RatelimitKeeper.GetDueScheduledUpdates(s.Ctx, 0, 101)
+	s.Require().Equal([]types.ScheduledRateLimitUpdate{notDueByHeight, dueByHeight, dueAtHeight}, dueUpdates,
+		"due scheduled updates by height only")
+}
+
+func (s *KeeperTestSuite) TestScheduleRateLimitUpdate() {
+	s.Ctx = s.Ctx.WithBlockHeight(50)
+	s.App.RatelimitKeeper.SetHourEpoch(s.Ctx, types.HourEpoch{EpochNumber: 5})
+
+	scheduleMsg := types.MsgScheduleRateLimitUpdate{
+		Authority:     authority,
+		ExecuteHeight: 100,
+		Update:        updateRateLimitMsg,
+	}
+
+	// Attempt to schedule an update for a rate limit that does not exist
+	_, err := s.App.RatelimitKeeper.ScheduleRateLimitUpdate(s.Ctx, &scheduleMsg)
+	s.Require().ErrorIs(err, types.ErrRateLimitNotFound)
+
+	s.createChannel(addRateLimitMsg.ChannelId)
+	s.createChannelValue(addRateLimitMsg.Denom, sdkmath.NewInt(100))
+	s.addRateLimitSuccessful()
+
+	// Schedule an update by height and by epoch, each should be assigned the next ID
+	scheduleId, err := s.App.RatelimitKeeper.ScheduleRateLimitUpdate(s.Ctx, &scheduleMsg)
+	s.Require().NoError(err, "no error expected when scheduling by height")
+	s.Require().Equal(uint64(1), scheduleId, "first schedule id")
+
+	scheduleMsg.ExecuteHeight = 0
+	scheduleMsg.ExecuteEpoch = 6
+	scheduleId, err = s.App.RatelimitKeeper.ScheduleRateLimitUpdate(s.Ctx, &scheduleMsg)
+	s.Require().NoError(err, "no error expected when scheduling by epoch")
+	s.Require().Equal(uint64(2), scheduleId, "second schedule id")
+
+	s.Require().Equal([]types.ScheduledRateLimitUpdate{
+		{ScheduleId: 1, ExecuteHeight: 100, Update: updateRateLimitMsg},
+		{ScheduleId: 2, ExecuteEpoch: 6, Update: updateRateLimitMsg},
+	}, s.App.RatelimitKeeper.GetAllScheduledUpdates(s.Ctx), "scheduled updates")
+
+	// Attempt to schedule an update at an epoch or height that's already been reached
+	scheduleMsg.ExecuteEpoch = 5
+	_, err = s.App.RatelimitKeeper.ScheduleRateLimitUpdate(s.Ctx, &scheduleMsg)
+	s.Require().ErrorContains(err, "execute epoch (5) must be after the current epoch (5)")
+
+	scheduleMsg.ExecuteEpoch = 0
+	scheduleMsg.ExecuteHeight = 50
+	_, err = s.App.RatelimitKeeper.ScheduleRateLimitUpdate(s.Ctx, &scheduleMsg)
+	s.Require().ErrorContains(err, "execute height (50) must be after the current height (50)")
+}
+
+func (s *KeeperTestSuite) TestProcessScheduledUpdates() {
+	s.Ctx = s.Ctx.WithBlockHeight(100)
+	s.App.RatelimitKeeper.SetHourEpoch(s.Ctx, types.HourEpoch{EpochNumber: 10})
+
+	s.createChannel(addRateLimitMsg.ChannelId)
+	s.createChannelValue(addRateLimitMsg.Denom, sdkmath.NewInt(100))
+	s.addRateLimitSuccessful()
+
+	// Store an update that's due by height, one that's due by epoch, and two that are not due yet
+	s.setScheduledUpdate(1, 0, 100)
+	s.setScheduledUpdate(2, 10, 0)
+	notDueByHeight := s.setScheduledUpdate(3, 0, 101)
+	notDueByEpoch := s.setScheduledUpdate(4, 11, 0)
+
+	// Add an update that's due for a rate limit that no longer exists
+	missingRateLimitUpdate := types.ScheduledRateLimitUpdate{ScheduleId: 5, ExecuteHeight: 90, Update: updateRateLimitMsg}
+	missingRateLimitUpdate.Update.ChannelId = "channel-99"
+	s.App.RatelimitKeeper.SetScheduledUpdate(s.Ctx, missingRateLimitUpdate)
+
+	s.App.RatelimitKeeper.ProcessScheduledUpdates(s.Ctx)
+
+	// The rate limit should have the updated quota
+	rateLimit, found := s.App.RatelimitKeeper.GetRateLimit(s.Ctx, updateRateLimitMsg.Denom, updateRateLimitMsg.ChannelId)
+	s.Require().True(found)
+	s.Require().Equal(updateRateLimitMsg.MaxPercentSend, rateLimit.Quota.MaxPercentSend, "max percent send")
+	s.Require().Equal(updateRateLimitMsg.MaxPercentRecv, rateLimit.Quota.MaxPercentRecv, "max percent recv")
+	s.Require().Equal(updateRateLimitMsg.DurationHours, rateLimit.Quota.DurationHours, "duration")
+
+	// Only the updates that are not due should remain, and the failed update should be dropped
+	s.Require().Equal([]types.ScheduledRateLimitUpdate{notDueByHeight, notDueByEpoch},
+		s.App.RatelimitKeeper.GetAllScheduledUpdates(s.Ctx), "remaining scheduled updates")
+
+	appliedEvents := 0
+	failedEvents := 0
+	for _, event := range s.Ctx.EventManager().Events() {
+		switch event.Type {
+		case types.EventScheduledUpdateApplied:
+			appliedEvents++
+		case types.EventScheduledUpdateFailed:
+			failedEvents++
+		}
+	}
+	s.Require().Equal(2, appliedEvents, "applied events")
+	s.Require().Equal(1, failedEvents, "failed events")
+}
+
+// Scheduled updates by epoch should be applied by the BeginBlocker once the epoch starts
+func (s *KeeperTestSuite) TestBeginBlocker_ScheduledUpdates() {
+	s.createChannel(addRateLimitMsg.ChannelId)
+	s.createChannelValue(addRateLimitMsg.Denom, sdkmath.NewInt(100))
+	s.addRateLimitSuccessful()
+
+	blockTime := time.Date(2024, 1, 1, 0, 0, 0, 0, time.UTC)
+	s.Ctx = s.Ctx.WithBlockTime(blockTime)
+	s.App.RatelimitKeeper.SetHourEpoch(s.Ctx, types.HourEpoch{
+		EpochNumber:    9,
+		Duration:       time.Minute,
+		EpochStartTime: blockTime.Add(-2 * time.Minute),
+	})
+	s.setScheduledUpdate(1, 10, 0)
+
+	s.App.RatelimitKeeper.BeginBlocker(s.Ctx)
+
+	rateLimit, found := s.App.RatelimitKeeper.GetRateLimit(s.Ctx, updateRateLimitMsg.Denom, updateRateLimitMsg.ChannelId)
+	s.Require().True(found)
+	s.Require().Equal(updateRateLimitMsg.DurationHours, rateLimit.Quota.DurationHours, "duration")
+	s.Require().Empty(s.App.RatelimitKeeper.GetAllScheduledUpdates(s.Ctx), "scheduled update should be removed")
+}
+
+func (s *KeeperTestSuite) TestMsgServer_ScheduleRateLimitUpdate() {
+	msgServer := keeper.NewMsgServerImpl(s.App.RatelimitKeeper)
+
+	s.createChannel(addRateLimitMsg.ChannelId)
+	s.createChannelValue(addRateLimitMsg.Denom, sdkmath.NewInt(100))
+	s.addRateLimitSuccessful()
+
+	scheduleMsg := types.MsgScheduleRateLimitUpdate{
+		Authority:     authority,
+		ExecuteHeight: s.Ctx.BlockHeight() + 10,
+		Update:        updateRateLimitMsg,
+	}
+	response, err := msgServer.ScheduleRateLimitUpdate(s.Ctx, &scheduleMsg)
+	s.Require().NoError(err)
+	s.Require().Equal(uint64(1), response.ScheduleId, "schedule id")
+
+	_, found := s.App.RatelimitKeeper.GetScheduledUpdate(s.Ctx, 1)
+	s.Require().True(found, "scheduled update should be stored")
+
+	// The rate limit should not be updated until the update is due
+	rateLimit, found := s.App.RatelimitKeeper.GetRateLimit(s.Ctx, addRateLimitMsg.Denom, addRateLimitMsg.ChannelId)
+	s.Require().True(found)
+	s.Require().Equal(addRateLimitMsg.DurationHours, rateLimit.Quota.DurationHours, "duration")
+
+	// Attempt to schedule with an invalid authority
+	scheduleMsg.Authority = "invalid"
+	_, err = msgServer.ScheduleRateLimitUpdate(s.Ctx, &scheduleMsg)
+	s.Require().ErrorContains(err, "invalid authority")
+}
+
+func (s *KeeperTestSuite) TestMsgServer_CancelScheduledRateLimitUpdate() {
+	msgServer := keeper.NewMsgServerImpl(s.App.RatelimitKeeper)
+
+	cancelMsg := types.MsgCancelScheduledRateLimitUpdate{
+		Authority:  authority,
+		ScheduleId: 1,
+	}
+
+	// Attempt to cancel a scheduled update that does not exist
+	_, err := msgServer.CancelScheduledRateLimitUpdate(s.Ctx, &cancelMsg)
+	s.Require().Equal(err, types.ErrScheduledUpdateNotFound)
+
+	s.setScheduledUpdate(1, 10, 0)
+	_, err = msgServer.CancelScheduledRateLimitUpdate(s.Ctx, &cancelMsg)
+	s.Require().NoError(err)
+
+	_, found := s.App.RatelimitKeeper.GetScheduledUpdate(s.Ctx, 1)
+	s.Require().False(found, "scheduled update should have been removed")
+}
+
+func (s *KeeperTestSuite) TestQueryScheduledRateLimitUpdates() {
+	otherChannelUpdate := types.ScheduledRateLimitUpdate{ScheduleId: 2, ExecuteEpoch: 10, Update: updateRateLimitMsg}
+	otherChannelUpdate.Update.ChannelId = "channel-1"
+	otherDenomUpdate := types.ScheduledRateLimitUpdate{ScheduleId: 3, ExecuteEpoch: 10, Update: updateRateLimitMsg}
+	otherDenomUpdate.Update.Denom = "other-denom"
+
+	pathUpdate := s.setScheduledUpdate(1, 10, 0)
+	s.App.RatelimitKeeper.SetScheduledUpdate(s.Ctx, otherChannelUpdate)
+	s.App.RatelimitKeeper.SetScheduledUpdate(s.Ctx, otherDenomUpdate)
+
+	testCases := []struct {
+		name            string
+		denom           string
+		channelId       string
+		expectedUpdates []types.ScheduledRateLimitUpdate
+	}{
+		{
+			name:            "no filter",
+			expectedUpdates: []types.ScheduledRateLimitUpdate{pathUpdate, otherChannelUpdate, otherDenomUpdate},
+		},
+		{
+			name:            "denom filter",
+			denom:           updateRateLimitMsg.Denom,
+			expectedUpdates: []types.ScheduledRateLimitUpdate{pathUpdate, otherChannelUpdate},
+		},
+		{
+			name:            "channel filter",
+			channelId:       updateRateLimitMsg.ChannelId,
+			expectedUpdates: []types.ScheduledRateLimitUpdate{pathUpdate, otherDenomUpdate},
+		},
+		{
+			name:            "denom and channel filter",
+			denom:           updateRateLimitMsg.Denom,
+			channelId:       updateRateLimitMsg.ChannelId,
+			expectedUpdates: []types.ScheduledRateLimitUpdate{pathUpdate},
+		},
+		{
+			name:      "no matches",
+			channelId: "channel-99",
+		},
+	}
+
+	for _, tc := range testCases {
+		s.Run(tc.name, func() {
+			queryResponse, err := s.QueryClient.ScheduledRateLimitUpdates(context.Background(), &types.QueryScheduledRateLimitUpdatesRequest{
+				Denom:     tc.denom,
+				ChannelId: tc.channelId,
+			})
+			s.Require().NoError(err)
+			s.Require().Equal(tc.expectedUpdates, queryResponse.ScheduledUpdates)
+		})
+	}
+
+	// Page through the denom filtered updates one at a time
+	firstPage, err := s.QueryClient.ScheduledRateLimitUpdates(context.Background(), &types.QueryScheduledRateLimitUpdatesRequest{
+		Denom:      updateRateLimitMsg.Denom,
+		Pagination: &query.PageRequest{Limit: 1, CountTotal: true},
+	})
+	s.Require().NoError(err, "no error expected when querying first page")
+	s.Require().Equal([]types.ScheduledRateLimitUpdate{pathUpdate}, firstPage.ScheduledUpdates, "first page")
+	s.Require().Equal(uint64(2), firstPage.Pagination.Total, "total filtered updates")
+
+	secondPage, err := s.QueryClient.ScheduledRateLimitUpdates(context.Background(), &types.QueryScheduledRateLimitUpdatesRequest{
+		Denom:      updateRateLimitMsg.Denom,
+		Pagination: &query.PageRequest{Key: firstPage.Pagination.NextKey, Limit: 1},
+	})
+	s.Require().NoError(err, "no error expected when querying second page")
+	s.Require().Equal([]types.ScheduledRateLimitUpdate{otherChannelUpdate}, secondPage.ScheduledUpdates, "second page")
+}
+
+// The next schedule ID should start after the last scheduled update from genesis
+func (s *KeeperTestSuite) TestGenesis_NextScheduleId() {
+	genesisState := types.DefaultGenesis()
+	genesisState.ScheduledUpdates = []types.ScheduledRateLimitUpdate{
+		{ScheduleId: 7, ExecuteEpoch: 10, Update: updateRateLimitMsg},
+		{ScheduleId: 3, ExecuteEpoch: 10, Update: updateRateLimitMsg},
+	}
+	s.App.RatelimitKeeper.InitGenesis(s.Ctx, *genesisState)
+
+	s.Require().Equal(uint64(8), s.App.RatelimitKeeper.GetNextScheduleId(s.Ctx), "next schedule id")
+}
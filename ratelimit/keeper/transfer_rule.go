@@ -0,0 +1,130 @@
+package keeper
+
+import (
+	"github.com/cosmos/cosmos-sdk/store/prefix"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	"github.com/Stride-Labs/ibc-rate-limiting/ratelimit/types"
+)
+
+// Stores/Updates a transfer rule in the store, along with its denom/channel index
+func (k Keeper) SetTransferRule(ctx sdk.Context, rule types.TransferRule) {
+	k.RemoveTransferRule(ctx, rule.RuleId)
+
+	store := prefix.NewStore(ctx.KVStore(k.storeKey), types.TransferRuleKeyPrefix)
+	key := types.KeyPrefix(rule.RuleId)
+	value := k.cdc.MustMarshal(&rule)
+	store.Set(key, value)
+
+	indexStore := prefix.NewStore(ctx.KVStore(k.storeKey), types.TransferRuleIndexPrefix)
+	indexStore.Set(types.GetTransferRuleIndexKey(rule.Denom, rule.ChannelId, rule.RuleId), []byte{1})
+}
+
+// Removes a transfer rule, and its denom/channel index, from the store
+func (k Keeper) RemoveTransferRule(ctx sdk.Context, ruleId string) {
+	rule, found := k.GetTransferRule(ctx, ruleId)
+	if !found {
+		return
+	}
+
+	store := prefix.NewStore(ctx.KVStore(k.storeKey), types.TransferRuleKeyPrefix)
+	key := types.KeyPrefix(ruleId)
+	store.Delete(key)
+
+	indexStore := prefix.NewStore(ctx.KVStore(k.storeKey), types.TransferRuleIndexPrefix)
+	indexStore.Delete(types.GetTransferRuleIndexKey(rule.Denom, rule.ChannelId, rule.RuleId))
+}
+
+// Grabs and returns a transfer rule from the store using the rule ID
+func (k Keeper) GetTransferRule(ctx sdk.Context, ruleId string) (rule types.TransferRule, found bool) {
+	store := prefix.NewStore(ctx.KVStore(k.storeKey), types.TransferRuleKeyPrefix)
+
+	key := types.KeyPrefix(ruleId)
+	value := store.Get(key)
+	if len(value) == 0 {
+		return rule, false
+	}
+
+	k.cdc.MustUnmarshal(value, &rule)
+	return rule, true
+}
+
+// Returns all transfer rules
+func (k Keeper) GetAllTransferRules(ctx sdk.Context) []types.TransferRule {
+	store := prefix.NewStore(ctx.KVStore(k.storeKey), types.TransferRuleKeyPrefix)
+
+	iterator := store.Iterator(nil, nil)
+	defer iterator.Close()
+
+	allRules := []types.TransferRule{}
+	for ; iterator.Valid(); iterator.Next() {
+		rule := types.TransferRule{}
+		k.cdc.MustUnmarshal(iterator.Value(), &rule)
+		allRules = append(allRules, rule)
+	}
+
+	return allRules
+}
+
+// Returns the transfer rules on a denom and channel, using the denom/channel index
+// An empty denom or channel ID returns the rules that apply to any denom or channel
+func (k Keeper) getIndexedTransferRules(ctx sdk.Context, denom, channelId string) []types.TransferRule {
+	indexStore := prefix.NewStore(ctx.KVStore(k.storeKey), types.TransferRuleIndexPrefix)
+
+	iterator := sdk.KVStorePrefixIterator(indexStore, types.GetTransferRuleIndexPrefix(denom, channelId))
+	defer iterator.Close()
+
+	rules := []types.TransferRule{}
+	prefixLength := len(types.GetTransferRuleIndexPrefix(denom, channelId))
+	for ; iterator.Valid(); iterator.Next() {
+		ruleId := string(iterator.Key()[prefixLength:])
+		if rule, found := k.GetTransferRule(ctx, ruleId); found {
+			rules = append(rules, rule)
+		}
+	}
+
+	return rules
+}
+
+// Returns the transfer rule that applies to the given packet, if any
+// Only the rules on the packet's denom and channel (or on any denom or channel) are checked,
+// and the memo is only parsed once
+// If multiple rules match, the most restrictive one is returned (DENY, then QUOTA, then EXEMPT),
+// with ties broken by rule ID
+func (k Keeper) GetMatchingTransferRule(ctx sdk.Context, packetInfo RateLimitedPacketInfo) (rule types.TransferRule, found bool) {
+	actionPriority := map[types.TransferRuleAction]int{
+		types.RULE_ACTION_DENY:   3,
+		types.RULE_ACTION_QUOTA:  2,
+		types.RULE_ACTION_EXEMPT: 1,
+	}
+
+	// Rules can apply to the packet's denom and/or channel, or to any denom and/or channel
+	paths := []types.Path{
+		{Denom: packetInfo.Denom, ChannelId: packetInfo.ChannelID},
+		{Denom: packetInfo.Denom},
+		{ChannelId: packetInfo.ChannelID},
+		{},
+	}
+	checkedPaths := map[types.Path]bool{}
+
+	memoKeys := types.ParseMemoKeys(packetInfo.Memo)
+	for _, path := range paths {
+		if checkedPaths[path] {
+			continue
+		}
+		checkedPaths[path] = true
+
+		for _, candidate := range k.getIndexedTransferRules(ctx, path.Denom, path.ChannelId) {
+			if !candidate.Matches(packetInfo.Denom, packetInfo.ChannelID, packetInfo.Receiver, packetInfo.Memo, memoKeys) {
+				continue
+			}
+			candidatePriority, rulePriority := actionPriority[candidate.Action], actionPriority[rule.Action]
+			if !found || candidatePriority > rulePriority || (candidatePriority == rulePriority && candidate.RuleId < rule.RuleId) {
+				rule = candidate
+				found = true
+			}
+		}
+	}
+
+	return rule, found
+}
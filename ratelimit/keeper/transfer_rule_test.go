@@ -0,0 +1,202 @@
+package keeper_test
+
+import (
+	sdkmath "cosmossdk.io/math"
+
+	"github.com/Stride-Labs/ibc-rate-limiting/ratelimit/keeper"
+	"github.com/Stride-Labs/ibc-rate-limiting/ratelimit/types"
+)
+
+const wasmMemo = `{"wasm": {"contract": "contract", "msg": {}}}`
+
+func (s *KeeperTestSuite) TestTransferRuleStore() {
+	allRules := []types.TransferRule{
+		{RuleId: "rule-A", MemoKey: "wasm", Action: types.RULE_ACTION_DENY},
+		{RuleId: "rule-B", Receiver: receiver, Action: types.RULE_ACTION_EXEMPT},
+		{RuleId: "rule-C", MemoContains: "swap", Action: types.RULE_ACTION_QUOTA,
+			Quota: &types.Quota{MaxPercentSend: sdkmath.NewInt(1), MaxPercentRecv: sdkmath.NewInt(1)}},
+	}
+
+	// Add each rule and confirm it can be read back
+	for _, rule := range allRules {
+		s.App.RatelimitKeeper.SetTransferRule(s.Ctx, rule)
+
+		actualRule, found := s.App.RatelimitKeeper.GetTransferRule(s.Ctx, rule.RuleId)
+		s.Require().True(found, "rule %s should have been found", rule.RuleId)
+		s.Require().Equal(rule, actualRule, "rule %s", rule.RuleId)
+	}
+	s.Require().Equal(allRules, s.App.RatelimitKeeper.GetAllTransferRules(s.Ctx), "all rules")
+
+	// Remove one of the rules
+	s.App.RatelimitKeeper.RemoveTransferRule(s.Ctx, "rule-B")
+
+	_, found := s.App.RatelimitKeeper.GetTransferRule(s.Ctx, "rule-B")
+	s.Require().False(found, "rule-B should have been removed")
+	s.Require().Equal([]types.TransferRule{allRules[0], allRules[2]}, s.App.RatelimitKeeper.GetAllTransferRules(s.Ctx))
+}
+
+func (s *KeeperTestSuite) TestGetMatchingTransferRule_Index() {
+	packetInfo := keeper.RateLimitedPacketInfo{
+		ChannelID: channelId,
+		Denom:     denom,
+		Amount:    sdkmath.NewInt(1),
+		Sender:    sender,
+		Receiver:  receiver,
+		Memo:      wasmMemo,
+	}
+
+	// Add rules on other denoms and channels, which should not match
+	s.App.RatelimitKeeper.SetTransferRule(s.Ctx, types.TransferRule{RuleId: "other-denom", Denom: "other", MemoKey: "wasm"})
+	s.App.RatelimitKeeper.SetTransferRule(s.Ctx, types.TransferRule{RuleId: "other-channel", ChannelId: "channel-9", MemoKey: "wasm"})
+
+	_, found := s.App.RatelimitKeeper.GetMatchingTransferRule(s.Ctx, packetInfo)
+	s.Require().False(found, "rules on other denoms and channels should not match")
+
+	// Move one of the rules to the packet's denom, it should match with its new path only
+	s.App.RatelimitKeeper.SetTransferRule(s.Ctx, types.TransferRule{RuleId: "other-denom", Denom: denom, MemoKey: "wasm"})
+
+	rule, found := s.App.RatelimitKeeper.GetMatchingTransferRule(s.Ctx, packetInfo)
+	s.Require().True(found, "rule moved to the denom should match")
+	s.Require().Equal("other-denom", rule.RuleId)
+
+	packetInfo.Denom = "other"
+	_, found = s.App.RatelimitKeeper.GetMatchingTransferRule(s.Ctx, packetInfo)
+	s.Require().False(found, "rule should no longer match its previous denom")
+	packetInfo.Denom = denom
+
+	// Add rules with the same action on the channel and on any path, the lowest rule ID should win
+	s.App.RatelimitKeeper.SetTransferRule(s.Ctx, types.TransferRule{RuleId: "any-path", MemoKey: "wasm"})
+	s.App.RatelimitKeeper.SetTransferRule(s.Ctx, types.TransferRule{RuleId: "channel", ChannelId: channelId, MemoKey: "wasm"})
+
+	rule, found = s.App.RatelimitKeeper.GetMatchingTransferRule(s.Ctx, packetInfo)
+	s.Require().True(found, "rules should match")
+	s.Require().Equal("any-path", rule.RuleId)
+
+	// Once removed, a rule should no longer match
+	s.App.RatelimitKeeper.RemoveTransferRule(s.Ctx, "any-path")
+
+	rule, found = s.App.RatelimitKeeper.GetMatchingTransferRule(s.Ctx, packetInfo)
+	s.Require().True(found, "rules should match")
+	s.Require().Equal("channel", rule.RuleId)
+}
+
+func (s *KeeperTestSuite) TestGetMatchingTransferRule() {
+	packetInfo := keeper.RateLimitedPacketInfo{
+		ChannelID: channelId,
+		Denom:     denom,
+		Amount:    sdkmath.NewInt(1),
+		Sender:    sender,
+		Receiver:  receiver,
+		Memo:      wasmMemo,
+	}
+
+	// No rules - should not be found
+	_, found := s.App.RatelimitKeeper.GetMatchingTransferRule(s.Ctx, packetInfo)
+	s.Require().False(found, "no rule should match before any are added")
+
+	// Add a non-matching rule and an exempt rule
+	s.App.RatelimitKeeper.SetTransferRule(s.Ctx, types.TransferRule{RuleId: "other", MemoKey: "autopilot", Action: types.RULE_ACTION_DENY})
+	s.App.RatelimitKeeper.SetTransferRule(s.Ctx, types.TransferRule{RuleId: "exempt", Receiver: receiver, Action: types.RULE_ACTION_EXEMPT})
+
+	rule, found := s.App.RatelimitKeeper.GetMatchingTransferRule(s.Ctx, packetInfo)
+	s.Require().True(found, "exempt rule should match")
+	s.Require().Equal("exempt", rule.RuleId)
+
+	// Add a quota rule, which should take precedence over the exemption
+	quota := &types.Quota{MaxPercentSend: sdkmath.NewInt(1), MaxPercentRecv: sdkmath.NewInt(1)}
+	s.App.RatelimitKeeper.SetTransferRule(s.Ctx, types.TransferRule{RuleId: "quota", MemoContains: "contract", Action: types.RULE_ACTION_QUOTA, Quota: quota})
+
+	rule, found = s.App.RatelimitKeeper.GetMatchingTransferRule(s.Ctx, packetInfo)
+	s.Require().True(found, "quota rule should match")
+	s.Require().Equal("quota", rule.RuleId)
+
+	// Add a deny rule, which should take precedence over everything
+	s.App.RatelimitKeeper.SetTransferRule(s.Ctx, types.TransferRule{RuleId: "deny", MemoKey: "wasm", Action: types.RULE_ACTION_DENY})
+
+	rule, found = s.App.RatelimitKeeper.GetMatchingTransferRule(s.Ctx, packetInfo)
+	s.Require().True(found, "deny rule should match")
+	s.Require().Equal("deny", rule.RuleId)
+}
+
+func (s *KeeperTestSuite) TestCheckRateLimitAndUpdateFlow_TransferRule() {
+	testCases := []struct {
+		name            string
+		rule            types.TransferRule
+		amount          int64
+		expectedUpdated bool
+		expectedOutflow int64
+		expectedError   string
+	}{
+		{
+			name:            "no matching rule",
+			rule:            types.TransferRule{RuleId: "rule", MemoKey: "autopilot", Action: types.RULE_ACTION_DENY},
+			amount:          5,
+			expectedUpdated: true,
+			expectedOutflow: 5,
+		},
+		{
+			name:          "denied by rule",
+			rule:          types.TransferRule{RuleId: "rule", MemoKey: "wasm", Action: types.RULE_ACTION_DENY},
+			amount:        5,
+			expectedError: types.ErrTransferDeniedByRule.Error(),
+		},
+		{
+			name:            "exempt by rule",
+			rule:            types.TransferRule{RuleId: "rule", Receiver: receiver, Action: types.RULE_ACTION_EXEMPT},
+			amount:          50,
+			expectedUpdated: false,
+			expectedOutflow: 0,
+		},
+		{
+			name: "within tighter quota from rule",
+			rule: types.TransferRule{RuleId: "rule", MemoKey: "wasm", Action: types.RULE_ACTION_QUOTA,
+				Quota: &types.Quota{MaxPercentSend: sdkmath.NewInt(5), MaxPercentRecv: sdkmath.NewInt(5)}},
+			amount:          5,
+			expectedUpdated: true,
+			expectedOutflow: 5,
+		},
+		{
+			name: "exceeds tighter quota from rule",
+			rule: types.TransferRule{RuleId: "rule", MemoKey: "wasm", Action: types.RULE_ACTION_QUOTA,
+				Quota: &types.Quota{MaxPercentSend: sdkmath.NewInt(5), MaxPercentRecv: sdkmath.NewInt(5)}},
+			amount:        6,
+			expectedError: types.ErrQuotaExceeded.Error(),
+		},
+	}
+
+	for _, tc := range testCases {
+		s.Run(tc.name, func() {
+			s.SetupTest()
+
+			// Add a rate limit with a 10% send quota and a channel value of 100
+			s.App.RatelimitKeeper.SetRateLimit(s.Ctx, types.RateLimit{
+				Path:  &types.Path{Denom: denom, ChannelId: channelId},
+				Quota: &types.Quota{MaxPercentSend: sdkmath.NewInt(10), MaxPercentRecv: sdkmath.NewInt(10), DurationHours: 1},
+				Flow:  &types.Flow{Inflow: sdkmath.ZeroInt(), Outflow: sdkmath.ZeroInt(), ChannelValue: sdkmath.NewInt(100)},
+			})
+			s.App.RatelimitKeeper.SetTransferRule(s.Ctx, tc.rule)
+
+			packetInfo := keeper.RateLimitedPacketInfo{
+				ChannelID: channelId,
+				Denom:     denom,
+				Amount:    sdkmath.NewInt(tc.amount),
+				Sender:    sender,
+				Receiver:  receiver,
+				Memo:      wasmMemo,
+			}
+			updated, err := s.App.RatelimitKeeper.CheckRateLimitAndUpdateFlow(s.Ctx, types.PACKET_SEND, packetInfo)
+			if tc.expectedError != "" {
+				s.Require().ErrorContains(err, tc.expectedError)
+				return
+			}
+			s.Require().NoError(err, "no error expected")
+			s.Require().Equal(tc.expectedUpdated, updated, "flow updated")
+
+			// Confirm the rate limit's quota was not modified by a quota rule
+			rateLimit, found := s.App.RatelimitKeeper.GetRateLimit(s.Ctx, denom, channelId)
+			s.Require().True(found)
+			s.Require().Equal(tc.expectedOutflow, rateLimit.Flow.Outflow.Int64(), "outflow")
+			s.Require().Equal(int64(10), rateLimit.Quota.MaxPercentSend.Int64(), "max percent send")
+		})
+	}
+}
@@ -0,0 +1,39 @@
+package v2
+
+import (
+	"github.com/cosmos/cosmos-sdk/codec"
+	"github.com/cosmos/cosmos-sdk/store/prefix"
+	storetypes "github.com/cosmos/cosmos-sdk/store/types"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	"github.com/Stride-Labs/ibc-rate-limiting/ratelimit/types"
+)
+
+// Migrates the store from v1 to v2
+// Populates the channel and denom indexes for each of the existing rate limits
+func MigrateStore(ctx sdk.Context, storeKey storetypes.StoreKey, cdc codec.BinaryCodec) error {
+	store := ctx.KVStore(storeKey)
+
+	// Read all rate limits first so the store isn't written to while iterating
+	rateLimitStore := prefix.NewStore(store, types.RateLimitKeyPrefix)
+	iterator := rateLimitStore.Iterator(nil, nil)
+	paths := []types.Path{}
+	for ; iterator.Valid(); iterator.Next() {
+		var rateLimit types.RateLimit
+		if err := cdc.Unmarshal(iterator.Value(), &rateLimit); err != nil {
+			iterator.Close()
+			return err
+		}
+		paths = append(paths, *rateLimit.Path)
+	}
+	iterator.Close()
+
+	channelIndexStore := prefix.NewStore(store, types.RateLimitChannelIndexPrefix)
+	denomIndexStore := prefix.NewStore(store, types.RateLimitDenomIndexPrefix)
+	for _, path := range paths {
+		channelIndexStore.Set(types.GetRateLimitChannelIndexKey(path.ChannelId, path.Denom), []byte{1})
+		denomIndexStore.Set(types.GetRateLimitDenomIndexKey(path.Denom, path.ChannelId), []byte{1})
+	}
+
+	return nil
+}
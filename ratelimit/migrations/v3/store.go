@@ -0,0 +1,60 @@
+package v3
+
+import (
+	"bytes"
+
+	"github.com/cosmos/cosmos-sdk/codec"
+	"github.com/cosmos/cosmos-sdk/store/prefix"
+	storetypes "github.com/cosmos/cosmos-sdk/store/types"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	"github.com/Stride-Labs/ibc-rate-limiting/ratelimit/types"
+)
+
+// In v2, pending send packets were stored with only a placeholder value
+var legacyPendingSendPacketValue = []byte{1}
+
+// Migrates the store from v2 to v3
+// Converts each pending send packet into a structured record. The amount charged by
+// these packets was never stored, so it's left empty and the amount is instead parsed
+// from the packet if it's refunded
+func MigrateStore(ctx sdk.Context, storeKey storetypes.StoreKey, cdc codec.BinaryCodec) error {
+	store := ctx.KVStore(storeKey)
+
+	// The packets are assumed to have been sent in the current epoch
+	var epochNumber uint64
+	if epochBz := store.Get(types.HourEpochKey); len(epochBz) != 0 {
+		var hourEpoch types.HourEpoch
+		if err := cdc.Unmarshal(epochBz, &hourEpoch); err != nil {
+			return err
+		}
+		epochNumber = hourEpoch.EpochNumber
+	}
+
+	// Read all legacy pending packets first so the store isn't written to while iterating
+	pendingPacketStore := prefix.NewStore(store, types.PendingSendPacketPrefix)
+	iterator := pendingPacketStore.Iterator(nil, nil)
+	pendingPackets := []types.PendingSendPacket{}
+	for ; iterator.Valid(); iterator.Next() {
+		if !bytes.Equal(iterator.Value(), legacyPendingSendPacketValue) {
+			continue
+		}
+		channelId, sequence := types.ParsePendingSendPacketKey(iterator.Key())
+		pendingPackets = append(pendingPackets, types.PendingSendPacket{
+			ChannelId:   channelId,
+			Sequence:    sequence,
+			Amount:      sdk.Coins{},
+			EpochNumber: epochNumber,
+			Direction:   types.PACKET_SEND,
+		})
+	}
+	iterator.Close()
+
+	for _, pendingPacket := range pendingPackets {
+		pendingPacket := pendingPacket
+		key := types.GetPendingSendPacketKey(pendingPacket.ChannelId, pendingPacket.Sequence)
+		pendingPacketStore.Set(key, cdc.MustMarshal(&pendingPacket))
+	}
+
+	return nil
+}
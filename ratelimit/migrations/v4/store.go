@@ -0,0 +1,55 @@
+package v4
+
+import (
+	"github.com/cosmos/cosmos-sdk/codec"
+	"github.com/cosmos/cosmos-sdk/store/prefix"
+	storetypes "github.com/cosmos/cosmos-sdk/store/types"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	"github.com/Stride-Labs/ibc-rate-limiting/ratelimit/types"
+)
+
+// Migrates the store from v3 to v4
+// Records the current quota window ID on the flow of each rate limit. Prior to v4, the
+// window ID was not stored, so without this, each flow would appear stale (and be reset
+// early) if lazy quota resets were enabled
+func MigrateStore(ctx sdk.Context, storeKey storetypes.StoreKey, cdc codec.BinaryCodec) error {
+	store := ctx.KVStore(storeKey)
+
+	var epochNumber uint64
+	if epochBz := store.Get(types.HourEpochKey); len(epochBz) != 0 {
+		var hourEpoch types.HourEpoch
+		if err := cdc.Unmarshal(epochBz, &hourEpoch); err != nil {
+			return err
+		}
+		epochNumber = hourEpoch.EpochNumber
+	}
+
+	// Read all rate limits first so the store isn't written to while iterating
+	rateLimitStore := prefix.NewStore(store, types.RateLimitKeyPrefix)
+	iterator := rateLimitStore.Iterator(nil, nil)
+	rateLimits := []types.RateLimit{}
+	for ; iterator.Valid(); iterator.Next() {
+		var rateLimit types.RateLimit
+		if err := cdc.Unmarshal(iterator.Value(), &rateLimit); err != nil {
+			iterator.Close()
+			return err
+		}
+		rateLimits = append(rateLimits, rateLimit)
+	}
+	iterator.Close()
+
+	for _, rateLimit := range rateLimits {
+		rateLimit := rateLimit
+		if rateLimit.Flow == nil {
+			continue
+		}
+		if durationHours := rateLimit.Quota.GetDurationHours(); durationHours != 0 {
+			rateLimit.Flow.WindowId = epochNumber / durationHours
+		}
+		key := types.GetRateLimitItemKey(rateLimit.Path.Denom, rateLimit.Path.ChannelId)
+		rateLimitStore.Set(key, cdc.MustMarshal(&rateLimit))
+	}
+
+	return nil
+}
@@ -4,6 +4,7 @@ import (
 	"context"
 	"encoding/json"
 	"fmt"
+	"sort"
 
 	"github.com/gorilla/mux"
 	"github.com/grpc-ecosystem/grpc-gateway/runtime"
@@ -11,12 +12,20 @@ import (
 
 	abci "github.com/cometbft/cometbft/abci/types"
 
+	"cosmossdk.io/core/appmodule"
+	"cosmossdk.io/depinject"
+
 	"github.com/cosmos/cosmos-sdk/client"
 	"github.com/cosmos/cosmos-sdk/codec"
 	cdctypes "github.com/cosmos/cosmos-sdk/codec/types"
+	storetypes "github.com/cosmos/cosmos-sdk/store/types"
 	sdk "github.com/cosmos/cosmos-sdk/types"
 	"github.com/cosmos/cosmos-sdk/types/module"
+	authtypes "github.com/cosmos/cosmos-sdk/x/auth/types"
+	govtypes "github.com/cosmos/cosmos-sdk/x/gov/types"
+	paramtypes "github.com/cosmos/cosmos-sdk/x/params/types"
 
+	modulev1 "github.com/Stride-Labs/ibc-rate-limiting/api/ratelimit/module/v1"
 	"github.com/Stride-Labs/ibc-rate-limiting/ratelimit/client/cli"
 	"github.com/Stride-Labs/ibc-rate-limiting/ratelimit/keeper"
 	"github.com/Stride-Labs/ibc-rate-limiting/ratelimit/types"
@@ -25,6 +34,7 @@ import (
 var (
 	_ module.AppModule      = AppModule{}
 	_ module.AppModuleBasic = AppModuleBasic{}
+	_ appmodule.AppModule   = AppModule{}
 )
 
 // ----------------------------------------------------------------------------
@@ -110,6 +120,12 @@ func NewAppModule(
 	}
 }
 
+// IsOnePerModuleType implements the depinject.OnePerModuleType interface.
+func (am AppModule) IsOnePerModuleType() {}
+
+// IsAppModule implements the appmodule.AppModule interface.
+func (am AppModule) IsAppModule() {}
+
 // Name returns the capability module's name.
 func (am AppModule) Name() string {
 	return am.AppModuleBasic.Name()
@@ -123,10 +139,23 @@ func (AppModule) QuerierRoute() string { return types.QuerierRoute }
 func (am AppModule) RegisterServices(cfg module.Configurator) {
 	types.RegisterQueryServer(cfg.QueryServer(), am.keeper)
 	types.RegisterMsgServer(cfg.MsgServer(), keeper.NewMsgServerImpl(am.keeper))
+
+	migrator := keeper.NewMigrator(am.keeper)
+	if err := cfg.RegisterMigration(types.ModuleName, 1, migrator.Migrate1to2); err != nil {
+		panic(fmt.Sprintf("failed to migrate x/%s from version 1 to 2: %v", types.ModuleName, err))
+	}
+	if err := cfg.RegisterMigration(types.ModuleName, 2, migrator.Migrate2to3); err != nil {
+		panic(fmt.Sprintf("failed to migrate x/%s from version 2 to 3: %v", types.ModuleName, err))
+	}
+	if err := cfg.RegisterMigration(types.ModuleName, 3, migrator.Migrate3to4); err != nil {
+		panic(fmt.Sprintf("failed to migrate x/%s from version 3 to 4: %v", types.ModuleName, err))
+	}
 }
 
 // RegisterInvariants registers the capability module's invariants.
-func (am AppModule) RegisterInvariants(_ sdk.InvariantRegistry) {}
+func (am AppModule) RegisterInvariants(ir sdk.InvariantRegistry) {
+	keeper.RegisterInvariants(ir, am.keeper)
+}
 
 // InitGenesis performs the capability module's genesis initialization It returns
 // no validator updates.
@@ -147,7 +176,7 @@ func (am AppModule) ExportGenesis(ctx sdk.Context, cdc codec.JSONCodec) json.Raw
 }
 
 // ConsensusVersion implements ConsensusVersion.
-func (AppModule) ConsensusVersion() uint64 { return 1 }
+func (AppModule) ConsensusVersion() uint64 { return 4 }
 
 // BeginBlock executes all ABCI BeginBlock logic respective to the capability module.
 func (am AppModule) BeginBlock(ctx sdk.Context, _ abci.RequestBeginBlock) {
@@ -159,3 +188,88 @@ func (am AppModule) BeginBlock(ctx sdk.Context, _ abci.RequestBeginBlock) {
 func (am AppModule) EndBlock(_ sdk.Context, _ abci.RequestEndBlock) []abci.ValidatorUpdate {
 	return []abci.ValidatorUpdate{}
 }
+
+// ----------------------------------------------------------------------------
+// App Wiring Setup
+// ----------------------------------------------------------------------------
+
+func init() {
+	appmodule.Register(
+		&modulev1.Module{},
+		appmodule.Provide(ProvideModule),
+		appmodule.Invoke(InvokeSetRateLimitHooks),
+	)
+}
+
+type ModuleInputs struct {
+	depinject.In
+
+	Config   *modulev1.Module
+	Cdc      codec.Codec
+	Key      *storetypes.KVStoreKey
+	Subspace paramtypes.Subspace
+
+	BankKeeper    types.BankKeeper
+	ChannelKeeper types.ChannelKeeper
+	ICS4Wrapper   types.ICS4Wrapper
+
+	// An optional price oracle, required to enforce quotas denominated in a quote currency
+	OracleKeeper types.OracleKeeper `optional:"true"`
+}
+
+type ModuleOutputs struct {
+	depinject.Out
+
+	RatelimitKeeper keeper.Keeper
+	Module          appmodule.AppModule
+}
+
+// ProvideModule builds the rate limit keeper and module from the app config
+// The IBC channel keeper and ICS4Wrapper must be supplied by the app, since the IBC
+// modules are not wired through depinject. The IBC middleware must still be added to
+// the transfer stack in the app's IBC router
+func ProvideModule(in ModuleInputs) ModuleOutputs {
+	// default to governance authority if not provided
+	authority := authtypes.NewModuleAddress(govtypes.ModuleName)
+	if in.Config.Authority != "" {
+		authority = authtypes.NewModuleAddressOrBech32Address(in.Config.Authority)
+	}
+
+	k := keeper.NewKeeper(
+		in.Cdc,
+		in.Key,
+		in.Subspace,
+		authority.String(),
+		in.BankKeeper,
+		in.ChannelKeeper,
+		in.ICS4Wrapper,
+	)
+	if in.OracleKeeper != nil {
+		k.SetOracleKeeper(in.OracleKeeper)
+	}
+	m := NewAppModule(in.Cdc, *k)
+
+	return ModuleOutputs{RatelimitKeeper: *k, Module: m}
+}
+
+// InvokeSetRateLimitHooks registers the rate limit hooks provided by other modules through depinject
+// (as a RateLimitHooksWrapper). The hooks are run in alphabetical order of the module names
+func InvokeSetRateLimitHooks(k keeper.Keeper, rateLimitHooks map[string]types.RateLimitHooksWrapper) error {
+	if len(rateLimitHooks) == 0 {
+		return nil
+	}
+
+	moduleNames := make([]string, 0, len(rateLimitHooks))
+	for moduleName := range rateLimitHooks {
+		moduleNames = append(moduleNames, moduleName)
+	}
+	sort.Strings(moduleNames)
+
+	var multiHooks types.MultiRateLimitHooks
+	for _, moduleName := range moduleNames {
+		multiHooks = append(multiHooks, rateLimitHooks[moduleName])
+	}
+	k.SetHooks(multiHooks)
+
+	return nil
+}
@@ -0,0 +1,135 @@
+package ratelimit_test
+
+import (
+	"testing"
+
+	appv1alpha1 "cosmossdk.io/api/cosmos/app/v1alpha1"
+	"cosmossdk.io/core/appconfig"
+	"cosmossdk.io/core/appmodule"
+	"cosmossdk.io/depinject"
+	sdkmath "cosmossdk.io/math"
+	storetypes "github.com/cosmos/cosmos-sdk/store/types"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	authtypes "github.com/cosmos/cosmos-sdk/x/auth/types"
+	govtypes "github.com/cosmos/cosmos-sdk/x/gov/types"
+	"github.com/stretchr/testify/suite"
+
+	modulev1 "github.com/Stride-Labs/ibc-rate-limiting/api/ratelimit/module/v1"
+	"github.com/Stride-Labs/ibc-rate-limiting/ratelimit"
+	"github.com/Stride-Labs/ibc-rate-limiting/ratelimit/keeper"
+	"github.com/Stride-Labs/ibc-rate-limiting/ratelimit/types"
+	"github.com/Stride-Labs/ibc-rate-limiting/testing/simapp/apptesting"
+)
+
+type ModuleTestSuite struct {
+	apptesting.AppTestHelper
+}
+
+func (s *ModuleTestSuite) SetupTest() {
+	s.Setup()
+}
+
+func TestModuleTestSuite(t *testing.T) {
+	suite.Run(t, new(ModuleTestSuite))
+}
+
+// Builds the rate limit keeper through depinject using the module config and the simapp dependencies
+// Additional configs (e.g. hooks provided by other modules) can be passed in
+func (s *ModuleTestSuite) injectKeeper(config *modulev1.Module, configs ...depinject.Config) (keeper.Keeper, map[string]appmodule.AppModule) {
+	appConfig := appconfig.Compose(&appv1alpha1.Config{
+		Modules: []*appv1alpha1.ModuleConfig{
+			{Name: types.ModuleName, Config: appconfig.WrapAny(config)},
+		},
+	})
+
+	var (
+		ratelimitKeeper keeper.Keeper
+		appModules      map[string]appmodule.AppModule
+	)
+	err := depinject.Inject(
+		depinject.Configs(
+			appConfig,
+			depinject.Supply(
+				s.App.AppCodec(),
+				storetypes.NewKVStoreKey(types.StoreKey),
+				s.App.GetSubspace(types.ModuleName),
+				s.App.BankKeeper,
+				s.App.IBCKeeper.ChannelKeeper,
+			),
+			// The rate limit keeper also implements ICS4Wrapper, so the app must bind the
+			// next ICS4Wrapper in the stack explicitly
+			depinject.BindInterface(
+				"github.com/Stride-Labs/ibc-rate-limiting/ratelimit/types/types.ICS4Wrapper",
+				"github.com/cosmos/ibc-go/v7/modules/core/04-channel/keeper/keeper.Keeper",
+			),
+			depinject.Configs(configs...),
+		),
+		&ratelimitKeeper,
+		&appModules,
+	)
+	s.Require().NoError(err, "no error expected when injecting the rate limit module")
+
+	return ratelimitKeeper, appModules
+}
+
+func (s *ModuleTestSuite) TestProvideModule_DefaultAuthority() {
+	ratelimitKeeper, appModules := s.injectKeeper(&modulev1.Module{})
+
+	expectedAuthority := authtypes.NewModuleAddress(govtypes.ModuleName).String()
+	s.Require().Equal(expectedAuthority, ratelimitKeeper.GetAuthority(), "authority")
+	s.Require().IsType(ratelimit.AppModule{}, appModules[types.ModuleName], "app module")
+}
+
+func (s *ModuleTestSuite) TestProvideModule_CustomAuthority() {
+	authority := s.TestAccs[0].String()
+	ratelimitKeeper, _ := s.injectKeeper(&modulev1.Module{Authority: authority})
+
+	s.Require().Equal(authority, ratelimitKeeper.GetAuthority(), "authority")
+}
+
+// Mock hooks that record the name of the module they were provided by when a quota is reset
+type recordingHooks struct {
+	moduleName string
+}
+
+// Module names of the hooks that were called, in order
+var hookCalls []string
+
+func (h recordingHooks) AfterTransferDenied(sdk.Context, string, string, string, types.PacketDirection, sdkmath.Int) {
+}
+
+func (h recordingHooks) AfterFlowUpdated(sdk.Context, types.RateLimit, types.PacketDirection, sdkmath.Int) {
+}
+
+func (h recordingHooks) AfterQuotaReset(sdk.Context, types.RateLimit) {
+	hookCalls = append(hookCalls, h.moduleName)
+}
+
+// depinject providers must be exported functions
+func ProvideModuleAHooks() types.RateLimitHooksWrapper {
+	return types.RateLimitHooksWrapper{RateLimitHooks: recordingHooks{moduleName: "moduleA"}}
+}
+
+func ProvideModuleBHooks() types.RateLimitHooksWrapper {
+	return types.RateLimitHooksWrapper{RateLimitHooks: recordingHooks{moduleName: "moduleB"}}
+}
+
+func (s *ModuleTestSuite) TestInvokeSetRateLimitHooks() {
+	hookCalls = []string{}
+
+	// The hooks should be registered on the keeper (even though the keeper was copied into
+	// the module before the invoker ran), and run in alphabetical order of the module names
+	ratelimitKeeper, _ := s.injectKeeper(
+		&modulev1.Module{},
+		depinject.ProvideInModule("moduleB", ProvideModuleBHooks),
+		depinject.ProvideInModule("moduleA", ProvideModuleAHooks),
+	)
+
+	ratelimitKeeper.Hooks().AfterQuotaReset(s.Ctx, types.RateLimit{})
+	s.Require().Equal([]string{"moduleA", "moduleB"}, hookCalls, "hook calls")
+}
+
+func (s *ModuleTestSuite) TestInvokeSetRateLimitHooks_NoHooks() {
+	ratelimitKeeper, _ := s.injectKeeper(&modulev1.Module{})
+	s.Require().Equal(types.MultiRateLimitHooks{}, ratelimitKeeper.Hooks(), "no hooks registered")
+}
@@ -0,0 +1,61 @@
+package types
+
+import (
+	"errors"
+	"fmt"
+
+	sdkmath "cosmossdk.io/math"
+)
+
+// Validate performs stateless validation of a channel value strategy
+func (s ChannelValueStrategy) Validate() error {
+	if _, ok := ChannelValueSource_name[int32(s.Source)]; !ok {
+		return fmt.Errorf("invalid channel value source (%d)", s.Source)
+	}
+
+	fixedValue := s.GetFixedValue()
+	if fixedValue.IsNegative() {
+		return fmt.Errorf("fixed channel value can not be negative, Provided: %v", fixedValue)
+	}
+	if s.Source == CHANNEL_VALUE_FIXED && fixedValue.IsZero() {
+		return errors.New("fixed channel value must be greater than 0 when the channel value source is fixed")
+	}
+
+	return nil
+}
+
+// Returns the fixed channel value, or zero if it was not specified
+func (s ChannelValueStrategy) GetFixedValue() sdkmath.Int {
+	if s.FixedValue.IsNil() {
+		return sdkmath.ZeroInt()
+	}
+	return s.FixedValue
+}
+
+// Determines the channel value from the total supply of the denom and the balance of the
+// channel's escrow account, according to the strategy's source
+// The fixed value is only included in the max/min comparison if it was specified
+func (s ChannelValueStrategy) GetChannelValue(supply, escrowBalance sdkmath.Int) sdkmath.Int {
+	fixedValue := s.GetFixedValue()
+
+	switch s.Source {
+	case CHANNEL_VALUE_ESCROW:
+		return escrowBalance
+	case CHANNEL_VALUE_FIXED:
+		return fixedValue
+	case CHANNEL_VALUE_MAX:
+		channelValue := sdkmath.MaxInt(supply, escrowBalance)
+		if fixedValue.IsPositive() {
+			channelValue = sdkmath.MaxInt(channelValue, fixedValue)
+		}
+		return channelValue
+	case CHANNEL_VALUE_MIN:
+		channelValue := sdkmath.MinInt(supply, escrowBalance)
+		if fixedValue.IsPositive() {
+			channelValue = sdkmath.MinInt(channelValue, fixedValue)
+		}
+		return channelValue
+	default:
+		return supply
+	}
+}
@@ -0,0 +1,118 @@
+package types_test
+
+import (
+	"testing"
+
+	sdkmath "cosmossdk.io/math"
+	"github.com/stretchr/testify/require"
+
+	"github.com/Stride-Labs/ibc-rate-limiting/ratelimit/types"
+)
+
+func TestValidateChannelValueStrategy(t *testing.T) {
+	testCases := []struct {
+		name     string
+		strategy types.ChannelValueStrategy
+		err      string
+	}{
+		{
+			name:     "supply",
+			strategy: types.ChannelValueStrategy{Source: types.CHANNEL_VALUE_SUPPLY},
+		},
+		{
+			name:     "escrow",
+			strategy: types.ChannelValueStrategy{Source: types.CHANNEL_VALUE_ESCROW},
+		},
+		{
+			name:     "fixed",
+			strategy: types.ChannelValueStrategy{Source: types.CHANNEL_VALUE_FIXED, FixedValue: sdkmath.NewInt(100)},
+		},
+		{
+			name:     "max with fixed value",
+			strategy: types.ChannelValueStrategy{Source: types.CHANNEL_VALUE_MAX, FixedValue: sdkmath.NewInt(100)},
+		},
+		{
+			name:     "min without fixed value",
+			strategy: types.ChannelValueStrategy{Source: types.CHANNEL_VALUE_MIN},
+		},
+		{
+			name:     "invalid source",
+			strategy: types.ChannelValueStrategy{Source: types.ChannelValueSource(10)},
+			err:      "invalid channel value source",
+		},
+		{
+			name:     "fixed without value",
+			strategy: types.ChannelValueStrategy{Source: types.CHANNEL_VALUE_FIXED},
+			err:      "fixed channel value must be greater than 0",
+		},
+		{
+			name:     "negative fixed value",
+			strategy: types.ChannelValueStrategy{Source: types.CHANNEL_VALUE_MAX, FixedValue: sdkmath.NewInt(-1)},
+			err:      "fixed channel value can not be negative",
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			if tc.err == "" {
+				require.NoError(t, tc.strategy.Validate(), "no error expected")
+			} else {
+				require.ErrorContains(t, tc.strategy.Validate(), tc.err)
+			}
+		})
+	}
+}
+
+func TestGetChannelValueFromStrategy(t *testing.T) {
+	supply := sdkmath.NewInt(1000)
+	escrowBalance := sdkmath.NewInt(200)
+
+	testCases := []struct {
+		name     string
+		strategy types.ChannelValueStrategy
+		expected sdkmath.Int
+	}{
+		{
+			name:     "supply",
+			strategy: types.ChannelValueStrategy{Source: types.CHANNEL_VALUE_SUPPLY},
+			expected: supply,
+		},
+		{
+			name:     "escrow",
+			strategy: types.ChannelValueStrategy{Source: types.CHANNEL_VALUE_ESCROW},
+			expected: escrowBalance,
+		},
+		{
+			name:     "fixed",
+			strategy: types.ChannelValueStrategy{Source: types.CHANNEL_VALUE_FIXED, FixedValue: sdkmath.NewInt(500)},
+			expected: sdkmath.NewInt(500),
+		},
+		{
+			name:     "max without fixed value",
+			strategy: types.ChannelValueStrategy{Source: types.CHANNEL_VALUE_MAX},
+			expected: supply,
+		},
+		{
+			name:     "max with fixed value",
+			strategy: types.ChannelValueStrategy{Source: types.CHANNEL_VALUE_MAX, FixedValue: sdkmath.NewInt(5000)},
+			expected: sdkmath.NewInt(5000),
+		},
+		{
+			name:     "min without fixed value",
+			strategy: types.ChannelValueStrategy{Source: types.CHANNEL_VALUE_MIN},
+			expected: escrowBalance,
+		},
+		{
+			name:     "min with fixed value",
+			strategy: types.ChannelValueStrategy{Source: types.CHANNEL_VALUE_MIN, FixedValue: sdkmath.NewInt(50)},
+			expected: sdkmath.NewInt(50),
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			actual := tc.strategy.GetChannelValue(supply, escrowBalance)
+			require.Equal(t, tc.expected.Int64(), actual.Int64())
+		})
+	}
+}
@@ -15,6 +15,13 @@ func RegisterLegacyAminoCodec(cdc *codec.LegacyAmino) {
 	legacy.RegisterAminoMsg(cdc, &MsgUpdateRateLimit{}, "ratelimit/MsgUpdateRateLimit")
 	legacy.RegisterAminoMsg(cdc, &MsgRemoveRateLimit{}, "ratelimit/MsgRemoveRateLimit")
 	legacy.RegisterAminoMsg(cdc, &MsgResetRateLimit{}, "ratelimit/MsgResetRateLimit")
+	legacy.RegisterAminoMsg(cdc, &MsgSetTransferRule{}, "ratelimit/MsgSetTransferRule")
+	legacy.RegisterAminoMsg(cdc, &MsgRemoveTransferRule{}, "ratelimit/MsgRemoveTransferRule")
+	legacy.RegisterAminoMsg(cdc, &MsgSetDenomGroup{}, "ratelimit/MsgSetDenomGroup")
+	legacy.RegisterAminoMsg(cdc, &MsgRemoveDenomGroup{}, "ratelimit/MsgRemoveDenomGroup")
+	legacy.RegisterAminoMsg(cdc, &MsgScheduleRateLimitUpdate{}, "ratelimit/MsgScheduleRateLimitUpdate")
+	legacy.RegisterAminoMsg(cdc, &MsgCancelScheduledRateLimitUpdate{}, "ratelimit/MsgCancelScheduledUpdate")
+	legacy.RegisterAminoMsg(cdc, &MsgBatchUpdateRateLimits{}, "ratelimit/MsgBatchUpdateRateLimits")
 }
 
 func RegisterInterfaces(registry cdctypes.InterfaceRegistry) {
@@ -23,6 +30,13 @@ func RegisterInterfaces(registry cdctypes.InterfaceRegistry) {
 		&MsgUpdateRateLimit{},
 		&MsgRemoveRateLimit{},
 		&MsgResetRateLimit{},
+		&MsgSetTransferRule{},
+		&MsgRemoveTransferRule{},
+		&MsgSetDenomGroup{},
+		&MsgRemoveDenomGroup{},
+		&MsgScheduleRateLimitUpdate{},
+		&MsgCancelScheduledRateLimitUpdate{},
+		&MsgBatchUpdateRateLimits{},
 	)
 	msgservice.RegisterMsgServiceDesc(registry, &_Msg_serviceDesc)
 }
@@ -0,0 +1,48 @@
+package types
+
+import (
+	"errors"
+	"fmt"
+	"strings"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+)
+
+// Prefix of each denom group ID, which distinguishes the group's rate limits from the
+// rate limits of individual denoms
+const DenomGroupPrefix = "group/"
+
+// Checks whether the denom of a rate limit refers to a denom group
+func IsDenomGroupId(denom string) bool {
+	return strings.HasPrefix(denom, DenomGroupPrefix)
+}
+
+// Validate performs stateless validation of a denom group
+func (g DenomGroup) Validate() error {
+	if !IsDenomGroupId(g.GroupId) {
+		return fmt.Errorf("group id (%s) must start with %s", g.GroupId, DenomGroupPrefix)
+	}
+	if err := sdk.ValidateDenom(g.GroupId); err != nil {
+		return fmt.Errorf("invalid group id: %s", err.Error())
+	}
+
+	if len(g.Denoms) == 0 {
+		return errors.New("at least one denom must be specified")
+	}
+
+	denoms := map[string]bool{}
+	for _, denom := range g.Denoms {
+		if err := sdk.ValidateDenom(denom); err != nil {
+			return fmt.Errorf("invalid denom (%s): %s", denom, err.Error())
+		}
+		if IsDenomGroupId(denom) {
+			return fmt.Errorf("denom (%s) can not be a denom group", denom)
+		}
+		if denoms[denom] {
+			return fmt.Errorf("duplicate denom (%s)", denom)
+		}
+		denoms[denom] = true
+	}
+
+	return nil
+}
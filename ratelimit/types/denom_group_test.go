@@ -0,0 +1,66 @@
+package types_test
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	"github.com/Stride-Labs/ibc-rate-limiting/ratelimit/types"
+)
+
+func TestValidateDenomGroup(t *testing.T) {
+	testCases := []struct {
+		name  string
+		group types.DenomGroup
+		err   string
+	}{
+		{
+			name:  "valid group",
+			group: types.DenomGroup{GroupId: "group/atom", Denoms: []string{"ibc/atom-direct", "ibc/atom-osmosis"}},
+		},
+		{
+			name:  "valid group with single denom",
+			group: types.DenomGroup{GroupId: "group/atom", Denoms: []string{"uatom"}},
+		},
+		{
+			name:  "missing group prefix",
+			group: types.DenomGroup{GroupId: "atom", Denoms: []string{"uatom"}},
+			err:   "group id (atom) must start with group/",
+		},
+		{
+			name:  "invalid group id",
+			group: types.DenomGroup{GroupId: "group/at*m", Denoms: []string{"uatom"}},
+			err:   "invalid group id",
+		},
+		{
+			name:  "no denoms",
+			group: types.DenomGroup{GroupId: "group/atom"},
+			err:   "at least one denom must be specified",
+		},
+		{
+			name:  "invalid denom",
+			group: types.DenomGroup{GroupId: "group/atom", Denoms: []string{"uatom", "x"}},
+			err:   "invalid denom (x)",
+		},
+		{
+			name:  "nested group",
+			group: types.DenomGroup{GroupId: "group/atom", Denoms: []string{"uatom", "group/cosmos"}},
+			err:   "denom (group/cosmos) can not be a denom group",
+		},
+		{
+			name:  "duplicate denom",
+			group: types.DenomGroup{GroupId: "group/atom", Denoms: []string{"uatom", "uatom"}},
+			err:   "duplicate denom (uatom)",
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			if tc.err == "" {
+				require.NoError(t, tc.group.Validate(), "no error expected")
+			} else {
+				require.ErrorContains(t, tc.group.Validate(), tc.err)
+			}
+		})
+	}
+}
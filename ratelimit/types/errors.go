@@ -21,4 +21,20 @@ var (
 	ErrDenomIsBlacklisted = errorsmod.Register(ModuleName, 7,
 		"denom is blacklisted",
 	)
+	ErrTransferRuleNotFound = errorsmod.Register(ModuleName, 8,
+		"transfer rule not found")
+	ErrTransferDeniedByRule = errorsmod.Register(ModuleName, 9,
+		"transfer denied by rule")
+	ErrPriceNotAvailable = errorsmod.Register(ModuleName, 10,
+		"price not available")
+	ErrDenomGroupNotFound = errorsmod.Register(ModuleName, 11,
+		"denom group not found")
+	ErrDenomGroupConflict = errorsmod.Register(ModuleName, 12,
+		"denom group conflict")
+	ErrScheduledUpdateNotFound = errorsmod.Register(ModuleName, 13,
+		"scheduled rate limit update not found")
+	ErrInvalidChannelValueStrategy = errorsmod.Register(ModuleName, 14,
+		"invalid channel value strategy")
+	ErrInvalidIcaTransfer = errorsmod.Register(ModuleName, 15,
+		"unable to decode transfer in interchain account tx")
 )
@@ -3,8 +3,15 @@ package types
 var (
 	EventTransferDenied = "transfer_denied"
 
+	EventScheduledUpdateApplied = "scheduled_rate_limit_update_applied"
+	EventScheduledUpdateFailed  = "scheduled_rate_limit_update_failed"
+
+	EventRateLimitUpsertSkipped = "rate_limit_upsert_skipped"
+
 	EventRateLimitExceeded = "rate_limit_exceeded"
 	EventBlacklistedDenom  = "blacklisted_denom"
+	EventTransferRule      = "transfer_rule"
+	EventPriceUnavailable  = "price_unavailable"
 
 	AttributeKeyReason  = "reason"
 	AttributeKeyModule  = "module"
@@ -13,4 +20,6 @@ var (
 	AttributeKeyChannel = "channel"
 	AttributeKeyAmount  = "amount"
 	AttributeKeyError   = "error"
+
+	AttributeKeyScheduleId = "schedule_id"
 )
@@ -12,6 +12,7 @@ import (
 // creating a x/ratelimit keeper.
 type BankKeeper interface {
 	GetSupply(ctx sdk.Context, denom string) sdk.Coin
+	GetBalance(ctx sdk.Context, addr sdk.AccAddress, denom string) sdk.Coin
 }
 
 // ChannelKeeper defines the channel contract that must be fulfilled when
@@ -19,6 +20,15 @@ type BankKeeper interface {
 type ChannelKeeper interface {
 	GetChannel(ctx sdk.Context, portID string, channelID string) (channeltypes.Channel, bool)
 	GetChannelClientState(ctx sdk.Context, portID string, channelID string) (string, ibcexported.ClientState, error)
+	GetAllChannelsWithPortPrefix(ctx sdk.Context, portPrefix string) []channeltypes.IdentifiedChannel
+}
+
+// OracleKeeper defines the price oracle contract that can optionally be registered
+// with the x/ratelimit keeper to enforce quotas denominated in a quote currency
+type OracleKeeper interface {
+	// Returns the price of one unit of the denom in units of the quote denom,
+	// or false if there is no price available
+	GetPrice(ctx sdk.Context, denom string, quoteDenom string) (price sdk.Dec, found bool)
 }
 
 // ICS4Wrapper defines the expected ICS4Wrapper for middleware
@@ -1,8 +1,11 @@
 package types
 
 import (
+	"fmt"
+
 	errorsmod "cosmossdk.io/errors"
 	sdkmath "cosmossdk.io/math"
+	sdk "github.com/cosmos/cosmos-sdk/types"
 )
 
 // Initializes a new flow from the channel value
@@ -16,6 +19,37 @@ func NewFlow(channelValue sdkmath.Int) Flow {
 	return flow
 }
 
+// Confirms the flow update mode is a known enum value
+func ValidateFlowUpdateMode(mode FlowUpdateMode) error {
+	if _, ok := FlowUpdateMode_name[int32(mode)]; !ok {
+		return fmt.Errorf("invalid flow update mode (%d)", mode)
+	}
+	return nil
+}
+
+// Scales an amount of the flow from the old channel value to the new channel value, so that
+// it remains the same proportion of the channel value (rounded down)
+// If the old channel value is zero, there's no proportion to maintain, and the amount is returned as is
+func ScaleFlowAmount(amount sdkmath.Int, oldChannelValue sdkmath.Int, newChannelValue sdkmath.Int) sdkmath.Int {
+	if oldChannelValue.IsZero() {
+		return amount
+	}
+	return amount.Mul(newChannelValue).Quo(oldChannelValue)
+}
+
+// Returns a copy of the flow with the new channel value, where the inflow and outflow
+// are scaled so that they remain the same proportion of the channel value
+func (f Flow) ScaleToChannelValue(channelValue sdkmath.Int) Flow {
+	return Flow{
+		Inflow:       ScaleFlowAmount(f.Inflow, f.ChannelValue, channelValue),
+		Outflow:      ScaleFlowAmount(f.Outflow, f.ChannelValue, channelValue),
+		ChannelValue: channelValue,
+		WindowId:     f.WindowId,
+		ValueInflow:  f.ValueInflow,
+		ValueOutflow: f.ValueOutflow,
+	}
+}
+
 // Adds an amount to the rate limit's flow after an incoming packet was received
 // Returns an error if the new inflow will cause the rate limit to exceed its quota
 func (f *Flow) AddInflow(amount sdkmath.Int, quota Quota) error {
@@ -45,3 +79,77 @@ func (f *Flow) AddOutflow(amount sdkmath.Int, quota Quota) error {
 	f.Outflow = f.Outflow.Add(amount)
 	return nil
 }
+
+// Returns the net flow in the given direction (e.g. outflow minus inflow for sends)
+func (f *Flow) GetNetFlow(direction PacketDirection) sdkmath.Int {
+	if direction == PACKET_RECV {
+		return f.Inflow.Sub(f.Outflow)
+	}
+	return f.Outflow.Sub(f.Inflow)
+}
+
+// Returns the net value of the flow in the given direction, in units of the value quota's quote denom
+// If the value of the flow hasn't been tracked yet, it's treated as zero
+func (f *Flow) GetNetValueFlow(direction PacketDirection) sdk.Dec {
+	valueInflow, valueOutflow := sdk.ZeroDec(), sdk.ZeroDec()
+	if f.ValueInflow != nil {
+		valueInflow = *f.ValueInflow
+	}
+	if f.ValueOutflow != nil {
+		valueOutflow = *f.ValueOutflow
+	}
+
+	if direction == PACKET_RECV {
+		return valueInflow.Sub(valueOutflow)
+	}
+	return valueOutflow.Sub(valueInflow)
+}
+
+// Adds the value of a transfer (in units of the quote denom) to the flow in the given direction
+// A negative value is used to refund a transfer
+func (f *Flow) AddValueFlow(direction PacketDirection, value sdk.Dec) {
+	if direction == PACKET_RECV {
+		valueInflow := value
+		if f.ValueInflow != nil {
+			valueInflow = f.ValueInflow.Add(value)
+		}
+		f.ValueInflow = &valueInflow
+		return
+	}
+
+	valueOutflow := value
+	if f.ValueOutflow != nil {
+		valueOutflow = f.ValueOutflow.Add(value)
+	}
+	f.ValueOutflow = &valueOutflow
+}
+
+// Returns the amount that can still be transferred in the given direction before the quota is exceeded
+// Note: if the channel value is zero, the quota is not enforced
+func (f *Flow) GetRemainingCapacity(direction PacketDirection, quota Quota) sdkmath.Int {
+	remaining := quota.GetThreshold(direction, f.ChannelValue).Sub(f.GetNetFlow(direction))
+	if remaining.IsNegative() {
+		return sdkmath.ZeroInt()
+	}
+	return remaining
+}
+
+// Returns the percentage of the quota threshold in the given direction that's used by the
+// current net flow (floored at 0)
+// If the threshold is zero, the quota is either fully used (since any transfer would exceed it),
+// or not enforced (if the channel value is zero)
+func (f *Flow) GetUtilization(direction PacketDirection, quota Quota) sdk.Dec {
+	threshold := quota.GetThreshold(direction, f.ChannelValue)
+	if threshold.IsZero() {
+		if f.ChannelValue.IsZero() {
+			return sdk.ZeroDec()
+		}
+		return sdk.NewDec(100)
+	}
+
+	netFlow := f.GetNetFlow(direction)
+	if netFlow.IsNegative() {
+		return sdk.ZeroDec()
+	}
+	return sdk.NewDecFromInt(netFlow).MulInt64(100).QuoInt(threshold)
+}
@@ -4,6 +4,7 @@ import (
 	"testing"
 
 	sdkmath "cosmossdk.io/math"
+	sdk "github.com/cosmos/cosmos-sdk/types"
 	"github.com/stretchr/testify/require"
 
 	"github.com/Stride-Labs/ibc-rate-limiting/ratelimit/types"
@@ -226,3 +227,222 @@ func TestOutInflow(t *testing.T) {
 		})
 	}
 }
+
+func TestGetRemainingCapacity(t *testing.T) {
+	quota := types.Quota{
+		MaxPercentRecv: sdkmath.NewInt(20),
+		MaxPercentSend: sdkmath.NewInt(10),
+		DurationHours:  uint64(1),
+	}
+
+	tests := []struct {
+		name      string
+		direction types.PacketDirection
+		inflow    int64
+		outflow   int64
+		expected  int64
+	}{
+		{
+			name:      "send with no flow",
+			direction: types.PACKET_SEND,
+			expected:  10,
+		},
+		{
+			name:      "recv with no flow",
+			direction: types.PACKET_RECV,
+			expected:  20,
+		},
+		{
+			name:      "send with net outflow",
+			direction: types.PACKET_SEND,
+			inflow:    2,
+			outflow:   6,
+			expected:  6,
+		},
+		{
+			name:      "send with net inflow",
+			direction: types.PACKET_SEND,
+			inflow:    6,
+			outflow:   2,
+			expected:  14,
+		},
+		{
+			name:      "recv with net outflow",
+			direction: types.PACKET_RECV,
+			inflow:    2,
+			outflow:   6,
+			expected:  24,
+		},
+		{
+			name:      "send quota already exceeded",
+			direction: types.PACKET_SEND,
+			outflow:   15,
+			expected:  0,
+		},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			flow := types.Flow{
+				Inflow:       sdkmath.NewInt(test.inflow),
+				Outflow:      sdkmath.NewInt(test.outflow),
+				ChannelValue: sdkmath.NewInt(100),
+			}
+			require.Equal(t, test.expected, flow.GetRemainingCapacity(test.direction, quota).Int64())
+		})
+	}
+}
+
+func TestGetUtilization(t *testing.T) {
+	quota := types.Quota{
+		MaxPercentRecv: sdkmath.NewInt(20),
+		MaxPercentSend: sdkmath.NewInt(10),
+		DurationHours:  uint64(1),
+	}
+
+	tests := []struct {
+		name         string
+		direction    types.PacketDirection
+		quota        types.Quota
+		inflow       int64
+		outflow      int64
+		channelValue int64
+		expected     string
+	}{
+		{
+			name:         "send with no flow",
+			direction:    types.PACKET_SEND,
+			quota:        quota,
+			channelValue: 100,
+			expected:     "0",
+		},
+		{
+			name:         "send with net outflow",
+			direction:    types.PACKET_SEND,
+			quota:        quota,
+			outflow:      8,
+			channelValue: 100,
+			expected:     "80",
+		},
+		{
+			name:         "recv with partial net inflow",
+			direction:    types.PACKET_RECV,
+			quota:        quota,
+			inflow:       7,
+			outflow:      2,
+			channelValue: 100,
+			expected:     "25",
+		},
+		{
+			name:         "send with net inflow",
+			direction:    types.PACKET_SEND,
+			quota:        quota,
+			inflow:       8,
+			channelValue: 100,
+			expected:     "0",
+		},
+		{
+			name:         "zero threshold",
+			direction:    types.PACKET_SEND,
+			quota:        types.Quota{MaxPercentSend: sdkmath.ZeroInt(), MaxPercentRecv: sdkmath.NewInt(10)},
+			channelValue: 100,
+			expected:     "100",
+		},
+		{
+			name:         "zero channel value",
+			direction:    types.PACKET_SEND,
+			quota:        quota,
+			outflow:      8,
+			channelValue: 0,
+			expected:     "0",
+		},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			flow := types.Flow{
+				Inflow:       sdkmath.NewInt(test.inflow),
+				Outflow:      sdkmath.NewInt(test.outflow),
+				ChannelValue: sdkmath.NewInt(test.channelValue),
+			}
+			expected := sdk.MustNewDecFromStr(test.expected)
+			actual := flow.GetUtilization(test.direction, test.quota)
+			require.True(t, expected.Equal(actual), "expected %v, got %v", expected, actual)
+		})
+	}
+}
+
+func TestScaleToChannelValue(t *testing.T) {
+	tests := []struct {
+		name            string
+		inflow          int64
+		outflow         int64
+		channelValue    int64
+		newChannelValue int64
+		expectedInflow  int64
+		expectedOutflow int64
+	}{
+		{
+			name:            "channel value increased",
+			inflow:          5,
+			outflow:         10,
+			channelValue:    100,
+			newChannelValue: 200,
+			expectedInflow:  10,
+			expectedOutflow: 20,
+		},
+		{
+			name:            "channel value decreased with rounding",
+			inflow:          5,
+			outflow:         11,
+			channelValue:    100,
+			newChannelValue: 30,
+			expectedInflow:  1,
+			expectedOutflow: 3,
+		},
+		{
+			name:            "channel value unchanged",
+			inflow:          5,
+			outflow:         10,
+			channelValue:    100,
+			newChannelValue: 100,
+			expectedInflow:  5,
+			expectedOutflow: 10,
+		},
+		{
+			name:            "new channel value zero",
+			inflow:          5,
+			outflow:         10,
+			channelValue:    100,
+			newChannelValue: 0,
+			expectedInflow:  0,
+			expectedOutflow: 0,
+		},
+		{
+			name:            "old channel value zero",
+			inflow:          5,
+			outflow:         10,
+			channelValue:    0,
+			newChannelValue: 100,
+			expectedInflow:  5,
+			expectedOutflow: 10,
+		},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			flow := types.Flow{
+				Inflow:       sdkmath.NewInt(test.inflow),
+				Outflow:      sdkmath.NewInt(test.outflow),
+				ChannelValue: sdkmath.NewInt(test.channelValue),
+				WindowId:     3,
+			}
+			scaledFlow := flow.ScaleToChannelValue(sdkmath.NewInt(test.newChannelValue))
+
+			require.Equal(t, test.expectedInflow, scaledFlow.Inflow.Int64(), "inflow")
+			require.Equal(t, test.expectedOutflow, scaledFlow.Outflow.Int64(), "outflow")
+			require.Equal(t, test.newChannelValue, scaledFlow.ChannelValue.Int64(), "channel value")
+			require.Equal(t, uint64(3), scaledFlow.WindowId, "window id")
+		})
+	}
+}
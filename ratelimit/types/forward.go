@@ -0,0 +1,60 @@
+package types
+
+import (
+	"encoding/json"
+	"errors"
+)
+
+// ForwardMetadata mirrors the "forward" object that packet-forward-middleware
+// reads from an ICS20 memo, e.g.
+//
+//	{"forward": {"receiver": "...", "port": "transfer", "channel": "channel-X"}}
+//
+// Only the fields relevant to rate limiting are required; the remaining fields
+// are kept so that the full hop can be surfaced to callers
+type ForwardMetadata struct {
+	Receiver string          `json:"receiver"`
+	Port     string          `json:"port"`
+	Channel  string          `json:"channel"`
+	Timeout  json.RawMessage `json:"timeout,omitempty"`
+	Retries  *uint8          `json:"retries,omitempty"`
+	Next     json.RawMessage `json:"next,omitempty"`
+}
+
+// Wrapper around the forward metadata as it appears at the top level of the memo
+type forwardMemo struct {
+	Forward *ForwardMetadata `json:"forward"`
+}
+
+// Validate checks that the hop has a destination port and channel
+func (m ForwardMetadata) Validate() error {
+	if m.Receiver == "" {
+		return errors.New("forward receiver must be specified")
+	}
+	if m.Port == "" {
+		return errors.New("forward port must be specified")
+	}
+	if m.Channel == "" {
+		return errors.New("forward channel must be specified")
+	}
+	return nil
+}
+
+// ParseForwardMetadata parses the packet-forward-middleware metadata from an ICS20 memo
+// Returns nil if the memo is empty, is not JSON, or does not contain a valid "forward" key,
+// since in any of those cases the transfer will not be forwarded
+func ParseForwardMetadata(memo string) *ForwardMetadata {
+	if memo == "" {
+		return nil
+	}
+
+	var parsedMemo forwardMemo
+	if err := json.Unmarshal([]byte(memo), &parsedMemo); err != nil {
+		return nil
+	}
+	if parsedMemo.Forward == nil || parsedMemo.Forward.Validate() != nil {
+		return nil
+	}
+
+	return parsedMemo.Forward
+}
@@ -0,0 +1,76 @@
+package types_test
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	"github.com/Stride-Labs/ibc-rate-limiting/ratelimit/types"
+)
+
+func TestParseForwardMetadata(t *testing.T) {
+	testCases := []struct {
+		name             string
+		memo             string
+		expectedReceiver string
+		expectedPort     string
+		expectedChannel  string
+		expectedNil      bool
+	}{
+		{
+			name:             "single hop",
+			memo:             `{"forward": {"receiver": "osmo1xxx", "port": "transfer", "channel": "channel-2"}}`,
+			expectedReceiver: "osmo1xxx",
+			expectedPort:     "transfer",
+			expectedChannel:  "channel-2",
+		},
+		{
+			name: "multi hop with retries and timeout",
+			memo: `{"forward": {"receiver": "osmo1xxx", "port": "transfer", "channel": "channel-2", "timeout": "10m", "retries": 2,
+				"next": {"forward": {"receiver": "juno1xxx", "port": "transfer", "channel": "channel-5"}}}}`,
+			expectedReceiver: "osmo1xxx",
+			expectedPort:     "transfer",
+			expectedChannel:  "channel-2",
+		},
+		{
+			name:        "empty memo",
+			memo:        "",
+			expectedNil: true,
+		},
+		{
+			name:        "non-json memo",
+			memo:        "some memo",
+			expectedNil: true,
+		},
+		{
+			name:        "non-forward memo",
+			memo:        `{"wasm": {"contract": "osmo1xxx", "msg": {}}}`,
+			expectedNil: true,
+		},
+		{
+			name:        "missing channel",
+			memo:        `{"forward": {"receiver": "osmo1xxx", "port": "transfer"}}`,
+			expectedNil: true,
+		},
+		{
+			name:        "missing receiver",
+			memo:        `{"forward": {"port": "transfer", "channel": "channel-2"}}`,
+			expectedNil: true,
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			metadata := types.ParseForwardMetadata(tc.memo)
+			if tc.expectedNil {
+				require.Nil(t, metadata)
+				return
+			}
+
+			require.NotNil(t, metadata)
+			require.Equal(t, tc.expectedReceiver, metadata.Receiver, "receiver")
+			require.Equal(t, tc.expectedPort, metadata.Port, "port")
+			require.Equal(t, tc.expectedChannel, metadata.Channel, "channel")
+		})
+	}
+}
@@ -43,6 +43,10 @@ func DefaultGenesis() *GenesisState {
 			EpochNumber: 0,
 			Duration:    time.Hour,
 		},
+		TransferRules:      []TransferRule{},
+		PendingSendPackets: []PendingSendPacket{},
+		DenomGroups:        []DenomGroup{},
+		ScheduledUpdates:   []ScheduledRateLimitUpdate{},
 	}
 }
 
@@ -54,12 +58,99 @@ func (gs GenesisState) Validate() error {
 		return err
 	}
 
+	// Validate each rate limit and confirm there is at most one rate limit per path
+	rateLimitPaths := map[string]bool{}
+	for i, rateLimit := range gs.RateLimits {
+		if err := rateLimit.Validate(); err != nil {
+			return errorsmod.Wrapf(err, "invalid rate limit (%d)", i)
+		}
+		pathKey := rateLimit.Path.Denom + "/" + rateLimit.Path.ChannelId
+		if rateLimitPaths[pathKey] {
+			return fmt.Errorf("duplicate rate limit (denom: %s, channel: %s)", rateLimit.Path.Denom, rateLimit.Path.ChannelId)
+		}
+		rateLimitPaths[pathKey] = true
+	}
+
+	// Validate each whitelisted address pair and confirm there are no duplicates
+	whitelistedPairs := map[WhitelistedAddressPair]bool{}
+	for _, addressPair := range gs.WhitelistedAddressPairs {
+		if err := addressPair.Validate(); err != nil {
+			return errorsmod.Wrapf(err, "invalid whitelisted address pair (sender: %s, receiver: %s)", addressPair.Sender, addressPair.Receiver)
+		}
+		if whitelistedPairs[addressPair] {
+			return fmt.Errorf("duplicate whitelisted address pair (sender: %s, receiver: %s)", addressPair.Sender, addressPair.Receiver)
+		}
+		whitelistedPairs[addressPair] = true
+	}
+
+	// Confirm each blacklisted denom is specified, and there are no duplicates
+	blacklistedDenoms := map[string]bool{}
+	for _, denom := range gs.BlacklistedDenoms {
+		if strings.TrimSpace(denom) == "" {
+			return errors.New("blacklisted denom can not be empty")
+		}
+		if blacklistedDenoms[denom] {
+			return fmt.Errorf("duplicate blacklisted denom (%s)", denom)
+		}
+		blacklistedDenoms[denom] = true
+	}
+
 	// Validate the format of the pending send packets
 	for _, pendingPacketId := range gs.PendingSendPacketSequenceNumbers {
 		if _, _, err := ParsePendingPacketId(pendingPacketId); err != nil {
 			return err
 		}
 	}
+	for _, pendingPacket := range gs.PendingSendPackets {
+		if err := pendingPacket.Validate(); err != nil {
+			return err
+		}
+	}
+
+	// Validate each transfer rule and confirm there are no duplicate IDs
+	transferRuleIds := map[string]bool{}
+	for _, rule := range gs.TransferRules {
+		if err := rule.Validate(); err != nil {
+			return errorsmod.Wrapf(err, "invalid transfer rule (%s)", rule.RuleId)
+		}
+		if transferRuleIds[rule.RuleId] {
+			return fmt.Errorf("duplicate transfer rule (%s)", rule.RuleId)
+		}
+		transferRuleIds[rule.RuleId] = true
+	}
+
+	// Validate each denom group and confirm there are no duplicate IDs, and that
+	// each denom belongs to at most one group
+	denomGroupIds := map[string]bool{}
+	groupedDenoms := map[string]string{}
+	for _, group := range gs.DenomGroups {
+		if err := group.Validate(); err != nil {
+			return errorsmod.Wrapf(err, "invalid denom group (%s)", group.GroupId)
+		}
+		if denomGroupIds[group.GroupId] {
+			return fmt.Errorf("duplicate denom group (%s)", group.GroupId)
+		}
+		denomGroupIds[group.GroupId] = true
+
+		for _, denom := range group.Denoms {
+			if otherGroupId, found := groupedDenoms[denom]; found {
+				return fmt.Errorf("denom (%s) is in multiple denom groups (%s, %s)", denom, otherGroupId, group.GroupId)
+			}
+			groupedDenoms[denom] = group.GroupId
+		}
+	}
+
+	// Validate each scheduled update and confirm there are no duplicate IDs
+	scheduleIds := map[uint64]bool{}
+	for _, scheduledUpdate := range gs.ScheduledUpdates {
+		if err := scheduledUpdate.Validate(); err != nil {
+			return errorsmod.Wrapf(err, "invalid scheduled update (%d)", scheduledUpdate.ScheduleId)
+		}
+		if scheduleIds[scheduledUpdate.ScheduleId] {
+			return fmt.Errorf("duplicate scheduled update (%d)", scheduledUpdate.ScheduleId)
+		}
+		scheduleIds[scheduledUpdate.ScheduleId] = true
+	}
 
 	// Verify the epoch hour duration is specified
 	if gs.HourEpoch.Duration == 0 {
@@ -25,12 +25,19 @@ const _ = proto.GoGoProtoPackageIsVersion3 // please upgrade the proto package
 
 // GenesisState defines the ratelimit module's genesis state.
 type GenesisState struct {
-	Params                           Params                   `protobuf:"bytes,1,opt,name=params,proto3" json:"params" yaml:"params"`
-	RateLimits                       []RateLimit              `protobuf:"bytes,2,rep,name=rate_limits,json=rateLimits,proto3" json:"rate_limits" yaml:"rate_limits"`
-	WhitelistedAddressPairs          []WhitelistedAddressPair `protobuf:"bytes,3,rep,name=whitelisted_address_pairs,json=whitelistedAddressPairs,proto3" json:"whitelisted_address_pairs" yaml:"whitelisted_address_pairs"`
-	BlacklistedDenoms                []string                 `protobuf:"bytes,4,rep,name=blacklisted_denoms,json=blacklistedDenoms,proto3" json:"blacklisted_denoms,omitempty"`
-	PendingSendPacketSequenceNumbers []string                 `protobuf:"bytes,5,rep,name=pending_send_packet_sequence_numbers,json=pendingSendPacketSequenceNumbers,proto3" json:"pending_send_packet_sequence_numbers,omitempty"`
-	HourEpoch                        HourEpoch                `protobuf:"bytes,6,opt,name=hour_epoch,json=hourEpoch,proto3" json:"hour_epoch" yaml:"hour_epoch"`
+	Params                  Params                   `protobuf:"bytes,1,opt,name=params,proto3" json:"params" yaml:"params"`
+	RateLimits              []RateLimit              `protobuf:"bytes,2,rep,name=rate_limits,json=rateLimits,proto3" json:"rate_limits" yaml:"rate_limits"`
+	WhitelistedAddressPairs []WhitelistedAddressPair `protobuf:"bytes,3,rep,name=whitelisted_address_pairs,json=whitelistedAddressPairs,proto3" json:"whitelisted_address_pairs" yaml:"whitelisted_address_pairs"`
+	BlacklistedDenoms       []string                 `protobuf:"bytes,4,rep,name=blacklisted_denoms,json=blacklistedDenoms,proto3" json:"blacklisted_denoms,omitempty"`
+	// Deprecated: pending send packets of the form {channelId}/{sequenceNumber}
+	// without the amount charged. Still accepted on import, but pending packets
+	// are exported to pending_send_packets
+	PendingSendPacketSequenceNumbers []string                   `protobuf:"bytes,5,rep,name=pending_send_packet_sequence_numbers,json=pendingSendPacketSequenceNumbers,proto3" json:"pending_send_packet_sequence_numbers,omitempty"`
+	HourEpoch                        HourEpoch                  `protobuf:"bytes,6,opt,name=hour_epoch,json=hourEpoch,proto3" json:"hour_epoch" yaml:"hour_epoch"`
+	TransferRules                    []TransferRule             `protobuf:"bytes,7,rep,name=transfer_rules,json=transferRules,proto3" json:"transfer_rules" yaml:"transfer_rules"`
+	PendingSendPackets               []PendingSendPacket        `protobuf:"bytes,8,rep,name=pending_send_packets,json=pendingSendPackets,proto3" json:"pending_send_packets" yaml:"pending_send_packets"`
+	DenomGroups                      []DenomGroup               `protobuf:"bytes,9,rep,name=denom_groups,json=denomGroups,proto3" json:"denom_groups" yaml:"denom_groups"`
+	ScheduledUpdates                 []ScheduledRateLimitUpdate `protobuf:"bytes,10,rep,name=scheduled_updates,json=scheduledUpdates,proto3" json:"scheduled_updates" yaml:"scheduled_updates"`
 }
 
 func (m *GenesisState) Reset()         { *m = GenesisState{} }
@@ -108,6 +115,34 @@ func (m *GenesisState) GetHourEpoch() HourEpoch {
 	return HourEpoch{}
 }
 
+func (m *GenesisState) GetTransferRules() []TransferRule {
+	if m != nil {
+		return m.TransferRules
+	}
+	return nil
+}
+
+func (m *GenesisState) GetPendingSendPackets() []PendingSendPacket {
+	if m != nil {
+		return m.PendingSendPackets
+	}
+	return nil
+}
+
+func (m *GenesisState) GetDenomGroups() []DenomGroup {
+	if m != nil {
+		return m.DenomGroups
+	}
+	return nil
+}
+
+func (m *GenesisState) GetScheduledUpdates() []ScheduledRateLimitUpdate {
+	if m != nil {
+		return m.ScheduledUpdates
+	}
+	return nil
+}
+
 func init() {
 	proto.RegisterType((*GenesisState)(nil), "ratelimit.v1.GenesisState")
 }
@@ -115,35 +150,45 @@ func init() {
 func init() { proto.RegisterFile("ratelimit/v1/genesis.proto", fileDescriptor_fbb08d6119688a03) }
 
 var fileDescriptor_fbb08d6119688a03 = []byte{
-	// 442 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x74, 0x92, 0x4f, 0x6b, 0xd4, 0x40,
-	0x1c, 0x86, 0x37, 0x6e, 0x5d, 0xe8, 0x6c, 0x3d, 0x74, 0xa8, 0x34, 0x1b, 0x24, 0x0d, 0xa1, 0x87,
-	0xbd, 0x6c, 0x42, 0xeb, 0x45, 0xbc, 0xb9, 0x2a, 0x7a, 0x28, 0x65, 0xcd, 0x0a, 0x82, 0x97, 0x30,
-	0x49, 0x7e, 0x24, 0x43, 0x37, 0x33, 0x71, 0x66, 0xd2, 0xd2, 0x6f, 0x20, 0x9e, 0xfc, 0x58, 0x3d,
-	0xf6, 0xe8, 0xa9, 0xc8, 0xee, 0x37, 0xf0, 0x13, 0x48, 0x66, 0xc6, 0xfd, 0x23, 0x7a, 0x4b, 0x78,
-	0x9f, 0xe7, 0x7d, 0x99, 0x64, 0x90, 0x27, 0x88, 0x82, 0x05, 0xad, 0xa9, 0x8a, 0xaf, 0xcf, 0xe2,
-	0x12, 0x18, 0x48, 0x2a, 0xa3, 0x46, 0x70, 0xc5, 0xf1, 0xc1, 0x3a, 0x8b, 0xae, 0xcf, 0xbc, 0xa3,
-	0x92, 0x97, 0x5c, 0x07, 0x71, 0xf7, 0x64, 0x18, 0x6f, 0xb4, 0xe3, 0x37, 0x44, 0x90, 0xda, 0xea,
-	0xde, 0xb3, 0x9d, 0x68, 0xd3, 0xa5, 0xd3, 0xf0, 0xeb, 0x1e, 0x3a, 0x78, 0x67, 0xe6, 0xe6, 0x8a,
-	0x28, 0xc0, 0xaf, 0xd1, 0xc0, 0xe8, 0xae, 0x13, 0x38, 0xe3, 0xe1, 0xf9, 0x51, 0xb4, 0x3d, 0x1f,
-	0xcd, 0x74, 0x36, 0x7d, 0x7a, 0xf7, 0x70, 0xd2, 0xfb, 0xf5, 0x70, 0xf2, 0xe4, 0x96, 0xd4, 0x8b,
-	0x97, 0xa1, 0x31, 0xc2, 0xc4, 0xaa, 0xf8, 0x23, 0x1a, 0x76, 0x56, 0xaa, 0x35, 0xe9, 0x3e, 0x0a,
-	0xfa, 0xe3, 0xe1, 0xf9, 0xf1, 0x6e, 0x53, 0x42, 0x14, 0x5c, 0x74, 0x2f, 0x53, 0xcf, 0x96, 0x61,
-	0x53, 0xb6, 0x65, 0x86, 0x09, 0x12, 0x7f, 0x30, 0x89, 0xbf, 0x39, 0x68, 0x74, 0x53, 0xd1, 0xae,
-	0x43, 0x2a, 0x28, 0x52, 0x52, 0x14, 0x02, 0xa4, 0x4c, 0x1b, 0x42, 0x85, 0x74, 0xfb, 0x7a, 0xe4,
-	0x74, 0x77, 0xe4, 0xd3, 0x06, 0x7f, 0x65, 0xe8, 0x19, 0xa1, 0x62, 0x3a, 0xb6, 0x8b, 0x81, 0x59,
-	0xfc, 0x6f, 0x69, 0x98, 0x1c, 0xdf, 0xfc, 0xb3, 0x41, 0xe2, 0x09, 0xc2, 0xd9, 0x82, 0xe4, 0x57,
-	0x56, 0x2b, 0x80, 0xf1, 0x5a, 0xba, 0x7b, 0x41, 0x7f, 0xbc, 0x9f, 0x1c, 0x6e, 0x25, 0x6f, 0x74,
-	0x80, 0x2f, 0xd1, 0x69, 0x03, 0xac, 0xa0, 0xac, 0x4c, 0x25, 0xb0, 0x22, 0x6d, 0x48, 0x7e, 0x05,
-	0x2a, 0x95, 0xf0, 0xa5, 0x05, 0x96, 0x43, 0xca, 0xda, 0x3a, 0x03, 0x21, 0xdd, 0xc7, 0xba, 0x20,
-	0xb0, 0xec, 0x1c, 0x58, 0x31, 0xd3, 0xe4, 0xdc, 0x82, 0x97, 0x86, 0xc3, 0x1f, 0x10, 0xaa, 0x78,
-	0x2b, 0x52, 0x68, 0x78, 0x5e, 0xb9, 0x03, 0xfd, 0xab, 0xfe, 0xfa, 0xc0, 0xef, 0x79, 0x2b, 0xde,
-	0x76, 0xf1, 0x74, 0x64, 0x8f, 0x7b, 0x68, 0x8e, 0xbb, 0x11, 0xc3, 0x64, 0xbf, 0x5a, 0x53, 0xc9,
-	0xdd, 0xd2, 0x77, 0xee, 0x97, 0xbe, 0xf3, 0x73, 0xe9, 0x3b, 0xdf, 0x57, 0x7e, 0xef, 0x7e, 0xe5,
-	0xf7, 0x7e, 0xac, 0xfc, 0xde, 0xe7, 0x17, 0x25, 0x55, 0x55, 0x9b, 0x45, 0x39, 0xaf, 0xe3, 0xb9,
-	0x12, 0xb4, 0x80, 0xc9, 0x05, 0xc9, 0x64, 0x4c, 0xb3, 0x7c, 0xd2, 0x4d, 0x4e, 0xf4, 0x26, 0x65,
-	0xe5, 0xe6, 0x7a, 0xc5, 0xea, 0xb6, 0x01, 0x99, 0x0d, 0xf4, 0x2d, 0x7b, 0xfe, 0x3b, 0x00, 0x00,
-	0xff, 0xff, 0x44, 0x16, 0x33, 0x59, 0xe0, 0x02, 0x00, 0x00,
+	// 602 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x74, 0x94, 0xcf, 0x6e, 0xd3, 0x4c,
+	0x14, 0xc5, 0xe3, 0xaf, 0x1f, 0x2d, 0x9d, 0xb4, 0x88, 0x0e, 0xad, 0xea, 0xa6, 0x90, 0x5a, 0xa6,
+	0x42, 0xd9, 0x24, 0x51, 0xcb, 0x06, 0xb1, 0xc3, 0x80, 0xca, 0xa2, 0xaa, 0xca, 0xa4, 0x08, 0xc4,
+	0xc6, 0x4c, 0x3c, 0x17, 0xc7, 0x6a, 0x62, 0x9b, 0xb9, 0xe3, 0x56, 0x7d, 0x05, 0x56, 0xbc, 0x0b,
+	0x2f, 0xd1, 0x65, 0x97, 0xac, 0x2a, 0xd4, 0xbe, 0x01, 0x4f, 0x80, 0x3c, 0x33, 0xf9, 0xe3, 0xb6,
+	0xec, 0x92, 0x9c, 0x73, 0x7e, 0xe7, 0xfa, 0x7a, 0x32, 0xa4, 0x21, 0xb9, 0x82, 0x61, 0x32, 0x4a,
+	0x54, 0xf7, 0x64, 0xa7, 0x1b, 0x43, 0x0a, 0x98, 0x60, 0x27, 0x97, 0x99, 0xca, 0xe8, 0xd2, 0x44,
+	0xeb, 0x9c, 0xec, 0x34, 0x56, 0xe3, 0x2c, 0xce, 0xb4, 0xd0, 0x2d, 0x3f, 0x19, 0x4f, 0x63, 0xa3,
+	0x92, 0xcf, 0xb9, 0xe4, 0x23, 0x1b, 0x6f, 0x3c, 0xae, 0x48, 0x53, 0x96, 0x51, 0x37, 0x2b, 0x2a,
+	0x46, 0x03, 0x10, 0xc5, 0x10, 0x8c, 0xe8, 0xff, 0x5c, 0x20, 0x4b, 0x7b, 0x66, 0x96, 0x9e, 0xe2,
+	0x0a, 0xe8, 0x6b, 0x32, 0x6f, 0xd8, 0xae, 0xe3, 0x39, 0xad, 0xfa, 0xee, 0x6a, 0x67, 0x76, 0xb6,
+	0xce, 0xa1, 0xd6, 0x82, 0xb5, 0xf3, 0xcb, 0xad, 0xda, 0x9f, 0xcb, 0xad, 0xe5, 0x33, 0x3e, 0x1a,
+	0xbe, 0xf4, 0x4d, 0xc2, 0x67, 0x36, 0x4a, 0x8f, 0x48, 0xbd, 0x4c, 0x85, 0x3a, 0x86, 0xee, 0x7f,
+	0xde, 0x5c, 0xab, 0xbe, 0xbb, 0x5e, 0x25, 0x31, 0xae, 0x60, 0xbf, 0xfc, 0x12, 0x34, 0x2c, 0x8c,
+	0x1a, 0xd8, 0x4c, 0xd2, 0x67, 0x44, 0x8e, 0x6d, 0x48, 0xbf, 0x3b, 0x64, 0xe3, 0x74, 0x90, 0x94,
+	0x0c, 0x54, 0x20, 0x42, 0x2e, 0x84, 0x04, 0xc4, 0x30, 0xe7, 0x89, 0x44, 0x77, 0x4e, 0x97, 0x6c,
+	0x57, 0x4b, 0x3e, 0x4e, 0xed, 0xaf, 0x8c, 0xfb, 0x90, 0x27, 0x32, 0x68, 0xd9, 0x46, 0xcf, 0x34,
+	0xfe, 0x13, 0xea, 0xb3, 0xf5, 0xd3, 0x3b, 0x09, 0x48, 0xdb, 0x84, 0xf6, 0x87, 0x3c, 0x3a, 0xb6,
+	0x31, 0x01, 0x69, 0x36, 0x42, 0xf7, 0x7f, 0x6f, 0xae, 0xb5, 0xc8, 0x56, 0x66, 0x94, 0x37, 0x5a,
+	0xa0, 0x07, 0x64, 0x3b, 0x87, 0x54, 0x24, 0x69, 0x1c, 0x22, 0xa4, 0x22, 0xcc, 0x79, 0x74, 0x0c,
+	0x2a, 0x44, 0xf8, 0x56, 0x40, 0x1a, 0x41, 0x98, 0x16, 0xa3, 0x3e, 0x48, 0x74, 0xef, 0x69, 0x80,
+	0x67, 0xbd, 0x3d, 0x48, 0xc5, 0xa1, 0x76, 0xf6, 0xac, 0xf1, 0xc0, 0xf8, 0xe8, 0x7b, 0x42, 0x06,
+	0x59, 0x21, 0x43, 0xc8, 0xb3, 0x68, 0xe0, 0xce, 0x7b, 0xce, 0xed, 0x05, 0xbf, 0xcb, 0x0a, 0xf9,
+	0xb6, 0x94, 0x83, 0x0d, 0xfb, 0xb8, 0x2b, 0xe6, 0x71, 0xa7, 0x41, 0x9f, 0x2d, 0x0e, 0xc6, 0x2e,
+	0xfa, 0x85, 0x3c, 0x50, 0x92, 0xa7, 0xf8, 0x15, 0x64, 0x28, 0x8b, 0x21, 0xa0, 0xbb, 0xa0, 0x57,
+	0xda, 0xa8, 0x62, 0x8f, 0xac, 0x87, 0x15, 0x43, 0x08, 0x9e, 0x58, 0xf2, 0x9a, 0x21, 0x57, 0xf3,
+	0x3e, 0x5b, 0x56, 0x33, 0x66, 0xa4, 0x27, 0x64, 0xf5, 0x8e, 0x25, 0xa0, 0x7b, 0x5f, 0xf7, 0x6c,
+	0xdd, 0x38, 0x69, 0x37, 0x57, 0x10, 0x3c, 0xb5, 0x65, 0x9b, 0xf6, 0xd0, 0xdd, 0x81, 0xf2, 0x19,
+	0xbd, 0xb5, 0x3a, 0xa4, 0x9f, 0xc8, 0x92, 0x7e, 0x3f, 0x61, 0x2c, 0xb3, 0x22, 0x47, 0x77, 0x51,
+	0xf7, 0xb9, 0xd5, 0x3e, 0xfd, 0xa2, 0xf6, 0x4a, 0x43, 0xb0, 0x69, 0x8b, 0x1e, 0x99, 0xa2, 0xd9,
+	0xac, 0xcf, 0xea, 0x62, 0x62, 0x44, 0x5a, 0x90, 0x95, 0xf1, 0x1f, 0x4a, 0x84, 0x45, 0x2e, 0xb8,
+	0x02, 0x74, 0x89, 0xc6, 0x3f, 0xab, 0xe2, 0x7b, 0x63, 0xdb, 0xe4, 0xdc, 0x7f, 0xd0, 0xf6, 0xc0,
+	0xb3, 0x65, 0xae, 0x29, 0xbb, 0x85, 0xf3, 0xd9, 0xc3, 0xc9, 0x6f, 0x26, 0x82, 0x01, 0x3b, 0xbf,
+	0x6a, 0x3a, 0x17, 0x57, 0x4d, 0xe7, 0xf7, 0x55, 0xd3, 0xf9, 0x71, 0xdd, 0xac, 0x5d, 0x5c, 0x37,
+	0x6b, 0xbf, 0xae, 0x9b, 0xb5, 0xcf, 0x2f, 0xe2, 0x44, 0x0d, 0x8a, 0x7e, 0x27, 0xca, 0x46, 0xdd,
+	0x9e, 0x92, 0x89, 0x80, 0xf6, 0x3e, 0xef, 0x63, 0x37, 0xe9, 0x47, 0xed, 0x72, 0x9e, 0xb6, 0x1e,
+	0x28, 0x49, 0xe3, 0xe9, 0x35, 0xd1, 0x55, 0x67, 0x39, 0x60, 0x7f, 0x5e, 0x5f, 0x08, 0xcf, 0xff,
+	0x0e, 0x00, 0x04, 0x34, 0x6b, 0x79, 0xa8, 0x04, 0x00, 0x00,
 }
 
 func (m *GenesisState) Marshal() (dAtA []byte, err error) {
@@ -166,6 +211,62 @@ func (m *GenesisState) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	_ = i
 	var l int
 	_ = l
+	if len(m.ScheduledUpdates) > 0 {
+		for iNdEx := len(m.ScheduledUpdates) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.ScheduledUpdates[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintGenesis(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x52
+		}
+	}
+	if len(m.DenomGroups) > 0 {
+		for iNdEx := len(m.DenomGroups) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.DenomGroups[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintGenesis(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x4a
+		}
+	}
+	if len(m.PendingSendPackets) > 0 {
+		for iNdEx := len(m.PendingSendPackets) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.PendingSendPackets[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintGenesis(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x42
+		}
+	}
+	if len(m.TransferRules) > 0 {
+		for iNdEx := len(m.TransferRules) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.TransferRules[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintGenesis(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x3a
+		}
+	}
 	{
 		size, err := m.HourEpoch.MarshalToSizedBuffer(dAtA[:i])
 		if err != nil {
@@ -280,6 +381,30 @@ func (m *GenesisState) Size() (n int) {
 	}
 	l = m.HourEpoch.Size()
 	n += 1 + l + sovGenesis(uint64(l))
+	if len(m.TransferRules) > 0 {
+		for _, e := range m.TransferRules {
+			l = e.Size()
+			n += 1 + l + sovGenesis(uint64(l))
+		}
+	}
+	if len(m.PendingSendPackets) > 0 {
+		for _, e := range m.PendingSendPackets {
+			l = e.Size()
+			n += 1 + l + sovGenesis(uint64(l))
+		}
+	}
+	if len(m.DenomGroups) > 0 {
+		for _, e := range m.DenomGroups {
+			l = e.Size()
+			n += 1 + l + sovGenesis(uint64(l))
+		}
+	}
+	if len(m.ScheduledUpdates) > 0 {
+		for _, e := range m.ScheduledUpdates {
+			l = e.Size()
+			n += 1 + l + sovGenesis(uint64(l))
+		}
+	}
 	return n
 }
 
@@ -516,6 +641,142 @@ func (m *GenesisState) Unmarshal(dAtA []byte) error {
 				return err
 			}
 			iNdEx = postIndex
+		case 7:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field TransferRules", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.TransferRules = append(m.TransferRules, TransferRule{})
+			if err := m.TransferRules[len(m.TransferRules)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 8:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field PendingSendPackets", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.PendingSendPackets = append(m.PendingSendPackets, PendingSendPacket{})
+			if err := m.PendingSendPackets[len(m.PendingSendPackets)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 9:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field DenomGroups", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.DenomGroups = append(m.DenomGroups, DenomGroup{})
+			if err := m.DenomGroups[len(m.DenomGroups)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 10:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ScheduledUpdates", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ScheduledUpdates = append(m.ScheduledUpdates, ScheduledRateLimitUpdate{})
+			if err := m.ScheduledUpdates[len(m.ScheduledUpdates)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipGenesis(dAtA[iNdEx:])
@@ -4,14 +4,42 @@ import (
 	"testing"
 	"time"
 
+	sdkmath "cosmossdk.io/math"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	authtypes "github.com/cosmos/cosmos-sdk/x/auth/types"
+	govtypes "github.com/cosmos/cosmos-sdk/x/gov/types"
+
 	"github.com/Stride-Labs/ibc-rate-limiting/ratelimit/types"
+	"github.com/Stride-Labs/ibc-rate-limiting/testing/simapp/apptesting"
 	"github.com/stretchr/testify/require"
 )
 
 func TestValidateGenesis(t *testing.T) {
+	apptesting.SetupConfig()
+
+	validUpdate := types.MsgUpdateRateLimit{
+		Authority:      authtypes.NewModuleAddress(govtypes.ModuleName).String(),
+		Denom:          "denom",
+		ChannelId:      "channel-0",
+		MaxPercentSend: sdkmath.NewInt(10),
+		MaxPercentRecv: sdkmath.NewInt(10),
+		DurationHours:  24,
+	}
+
+	senderA := authtypes.NewModuleAddress("senderA").String()
+	senderB := authtypes.NewModuleAddress("senderB").String()
+
 	currentHour := 13
 	blockTime := time.Date(2024, 1, 1, currentHour, 55, 8, 0, time.UTC) // 13:55:08
 
+	validRateLimit := func(denom, channelId string) types.RateLimit {
+		return types.RateLimit{
+			Path:  &types.Path{Denom: denom, ChannelId: channelId},
+			Quota: &types.Quota{MaxPercentSend: sdkmath.NewInt(10), MaxPercentRecv: sdkmath.NewInt(10), DurationHours: 24},
+			Flow:  &types.Flow{Inflow: sdkmath.ZeroInt(), Outflow: sdkmath.ZeroInt(), ChannelValue: sdkmath.NewInt(100)},
+		}
+	}
+
 	testCases := []struct {
 		name          string
 		genesisState  types.GenesisState
@@ -25,8 +53,8 @@ func TestValidateGenesis(t *testing.T) {
 			name: "valid custom state",
 			genesisState: types.GenesisState{
 				WhitelistedAddressPairs: []types.WhitelistedAddressPair{
-					{Sender: "senderA", Receiver: "receiverA"},
-					{Sender: "senderB", Receiver: "receiverB"},
+					{Sender: senderA, Receiver: "receiverA"},
+					{Sender: senderB, Receiver: "receiverB"},
 				},
 				BlacklistedDenoms:                []string{"denomA", "denomB"},
 				PendingSendPacketSequenceNumbers: []string{"channel-0/1", "channel-2/3"},
@@ -36,7 +64,18 @@ func TestValidateGenesis(t *testing.T) {
 					Duration:         time.Minute,
 					EpochStartHeight: 1,
 				},
+				TransferRules: []types.TransferRule{
+					{RuleId: "ruleA", MemoKey: "wasm", Action: types.RULE_ACTION_DENY},
+					{RuleId: "ruleB", Receiver: "receiverA", Action: types.RULE_ACTION_EXEMPT},
+				},
+			},
+		},
+		{
+			name: "invalid params - forwarded transfer policy",
+			genesisState: types.GenesisState{
+				Params: types.Params{ForwardedTransferPolicy: 99},
 			},
+			expectedError: "invalid forwarded transfer policy",
 		},
 		{
 			name: "invalid packet sequence - wrong delimiter",
@@ -59,6 +98,212 @@ func TestValidateGenesis(t *testing.T) {
 			},
 			expectedError: "unable to parse sequence number (X) from pending send packet",
 		},
+		{
+			name: "valid pending send packets",
+			genesisState: types.GenesisState{
+				PendingSendPackets: []types.PendingSendPacket{
+					{ChannelId: "channel-0", Sequence: 1, Amount: sdk.NewCoins(sdk.NewCoin("denomA", sdkmath.NewInt(10)))},
+					{ChannelId: "channel-2", Sequence: 3},
+				},
+				HourEpoch: types.HourEpoch{Duration: time.Minute},
+			},
+		},
+		{
+			name: "invalid pending send packet - invalid channel ID",
+			genesisState: types.GenesisState{
+				PendingSendPackets: []types.PendingSendPacket{
+					{ChannelId: "channelX", Sequence: 1},
+				},
+			},
+			expectedError: "invalid channel ID (channelX) in pending send packet",
+		},
+		{
+			name: "invalid pending send packet - invalid amount",
+			genesisState: types.GenesisState{
+				PendingSendPackets: []types.PendingSendPacket{
+					{ChannelId: "channel-0", Sequence: 1, Amount: sdk.Coins{{Denom: "denomA", Amount: sdkmath.NewInt(-1)}}},
+				},
+			},
+			expectedError: "invalid amount in pending send packet (channel-0/1)",
+		},
+		{
+			name: "invalid pending send packet - invalid direction",
+			genesisState: types.GenesisState{
+				PendingSendPackets: []types.PendingSendPacket{
+					{ChannelId: "channel-0", Sequence: 1, Direction: types.PACKET_RECV},
+				},
+			},
+			expectedError: "invalid direction (PACKET_RECV) in pending send packet (channel-0/1), must be a send",
+		},
+		{
+			name: "invalid transfer rule",
+			genesisState: types.GenesisState{
+				TransferRules: []types.TransferRule{
+					{RuleId: "ruleA", Action: types.RULE_ACTION_DENY},
+				},
+			},
+			expectedError: "invalid transfer rule (ruleA)",
+		},
+		{
+			name: "duplicate transfer rule",
+			genesisState: types.GenesisState{
+				TransferRules: []types.TransferRule{
+					{RuleId: "ruleA", MemoKey: "wasm", Action: types.RULE_ACTION_DENY},
+					{RuleId: "ruleA", Receiver: "receiverA", Action: types.RULE_ACTION_EXEMPT},
+				},
+			},
+			expectedError: "duplicate transfer rule (ruleA)",
+		},
+		{
+			name: "invalid denom group",
+			genesisState: types.GenesisState{
+				DenomGroups: []types.DenomGroup{
+					{GroupId: "group/atom"},
+				},
+			},
+			expectedError: "invalid denom group (group/atom)",
+		},
+		{
+			name: "duplicate denom group",
+			genesisState: types.GenesisState{
+				DenomGroups: []types.DenomGroup{
+					{GroupId: "group/atom", Denoms: []string{"ibc/atom-direct"}},
+					{GroupId: "group/atom", Denoms: []string{"ibc/atom-osmosis"}},
+				},
+			},
+			expectedError: "duplicate denom group (group/atom)",
+		},
+		{
+			name: "denom in multiple denom groups",
+			genesisState: types.GenesisState{
+				DenomGroups: []types.DenomGroup{
+					{GroupId: "group/atom", Denoms: []string{"ibc/atom-direct"}},
+					{GroupId: "group/cosmos", Denoms: []string{"ibc/atom-direct"}},
+				},
+			},
+			expectedError: "denom (ibc/atom-direct) is in multiple denom groups (group/atom, group/cosmos)",
+		},
+		{
+			name: "valid scheduled updates",
+			genesisState: types.GenesisState{
+				HourEpoch: types.DefaultGenesis().HourEpoch,
+				ScheduledUpdates: []types.ScheduledRateLimitUpdate{
+					{ScheduleId: 1, ExecuteEpoch: 10, Update: validUpdate},
+					{ScheduleId: 2, ExecuteHeight: 100, Update: validUpdate},
+				},
+			},
+		},
+		{
+			name: "valid rate limits",
+			genesisState: types.GenesisState{
+				HourEpoch: types.DefaultGenesis().HourEpoch,
+				RateLimits: []types.RateLimit{
+					validRateLimit("denomA", "channel-0"),
+					validRateLimit("denomA", "channel-1"),
+					validRateLimit("denomB", "channel-0"),
+				},
+			},
+		},
+		{
+			name: "invalid rate limit",
+			genesisState: types.GenesisState{
+				RateLimits: []types.RateLimit{
+					validRateLimit("denomA", "channel-0"),
+					{Path: &types.Path{Denom: "denomB", ChannelId: "channel-0"}},
+				},
+			},
+			expectedError: "invalid rate limit (1): quota must be specified",
+		},
+		{
+			name: "duplicate rate limit",
+			genesisState: types.GenesisState{
+				RateLimits: []types.RateLimit{
+					validRateLimit("denomA", "channel-0"),
+					validRateLimit("denomA", "channel-0"),
+				},
+			},
+			expectedError: "duplicate rate limit (denom: denomA, channel: channel-0)",
+		},
+		{
+			name: "invalid whitelisted address pair",
+			genesisState: types.GenesisState{
+				WhitelistedAddressPairs: []types.WhitelistedAddressPair{
+					{Sender: senderA, Receiver: ""},
+				},
+			},
+			expectedError: "invalid whitelisted address pair (sender: " + senderA + ", receiver: ): receiver must be specified",
+		},
+		{
+			name: "whitelisted receiver with whitespace",
+			genesisState: types.GenesisState{
+				WhitelistedAddressPairs: []types.WhitelistedAddressPair{
+					{Sender: senderA, Receiver: " receiverA"},
+				},
+			},
+			expectedError: "receiver can not contain leading or trailing whitespace",
+		},
+		{
+			name: "invalid whitelisted sender",
+			genesisState: types.GenesisState{
+				WhitelistedAddressPairs: []types.WhitelistedAddressPair{
+					{Sender: "senderA", Receiver: "receiverA"},
+				},
+			},
+			expectedError: "invalid sender address (senderA)",
+		},
+		{
+			name: "whitelisted sender with whitespace",
+			genesisState: types.GenesisState{
+				WhitelistedAddressPairs: []types.WhitelistedAddressPair{
+					{Sender: " " + senderA, Receiver: "receiverA"},
+				},
+			},
+			expectedError: "invalid sender address",
+		},
+		{
+			name: "duplicate whitelisted address pair",
+			genesisState: types.GenesisState{
+				WhitelistedAddressPairs: []types.WhitelistedAddressPair{
+					{Sender: senderA, Receiver: "receiverA"},
+					{Sender: senderA, Receiver: "receiverB"},
+					{Sender: senderA, Receiver: "receiverA"},
+				},
+			},
+			expectedError: "duplicate whitelisted address pair (sender: " + senderA + ", receiver: receiverA)",
+		},
+		{
+			name: "empty blacklisted denom",
+			genesisState: types.GenesisState{
+				BlacklistedDenoms: []string{"denomA", ""},
+			},
+			expectedError: "blacklisted denom can not be empty",
+		},
+		{
+			name: "duplicate blacklisted denom",
+			genesisState: types.GenesisState{
+				BlacklistedDenoms: []string{"denomA", "denomB", "denomA"},
+			},
+			expectedError: "duplicate blacklisted denom (denomA)",
+		},
+		{
+			name: "invalid scheduled update",
+			genesisState: types.GenesisState{
+				ScheduledUpdates: []types.ScheduledRateLimitUpdate{
+					{ScheduleId: 1, Update: validUpdate},
+				},
+			},
+			expectedError: "invalid scheduled update (1)",
+		},
+		{
+			name: "duplicate scheduled update",
+			genesisState: types.GenesisState{
+				ScheduledUpdates: []types.ScheduledRateLimitUpdate{
+					{ScheduleId: 1, ExecuteEpoch: 10, Update: validUpdate},
+					{ScheduleId: 1, ExecuteHeight: 100, Update: validUpdate},
+				},
+			},
+			expectedError: "duplicate scheduled update (1)",
+		},
 		{
 			name: "invalid hour epoch - no duration",
 			genesisState: types.GenesisState{
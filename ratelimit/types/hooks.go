@@ -0,0 +1,52 @@
+package types
+
+import (
+	sdkmath "cosmossdk.io/math"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+)
+
+// RateLimitHooks defines the hooks that other modules can register to react to rate limit events
+//
+// Note: AfterTransferDenied is called from within a transfer that is about to fail, so any state
+// written by the hook will be reverted along with the rest of the transfer
+type RateLimitHooks interface {
+	// Called when a transfer is rejected because of a blacklisted denom, a transfer rule, or an exceeded quota
+	AfterTransferDenied(ctx sdk.Context, reason, denom, channelId string, direction PacketDirection, amount sdkmath.Int)
+	// Called after a transfer is added to a rate limit's flow
+	AfterFlowUpdated(ctx sdk.Context, rateLimit RateLimit, direction PacketDirection, amount sdkmath.Int)
+	// Called after a rate limit's flow is reset, either from the epoch or from governance
+	AfterQuotaReset(ctx sdk.Context, rateLimit RateLimit)
+}
+
+// RateLimitHooksWrapper is a wrapper for modules to inject RateLimitHooks using depinject
+type RateLimitHooksWrapper struct{ RateLimitHooks }
+
+// IsOnePerModuleType implements the depinject.OnePerModuleType interface
+func (RateLimitHooksWrapper) IsOnePerModuleType() {}
+
+var _ RateLimitHooks = MultiRateLimitHooks{}
+
+// MultiRateLimitHooks combines multiple rate limit hooks, all hook functions are run in array sequence
+type MultiRateLimitHooks []RateLimitHooks
+
+func NewMultiRateLimitHooks(hooks ...RateLimitHooks) MultiRateLimitHooks {
+	return hooks
+}
+
+func (h MultiRateLimitHooks) AfterTransferDenied(ctx sdk.Context, reason, denom, channelId string, direction PacketDirection, amount sdkmath.Int) {
+	for i := range h {
+		h[i].AfterTransferDenied(ctx, reason, denom, channelId, direction, amount)
+	}
+}
+
+func (h MultiRateLimitHooks) AfterFlowUpdated(ctx sdk.Context, rateLimit RateLimit, direction PacketDirection, amount sdkmath.Int) {
+	for i := range h {
+		h[i].AfterFlowUpdated(ctx, rateLimit, direction, amount)
+	}
+}
+
+func (h MultiRateLimitHooks) AfterQuotaReset(ctx sdk.Context, rateLimit RateLimit) {
+	for i := range h {
+		h[i].AfterQuotaReset(ctx, rateLimit)
+	}
+}
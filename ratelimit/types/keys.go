@@ -1,6 +1,13 @@
 package types
 
-import "encoding/binary"
+import (
+	"encoding/binary"
+	"errors"
+	"fmt"
+	"strings"
+
+	"github.com/cosmos/cosmos-sdk/types/address"
+)
 
 const (
 	ModuleName = "ratelimit"
@@ -26,21 +33,156 @@ var (
 	DenomBlacklistKeyPrefix   = KeyPrefix("denom-blacklist")
 	AddressWhitelistKeyPrefix = KeyPrefix("address-blacklist")
 	HourEpochKey              = KeyPrefix("hour-epoch")
+	ForwardedTransferPrefix   = KeyPrefix("forwarded-transfer")
+	TransferRuleKeyPrefix     = KeyPrefix("transfer-rule")
+
+	// Secondary index of the transfer rules by denom and channel (either of which may be empty)
+	// Note: this can't start with the transfer rule prefix, or it'd be included
+	// when iterating over the rules
+	TransferRuleIndexPrefix = KeyPrefix("indexed-transfer-rule")
+
+	// Secondary indexes of the rate limits by channel and by denom
+	// Note: these can't start with the rate limit prefix, or they'd be included
+	// when iterating over the rate limits
+	RateLimitChannelIndexPrefix = KeyPrefix("channel-rate-limit-index")
+	RateLimitDenomIndexPrefix   = KeyPrefix("denom-rate-limit-index")
+
+	// Cache of the counterparty chain ID for each rate limited channel
+	ChannelChainIdKeyPrefix = KeyPrefix("channel-chain-id")
+
+	// Denom groups by group ID, and the group ID of each grouped denom
+	// Note: the member index can't start with the group prefix, or it'd be included
+	// when iterating over the groups
+	DenomGroupKeyPrefix       = KeyPrefix("denom-group")
+	DenomGroupMemberKeyPrefix = KeyPrefix("grouped-denom")
+
+	// Scheduled rate limit updates by execution height or epoch (and then schedule ID), the
+	// index from each schedule ID to its update's key, and the ID of the next scheduled update
+	// Note: the index can't start with the update prefix, or it'd be included when iterating
+	// over the updates
+	ScheduledUpdateKeyPrefix     = KeyPrefix("scheduled-update")
+	ScheduledUpdateIdIndexPrefix = KeyPrefix("schedule-id-index")
+	NextScheduleIdKey            = KeyPrefix("next-schedule-id")
+
+	// The LazyQuotaReset param value that was last applied, used to detect when it's changed
+	LazyQuotaResetKey = KeyPrefix("lazy-quota-reset")
+
+	// The scheduled updates that execute at a block height are stored separately from
+	// those that execute at an hour epoch, so that each can be iterated up to the current value
+	ScheduledUpdateHeightPrefix = []byte{0x01}
+	ScheduledUpdateEpochPrefix  = []byte{0x02}
 
 	PendingSendPacketChannelLength int = 16
+
+	// The max length of a field that's length prefixed with a single byte in an index key
+	MaxIndexedFieldLength int = 255
 )
 
+// Get the schedule ID index key from the schedule ID
+func GetScheduledUpdateIdKey(scheduleId uint64) []byte {
+	scheduleIdBz := make([]byte, 8)
+	binary.BigEndian.PutUint64(scheduleIdBz, scheduleId)
+	return scheduleIdBz
+}
+
+// Get the prefix of the scheduled updates that execute at a block height or at an hour epoch,
+// and the execution height or epoch of the update
+func getScheduledUpdateTrigger(scheduledUpdate ScheduledRateLimitUpdate) (triggerPrefix []byte, execution uint64) {
+	if scheduledUpdate.ExecuteHeight != 0 {
+		return ScheduledUpdateHeightPrefix, uint64(scheduledUpdate.ExecuteHeight)
+	}
+	return ScheduledUpdateEpochPrefix, scheduledUpdate.ExecuteEpoch
+}
+
+// Get the scheduled update key from its execution height or epoch and schedule ID
+// Key: {trigger prefix}{execution height or epoch}{schedule ID}
+func GetScheduledUpdateKey(scheduledUpdate ScheduledRateLimitUpdate) []byte {
+	triggerPrefix, execution := getScheduledUpdateTrigger(scheduledUpdate)
+
+	key := make([]byte, 0, len(triggerPrefix)+16)
+	key = append(key, triggerPrefix...)
+	key = binary.BigEndian.AppendUint64(key, execution)
+	return binary.BigEndian.AppendUint64(key, scheduledUpdate.ScheduleId)
+}
+
+// Get the end key (exclusive) of the scheduled updates under a trigger prefix that are due
+// at the given block height or hour epoch (i.e. that execute at or before it)
+func GetScheduledUpdateDueEndKey(triggerPrefix []byte, current uint64) []byte {
+	key := make([]byte, 0, len(triggerPrefix)+8)
+	key = append(key, triggerPrefix...)
+	return binary.BigEndian.AppendUint64(key, current+1)
+}
+
 // Get the rate limit byte key built from the denom and channelId
 func GetRateLimitItemKey(denom string, channelId string) []byte {
 	return append(KeyPrefix(denom), KeyPrefix(channelId)...)
 }
 
+// Get the prefix of all rate limit channel index keys for a given channel
+// The channel ID is length prefixed so that the denom can be extracted from the remainder of the key
+func GetRateLimitChannelIndexPrefix(channelId string) []byte {
+	return address.MustLengthPrefix(KeyPrefix(channelId))
+}
+
+// Get the rate limit channel index key from the channelId and denom
+func GetRateLimitChannelIndexKey(channelId string, denom string) []byte {
+	return append(GetRateLimitChannelIndexPrefix(channelId), KeyPrefix(denom)...)
+}
+
+// Get the prefix of all rate limit denom index keys for a given denom
+// The denom is length prefixed so that the channel ID can be extracted from the remainder of the key
+func GetRateLimitDenomIndexPrefix(denom string) []byte {
+	return address.MustLengthPrefix(KeyPrefix(denom))
+}
+
+// Get the rate limit denom index key from the denom and channelId
+func GetRateLimitDenomIndexKey(denom string, channelId string) []byte {
+	return append(GetRateLimitDenomIndexPrefix(denom), KeyPrefix(channelId)...)
+}
+
+// Parses the channel ID from a rate limit channel index key (relative to the index prefix)
+func ParseRateLimitChannelIndexKey(key []byte) (channelId string) {
+	channelIdLength := int(key[0])
+	return string(key[1 : 1+channelIdLength])
+}
+
+// Parses a rate limit channel or denom index key (relative to the index prefix) into the
+// length prefixed field and the remainder of the key (i.e. the channel ID and denom for the
+// channel index, and the denom and channel ID for the denom index)
+// Unlike ParseRateLimitChannelIndexKey, this returns an error instead of panicking if the
+// key is malformed, so that it can be used to check the store's consistency
+func ParseRateLimitIndexKey(key []byte) (prefixedField string, remainder string, err error) {
+	if len(key) == 0 {
+		return "", "", errors.New("rate limit index key is empty")
+	}
+	fieldLength := int(key[0])
+	if fieldLength == 0 || len(key) <= 1+fieldLength {
+		return "", "", fmt.Errorf("rate limit index key (%x) has an invalid length prefix", key)
+	}
+	return string(key[1 : 1+fieldLength]), string(key[1+fieldLength:]), nil
+}
+
+// Get the prefix of the transfer rule index keys for the rules on a denom and channel
+// Each is length prefixed (including when empty, for rules that apply to any denom or channel),
+// so that e.g. a rule on only the denom does not share a prefix with a rule on only the channel
+func GetTransferRuleIndexPrefix(denom string, channelId string) []byte {
+	prefix := make([]byte, 0, 2+len(denom)+len(channelId))
+	prefix = append(prefix, byte(len(denom)))
+	prefix = append(prefix, denom...)
+	prefix = append(prefix, byte(len(channelId)))
+	return append(prefix, channelId...)
+}
+
+// Get the transfer rule index key from the rule's denom, channel and ID
+func GetTransferRuleIndexKey(denom string, channelId string, ruleId string) []byte {
+	return append(GetTransferRuleIndexPrefix(denom, channelId), KeyPrefix(ruleId)...)
+}
+
 // Get the pending send packet key from the channel ID and sequence number
 // The channel ID must be fixed length to allow for extracting the underlying
 // values from a key
 func GetPendingSendPacketKey(channelId string, sequenceNumber uint64) []byte {
-	channelIdBz := make([]byte, PendingSendPacketChannelLength)
-	copy(channelIdBz[:], channelId)
+	channelIdBz := GetPendingSendPacketChannelPrefix(channelId)
 
 	sequenceNumberBz := make([]byte, 8)
 	binary.BigEndian.PutUint64(sequenceNumberBz, sequenceNumber)
@@ -48,7 +190,28 @@ func GetPendingSendPacketKey(channelId string, sequenceNumber uint64) []byte {
 	return append(channelIdBz, sequenceNumberBz...)
 }
 
+// Get the prefix of all pending send packet keys on a channel
+// The channel ID is padded to a fixed length so that, e.g., channel-1 does not match channel-10
+func GetPendingSendPacketChannelPrefix(channelId string) []byte {
+	channelIdBz := make([]byte, PendingSendPacketChannelLength)
+	copy(channelIdBz[:], channelId)
+	return channelIdBz
+}
+
+// Parses the channel ID and sequence number from a pending send packet key
+func ParsePendingSendPacketKey(key []byte) (channelId string, sequenceNumber uint64) {
+	channelId = string(key[:PendingSendPacketChannelLength])
+	channelId = strings.TrimRight(channelId, "\x00") // removes null bytes from suffix
+	sequenceNumber = binary.BigEndian.Uint64(key[PendingSendPacketChannelLength:])
+	return channelId, sequenceNumber
+}
+
 // Get the whitelist path key from a sender and receiver address
 func GetAddressWhitelistKey(sender, receiver string) []byte {
 	return append(KeyPrefix(sender), KeyPrefix(receiver)...)
 }
+
+// Get the forwarded transfer key from the denom and the channelId of the outbound hop
+func GetForwardedTransferKey(denom string, channelId string) []byte {
+	return append(KeyPrefix(denom), KeyPrefix(channelId)...)
+}
@@ -1,6 +1,7 @@
 package types
 
 import (
+	"errors"
 	"regexp"
 
 	errorsmod "cosmossdk.io/errors"
@@ -15,6 +16,17 @@ const (
 	TypeMsgUpdateRateLimit = "UpdateRateLimit"
 	TypeMsgRemoveRateLimit = "RemoveRateLimit"
 	TypeMsgResetRateLimit  = "ResetRateLimit"
+
+	TypeMsgSetTransferRule    = "SetTransferRule"
+	TypeMsgRemoveTransferRule = "RemoveTransferRule"
+
+	TypeMsgSetDenomGroup    = "SetDenomGroup"
+	TypeMsgRemoveDenomGroup = "RemoveDenomGroup"
+
+	TypeMsgScheduleRateLimitUpdate        = "ScheduleRateLimitUpdate"
+	TypeMsgCancelScheduledRateLimitUpdate = "CancelScheduledRateLimitUpdate"
+
+	TypeMsgBatchUpdateRateLimits = "BatchUpdateRateLimits"
 )
 
 var (
@@ -22,12 +34,26 @@ var (
 	_ sdk.Msg = &MsgUpdateRateLimit{}
 	_ sdk.Msg = &MsgRemoveRateLimit{}
 	_ sdk.Msg = &MsgResetRateLimit{}
+	_ sdk.Msg = &MsgSetTransferRule{}
+	_ sdk.Msg = &MsgRemoveTransferRule{}
+	_ sdk.Msg = &MsgSetDenomGroup{}
+	_ sdk.Msg = &MsgRemoveDenomGroup{}
+	_ sdk.Msg = &MsgScheduleRateLimitUpdate{}
+	_ sdk.Msg = &MsgCancelScheduledRateLimitUpdate{}
+	_ sdk.Msg = &MsgBatchUpdateRateLimits{}
 
 	// Implement legacy interface for ledger support
 	_ legacytx.LegacyMsg = &MsgAddRateLimit{}
 	_ legacytx.LegacyMsg = &MsgUpdateRateLimit{}
 	_ legacytx.LegacyMsg = &MsgRemoveRateLimit{}
 	_ legacytx.LegacyMsg = &MsgResetRateLimit{}
+	_ legacytx.LegacyMsg = &MsgSetTransferRule{}
+	_ legacytx.LegacyMsg = &MsgRemoveTransferRule{}
+	_ legacytx.LegacyMsg = &MsgSetDenomGroup{}
+	_ legacytx.LegacyMsg = &MsgRemoveDenomGroup{}
+	_ legacytx.LegacyMsg = &MsgScheduleRateLimitUpdate{}
+	_ legacytx.LegacyMsg = &MsgCancelScheduledRateLimitUpdate{}
+	_ legacytx.LegacyMsg = &MsgBatchUpdateRateLimits{}
 )
 
 // ----------------------------------------------
@@ -103,6 +129,23 @@ func (msg *MsgAddRateLimit) ValidateBasic() error {
 		return errorsmod.Wrapf(sdkerrors.ErrInvalidRequest, "duration can not be zero")
 	}
 
+	if msg.WindowOffset >= msg.DurationHours {
+		return errorsmod.Wrapf(sdkerrors.ErrInvalidRequest,
+			"window offset (%d) must be less than the duration (%d)", msg.WindowOffset, msg.DurationHours)
+	}
+
+	if msg.ChannelValueStrategy != nil {
+		if err := msg.ChannelValueStrategy.Validate(); err != nil {
+			return errorsmod.Wrapf(sdkerrors.ErrInvalidRequest, "invalid channel value strategy: %s", err.Error())
+		}
+	}
+
+	if msg.ValueQuota != nil {
+		if err := msg.ValueQuota.Validate(); err != nil {
+			return errorsmod.Wrapf(sdkerrors.ErrInvalidRequest, "invalid value quota: %s", err.Error())
+		}
+	}
+
 	return nil
 }
 
@@ -179,6 +222,27 @@ func (msg *MsgUpdateRateLimit) ValidateBasic() error {
 		return errorsmod.Wrapf(sdkerrors.ErrInvalidRequest, "duration can not be zero")
 	}
 
+	if msg.WindowOffset >= msg.DurationHours {
+		return errorsmod.Wrapf(sdkerrors.ErrInvalidRequest,
+			"window offset (%d) must be less than the duration (%d)", msg.WindowOffset, msg.DurationHours)
+	}
+
+	if msg.ChannelValueStrategy != nil {
+		if err := msg.ChannelValueStrategy.Validate(); err != nil {
+			return errorsmod.Wrapf(sdkerrors.ErrInvalidRequest, "invalid channel value strategy: %s", err.Error())
+		}
+	}
+
+	if msg.ValueQuota != nil {
+		if err := msg.ValueQuota.Validate(); err != nil {
+			return errorsmod.Wrapf(sdkerrors.ErrInvalidRequest, "invalid value quota: %s", err.Error())
+		}
+	}
+
+	if err := ValidateFlowUpdateMode(msg.FlowUpdateMode); err != nil {
+		return errorsmod.Wrapf(sdkerrors.ErrInvalidRequest, err.Error())
+	}
+
 	return nil
 }
 
@@ -289,3 +353,398 @@ func (msg *MsgResetRateLimit) ValidateBasic() error {
 
 	return nil
 }
+
+// ----------------------------------------------
+//               MsgSetTransferRule
+// ----------------------------------------------
+
+func NewMsgSetTransferRule(rule TransferRule) *MsgSetTransferRule {
+	return &MsgSetTransferRule{
+		Rule: rule,
+	}
+}
+
+func (msg MsgSetTransferRule) Type() string {
+	return TypeMsgSetTransferRule
+}
+
+func (msg MsgSetTransferRule) Route() string {
+	return RouterKey
+}
+
+func (msg *MsgSetTransferRule) GetSigners() []sdk.AccAddress {
+	staker, err := sdk.AccAddressFromBech32(msg.Authority)
+	if err != nil {
+		panic(err)
+	}
+	return []sdk.AccAddress{staker}
+}
+
+func (msg *MsgSetTransferRule) GetSignBytes() []byte {
+	bz := ModuleCdc.MustMarshalJSON(msg)
+	return sdk.MustSortJSON(bz)
+}
+
+func (msg *MsgSetTransferRule) ValidateBasic() error {
+	_, err := sdk.AccAddressFromBech32(msg.Authority)
+	if err != nil {
+		return errorsmod.Wrapf(sdkerrors.ErrInvalidAddress, "invalid authority address (%s)", err)
+	}
+
+	if err := msg.Rule.Validate(); err != nil {
+		return errorsmod.Wrapf(sdkerrors.ErrInvalidRequest, "invalid transfer rule: %s", err.Error())
+	}
+
+	return nil
+}
+
+// ----------------------------------------------
+//               MsgRemoveTransferRule
+// ----------------------------------------------
+
+func NewMsgRemoveTransferRule(ruleId string) *MsgRemoveTransferRule {
+	return &MsgRemoveTransferRule{
+		RuleId: ruleId,
+	}
+}
+
+func (msg MsgRemoveTransferRule) Type() string {
+	return TypeMsgRemoveTransferRule
+}
+
+func (msg MsgRemoveTransferRule) Route() string {
+	return RouterKey
+}
+
+func (msg *MsgRemoveTransferRule) GetSigners() []sdk.AccAddress {
+	staker, err := sdk.AccAddressFromBech32(msg.Authority)
+	if err != nil {
+		panic(err)
+	}
+	return []sdk.AccAddress{staker}
+}
+
+func (msg *MsgRemoveTransferRule) GetSignBytes() []byte {
+	bz := ModuleCdc.MustMarshalJSON(msg)
+	return sdk.MustSortJSON(bz)
+}
+
+func (msg *MsgRemoveTransferRule) ValidateBasic() error {
+	_, err := sdk.AccAddressFromBech32(msg.Authority)
+	if err != nil {
+		return errorsmod.Wrapf(sdkerrors.ErrInvalidAddress, "invalid authority address (%s)", err)
+	}
+
+	if msg.RuleId == "" {
+		return errorsmod.Wrapf(sdkerrors.ErrInvalidRequest, "rule id must be specified")
+	}
+
+	return nil
+}
+
+// ----------------------------------------------
+//               MsgSetDenomGroup
+// ----------------------------------------------
+
+func NewMsgSetDenomGroup(group DenomGroup) *MsgSetDenomGroup {
+	return &MsgSetDenomGroup{
+		Group: group,
+	}
+}
+
+func (msg MsgSetDenomGroup) Type() string {
+	return TypeMsgSetDenomGroup
+}
+
+func (msg MsgSetDenomGroup) Route() string {
+	return RouterKey
+}
+
+func (msg *MsgSetDenomGroup) GetSigners() []sdk.AccAddress {
+	staker, err := sdk.AccAddressFromBech32(msg.Authority)
+	if err != nil {
+		panic(err)
+	}
+	return []sdk.AccAddress{staker}
+}
+
+func (msg *MsgSetDenomGroup) GetSignBytes() []byte {
+	bz := ModuleCdc.MustMarshalJSON(msg)
+	return sdk.MustSortJSON(bz)
+}
+
+func (msg *MsgSetDenomGroup) ValidateBasic() error {
+	_, err := sdk.AccAddressFromBech32(msg.Authority)
+	if err != nil {
+		return errorsmod.Wrapf(sdkerrors.ErrInvalidAddress, "invalid authority address (%s)", err)
+	}
+
+	if err := msg.Group.Validate(); err != nil {
+		return errorsmod.Wrapf(sdkerrors.ErrInvalidRequest, "invalid denom group: %s", err.Error())
+	}
+
+	return nil
+}
+
+// ----------------------------------------------
+//               MsgRemoveDenomGroup
+// ----------------------------------------------
+
+func NewMsgRemoveDenomGroup(groupId string) *MsgRemoveDenomGroup {
+	return &MsgRemoveDenomGroup{
+		GroupId: groupId,
+	}
+}
+
+func (msg MsgRemoveDenomGroup) Type() string {
+	return TypeMsgRemoveDenomGroup
+}
+
+func (msg MsgRemoveDenomGroup) Route() string {
+	return RouterKey
+}
+
+func (msg *MsgRemoveDenomGroup) GetSigners() []sdk.AccAddress {
+	staker, err := sdk.AccAddressFromBech32(msg.Authority)
+	if err != nil {
+		panic(err)
+	}
+	return []sdk.AccAddress{staker}
+}
+
+func (msg *MsgRemoveDenomGroup) GetSignBytes() []byte {
+	bz := ModuleCdc.MustMarshalJSON(msg)
+	return sdk.MustSortJSON(bz)
+}
+
+func (msg *MsgRemoveDenomGroup) ValidateBasic() error {
+	_, err := sdk.AccAddressFromBech32(msg.Authority)
+	if err != nil {
+		return errorsmod.Wrapf(sdkerrors.ErrInvalidAddress, "invalid authority address (%s)", err)
+	}
+
+	if !IsDenomGroupId(msg.GroupId) {
+		return errorsmod.Wrapf(sdkerrors.ErrInvalidRequest, "group id (%s) must start with %s", msg.GroupId, DenomGroupPrefix)
+	}
+
+	return nil
+}
+
+// ----------------------------------------------
+//               MsgScheduleRateLimitUpdate
+// ----------------------------------------------
+
+func NewMsgScheduleRateLimitUpdate(executeEpoch uint64, executeHeight int64, update MsgUpdateRateLimit) *MsgScheduleRateLimitUpdate {
+	return &MsgScheduleRateLimitUpdate{
+		ExecuteEpoch:  executeEpoch,
+		ExecuteHeight: executeHeight,
+		Update:        update,
+	}
+}
+
+func (msg MsgScheduleRateLimitUpdate) Type() string {
+	return TypeMsgScheduleRateLimitUpdate
+}
+
+func (msg MsgScheduleRateLimitUpdate) Route() string {
+	return RouterKey
+}
+
+func (msg *MsgScheduleRateLimitUpdate) GetSigners() []sdk.AccAddress {
+	staker, err := sdk.AccAddressFromBech32(msg.Authority)
+	if err != nil {
+		panic(err)
+	}
+	return []sdk.AccAddress{staker}
+}
+
+func (msg *MsgScheduleRateLimitUpdate) GetSignBytes() []byte {
+	bz := ModuleCdc.MustMarshalJSON(msg)
+	return sdk.MustSortJSON(bz)
+}
+
+func (msg *MsgScheduleRateLimitUpdate) ValidateBasic() error {
+	_, err := sdk.AccAddressFromBech32(msg.Authority)
+	if err != nil {
+		return errorsmod.Wrapf(sdkerrors.ErrInvalidAddress, "invalid authority address (%s)", err)
+	}
+
+	if err := ValidateScheduleTrigger(msg.ExecuteEpoch, msg.ExecuteHeight); err != nil {
+		return errorsmod.Wrapf(sdkerrors.ErrInvalidRequest, "invalid schedule: %s", err.Error())
+	}
+
+	if msg.Update.Authority != msg.Authority {
+		return errorsmod.Wrapf(sdkerrors.ErrInvalidRequest,
+			"update authority (%s) must match the authority (%s)", msg.Update.Authority, msg.Authority)
+	}
+
+	if err := msg.Update.ValidateBasic(); err != nil {
+		return errorsmod.Wrapf(sdkerrors.ErrInvalidRequest, "invalid update: %s", err.Error())
+	}
+
+	return nil
+}
+
+// ----------------------------------------------
+//               MsgCancelScheduledRateLimitUpdate
+// ----------------------------------------------
+
+func NewMsgCancelScheduledRateLimitUpdate(scheduleId uint64) *MsgCancelScheduledRateLimitUpdate {
+	return &MsgCancelScheduledRateLimitUpdate{
+		ScheduleId: scheduleId,
+	}
+}
+
+func (msg MsgCancelScheduledRateLimitUpdate) Type() string {
+	return TypeMsgCancelScheduledRateLimitUpdate
+}
+
+func (msg MsgCancelScheduledRateLimitUpdate) Route() string {
+	return RouterKey
+}
+
+func (msg *MsgCancelScheduledRateLimitUpdate) GetSigners() []sdk.AccAddress {
+	staker, err := sdk.AccAddressFromBech32(msg.Authority)
+	if err != nil {
+		panic(err)
+	}
+	return []sdk.AccAddress{staker}
+}
+
+func (msg *MsgCancelScheduledRateLimitUpdate) GetSignBytes() []byte {
+	bz := ModuleCdc.MustMarshalJSON(msg)
+	return sdk.MustSortJSON(bz)
+}
+
+func (msg *MsgCancelScheduledRateLimitUpdate) ValidateBasic() error {
+	_, err := sdk.AccAddressFromBech32(msg.Authority)
+	if err != nil {
+		return errorsmod.Wrapf(sdkerrors.ErrInvalidAddress, "invalid authority address (%s)", err)
+	}
+
+	return nil
+}
+
+// ----------------------------------------------
+//               MsgBatchUpdateRateLimits
+// ----------------------------------------------
+
+func NewMsgBatchUpdateRateLimits(upserts []RateLimitUpsert, removals []Path, skipZeroChannelValue bool) *MsgBatchUpdateRateLimits {
+	return &MsgBatchUpdateRateLimits{
+		Upserts:              upserts,
+		Removals:             removals,
+		SkipZeroChannelValue: skipZeroChannelValue,
+	}
+}
+
+func (msg MsgBatchUpdateRateLimits) Type() string {
+	return TypeMsgBatchUpdateRateLimits
+}
+
+func (msg MsgBatchUpdateRateLimits) Route() string {
+	return RouterKey
+}
+
+func (msg *MsgBatchUpdateRateLimits) GetSigners() []sdk.AccAddress {
+	staker, err := sdk.AccAddressFromBech32(msg.Authority)
+	if err != nil {
+		panic(err)
+	}
+	return []sdk.AccAddress{staker}
+}
+
+func (msg *MsgBatchUpdateRateLimits) GetSignBytes() []byte {
+	bz := ModuleCdc.MustMarshalJSON(msg)
+	return sdk.MustSortJSON(bz)
+}
+
+// Validates each entry in the batch with the same checks as the individual messages,
+// and confirms each rate limit only appears once
+// Every invalid entry is reported (rather than just the first), each identified by its index and path
+func (msg *MsgBatchUpdateRateLimits) ValidateBasic() error {
+	_, err := sdk.AccAddressFromBech32(msg.Authority)
+	if err != nil {
+		return errorsmod.Wrapf(sdkerrors.ErrInvalidAddress, "invalid authority address (%s)", err)
+	}
+
+	if len(msg.Upserts) == 0 && len(msg.Removals) == 0 {
+		return errorsmod.Wrapf(sdkerrors.ErrInvalidRequest, "at least one upsert or removal must be specified")
+	}
+
+	paths := map[string]bool{}
+	checkDuplicate := func(denom, channelId string) error {
+		pathKey := denom + "/" + channelId
+		if paths[pathKey] {
+			return errorsmod.Wrapf(sdkerrors.ErrInvalidRequest,
+				"rate limit (denom: %s, channel: %s) is specified more than once", denom, channelId)
+		}
+		paths[pathKey] = true
+		return nil
+	}
+
+	entryErrors := []error{}
+	for i, upsert := range msg.Upserts {
+		updateMsg := upsert.ToUpdateMsg(msg.Authority)
+		if err := updateMsg.ValidateBasic(); err != nil {
+			entryErrors = append(entryErrors,
+				errorsmod.Wrapf(err, "invalid upsert %d (denom: %s, channel: %s)", i, upsert.Denom, upsert.ChannelId))
+		}
+		if err := checkDuplicate(upsert.Denom, upsert.ChannelId); err != nil {
+			entryErrors = append(entryErrors, err)
+		}
+	}
+
+	for i, removal := range msg.Removals {
+		removeMsg := MsgRemoveRateLimit{Authority: msg.Authority, Denom: removal.Denom, ChannelId: removal.ChannelId}
+		if err := removeMsg.ValidateBasic(); err != nil {
+			entryErrors = append(entryErrors,
+				errorsmod.Wrapf(err, "invalid removal %d (denom: %s, channel: %s)", i, removal.Denom, removal.ChannelId))
+		}
+		if err := checkDuplicate(removal.Denom, removal.ChannelId); err != nil {
+			entryErrors = append(entryErrors, err)
+		}
+	}
+
+	return JoinBatchErrors(entryErrors)
+}
+
+// Combines the errors from each failed entry in a batch into a single error
+// A lone error is returned as is so that it keeps its ABCI code, otherwise the errors are
+// joined (each can still be matched with errors.Is)
+func JoinBatchErrors(entryErrors []error) error {
+	if len(entryErrors) == 1 {
+		return entryErrors[0]
+	}
+	return errors.Join(entryErrors...)
+}
+
+// Returns the message to add the rate limit in a batch upsert, if it does not exist
+func (u RateLimitUpsert) ToAddMsg(authority string) MsgAddRateLimit {
+	return MsgAddRateLimit{
+		Authority:            authority,
+		Denom:                u.Denom,
+		ChannelId:            u.ChannelId,
+		MaxPercentSend:       u.MaxPercentSend,
+		MaxPercentRecv:       u.MaxPercentRecv,
+		DurationHours:        u.DurationHours,
+		ChannelValueStrategy: u.ChannelValueStrategy,
+		ValueQuota:           u.ValueQuota,
+		WindowOffset:         u.WindowOffset,
+	}
+}
+
+// Returns the message to update the rate limit in a batch upsert, if it already exists
+func (u RateLimitUpsert) ToUpdateMsg(authority string) MsgUpdateRateLimit {
+	return MsgUpdateRateLimit{
+		Authority:            authority,
+		Denom:                u.Denom,
+		ChannelId:            u.ChannelId,
+		MaxPercentSend:       u.MaxPercentSend,
+		MaxPercentRecv:       u.MaxPercentRecv,
+		DurationHours:        u.DurationHours,
+		ChannelValueStrategy: u.ChannelValueStrategy,
+		ValueQuota:           u.ValueQuota,
+		WindowOffset:         u.WindowOffset,
+		FlowUpdateMode:       u.FlowUpdateMode,
+	}
+}
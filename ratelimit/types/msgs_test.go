@@ -4,6 +4,7 @@ import (
 	"testing"
 
 	sdkmath "cosmossdk.io/math"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
 	authtypes "github.com/cosmos/cosmos-sdk/x/auth/types"
 	govtypes "github.com/cosmos/cosmos-sdk/x/gov/types"
 	"github.com/stretchr/testify/require"
@@ -150,6 +151,89 @@ func TestMsgAddRateLimit(t *testing.T) {
 			},
 			err: "duration can not be zero",
 		},
+		{
+			name: "successful window offset",
+			msg: types.MsgAddRateLimit{
+				Authority:      validAuthority,
+				Denom:          validDenom,
+				ChannelId:      validChannelId,
+				MaxPercentSend: validMaxPercentSend,
+				MaxPercentRecv: validMaxPercentRecv,
+				DurationHours:  validDurationHours,
+				WindowOffset:   validDurationHours - 1,
+			},
+		},
+		{
+			name: "window offset not less than duration",
+			msg: types.MsgAddRateLimit{
+				Authority:      validAuthority,
+				Denom:          validDenom,
+				ChannelId:      validChannelId,
+				MaxPercentSend: validMaxPercentSend,
+				MaxPercentRecv: validMaxPercentRecv,
+				DurationHours:  validDurationHours,
+				WindowOffset:   validDurationHours,
+			},
+			err: "window offset (60) must be less than the duration (60)",
+		},
+		{
+			name: "successful escrow channel value strategy",
+			msg: types.MsgAddRateLimit{
+				Authority:            validAuthority,
+				Denom:                validDenom,
+				ChannelId:            validChannelId,
+				MaxPercentSend:       validMaxPercentSend,
+				MaxPercentRecv:       validMaxPercentRecv,
+				DurationHours:        validDurationHours,
+				ChannelValueStrategy: &types.ChannelValueStrategy{Source: types.CHANNEL_VALUE_ESCROW},
+			},
+		},
+		{
+			name: "invalid channel value strategy",
+			msg: types.MsgAddRateLimit{
+				Authority:            validAuthority,
+				Denom:                validDenom,
+				ChannelId:            validChannelId,
+				MaxPercentSend:       validMaxPercentSend,
+				MaxPercentRecv:       validMaxPercentRecv,
+				DurationHours:        validDurationHours,
+				ChannelValueStrategy: &types.ChannelValueStrategy{Source: types.CHANNEL_VALUE_FIXED},
+			},
+			err: "invalid channel value strategy",
+		},
+		{
+			name: "successful value quota",
+			msg: types.MsgAddRateLimit{
+				Authority:      validAuthority,
+				Denom:          validDenom,
+				ChannelId:      validChannelId,
+				MaxPercentSend: validMaxPercentSend,
+				MaxPercentRecv: validMaxPercentRecv,
+				DurationHours:  validDurationHours,
+				ValueQuota: &types.ValueQuota{
+					QuoteDenom:   "usd",
+					MaxValueSend: sdkmath.NewInt(5_000_000),
+					MaxValueRecv: sdkmath.NewInt(5_000_000),
+				},
+			},
+		},
+		{
+			name: "invalid value quota",
+			msg: types.MsgAddRateLimit{
+				Authority:      validAuthority,
+				Denom:          validDenom,
+				ChannelId:      validChannelId,
+				MaxPercentSend: validMaxPercentSend,
+				MaxPercentRecv: validMaxPercentRecv,
+				DurationHours:  validDurationHours,
+				ValueQuota: &types.ValueQuota{
+					QuoteDenom:   "usd",
+					MaxValueSend: sdkmath.NewInt(-1),
+					MaxValueRecv: sdkmath.NewInt(5_000_000),
+				},
+			},
+			err: "invalid value quota",
+		},
 	}
 
 	for _, tc := range testCases {
@@ -309,6 +393,114 @@ func TestMsgUpdateRateLimit(t *testing.T) {
 			},
 			err: "duration can not be zero",
 		},
+		{
+			name: "successful window offset",
+			msg: types.MsgUpdateRateLimit{
+				Authority:      validAuthority,
+				Denom:          validDenom,
+				ChannelId:      validChannelId,
+				MaxPercentSend: validMaxPercentSend,
+				MaxPercentRecv: validMaxPercentRecv,
+				DurationHours:  validDurationHours,
+				WindowOffset:   validDurationHours - 1,
+			},
+		},
+		{
+			name: "window offset not less than duration",
+			msg: types.MsgUpdateRateLimit{
+				Authority:      validAuthority,
+				Denom:          validDenom,
+				ChannelId:      validChannelId,
+				MaxPercentSend: validMaxPercentSend,
+				MaxPercentRecv: validMaxPercentRecv,
+				DurationHours:  validDurationHours,
+				WindowOffset:   validDurationHours,
+			},
+			err: "window offset (60) must be less than the duration (60)",
+		},
+		{
+			name: "successful escrow channel value strategy",
+			msg: types.MsgUpdateRateLimit{
+				Authority:            validAuthority,
+				Denom:                validDenom,
+				ChannelId:            validChannelId,
+				MaxPercentSend:       validMaxPercentSend,
+				MaxPercentRecv:       validMaxPercentRecv,
+				DurationHours:        validDurationHours,
+				ChannelValueStrategy: &types.ChannelValueStrategy{Source: types.CHANNEL_VALUE_ESCROW},
+			},
+		},
+		{
+			name: "invalid channel value strategy",
+			msg: types.MsgUpdateRateLimit{
+				Authority:            validAuthority,
+				Denom:                validDenom,
+				ChannelId:            validChannelId,
+				MaxPercentSend:       validMaxPercentSend,
+				MaxPercentRecv:       validMaxPercentRecv,
+				DurationHours:        validDurationHours,
+				ChannelValueStrategy: &types.ChannelValueStrategy{Source: types.CHANNEL_VALUE_FIXED},
+			},
+			err: "invalid channel value strategy",
+		},
+		{
+			name: "successful value quota",
+			msg: types.MsgUpdateRateLimit{
+				Authority:      validAuthority,
+				Denom:          validDenom,
+				ChannelId:      validChannelId,
+				MaxPercentSend: validMaxPercentSend,
+				MaxPercentRecv: validMaxPercentRecv,
+				DurationHours:  validDurationHours,
+				ValueQuota: &types.ValueQuota{
+					QuoteDenom:   "usd",
+					MaxValueSend: sdkmath.NewInt(5_000_000),
+					MaxValueRecv: sdkmath.NewInt(5_000_000),
+				},
+			},
+		},
+		{
+			name: "invalid value quota",
+			msg: types.MsgUpdateRateLimit{
+				Authority:      validAuthority,
+				Denom:          validDenom,
+				ChannelId:      validChannelId,
+				MaxPercentSend: validMaxPercentSend,
+				MaxPercentRecv: validMaxPercentRecv,
+				DurationHours:  validDurationHours,
+				ValueQuota: &types.ValueQuota{
+					QuoteDenom:   "usd",
+					MaxValueSend: sdkmath.NewInt(-1),
+					MaxValueRecv: sdkmath.NewInt(5_000_000),
+				},
+			},
+			err: "invalid value quota",
+		},
+		{
+			name: "successful flow update mode",
+			msg: types.MsgUpdateRateLimit{
+				Authority:      validAuthority,
+				Denom:          validDenom,
+				ChannelId:      validChannelId,
+				MaxPercentSend: validMaxPercentSend,
+				MaxPercentRecv: validMaxPercentRecv,
+				DurationHours:  validDurationHours,
+				FlowUpdateMode: types.FLOW_UPDATE_PROPORTIONAL,
+			},
+		},
+		{
+			name: "invalid flow update mode",
+			msg: types.MsgUpdateRateLimit{
+				Authority:      validAuthority,
+				Denom:          validDenom,
+				ChannelId:      validChannelId,
+				MaxPercentSend: validMaxPercentSend,
+				MaxPercentRecv: validMaxPercentRecv,
+				DurationHours:  validDurationHours,
+				FlowUpdateMode: types.FlowUpdateMode(10),
+			},
+			err: "invalid flow update mode (10)",
+		},
 	}
 
 	for _, tc := range testCases {
@@ -467,3 +659,525 @@ func TestMsgResetRateLimit(t *testing.T) {
 		})
 	}
 }
+
+// ----------------------------------------------
+//               MsgSetTransferRule
+// ----------------------------------------------
+
+func TestMsgSetTransferRule(t *testing.T) {
+	apptesting.SetupConfig()
+
+	validAuthority := authtypes.NewModuleAddress(govtypes.ModuleName).String()
+	validRule := types.TransferRule{
+		RuleId:  "wasm-hooks",
+		MemoKey: "wasm",
+		Action:  types.RULE_ACTION_DENY,
+	}
+
+	testCases := []struct {
+		name string
+		msg  types.MsgSetTransferRule
+		err  string
+	}{
+		{
+			name: "successful message",
+			msg: types.MsgSetTransferRule{
+				Authority: validAuthority,
+				Rule:      validRule,
+			},
+		},
+		{
+			name: "invalid authority",
+			msg: types.MsgSetTransferRule{
+				Authority: "invalid_address",
+				Rule:      validRule,
+			},
+			err: "invalid authority",
+		},
+		{
+			name: "invalid rule",
+			msg: types.MsgSetTransferRule{
+				Authority: validAuthority,
+				Rule:      types.TransferRule{RuleId: "rule", Action: types.RULE_ACTION_DENY},
+			},
+			err: "invalid transfer rule",
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			if tc.err == "" {
+				require.NoError(t, tc.msg.ValidateBasic(), "test: %v", tc.name)
+				require.Equal(t, tc.msg.Rule, validRule, "rule")
+
+				require.Equal(t, tc.msg.Type(), types.TypeMsgSetTransferRule, "type")
+				require.Equal(t, tc.msg.Route(), types.ModuleName, "route")
+			} else {
+				require.ErrorContains(t, tc.msg.ValidateBasic(), tc.err, "test: %v", tc.name)
+			}
+		})
+	}
+}
+
+// ----------------------------------------------
+//               MsgRemoveTransferRule
+// ----------------------------------------------
+
+func TestMsgRemoveTransferRule(t *testing.T) {
+	apptesting.SetupConfig()
+
+	validAuthority := authtypes.NewModuleAddress(govtypes.ModuleName).String()
+	validRuleId := "wasm-hooks"
+
+	testCases := []struct {
+		name string
+		msg  types.MsgRemoveTransferRule
+		err  string
+	}{
+		{
+			name: "successful message",
+			msg: types.MsgRemoveTransferRule{
+				Authority: validAuthority,
+				RuleId:    validRuleId,
+			},
+		},
+		{
+			name: "invalid authority",
+			msg: types.MsgRemoveTransferRule{
+				Authority: "invalid_address",
+				RuleId:    validRuleId,
+			},
+			err: "invalid authority",
+		},
+		{
+			name: "missing rule id",
+			msg: types.MsgRemoveTransferRule{
+				Authority: validAuthority,
+			},
+			err: "rule id must be specified",
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			if tc.err == "" {
+				require.NoError(t, tc.msg.ValidateBasic(), "test: %v", tc.name)
+				require.Equal(t, tc.msg.RuleId, validRuleId, "rule id")
+
+				require.Equal(t, tc.msg.Type(), types.TypeMsgRemoveTransferRule, "type")
+				require.Equal(t, tc.msg.Route(), types.ModuleName, "route")
+			} else {
+				require.ErrorContains(t, tc.msg.ValidateBasic(), tc.err, "test: %v", tc.name)
+			}
+		})
+	}
+}
+
+// ----------------------------------------------
+//               MsgSetDenomGroup
+// ----------------------------------------------
+
+func TestMsgSetDenomGroup(t *testing.T) {
+	apptesting.SetupConfig()
+
+	validAuthority := authtypes.NewModuleAddress(govtypes.ModuleName).String()
+	validGroup := types.DenomGroup{
+		GroupId: "group/atom",
+		Denoms:  []string{"ibc/atom-direct", "ibc/atom-osmosis"},
+	}
+
+	testCases := []struct {
+		name string
+		msg  types.MsgSetDenomGroup
+		err  string
+	}{
+		{
+			name: "successful message",
+			msg: types.MsgSetDenomGroup{
+				Authority: validAuthority,
+				Group:     validGroup,
+			},
+		},
+		{
+			name: "invalid authority",
+			msg: types.MsgSetDenomGroup{
+				Authority: "invalid_address",
+				Group:     validGroup,
+			},
+			err: "invalid authority",
+		},
+		{
+			name: "invalid group",
+			msg: types.MsgSetDenomGroup{
+				Authority: validAuthority,
+				Group:     types.DenomGroup{GroupId: "atom", Denoms: validGroup.Denoms},
+			},
+			err: "invalid denom group",
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			if tc.err == "" {
+				require.NoError(t, tc.msg.ValidateBasic(), "test: %v", tc.name)
+				require.Equal(t, tc.msg.Group, validGroup, "group")
+
+				require.Equal(t, tc.msg.Type(), types.TypeMsgSetDenomGroup, "type")
+				require.Equal(t, tc.msg.Route(), types.ModuleName, "route")
+			} else {
+				require.ErrorContains(t, tc.msg.ValidateBasic(), tc.err, "test: %v", tc.name)
+			}
+		})
+	}
+}
+
+// ----------------------------------------------
+//               MsgRemoveDenomGroup
+// ----------------------------------------------
+
+func TestMsgRemoveDenomGroup(t *testing.T) {
+	apptesting.SetupConfig()
+
+	validAuthority := authtypes.NewModuleAddress(govtypes.ModuleName).String()
+	validGroupId := "group/atom"
+
+	testCases := []struct {
+		name string
+		msg  types.MsgRemoveDenomGroup
+		err  string
+	}{
+		{
+			name: "successful message",
+			msg: types.MsgRemoveDenomGroup{
+				Authority: validAuthority,
+				GroupId:   validGroupId,
+			},
+		},
+		{
+			name: "invalid authority",
+			msg: types.MsgRemoveDenomGroup{
+				Authority: "invalid_address",
+				GroupId:   validGroupId,
+			},
+			err: "invalid authority",
+		},
+		{
+			name: "invalid group id",
+			msg: types.MsgRemoveDenomGroup{
+				Authority: validAuthority,
+				GroupId:   "atom",
+			},
+			err: "must start with group/",
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			if tc.err == "" {
+				require.NoError(t, tc.msg.ValidateBasic(), "test: %v", tc.name)
+				require.Equal(t, tc.msg.GroupId, validGroupId, "group id")
+
+				require.Equal(t, tc.msg.Type(), types.TypeMsgRemoveDenomGroup, "type")
+				require.Equal(t, tc.msg.Route(), types.ModuleName, "route")
+			} else {
+				require.ErrorContains(t, tc.msg.ValidateBasic(), tc.err, "test: %v", tc.name)
+			}
+		})
+	}
+}
+
+// ----------------------------------------------
+//               MsgScheduleRateLimitUpdate
+// ----------------------------------------------
+
+func TestMsgScheduleRateLimitUpdate(t *testing.T) {
+	apptesting.SetupConfig()
+
+	validAuthority := authtypes.NewModuleAddress(govtypes.ModuleName).String()
+	validUpdate := types.MsgUpdateRateLimit{
+		Authority:      validAuthority,
+		Denom:          "denom",
+		ChannelId:      "channel-0",
+		MaxPercentSend: sdkmath.NewInt(10),
+		MaxPercentRecv: sdkmath.NewInt(10),
+		DurationHours:  60,
+	}
+
+	mismatchedAuthorityUpdate := validUpdate
+	mismatchedAuthorityUpdate.Authority = authtypes.NewModuleAddress(types.ModuleName).String()
+
+	invalidUpdate := validUpdate
+	invalidUpdate.DurationHours = 0
+
+	testCases := []struct {
+		name string
+		msg  types.MsgScheduleRateLimitUpdate
+		err  string
+	}{
+		{
+			name: "successful message by epoch",
+			msg: types.MsgScheduleRateLimitUpdate{
+				Authority:    validAuthority,
+				ExecuteEpoch: 10,
+				Update:       validUpdate,
+			},
+		},
+		{
+			name: "successful message by height",
+			msg: types.MsgScheduleRateLimitUpdate{
+				Authority:     validAuthority,
+				ExecuteHeight: 100,
+				Update:        validUpdate,
+			},
+		},
+		{
+			name: "invalid authority",
+			msg: types.MsgScheduleRateLimitUpdate{
+				Authority:    "invalid_address",
+				ExecuteEpoch: 10,
+				Update:       validUpdate,
+			},
+			err: "invalid authority",
+		},
+		{
+			name: "no epoch or height",
+			msg: types.MsgScheduleRateLimitUpdate{
+				Authority: validAuthority,
+				Update:    validUpdate,
+			},
+			err: "invalid schedule",
+		},
+		{
+			name: "both epoch and height",
+			msg: types.MsgScheduleRateLimitUpdate{
+				Authority:     validAuthority,
+				ExecuteEpoch:  10,
+				ExecuteHeight: 100,
+				Update:        validUpdate,
+			},
+			err: "invalid schedule",
+		},
+		{
+			name: "mismatched update authority",
+			msg: types.MsgScheduleRateLimitUpdate{
+				Authority:    validAuthority,
+				ExecuteEpoch: 10,
+				Update:       mismatchedAuthorityUpdate,
+			},
+			err: "must match the authority",
+		},
+		{
+			name: "invalid update",
+			msg: types.MsgScheduleRateLimitUpdate{
+				Authority:    validAuthority,
+				ExecuteEpoch: 10,
+				Update:       invalidUpdate,
+			},
+			err: "invalid update",
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			if tc.err == "" {
+				require.NoError(t, tc.msg.ValidateBasic(), "test: %v", tc.name)
+
+				require.Equal(t, tc.msg.Type(), types.TypeMsgScheduleRateLimitUpdate, "type")
+				require.Equal(t, tc.msg.Route(), types.ModuleName, "route")
+			} else {
+				require.ErrorContains(t, tc.msg.ValidateBasic(), tc.err, "test: %v", tc.name)
+			}
+		})
+	}
+}
+
+// ----------------------------------------------
+//               MsgCancelScheduledRateLimitUpdate
+// ----------------------------------------------
+
+func TestMsgCancelScheduledRateLimitUpdate(t *testing.T) {
+	apptesting.SetupConfig()
+
+	validAuthority := authtypes.NewModuleAddress(govtypes.ModuleName).String()
+
+	testCases := []struct {
+		name string
+		msg  types.MsgCancelScheduledRateLimitUpdate
+		err  string
+	}{
+		{
+			name: "successful message",
+			msg: types.MsgCancelScheduledRateLimitUpdate{
+				Authority:  validAuthority,
+				ScheduleId: 1,
+			},
+		},
+		{
+			name: "invalid authority",
+			msg: types.MsgCancelScheduledRateLimitUpdate{
+				Authority:  "invalid_address",
+				ScheduleId: 1,
+			},
+			err: "invalid authority",
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			if tc.err == "" {
+				require.NoError(t, tc.msg.ValidateBasic(), "test: %v", tc.name)
+
+				require.Equal(t, tc.msg.Type(), types.TypeMsgCancelScheduledRateLimitUpdate, "type")
+				require.Equal(t, tc.msg.Route(), types.ModuleName, "route")
+			} else {
+				require.ErrorContains(t, tc.msg.ValidateBasic(), tc.err, "test: %v", tc.name)
+			}
+		})
+	}
+}
+
+// ----------------------------------------------
+//               MsgBatchUpdateRateLimits
+// ----------------------------------------------
+
+func TestMsgBatchUpdateRateLimits(t *testing.T) {
+	apptesting.SetupConfig()
+
+	validAuthority := authtypes.NewModuleAddress(govtypes.ModuleName).String()
+	validUpsert := func(denom, channelId string) types.RateLimitUpsert {
+		return types.RateLimitUpsert{
+			Denom:          denom,
+			ChannelId:      channelId,
+			MaxPercentSend: sdkmath.NewInt(10),
+			MaxPercentRecv: sdkmath.NewInt(10),
+			DurationHours:  24,
+		}
+	}
+
+	invalidUpsert := validUpsert("denomB", "channel-1")
+	invalidUpsert.DurationHours = 0
+
+	testCases := []struct {
+		name string
+		msg  types.MsgBatchUpdateRateLimits
+		err  string
+	}{
+		{
+			name: "successful message",
+			msg: types.MsgBatchUpdateRateLimits{
+				Authority: validAuthority,
+				Upserts:   []types.RateLimitUpsert{validUpsert("denomA", "channel-0"), validUpsert("denomA", "channel-1")},
+				Removals:  []types.Path{{Denom: "denomB", ChannelId: "channel-0"}},
+			},
+		},
+		{
+			name: "successful message with only removals",
+			msg: types.MsgBatchUpdateRateLimits{
+				Authority: validAuthority,
+				Removals:  []types.Path{{Denom: "denomB", ChannelId: "channel-0"}},
+			},
+		},
+		{
+			name: "invalid authority",
+			msg: types.MsgBatchUpdateRateLimits{
+				Authority: "invalid_address",
+				Upserts:   []types.RateLimitUpsert{validUpsert("denomA", "channel-0")},
+			},
+			err: "invalid authority",
+		},
+		{
+			name: "empty batch",
+			msg: types.MsgBatchUpdateRateLimits{
+				Authority: validAuthority,
+			},
+			err: "at least one upsert or removal must be specified",
+		},
+		{
+			name: "invalid upsert",
+			msg: types.MsgBatchUpdateRateLimits{
+				Authority: validAuthority,
+				Upserts:   []types.RateLimitUpsert{validUpsert("denomA", "channel-0"), invalidUpsert},
+			},
+			err: "invalid upsert 1 (denom: denomB, channel: channel-1): duration can not be zero",
+		},
+		{
+			name: "invalid removal",
+			msg: types.MsgBatchUpdateRateLimits{
+				Authority: validAuthority,
+				Removals:  []types.Path{{Denom: "denomB", ChannelId: "channel-"}},
+			},
+			err: "invalid removal 0 (denom: denomB, channel: channel-)",
+		},
+		{
+			name: "duplicate upsert",
+			msg: types.MsgBatchUpdateRateLimits{
+				Authority: validAuthority,
+				Upserts:   []types.RateLimitUpsert{validUpsert("denomA", "channel-0"), validUpsert("denomA", "channel-0")},
+			},
+			err: "rate limit (denom: denomA, channel: channel-0) is specified more than once",
+		},
+		{
+			name: "upsert and removal of the same rate limit",
+			msg: types.MsgBatchUpdateRateLimits{
+				Authority: validAuthority,
+				Upserts:   []types.RateLimitUpsert{validUpsert("denomA", "channel-0")},
+				Removals:  []types.Path{{Denom: "denomA", ChannelId: "channel-0"}},
+			},
+			err: "rate limit (denom: denomA, channel: channel-0) is specified more than once",
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			if tc.err == "" {
+				require.NoError(t, tc.msg.ValidateBasic(), "test: %v", tc.name)
+
+				require.Equal(t, tc.msg.Type(), types.TypeMsgBatchUpdateRateLimits, "type")
+				require.Equal(t, tc.msg.Route(), types.ModuleName, "route")
+			} else {
+				require.ErrorContains(t, tc.msg.ValidateBasic(), tc.err, "test: %v", tc.name)
+			}
+		})
+	}
+}
+
+func TestMsgBatchUpdateRateLimits_MultipleInvalidEntries(t *testing.T) {
+	apptesting.SetupConfig()
+
+	validUpsert := types.RateLimitUpsert{
+		Denom:          "denomA",
+		ChannelId:      "channel-0",
+		MaxPercentSend: sdkmath.NewInt(10),
+		MaxPercentRecv: sdkmath.NewInt(10),
+		DurationHours:  24,
+	}
+	zeroDurationUpsert := validUpsert
+	zeroDurationUpsert.ChannelId = "channel-1"
+	zeroDurationUpsert.DurationHours = 0
+	zeroPercentUpsert := validUpsert
+	zeroPercentUpsert.ChannelId = "channel-2"
+	zeroPercentUpsert.MaxPercentSend = sdkmath.ZeroInt()
+	zeroPercentUpsert.MaxPercentRecv = sdkmath.ZeroInt()
+
+	msg := types.MsgBatchUpdateRateLimits{
+		Authority: authtypes.NewModuleAddress(govtypes.ModuleName).String(),
+		Upserts:   []types.RateLimitUpsert{validUpsert, zeroDurationUpsert, zeroPercentUpsert},
+		Removals: []types.Path{
+			{Denom: "denomB", ChannelId: "channel-"},
+			{Denom: "denomA", ChannelId: "channel-0"},
+		},
+	}
+
+	// Every invalid entry should be reported, not just the first
+	err := msg.ValidateBasic()
+	require.ErrorIs(t, err, sdkerrors.ErrInvalidRequest)
+	for _, expectedErr := range []string{
+		"invalid upsert 1 (denom: denomA, channel: channel-1): duration can not be zero",
+		"invalid upsert 2 (denom: denomA, channel: channel-2)",
+		"invalid removal 0 (denom: denomB, channel: channel-)",
+		"rate limit (denom: denomA, channel: channel-0) is specified more than once",
+	} {
+		require.ErrorContains(t, err, expectedErr)
+	}
+	require.NotContains(t, err.Error(), "invalid upsert 0", "valid upsert should not be reported")
+}
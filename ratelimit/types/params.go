@@ -1,32 +1,69 @@
 package types
 
 import (
+	"fmt"
+
 	paramtypes "github.com/cosmos/cosmos-sdk/x/params/types"
 )
 
 var _ paramtypes.ParamSet = (*Params)(nil)
 
+var (
+	KeyForwardedTransferPolicy = []byte("ForwardedTransferPolicy")
+	KeyLazyQuotaReset          = []byte("LazyQuotaReset")
+)
+
 // ParamKeyTable the param key table for launch module
 func ParamKeyTable() paramtypes.KeyTable {
 	return paramtypes.NewKeyTable().RegisterParamSet(&Params{})
 }
 
 // NewParams creates a new Params instance
-func NewParams() Params {
-	return Params{}
+func NewParams(forwardedTransferPolicy ForwardedTransferPolicy, lazyQuotaReset bool) Params {
+	return Params{
+		ForwardedTransferPolicy: forwardedTransferPolicy,
+		LazyQuotaReset:          lazyQuotaReset,
+	}
 }
 
 // DefaultParams returns a default set of parameters
 func DefaultParams() Params {
-	return NewParams()
+	return NewParams(FORWARD_POLICY_COUNT_BOTH, false)
 }
 
 // ParamSetPairs get the params.ParamSet
 func (p *Params) ParamSetPairs() paramtypes.ParamSetPairs {
-	return paramtypes.ParamSetPairs{}
+	return paramtypes.ParamSetPairs{
+		paramtypes.NewParamSetPair(KeyForwardedTransferPolicy, &p.ForwardedTransferPolicy, validateForwardedTransferPolicy),
+		paramtypes.NewParamSetPair(KeyLazyQuotaReset, &p.LazyQuotaReset, validateLazyQuotaReset),
+	}
 }
 
 // Validate validates the set of params
 func (p Params) Validate() error {
+	if err := validateForwardedTransferPolicy(p.ForwardedTransferPolicy); err != nil {
+		return err
+	}
+	return validateLazyQuotaReset(p.LazyQuotaReset)
+}
+
+func validateForwardedTransferPolicy(i interface{}) error {
+	policy, ok := i.(ForwardedTransferPolicy)
+	if !ok {
+		return fmt.Errorf("invalid parameter type: %T", i)
+	}
+
+	if _, ok := ForwardedTransferPolicy_name[int32(policy)]; !ok {
+		return fmt.Errorf("invalid forwarded transfer policy: %d", policy)
+	}
+
+	return nil
+}
+
+func validateLazyQuotaReset(i interface{}) error {
+	if _, ok := i.(bool); !ok {
+		return fmt.Errorf("invalid parameter type: %T", i)
+	}
+
 	return nil
 }
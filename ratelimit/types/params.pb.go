@@ -5,6 +5,7 @@ package types
 
 import (
 	fmt "fmt"
+	_ "github.com/cosmos/gogoproto/gogoproto"
 	proto "github.com/cosmos/gogoproto/proto"
 	io "io"
 	math "math"
@@ -22,8 +23,51 @@ var _ = math.Inf
 // proto package needs to be updated.
 const _ = proto.GoGoProtoPackageIsVersion3 // please upgrade the proto package
 
+// ForwardedTransferPolicy defines how transfers that are only passing through
+// this chain via packet-forward-middleware (i.e. the recv packet contains
+// a "forward" memo) are counted towards the rate limit quotas
+type ForwardedTransferPolicy int32
+
+const (
+	// Both the inbound and outbound legs are counted against their respective
+	// channel's quota (same as any other transfer)
+	FORWARD_POLICY_COUNT_BOTH ForwardedTransferPolicy = 0
+	// Only the outbound hop is counted; the inbound leg is not charged against
+	// the inflow quota of the receiving channel
+	FORWARD_POLICY_NET_HOP ForwardedTransferPolicy = 1
+	// Neither leg is counted towards the rate limit quotas
+	FORWARD_POLICY_EXEMPT ForwardedTransferPolicy = 2
+)
+
+var ForwardedTransferPolicy_name = map[int32]string{
+	0: "FORWARD_POLICY_COUNT_BOTH",
+	1: "FORWARD_POLICY_NET_HOP",
+	2: "FORWARD_POLICY_EXEMPT",
+}
+
+var ForwardedTransferPolicy_value = map[string]int32{
+	"FORWARD_POLICY_COUNT_BOTH": 0,
+	"FORWARD_POLICY_NET_HOP":    1,
+	"FORWARD_POLICY_EXEMPT":     2,
+}
+
+func (x ForwardedTransferPolicy) String() string {
+	return proto.EnumName(ForwardedTransferPolicy_name, int32(x))
+}
+
+func (ForwardedTransferPolicy) EnumDescriptor() ([]byte, []int) {
+	return fileDescriptor_3a98f618ae7612ca, []int{0}
+}
+
 // Params defines the ratelimit module's parameters.
 type Params struct {
+	// ForwardedTransferPolicy specifies how multi-hop transfers routed through
+	// this chain by packet-forward-middleware are counted
+	ForwardedTransferPolicy ForwardedTransferPolicy `protobuf:"varint,1,opt,name=forwarded_transfer_policy,json=forwardedTransferPolicy,proto3,enum=ratelimit.v1.ForwardedTransferPolicy" json:"forwarded_transfer_policy,omitempty" yaml:"forwarded_transfer_policy"`
+	// LazyQuotaReset disables the reset of every rate limit at the start of
+	// each quota window in BeginBlocker. Instead, a rate limit's flow is reset
+	// the first time it's touched in a new window
+	LazyQuotaReset bool `protobuf:"varint,2,opt,name=lazy_quota_reset,json=lazyQuotaReset,proto3" json:"lazy_quota_reset,omitempty" yaml:"lazy_quota_reset"`
 }
 
 func (m *Params) Reset()         { *m = Params{} }
@@ -59,23 +103,52 @@ func (m *Params) XXX_DiscardUnknown() {
 
 var xxx_messageInfo_Params proto.InternalMessageInfo
 
+func (m *Params) GetForwardedTransferPolicy() ForwardedTransferPolicy {
+	if m != nil {
+		return m.ForwardedTransferPolicy
+	}
+	return FORWARD_POLICY_COUNT_BOTH
+}
+
+func (m *Params) GetLazyQuotaReset() bool {
+	if m != nil {
+		return m.LazyQuotaReset
+	}
+	return false
+}
+
 func init() {
+	proto.RegisterEnum("ratelimit.v1.ForwardedTransferPolicy", ForwardedTransferPolicy_name, ForwardedTransferPolicy_value)
 	proto.RegisterType((*Params)(nil), "ratelimit.v1.Params")
 }
 
 func init() { proto.RegisterFile("ratelimit/v1/params.proto", fileDescriptor_3a98f618ae7612ca) }
 
 var fileDescriptor_3a98f618ae7612ca = []byte{
-	// 143 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xe2, 0x92, 0x2c, 0x4a, 0x2c, 0x49,
-	0xcd, 0xc9, 0xcc, 0xcd, 0x2c, 0xd1, 0x2f, 0x33, 0xd4, 0x2f, 0x48, 0x2c, 0x4a, 0xcc, 0x2d, 0xd6,
-	0x2b, 0x28, 0xca, 0x2f, 0xc9, 0x17, 0xe2, 0x81, 0x4b, 0xe9, 0x95, 0x19, 0x2a, 0x71, 0x70, 0xb1,
-	0x05, 0x80, 0x65, 0x9d, 0x82, 0x4e, 0x3c, 0x92, 0x63, 0xbc, 0xf0, 0x48, 0x8e, 0xf1, 0xc1, 0x23,
-	0x39, 0xc6, 0x09, 0x8f, 0xe5, 0x18, 0x2e, 0x3c, 0x96, 0x63, 0xb8, 0xf1, 0x58, 0x8e, 0x21, 0xca,
-	0x22, 0x3d, 0xb3, 0x24, 0xa3, 0x34, 0x49, 0x2f, 0x39, 0x3f, 0x57, 0x3f, 0xb8, 0xa4, 0x28, 0x33,
-	0x25, 0x55, 0xd7, 0x27, 0x31, 0xa9, 0x58, 0x3f, 0x33, 0x29, 0x59, 0x17, 0x64, 0x98, 0x2e, 0xd8,
-	0xb4, 0xcc, 0xbc, 0x74, 0x7d, 0x84, 0xad, 0x25, 0x95, 0x05, 0xa9, 0xc5, 0x49, 0x6c, 0x60, 0x2b,
-	0x8d, 0x01, 0x01, 0x00, 0x00, 0xff, 0xff, 0xfc, 0x5d, 0x17, 0x50, 0x8f, 0x00, 0x00, 0x00,
+	// 357 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x74, 0x91, 0xdd, 0x6a, 0xe2, 0x40,
+	0x18, 0x86, 0x33, 0xb2, 0xc8, 0x32, 0x2c, 0x12, 0xc2, 0xee, 0x6a, 0x5c, 0x36, 0x4a, 0xd8, 0x05,
+	0x29, 0x98, 0x60, 0x7b, 0x52, 0x7a, 0xd6, 0xd8, 0x88, 0x05, 0x6b, 0xd2, 0x34, 0xa5, 0x3f, 0x27,
+	0xc3, 0x44, 0x63, 0x1a, 0x48, 0x9c, 0x74, 0x32, 0x5a, 0xd2, 0x1b, 0x68, 0x0f, 0x7b, 0x0f, 0xbd,
+	0x99, 0x1e, 0x7a, 0xd8, 0x9e, 0x48, 0xd1, 0x3b, 0xf0, 0x0a, 0x4a, 0x62, 0xff, 0x90, 0x7a, 0x36,
+	0xbc, 0xcf, 0xf3, 0xbd, 0x0c, 0xdf, 0x07, 0x45, 0x8a, 0x99, 0x1b, 0xf8, 0xa1, 0xcf, 0xd4, 0x71,
+	0x43, 0x8d, 0x30, 0xc5, 0x61, 0xac, 0x44, 0x94, 0x30, 0x22, 0xfc, 0x78, 0x47, 0xca, 0xb8, 0x51,
+	0xfe, 0xe9, 0x11, 0x8f, 0x64, 0x40, 0x4d, 0x5f, 0x4b, 0x47, 0x7e, 0x02, 0x30, 0x6f, 0x66, 0x43,
+	0xc2, 0x0d, 0x80, 0xe2, 0x80, 0xd0, 0x2b, 0x4c, 0xfb, 0x6e, 0x1f, 0x31, 0x8a, 0x87, 0xf1, 0xc0,
+	0xa5, 0x28, 0x22, 0x81, 0xdf, 0x4b, 0x4a, 0xa0, 0x0a, 0x6a, 0x85, 0xcd, 0xff, 0xca, 0xe7, 0x4e,
+	0xa5, 0xf5, 0xa6, 0xdb, 0xaf, 0xb6, 0x99, 0xc9, 0xda, 0xbf, 0xc5, 0xb4, 0x52, 0x4d, 0x70, 0x18,
+	0xec, 0xc8, 0x6b, 0x1b, 0x65, 0xab, 0x38, 0xf8, 0x7a, 0x5c, 0xd0, 0x21, 0x1f, 0xe0, 0xeb, 0x04,
+	0x5d, 0x8e, 0x08, 0xc3, 0x88, 0xba, 0xb1, 0xcb, 0x4a, 0xb9, 0x2a, 0xa8, 0x7d, 0xd7, 0xfe, 0x2c,
+	0xa6, 0x95, 0xe2, 0xb2, 0x78, 0xd5, 0x90, 0xad, 0x42, 0x1a, 0x1d, 0xa6, 0x89, 0x95, 0x06, 0x1b,
+	0x23, 0x58, 0x5c, 0xf3, 0x41, 0xe1, 0x2f, 0x14, 0x5b, 0x86, 0x75, 0xb2, 0x6b, 0xed, 0x21, 0xd3,
+	0xe8, 0xec, 0x37, 0xcf, 0x50, 0xd3, 0x38, 0xee, 0xda, 0x48, 0x33, 0xec, 0x36, 0xcf, 0x09, 0x65,
+	0xf8, 0x7b, 0x05, 0x77, 0x75, 0x1b, 0xb5, 0x0d, 0x93, 0x07, 0x82, 0x08, 0x7f, 0xad, 0x30, 0xfd,
+	0x54, 0x3f, 0x30, 0x6d, 0x3e, 0x57, 0xfe, 0x76, 0x7b, 0x2f, 0x71, 0x9a, 0xf5, 0x30, 0x93, 0xc0,
+	0x64, 0x26, 0x81, 0xe7, 0x99, 0x04, 0xee, 0xe6, 0x12, 0x37, 0x99, 0x4b, 0xdc, 0xe3, 0x5c, 0xe2,
+	0xce, 0xb7, 0x3d, 0x9f, 0x5d, 0x8c, 0x1c, 0xa5, 0x47, 0x42, 0xf5, 0x88, 0x51, 0xbf, 0xef, 0xd6,
+	0x3b, 0xd8, 0x89, 0x55, 0xdf, 0xe9, 0xd5, 0xd3, 0xbd, 0xd6, 0xb3, 0xc5, 0xfa, 0x43, 0x4f, 0xfd,
+	0x38, 0x2a, 0x4b, 0x22, 0x37, 0x76, 0xf2, 0xd9, 0xb5, 0xb6, 0x5e, 0x06, 0x00, 0xfc, 0x7e, 0xc0,
+	0x12, 0xee, 0x01, 0x00, 0x00,
 }
 
 func (m *Params) Marshal() (dAtA []byte, err error) {
@@ -98,6 +171,21 @@ func (m *Params) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	_ = i
 	var l int
 	_ = l
+	if m.LazyQuotaReset {
+		i--
+		if m.LazyQuotaReset {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i--
+		dAtA[i] = 0x10
+	}
+	if m.ForwardedTransferPolicy != 0 {
+		i = encodeVarintParams(dAtA, i, uint64(m.ForwardedTransferPolicy))
+		i--
+		dAtA[i] = 0x8
+	}
 	return len(dAtA) - i, nil
 }
 
@@ -118,6 +206,12 @@ func (m *Params) Size() (n int) {
 	}
 	var l int
 	_ = l
+	if m.ForwardedTransferPolicy != 0 {
+		n += 1 + sovParams(uint64(m.ForwardedTransferPolicy))
+	}
+	if m.LazyQuotaReset {
+		n += 2
+	}
 	return n
 }
 
@@ -156,6 +250,45 @@ func (m *Params) Unmarshal(dAtA []byte) error {
 			return fmt.Errorf("proto: Params: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ForwardedTransferPolicy", wireType)
+			}
+			m.ForwardedTransferPolicy = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowParams
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.ForwardedTransferPolicy |= ForwardedTransferPolicy(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field LazyQuotaReset", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowParams
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.LazyQuotaReset = bool(v != 0)
 		default:
 			iNdEx = preIndex
 			skippy, err := skipParams(dAtA[iNdEx:])
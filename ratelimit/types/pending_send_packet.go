@@ -0,0 +1,37 @@
+package types
+
+import (
+	"fmt"
+	"strings"
+
+	errorsmod "cosmossdk.io/errors"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+)
+
+// Validate performs stateless validation of a pending send packet
+func (p PendingSendPacket) Validate() error {
+	if !strings.HasPrefix(p.ChannelId, "channel-") {
+		return fmt.Errorf("invalid channel ID (%s) in pending send packet", p.ChannelId)
+	}
+	if err := p.Amount.Validate(); err != nil {
+		return errorsmod.Wrapf(err, "invalid amount in pending send packet (%s/%d)", p.ChannelId, p.Sequence)
+	}
+	if err := p.Value.Validate(); err != nil {
+		return errorsmod.Wrapf(err, "invalid value in pending send packet (%s/%d)", p.ChannelId, p.Sequence)
+	}
+	if p.Direction != PACKET_SEND {
+		return fmt.Errorf("invalid direction (%s) in pending send packet (%s/%d), must be a send", p.Direction, p.ChannelId, p.Sequence)
+	}
+	return nil
+}
+
+// Returns the value charged to the value quota of the rate limit for the given denom
+// (zero if the rate limit doesn't have a value quota)
+func (p PendingSendPacket) GetValueOf(denom string) sdk.Dec {
+	for _, value := range p.Value {
+		if value.Denom == denom {
+			return value.Amount
+		}
+	}
+	return sdk.ZeroDec()
+}
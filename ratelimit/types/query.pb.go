@@ -6,9 +6,13 @@ package types
 import (
 	context "context"
 	fmt "fmt"
+	github_com_cosmos_cosmos_sdk_types "github.com/cosmos/cosmos-sdk/types"
+	query "github.com/cosmos/cosmos-sdk/types/query"
 	_ "github.com/cosmos/gogoproto/gogoproto"
 	grpc1 "github.com/cosmos/gogoproto/grpc"
 	proto "github.com/cosmos/gogoproto/proto"
+	_ "github.com/cosmos/gogoproto/types"
+	github_com_cosmos_gogoproto_types "github.com/cosmos/gogoproto/types"
 	_ "google.golang.org/genproto/googleapis/api/annotations"
 	grpc "google.golang.org/grpc"
 	codes "google.golang.org/grpc/codes"
@@ -16,12 +20,14 @@ import (
 	io "io"
 	math "math"
 	math_bits "math/bits"
+	time "time"
 )
 
 // Reference imports to suppress errors if they are not otherwise used.
 var _ = proto.Marshal
 var _ = fmt.Errorf
 var _ = math.Inf
+var _ = time.Kitchen
 
 // This is a compile-time assertion to ensure that this generated file
 // is compatible with the proto package it is being compiled against.
@@ -30,7 +36,16 @@ var _ = math.Inf
 const _ = proto.GoGoProtoPackageIsVersion3 // please upgrade the proto package
 
 // Queries all rate limits
+// Each of the filters is optional
 type QueryAllRateLimitsRequest struct {
+	Pagination *query.PageRequest `protobuf:"bytes,1,opt,name=pagination,proto3" json:"pagination,omitempty"`
+	// Only return rate limits with the given denom
+	Denom string `protobuf:"bytes,2,opt,name=denom,proto3" json:"denom,omitempty"`
+	// Only return rate limits on the given channel
+	ChannelId string `protobuf:"bytes,3,opt,name=channel_id,json=channelId,proto3" json:"channel_id,omitempty"`
+	// Only return rate limits where either the send or recv utilization (as a
+	// percentage of the threshold) is at least the given value (e.g. "80")
+	MinUtilization string `protobuf:"bytes,4,opt,name=min_utilization,json=minUtilization,proto3" json:"min_utilization,omitempty"`
 }
 
 func (m *QueryAllRateLimitsRequest) Reset()         { *m = QueryAllRateLimitsRequest{} }
@@ -66,8 +81,37 @@ func (m *QueryAllRateLimitsRequest) XXX_DiscardUnknown() {
 
 var xxx_messageInfo_QueryAllRateLimitsRequest proto.InternalMessageInfo
 
+func (m *QueryAllRateLimitsRequest) GetPagination() *query.PageRequest {
+	if m != nil {
+		return m.Pagination
+	}
+	return nil
+}
+
+func (m *QueryAllRateLimitsRequest) GetDenom() string {
+	if m != nil {
+		return m.Denom
+	}
+	return ""
+}
+
+func (m *QueryAllRateLimitsRequest) GetChannelId() string {
+	if m != nil {
+		return m.ChannelId
+	}
+	return ""
+}
+
+func (m *QueryAllRateLimitsRequest) GetMinUtilization() string {
+	if m != nil {
+		return m.MinUtilization
+	}
+	return ""
+}
+
 type QueryAllRateLimitsResponse struct {
-	RateLimits []RateLimit `protobuf:"bytes,1,rep,name=rate_limits,json=rateLimits,proto3" json:"rate_limits"`
+	RateLimits []RateLimit         `protobuf:"bytes,1,rep,name=rate_limits,json=rateLimits,proto3" json:"rate_limits"`
+	Pagination *query.PageResponse `protobuf:"bytes,2,opt,name=pagination,proto3" json:"pagination,omitempty"`
 }
 
 func (m *QueryAllRateLimitsResponse) Reset()         { *m = QueryAllRateLimitsResponse{} }
@@ -110,6 +154,13 @@ func (m *QueryAllRateLimitsResponse) GetRateLimits() []RateLimit {
 	return nil
 }
 
+func (m *QueryAllRateLimitsResponse) GetPagination() *query.PageResponse {
+	if m != nil {
+		return m.Pagination
+	}
+	return nil
+}
+
 // Queries a specific rate limit by channel ID and denom
 type QueryRateLimitRequest struct {
 	Denom     string `protobuf:"bytes,1,opt,name=denom,proto3" json:"denom,omitempty"`
@@ -298,7 +349,8 @@ func (m *QueryRateLimitsByChainIdResponse) GetRateLimits() []RateLimit {
 
 // Queries all the rate limits for a given channel ID
 type QueryRateLimitsByChannelIdRequest struct {
-	ChannelId string `protobuf:"bytes,1,opt,name=channel_id,json=channelId,proto3" json:"channel_id,omitempty"`
+	ChannelId  string             `protobuf:"bytes,1,opt,name=channel_id,json=channelId,proto3" json:"channel_id,omitempty"`
+	Pagination *query.PageRequest `protobuf:"bytes,2,opt,name=pagination,proto3" json:"pagination,omitempty"`
 }
 
 func (m *QueryRateLimitsByChannelIdRequest) Reset()         { *m = QueryRateLimitsByChannelIdRequest{} }
@@ -341,8 +393,16 @@ func (m *QueryRateLimitsByChannelIdRequest) GetChannelId() string {
 	return ""
 }
 
+func (m *QueryRateLimitsByChannelIdRequest) GetPagination() *query.PageRequest {
+	if m != nil {
+		return m.Pagination
+	}
+	return nil
+}
+
 type QueryRateLimitsByChannelIdResponse struct {
-	RateLimits []RateLimit `protobuf:"bytes,1,rep,name=rate_limits,json=rateLimits,proto3" json:"rate_limits"`
+	RateLimits []RateLimit         `protobuf:"bytes,1,rep,name=rate_limits,json=rateLimits,proto3" json:"rate_limits"`
+	Pagination *query.PageResponse `protobuf:"bytes,2,opt,name=pagination,proto3" json:"pagination,omitempty"`
 }
 
 func (m *QueryRateLimitsByChannelIdResponse) Reset()         { *m = QueryRateLimitsByChannelIdResponse{} }
@@ -385,15 +445,128 @@ func (m *QueryRateLimitsByChannelIdResponse) GetRateLimits() []RateLimit {
 	return nil
 }
 
+func (m *QueryRateLimitsByChannelIdResponse) GetPagination() *query.PageResponse {
+	if m != nil {
+		return m.Pagination
+	}
+	return nil
+}
+
+// Queries all the rate limits for a given denom
+type QueryRateLimitsByDenomRequest struct {
+	Denom      string             `protobuf:"bytes,1,opt,name=denom,proto3" json:"denom,omitempty"`
+	Pagination *query.PageRequest `protobuf:"bytes,2,opt,name=pagination,proto3" json:"pagination,omitempty"`
+}
+
+func (m *QueryRateLimitsByDenomRequest) Reset()         { *m = QueryRateLimitsByDenomRequest{} }
+func (m *QueryRateLimitsByDenomRequest) String() string { return proto.CompactTextString(m) }
+func (*QueryRateLimitsByDenomRequest) ProtoMessage()    {}
+func (*QueryRateLimitsByDenomRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_d909918e357d6d0b, []int{8}
+}
+func (m *QueryRateLimitsByDenomRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryRateLimitsByDenomRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryRateLimitsByDenomRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *QueryRateLimitsByDenomRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryRateLimitsByDenomRequest.Merge(m, src)
+}
+func (m *QueryRateLimitsByDenomRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryRateLimitsByDenomRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryRateLimitsByDenomRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QueryRateLimitsByDenomRequest proto.InternalMessageInfo
+
+func (m *QueryRateLimitsByDenomRequest) GetDenom() string {
+	if m != nil {
+		return m.Denom
+	}
+	return ""
+}
+
+func (m *QueryRateLimitsByDenomRequest) GetPagination() *query.PageRequest {
+	if m != nil {
+		return m.Pagination
+	}
+	return nil
+}
+
+type QueryRateLimitsByDenomResponse struct {
+	RateLimits []RateLimit         `protobuf:"bytes,1,rep,name=rate_limits,json=rateLimits,proto3" json:"rate_limits"`
+	Pagination *query.PageResponse `protobuf:"bytes,2,opt,name=pagination,proto3" json:"pagination,omitempty"`
+}
+
+func (m *QueryRateLimitsByDenomResponse) Reset()         { *m = QueryRateLimitsByDenomResponse{} }
+func (m *QueryRateLimitsByDenomResponse) String() string { return proto.CompactTextString(m) }
+func (*QueryRateLimitsByDenomResponse) ProtoMessage()    {}
+func (*QueryRateLimitsByDenomResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_d909918e357d6d0b, []int{9}
+}
+func (m *QueryRateLimitsByDenomResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryRateLimitsByDenomResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryRateLimitsByDenomResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *QueryRateLimitsByDenomResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryRateLimitsByDenomResponse.Merge(m, src)
+}
+func (m *QueryRateLimitsByDenomResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryRateLimitsByDenomResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryRateLimitsByDenomResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QueryRateLimitsByDenomResponse proto.InternalMessageInfo
+
+func (m *QueryRateLimitsByDenomResponse) GetRateLimits() []RateLimit {
+	if m != nil {
+		return m.RateLimits
+	}
+	return nil
+}
+
+func (m *QueryRateLimitsByDenomResponse) GetPagination() *query.PageResponse {
+	if m != nil {
+		return m.Pagination
+	}
+	return nil
+}
+
 // Queries all blacklisted denoms
 type QueryAllBlacklistedDenomsRequest struct {
+	Pagination *query.PageRequest `protobuf:"bytes,1,opt,name=pagination,proto3" json:"pagination,omitempty"`
 }
 
 func (m *QueryAllBlacklistedDenomsRequest) Reset()         { *m = QueryAllBlacklistedDenomsRequest{} }
 func (m *QueryAllBlacklistedDenomsRequest) String() string { return proto.CompactTextString(m) }
 func (*QueryAllBlacklistedDenomsRequest) ProtoMessage()    {}
 func (*QueryAllBlacklistedDenomsRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_d909918e357d6d0b, []int{8}
+	return fileDescriptor_d909918e357d6d0b, []int{10}
 }
 func (m *QueryAllBlacklistedDenomsRequest) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -422,15 +595,23 @@ func (m *QueryAllBlacklistedDenomsRequest) XXX_DiscardUnknown() {
 
 var xxx_messageInfo_QueryAllBlacklistedDenomsRequest proto.InternalMessageInfo
 
+func (m *QueryAllBlacklistedDenomsRequest) GetPagination() *query.PageRequest {
+	if m != nil {
+		return m.Pagination
+	}
+	return nil
+}
+
 type QueryAllBlacklistedDenomsResponse struct {
-	Denoms []string `protobuf:"bytes,1,rep,name=denoms,proto3" json:"denoms,omitempty"`
+	Denoms     []string            `protobuf:"bytes,1,rep,name=denoms,proto3" json:"denoms,omitempty"`
+	Pagination *query.PageResponse `protobuf:"bytes,2,opt,name=pagination,proto3" json:"pagination,omitempty"`
 }
 
 func (m *QueryAllBlacklistedDenomsResponse) Reset()         { *m = QueryAllBlacklistedDenomsResponse{} }
 func (m *QueryAllBlacklistedDenomsResponse) String() string { return proto.CompactTextString(m) }
 func (*QueryAllBlacklistedDenomsResponse) ProtoMessage()    {}
 func (*QueryAllBlacklistedDenomsResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_d909918e357d6d0b, []int{9}
+	return fileDescriptor_d909918e357d6d0b, []int{11}
 }
 func (m *QueryAllBlacklistedDenomsResponse) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -466,15 +647,23 @@ func (m *QueryAllBlacklistedDenomsResponse) GetDenoms() []string {
 	return nil
 }
 
+func (m *QueryAllBlacklistedDenomsResponse) GetPagination() *query.PageResponse {
+	if m != nil {
+		return m.Pagination
+	}
+	return nil
+}
+
 // Queries all whitelisted address pairs
 type QueryAllWhitelistedAddressesRequest struct {
+	Pagination *query.PageRequest `protobuf:"bytes,1,opt,name=pagination,proto3" json:"pagination,omitempty"`
 }
 
 func (m *QueryAllWhitelistedAddressesRequest) Reset()         { *m = QueryAllWhitelistedAddressesRequest{} }
 func (m *QueryAllWhitelistedAddressesRequest) String() string { return proto.CompactTextString(m) }
 func (*QueryAllWhitelistedAddressesRequest) ProtoMessage()    {}
 func (*QueryAllWhitelistedAddressesRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_d909918e357d6d0b, []int{10}
+	return fileDescriptor_d909918e357d6d0b, []int{12}
 }
 func (m *QueryAllWhitelistedAddressesRequest) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -503,15 +692,23 @@ func (m *QueryAllWhitelistedAddressesRequest) XXX_DiscardUnknown() {
 
 var xxx_messageInfo_QueryAllWhitelistedAddressesRequest proto.InternalMessageInfo
 
+func (m *QueryAllWhitelistedAddressesRequest) GetPagination() *query.PageRequest {
+	if m != nil {
+		return m.Pagination
+	}
+	return nil
+}
+
 type QueryAllWhitelistedAddressesResponse struct {
 	AddressPairs []WhitelistedAddressPair `protobuf:"bytes,1,rep,name=address_pairs,json=addressPairs,proto3" json:"address_pairs"`
+	Pagination   *query.PageResponse      `protobuf:"bytes,2,opt,name=pagination,proto3" json:"pagination,omitempty"`
 }
 
 func (m *QueryAllWhitelistedAddressesResponse) Reset()         { *m = QueryAllWhitelistedAddressesResponse{} }
 func (m *QueryAllWhitelistedAddressesResponse) String() string { return proto.CompactTextString(m) }
 func (*QueryAllWhitelistedAddressesResponse) ProtoMessage()    {}
 func (*QueryAllWhitelistedAddressesResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_d909918e357d6d0b, []int{11}
+	return fileDescriptor_d909918e357d6d0b, []int{13}
 }
 func (m *QueryAllWhitelistedAddressesResponse) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -547,904 +744,6558 @@ func (m *QueryAllWhitelistedAddressesResponse) GetAddressPairs() []WhitelistedAd
 	return nil
 }
 
-func init() {
-	proto.RegisterType((*QueryAllRateLimitsRequest)(nil), "ratelimit.v1.QueryAllRateLimitsRequest")
-	proto.RegisterType((*QueryAllRateLimitsResponse)(nil), "ratelimit.v1.QueryAllRateLimitsResponse")
-	proto.RegisterType((*QueryRateLimitRequest)(nil), "ratelimit.v1.QueryRateLimitRequest")
-	proto.RegisterType((*QueryRateLimitResponse)(nil), "ratelimit.v1.QueryRateLimitResponse")
-	proto.RegisterType((*QueryRateLimitsByChainIdRequest)(nil), "ratelimit.v1.QueryRateLimitsByChainIdRequest")
-	proto.RegisterType((*QueryRateLimitsByChainIdResponse)(nil), "ratelimit.v1.QueryRateLimitsByChainIdResponse")
-	proto.RegisterType((*QueryRateLimitsByChannelIdRequest)(nil), "ratelimit.v1.QueryRateLimitsByChannelIdRequest")
-	proto.RegisterType((*QueryRateLimitsByChannelIdResponse)(nil), "ratelimit.v1.QueryRateLimitsByChannelIdResponse")
-	proto.RegisterType((*QueryAllBlacklistedDenomsRequest)(nil), "ratelimit.v1.QueryAllBlacklistedDenomsRequest")
-	proto.RegisterType((*QueryAllBlacklistedDenomsResponse)(nil), "ratelimit.v1.QueryAllBlacklistedDenomsResponse")
-	proto.RegisterType((*QueryAllWhitelistedAddressesRequest)(nil), "ratelimit.v1.QueryAllWhitelistedAddressesRequest")
-	proto.RegisterType((*QueryAllWhitelistedAddressesResponse)(nil), "ratelimit.v1.QueryAllWhitelistedAddressesResponse")
+func (m *QueryAllWhitelistedAddressesResponse) GetPagination() *query.PageResponse {
+	if m != nil {
+		return m.Pagination
+	}
+	return nil
 }
 
-func init() { proto.RegisterFile("ratelimit/v1/query.proto", fileDescriptor_d909918e357d6d0b) }
-
-var fileDescriptor_d909918e357d6d0b = []byte{
-	// 692 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xac, 0x56, 0xcf, 0x4f, 0xd4, 0x4e,
-	0x14, 0xdf, 0xe1, 0xfb, 0x05, 0xd9, 0x07, 0x5c, 0x46, 0x7e, 0x2c, 0x15, 0x17, 0x2c, 0x18, 0xb9,
-	0x6c, 0x2b, 0x10, 0x8d, 0x09, 0x4a, 0x60, 0x31, 0x06, 0x0c, 0x89, 0x58, 0x0f, 0x26, 0xc6, 0x64,
-	0x33, 0x6d, 0x27, 0xdd, 0x89, 0xa5, 0x5d, 0x3a, 0x05, 0xb2, 0x31, 0x5c, 0xfc, 0x0b, 0x4c, 0xfc,
-	0x03, 0xbc, 0xfa, 0x47, 0x78, 0xf4, 0xc0, 0x91, 0xc4, 0x8b, 0x27, 0x63, 0x80, 0x3f, 0xc4, 0x74,
-	0x3a, 0x6d, 0x2d, 0x74, 0x97, 0x85, 0x70, 0x9b, 0xce, 0x7b, 0xef, 0xf3, 0x3e, 0x9f, 0x37, 0xef,
-	0xbd, 0x14, 0x2a, 0x01, 0x09, 0xa9, 0xcb, 0x76, 0x58, 0xa8, 0xef, 0x2f, 0xe8, 0xbb, 0x7b, 0x34,
-	0x68, 0x6b, 0xad, 0xc0, 0x0f, 0x7d, 0x3c, 0x9c, 0x5a, 0xb4, 0xfd, 0x05, 0x65, 0x2a, 0xe7, 0x97,
-	0x99, 0x84, 0xaf, 0x32, 0xe5, 0xf8, 0xbe, 0xe3, 0x52, 0x9d, 0xb4, 0x98, 0x4e, 0x3c, 0xcf, 0x0f,
-	0x49, 0xc8, 0x7c, 0x8f, 0x4b, 0xeb, 0xa8, 0xe3, 0x3b, 0xbe, 0x38, 0xea, 0xd1, 0x29, 0xbe, 0x55,
-	0xef, 0xc0, 0xe4, 0xeb, 0x28, 0xdd, 0x9a, 0xeb, 0x1a, 0x24, 0xa4, 0x5b, 0x11, 0x1c, 0x37, 0xe8,
-	0xee, 0x1e, 0xe5, 0xa1, 0xfa, 0x1e, 0x94, 0x22, 0x23, 0x6f, 0xf9, 0x1e, 0xa7, 0x78, 0x05, 0x86,
-	0x22, 0x06, 0x0d, 0x41, 0x81, 0x57, 0xd0, 0xcc, 0x7f, 0xf3, 0x43, 0x8b, 0x13, 0xda, 0xbf, 0x84,
-	0xb5, 0x34, 0xac, 0xfe, 0xff, 0xd1, 0xef, 0xe9, 0x92, 0x01, 0x41, 0x8a, 0xa3, 0x6e, 0xc1, 0x98,
-	0x40, 0x4f, 0x7d, 0x64, 0x5a, 0x3c, 0x0a, 0xfd, 0x36, 0xf5, 0xfc, 0x9d, 0x0a, 0x9a, 0x41, 0xf3,
-	0x65, 0x23, 0xfe, 0xc0, 0x77, 0x01, 0xac, 0x26, 0xf1, 0x3c, 0xea, 0x36, 0x98, 0x5d, 0xe9, 0x13,
-	0xa6, 0xb2, 0xbc, 0xd9, 0xb4, 0xd5, 0x6d, 0x18, 0x3f, 0x8f, 0x26, 0x79, 0x3e, 0x06, 0xc8, 0x78,
-	0x0a, 0xcc, 0xce, 0x34, 0x8d, 0x72, 0x4a, 0x50, 0x7d, 0x0a, 0xd3, 0x79, 0x44, 0x5e, 0x6f, 0xaf,
-	0x37, 0x09, 0xf3, 0x36, 0xed, 0x84, 0xe9, 0x24, 0x0c, 0x5a, 0xd1, 0x4d, 0xc4, 0x28, 0x26, 0x7b,
-	0xcb, 0x8a, 0x3d, 0x54, 0x13, 0x66, 0x3a, 0x47, 0xdf, 0x50, 0x05, 0xeb, 0x70, 0xaf, 0x28, 0x47,
-	0x5c, 0x91, 0x84, 0x63, 0xbe, 0x6e, 0xe8, 0x7c, 0xdd, 0x6c, 0x50, 0xbb, 0x61, 0xdc, 0x10, 0x53,
-	0x55, 0x56, 0x63, 0xcd, 0x75, 0xeb, 0x2e, 0xb1, 0x3e, 0xb8, 0x8c, 0x87, 0xd4, 0x7e, 0x1e, 0x3d,
-	0x6c, 0xda, 0x6d, 0xcb, 0x52, 0x4d, 0xb1, 0x8f, 0x24, 0x32, 0x0e, 0x03, 0xa2, 0x1d, 0x62, 0x0e,
-	0x65, 0x43, 0x7e, 0xa9, 0xf7, 0x61, 0x36, 0x09, 0x7e, 0xdb, 0x64, 0x11, 0xab, 0x28, 0x78, 0xcd,
-	0xb6, 0x03, 0xca, 0x39, 0x4d, 0x73, 0x1c, 0xc0, 0x5c, 0x77, 0x37, 0x99, 0xe6, 0x15, 0x8c, 0x90,
-	0xf8, 0xb2, 0xd1, 0x22, 0x2c, 0x48, 0x14, 0xcf, 0xe5, 0x15, 0x5f, 0x84, 0xd8, 0x26, 0x2c, 0x90,
-	0xf2, 0x87, 0x49, 0x76, 0xc5, 0x17, 0xcf, 0x06, 0xa1, 0x5f, 0x64, 0xc6, 0x5f, 0x11, 0x8c, 0xe4,
-	0x06, 0x0a, 0x3f, 0xc8, 0xa3, 0x76, 0x9c, 0x47, 0x65, 0xfe, 0x72, 0xc7, 0x98, 0xbf, 0xba, 0xfc,
-	0xe9, 0xe7, 0xd9, 0x97, 0xbe, 0x47, 0x78, 0x49, 0x7f, 0x13, 0x06, 0xcc, 0xa6, 0xb5, 0x2d, 0x62,
-	0x72, 0x9d, 0x99, 0x56, 0x2d, 0x42, 0xa8, 0x09, 0x08, 0xe6, 0x39, 0xd9, 0x0a, 0xc9, 0x4e, 0x1c,
-	0x7f, 0x43, 0x50, 0x4e, 0x31, 0xf1, 0x6c, 0x41, 0xd2, 0xf3, 0x23, 0xab, 0xcc, 0x75, 0x77, 0x92,
-	0xac, 0xb6, 0x05, 0xab, 0x97, 0x78, 0xe3, 0xea, 0xac, 0xf4, 0x8f, 0x59, 0x13, 0x1f, 0xea, 0x66,
-	0xbb, 0x11, 0x2f, 0x85, 0xef, 0x08, 0x6e, 0x17, 0x4c, 0x18, 0xae, 0x75, 0xe3, 0x73, 0x61, 0x8e,
-	0x15, 0xad, 0x57, 0x77, 0x29, 0xe4, 0x85, 0x10, 0xb2, 0x8a, 0x57, 0xae, 0x51, 0x5e, 0xa1, 0x44,
-	0xac, 0x8c, 0x43, 0xfc, 0x03, 0xc1, 0x58, 0xe1, 0xe0, 0x61, 0xfd, 0x72, 0x46, 0xb9, 0x31, 0x57,
-	0x1e, 0xf6, 0x1e, 0x20, 0x45, 0x6c, 0x08, 0x11, 0x75, 0xbc, 0x7a, 0x5d, 0x11, 0xc9, 0x73, 0x44,
-	0xaf, 0x30, 0x5a, 0x34, 0xb5, 0x58, 0x2b, 0x6e, 0xd8, 0x4e, 0x2b, 0x40, 0xd1, 0x7b, 0xf6, 0x97,
-	0x1a, 0xd6, 0x85, 0x86, 0x67, 0x78, 0xb9, 0x67, 0x0d, 0x66, 0x86, 0x15, 0xf7, 0x10, 0xc7, 0x47,
-	0x08, 0x26, 0x3a, 0x2c, 0x04, 0xbc, 0x50, 0xcc, 0xa8, 0xcb, 0x8e, 0x51, 0x16, 0xaf, 0x12, 0x72,
-	0xed, 0x86, 0x3a, 0xc8, 0xe0, 0x1a, 0x24, 0xc1, 0xab, 0x1b, 0x47, 0x27, 0x55, 0x74, 0x7c, 0x52,
-	0x45, 0x7f, 0x4e, 0xaa, 0xe8, 0xf3, 0x69, 0xb5, 0x74, 0x7c, 0x5a, 0x2d, 0xfd, 0x3a, 0xad, 0x96,
-	0xde, 0x3d, 0x71, 0x58, 0xd8, 0xdc, 0x33, 0x35, 0xcb, 0xdf, 0xe9, 0x39, 0x47, 0xd8, 0x6e, 0x51,
-	0x6e, 0x0e, 0x88, 0x3f, 0x85, 0xa5, 0xbf, 0x01, 0x00, 0x00, 0xff, 0xff, 0x5f, 0x83, 0x66, 0x8e,
-	0xa5, 0x08, 0x00, 0x00,
+// Queries all transfer rules
+type QueryAllTransferRulesRequest struct {
+	Pagination *query.PageRequest `protobuf:"bytes,1,opt,name=pagination,proto3" json:"pagination,omitempty"`
 }
 
-// Reference imports to suppress errors if they are not otherwise used.
-var _ context.Context
-var _ grpc.ClientConn
-
-// This is a compile-time assertion to ensure that this generated file
-// is compatible with the grpc package it is being compiled against.
-const _ = grpc.SupportPackageIsVersion4
-
-// QueryClient is the client API for Query service.
-//
-// For semantics around ctx use and closing/ending streaming RPCs, please refer to https://godoc.org/google.golang.org/grpc#ClientConn.NewStream.
-type QueryClient interface {
-	// Queries all rate limits
-	AllRateLimits(ctx context.Context, in *QueryAllRateLimitsRequest, opts ...grpc.CallOption) (*QueryAllRateLimitsResponse, error)
-	// Queries a specific rate limit by channel ID and denom
-	// Ex:
-	//  - /ratelimit/{channel_id}/by_denom?denom={denom}
-	RateLimit(ctx context.Context, in *QueryRateLimitRequest, opts ...grpc.CallOption) (*QueryRateLimitResponse, error)
-	// Queries all the rate limits for a given chain
-	RateLimitsByChainId(ctx context.Context, in *QueryRateLimitsByChainIdRequest, opts ...grpc.CallOption) (*QueryRateLimitsByChainIdResponse, error)
-	// Queries all the rate limits for a given channel ID
-	RateLimitsByChannelId(ctx context.Context, in *QueryRateLimitsByChannelIdRequest, opts ...grpc.CallOption) (*QueryRateLimitsByChannelIdResponse, error)
-	// Queries all blacklisted denoms
-	AllBlacklistedDenoms(ctx context.Context, in *QueryAllBlacklistedDenomsRequest, opts ...grpc.CallOption) (*QueryAllBlacklistedDenomsResponse, error)
-	// Queries all whitelisted address pairs
-	AllWhitelistedAddresses(ctx context.Context, in *QueryAllWhitelistedAddressesRequest, opts ...grpc.CallOption) (*QueryAllWhitelistedAddressesResponse, error)
+func (m *QueryAllTransferRulesRequest) Reset()         { *m = QueryAllTransferRulesRequest{} }
+func (m *QueryAllTransferRulesRequest) String() string { return proto.CompactTextString(m) }
+func (*QueryAllTransferRulesRequest) ProtoMessage()    {}
+func (*QueryAllTransferRulesRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_d909918e357d6d0b, []int{14}
 }
-
-type queryClient struct {
-	cc grpc1.ClientConn
+func (m *QueryAllTransferRulesRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
 }
-
-func NewQueryClient(cc grpc1.ClientConn) QueryClient {
-	return &queryClient{cc}
+func (m *QueryAllTransferRulesRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryAllTransferRulesRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *QueryAllTransferRulesRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryAllTransferRulesRequest.Merge(m, src)
+}
+func (m *QueryAllTransferRulesRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryAllTransferRulesRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryAllTransferRulesRequest.DiscardUnknown(m)
 }
 
-func (c *queryClient) AllRateLimits(ctx context.Context, in *QueryAllRateLimitsRequest, opts ...grpc.CallOption) (*QueryAllRateLimitsResponse, error) {
-	out := new(QueryAllRateLimitsResponse)
-	err := c.cc.Invoke(ctx, "/ratelimit.v1.Query/AllRateLimits", in, out, opts...)
-	if err != nil {
-		return nil, err
+var xxx_messageInfo_QueryAllTransferRulesRequest proto.InternalMessageInfo
+
+func (m *QueryAllTransferRulesRequest) GetPagination() *query.PageRequest {
+	if m != nil {
+		return m.Pagination
 	}
-	return out, nil
+	return nil
 }
 
-func (c *queryClient) RateLimit(ctx context.Context, in *QueryRateLimitRequest, opts ...grpc.CallOption) (*QueryRateLimitResponse, error) {
-	out := new(QueryRateLimitResponse)
-	err := c.cc.Invoke(ctx, "/ratelimit.v1.Query/RateLimit", in, out, opts...)
-	if err != nil {
-		return nil, err
-	}
-	return out, nil
+type QueryAllTransferRulesResponse struct {
+	TransferRules []TransferRule      `protobuf:"bytes,1,rep,name=transfer_rules,json=transferRules,proto3" json:"transfer_rules"`
+	Pagination    *query.PageResponse `protobuf:"bytes,2,opt,name=pagination,proto3" json:"pagination,omitempty"`
 }
 
-func (c *queryClient) RateLimitsByChainId(ctx context.Context, in *QueryRateLimitsByChainIdRequest, opts ...grpc.CallOption) (*QueryRateLimitsByChainIdResponse, error) {
-	out := new(QueryRateLimitsByChainIdResponse)
-	err := c.cc.Invoke(ctx, "/ratelimit.v1.Query/RateLimitsByChainId", in, out, opts...)
-	if err != nil {
-		return nil, err
+func (m *QueryAllTransferRulesResponse) Reset()         { *m = QueryAllTransferRulesResponse{} }
+func (m *QueryAllTransferRulesResponse) String() string { return proto.CompactTextString(m) }
+func (*QueryAllTransferRulesResponse) ProtoMessage()    {}
+func (*QueryAllTransferRulesResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_d909918e357d6d0b, []int{15}
+}
+func (m *QueryAllTransferRulesResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryAllTransferRulesResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryAllTransferRulesResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
 	}
-	return out, nil
+}
+func (m *QueryAllTransferRulesResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryAllTransferRulesResponse.Merge(m, src)
+}
+func (m *QueryAllTransferRulesResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryAllTransferRulesResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryAllTransferRulesResponse.DiscardUnknown(m)
 }
 
-func (c *queryClient) RateLimitsByChannelId(ctx context.Context, in *QueryRateLimitsByChannelIdRequest, opts ...grpc.CallOption) (*QueryRateLimitsByChannelIdResponse, error) {
-	out := new(QueryRateLimitsByChannelIdResponse)
-	err := c.cc.Invoke(ctx, "/ratelimit.v1.Query/RateLimitsByChannelId", in, out, opts...)
-	if err != nil {
-		return nil, err
+var xxx_messageInfo_QueryAllTransferRulesResponse proto.InternalMessageInfo
+
+func (m *QueryAllTransferRulesResponse) GetTransferRules() []TransferRule {
+	if m != nil {
+		return m.TransferRules
 	}
-	return out, nil
+	return nil
 }
 
-func (c *queryClient) AllBlacklistedDenoms(ctx context.Context, in *QueryAllBlacklistedDenomsRequest, opts ...grpc.CallOption) (*QueryAllBlacklistedDenomsResponse, error) {
-	out := new(QueryAllBlacklistedDenomsResponse)
-	err := c.cc.Invoke(ctx, "/ratelimit.v1.Query/AllBlacklistedDenoms", in, out, opts...)
-	if err != nil {
-		return nil, err
+func (m *QueryAllTransferRulesResponse) GetPagination() *query.PageResponse {
+	if m != nil {
+		return m.Pagination
 	}
-	return out, nil
+	return nil
 }
 
-func (c *queryClient) AllWhitelistedAddresses(ctx context.Context, in *QueryAllWhitelistedAddressesRequest, opts ...grpc.CallOption) (*QueryAllWhitelistedAddressesResponse, error) {
-	out := new(QueryAllWhitelistedAddressesResponse)
-	err := c.cc.Invoke(ctx, "/ratelimit.v1.Query/AllWhitelistedAddresses", in, out, opts...)
-	if err != nil {
-		return nil, err
-	}
-	return out, nil
+// Checks whether a transfer would be allowed by the rate limits
+// The denom should be specified as it's tracked by the rate limit (i.e. the
+// base denom for native tokens, or the ibc/ hash for IBC tokens)
+type QueryCheckTransferRequest struct {
+	Denom     string          `protobuf:"bytes,1,opt,name=denom,proto3" json:"denom,omitempty"`
+	ChannelId string          `protobuf:"bytes,2,opt,name=channel_id,json=channelId,proto3" json:"channel_id,omitempty"`
+	Direction PacketDirection `protobuf:"varint,3,opt,name=direction,proto3,enum=ratelimit.v1.PacketDirection" json:"direction,omitempty"`
+	Amount    string          `protobuf:"bytes,4,opt,name=amount,proto3" json:"amount,omitempty"`
+	Sender    string          `protobuf:"bytes,5,opt,name=sender,proto3" json:"sender,omitempty"`
+	Receiver  string          `protobuf:"bytes,6,opt,name=receiver,proto3" json:"receiver,omitempty"`
+	Memo      string          `protobuf:"bytes,7,opt,name=memo,proto3" json:"memo,omitempty"`
 }
 
-// QueryServer is the server API for Query service.
-type QueryServer interface {
-	// Queries all rate limits
-	AllRateLimits(context.Context, *QueryAllRateLimitsRequest) (*QueryAllRateLimitsResponse, error)
-	// Queries a specific rate limit by channel ID and denom
-	// Ex:
-	//  - /ratelimit/{channel_id}/by_denom?denom={denom}
-	RateLimit(context.Context, *QueryRateLimitRequest) (*QueryRateLimitResponse, error)
-	// Queries all the rate limits for a given chain
-	RateLimitsByChainId(context.Context, *QueryRateLimitsByChainIdRequest) (*QueryRateLimitsByChainIdResponse, error)
-	// Queries all the rate limits for a given channel ID
-	RateLimitsByChannelId(context.Context, *QueryRateLimitsByChannelIdRequest) (*QueryRateLimitsByChannelIdResponse, error)
-	// Queries all blacklisted denoms
-	AllBlacklistedDenoms(context.Context, *QueryAllBlacklistedDenomsRequest) (*QueryAllBlacklistedDenomsResponse, error)
-	// Queries all whitelisted address pairs
-	AllWhitelistedAddresses(context.Context, *QueryAllWhitelistedAddressesRequest) (*QueryAllWhitelistedAddressesResponse, error)
-}
-
-// UnimplementedQueryServer can be embedded to have forward compatible implementations.
-type UnimplementedQueryServer struct {
-}
-
-func (*UnimplementedQueryServer) AllRateLimits(ctx context.Context, req *QueryAllRateLimitsRequest) (*QueryAllRateLimitsResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method AllRateLimits not implemented")
+func (m *QueryCheckTransferRequest) Reset()         { *m = QueryCheckTransferRequest{} }
+func (m *QueryCheckTransferRequest) String() string { return proto.CompactTextString(m) }
+func (*QueryCheckTransferRequest) ProtoMessage()    {}
+func (*QueryCheckTransferRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_d909918e357d6d0b, []int{16}
 }
-func (*UnimplementedQueryServer) RateLimit(ctx context.Context, req *QueryRateLimitRequest) (*QueryRateLimitResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method RateLimit not implemented")
+func (m *QueryCheckTransferRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
 }
-func (*UnimplementedQueryServer) RateLimitsByChainId(ctx context.Context, req *QueryRateLimitsByChainIdRequest) (*QueryRateLimitsByChainIdResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method RateLimitsByChainId not implemented")
+func (m *QueryCheckTransferRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryCheckTransferRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
 }
-func (*UnimplementedQueryServer) RateLimitsByChannelId(ctx context.Context, req *QueryRateLimitsByChannelIdRequest) (*QueryRateLimitsByChannelIdResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method RateLimitsByChannelId not implemented")
+func (m *QueryCheckTransferRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryCheckTransferRequest.Merge(m, src)
 }
-func (*UnimplementedQueryServer) AllBlacklistedDenoms(ctx context.Context, req *QueryAllBlacklistedDenomsRequest) (*QueryAllBlacklistedDenomsResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method AllBlacklistedDenoms not implemented")
+func (m *QueryCheckTransferRequest) XXX_Size() int {
+	return m.Size()
 }
-func (*UnimplementedQueryServer) AllWhitelistedAddresses(ctx context.Context, req *QueryAllWhitelistedAddressesRequest) (*QueryAllWhitelistedAddressesResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method AllWhitelistedAddresses not implemented")
+func (m *QueryCheckTransferRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryCheckTransferRequest.DiscardUnknown(m)
 }
 
-func RegisterQueryServer(s grpc1.Server, srv QueryServer) {
-	s.RegisterService(&_Query_serviceDesc, srv)
-}
+var xxx_messageInfo_QueryCheckTransferRequest proto.InternalMessageInfo
 
-func _Query_AllRateLimits_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(QueryAllRateLimitsRequest)
-	if err := dec(in); err != nil {
-		return nil, err
-	}
-	if interceptor == nil {
-		return srv.(QueryServer).AllRateLimits(ctx, in)
-	}
-	info := &grpc.UnaryServerInfo{
-		Server:     srv,
-		FullMethod: "/ratelimit.v1.Query/AllRateLimits",
-	}
-	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(QueryServer).AllRateLimits(ctx, req.(*QueryAllRateLimitsRequest))
+func (m *QueryCheckTransferRequest) GetDenom() string {
+	if m != nil {
+		return m.Denom
 	}
-	return interceptor(ctx, in, info, handler)
+	return ""
 }
 
-func _Query_RateLimit_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(QueryRateLimitRequest)
-	if err := dec(in); err != nil {
-		return nil, err
-	}
-	if interceptor == nil {
-		return srv.(QueryServer).RateLimit(ctx, in)
-	}
-	info := &grpc.UnaryServerInfo{
-		Server:     srv,
-		FullMethod: "/ratelimit.v1.Query/RateLimit",
-	}
-	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(QueryServer).RateLimit(ctx, req.(*QueryRateLimitRequest))
+func (m *QueryCheckTransferRequest) GetChannelId() string {
+	if m != nil {
+		return m.ChannelId
 	}
-	return interceptor(ctx, in, info, handler)
+	return ""
 }
 
-func _Query_RateLimitsByChainId_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(QueryRateLimitsByChainIdRequest)
-	if err := dec(in); err != nil {
-		return nil, err
-	}
-	if interceptor == nil {
-		return srv.(QueryServer).RateLimitsByChainId(ctx, in)
-	}
-	info := &grpc.UnaryServerInfo{
-		Server:     srv,
-		FullMethod: "/ratelimit.v1.Query/RateLimitsByChainId",
-	}
-	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(QueryServer).RateLimitsByChainId(ctx, req.(*QueryRateLimitsByChainIdRequest))
+func (m *QueryCheckTransferRequest) GetDirection() PacketDirection {
+	if m != nil {
+		return m.Direction
 	}
-	return interceptor(ctx, in, info, handler)
+	return PACKET_SEND
 }
 
-func _Query_RateLimitsByChannelId_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(QueryRateLimitsByChannelIdRequest)
-	if err := dec(in); err != nil {
-		return nil, err
-	}
-	if interceptor == nil {
-		return srv.(QueryServer).RateLimitsByChannelId(ctx, in)
-	}
-	info := &grpc.UnaryServerInfo{
-		Server:     srv,
-		FullMethod: "/ratelimit.v1.Query/RateLimitsByChannelId",
+func (m *QueryCheckTransferRequest) GetAmount() string {
+	if m != nil {
+		return m.Amount
 	}
-	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(QueryServer).RateLimitsByChannelId(ctx, req.(*QueryRateLimitsByChannelIdRequest))
+	return ""
+}
+
+func (m *QueryCheckTransferRequest) GetSender() string {
+	if m != nil {
+		return m.Sender
 	}
-	return interceptor(ctx, in, info, handler)
+	return ""
 }
 
-func _Query_AllBlacklistedDenoms_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(QueryAllBlacklistedDenomsRequest)
-	if err := dec(in); err != nil {
-		return nil, err
+func (m *QueryCheckTransferRequest) GetReceiver() string {
+	if m != nil {
+		return m.Receiver
 	}
-	if interceptor == nil {
-		return srv.(QueryServer).AllBlacklistedDenoms(ctx, in)
+	return ""
+}
+
+func (m *QueryCheckTransferRequest) GetMemo() string {
+	if m != nil {
+		return m.Memo
 	}
-	info := &grpc.UnaryServerInfo{
-		Server:     srv,
-		FullMethod: "/ratelimit.v1.Query/AllBlacklistedDenoms",
+	return ""
+}
+
+type QueryCheckTransferResponse struct {
+	// Whether the transfer would be accepted
+	Allowed bool `protobuf:"varint,1,opt,name=allowed,proto3" json:"allowed,omitempty"`
+	// If the transfer would be denied, the reason for the denial (matches the
+	// reason in the transfer_denied event) and the full error message
+	Reason string `protobuf:"bytes,2,opt,name=reason,proto3" json:"reason,omitempty"`
+	Error  string `protobuf:"bytes,3,opt,name=error,proto3" json:"error,omitempty"`
+	// Whether the transfer counts towards a rate limit quota
+	RateLimited bool `protobuf:"varint,4,opt,name=rate_limited,json=rateLimited,proto3" json:"rate_limited,omitempty"`
+	// If rate limited, the amount that can still be transferred in the given
+	// direction in the current window (before this transfer)
+	RemainingCapacity github_com_cosmos_cosmos_sdk_types.Int `protobuf:"bytes,5,opt,name=remaining_capacity,json=remainingCapacity,proto3,customtype=github.com/cosmos/cosmos-sdk/types.Int" json:"remaining_capacity"`
+}
+
+func (m *QueryCheckTransferResponse) Reset()         { *m = QueryCheckTransferResponse{} }
+func (m *QueryCheckTransferResponse) String() string { return proto.CompactTextString(m) }
+func (*QueryCheckTransferResponse) ProtoMessage()    {}
+func (*QueryCheckTransferResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_d909918e357d6d0b, []int{17}
+}
+func (m *QueryCheckTransferResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryCheckTransferResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryCheckTransferResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
 	}
-	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(QueryServer).AllBlacklistedDenoms(ctx, req.(*QueryAllBlacklistedDenomsRequest))
+}
+func (m *QueryCheckTransferResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryCheckTransferResponse.Merge(m, src)
+}
+func (m *QueryCheckTransferResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryCheckTransferResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryCheckTransferResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QueryCheckTransferResponse proto.InternalMessageInfo
+
+func (m *QueryCheckTransferResponse) GetAllowed() bool {
+	if m != nil {
+		return m.Allowed
 	}
-	return interceptor(ctx, in, info, handler)
+	return false
 }
 
-func _Query_AllWhitelistedAddresses_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(QueryAllWhitelistedAddressesRequest)
-	if err := dec(in); err != nil {
-		return nil, err
+func (m *QueryCheckTransferResponse) GetReason() string {
+	if m != nil {
+		return m.Reason
 	}
-	if interceptor == nil {
-		return srv.(QueryServer).AllWhitelistedAddresses(ctx, in)
+	return ""
+}
+
+func (m *QueryCheckTransferResponse) GetError() string {
+	if m != nil {
+		return m.Error
 	}
-	info := &grpc.UnaryServerInfo{
-		Server:     srv,
-		FullMethod: "/ratelimit.v1.Query/AllWhitelistedAddresses",
+	return ""
+}
+
+func (m *QueryCheckTransferResponse) GetRateLimited() bool {
+	if m != nil {
+		return m.RateLimited
 	}
-	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(QueryServer).AllWhitelistedAddresses(ctx, req.(*QueryAllWhitelistedAddressesRequest))
+	return false
+}
+
+// RateLimitCapacity describes how much of a rate limit's quota is still
+// available in the current window
+type RateLimitCapacity struct {
+	RateLimit RateLimit `protobuf:"bytes,1,opt,name=rate_limit,json=rateLimit,proto3" json:"rate_limit"`
+	// The max amount that can currently be sent/received before the quota is
+	// exceeded
+	RemainingSend github_com_cosmos_cosmos_sdk_types.Int `protobuf:"bytes,2,opt,name=remaining_send,json=remainingSend,proto3,customtype=github.com/cosmos/cosmos-sdk/types.Int" json:"remaining_send"`
+	RemainingRecv github_com_cosmos_cosmos_sdk_types.Int `protobuf:"bytes,3,opt,name=remaining_recv,json=remainingRecv,proto3,customtype=github.com/cosmos/cosmos-sdk/types.Int" json:"remaining_recv"`
+	// The percentage of the send/recv threshold used by the current net flow
+	// (e.g. 80 indicates the net outflow is 80% of the send threshold)
+	SendUtilization github_com_cosmos_cosmos_sdk_types.Dec `protobuf:"bytes,4,opt,name=send_utilization,json=sendUtilization,proto3,customtype=github.com/cosmos/cosmos-sdk/types.Dec" json:"send_utilization"`
+	RecvUtilization github_com_cosmos_cosmos_sdk_types.Dec `protobuf:"bytes,5,opt,name=recv_utilization,json=recvUtilization,proto3,customtype=github.com/cosmos/cosmos-sdk/types.Dec" json:"recv_utilization"`
+	// The hour epoch at which the rate limit will next be reset, and the time
+	// at which that epoch starts (the reset occurs in the first block after
+	// this time). Both are empty if the rate limit does not reset
+	NextResetEpoch uint64     `protobuf:"varint,6,opt,name=next_reset_epoch,json=nextResetEpoch,proto3" json:"next_reset_epoch,omitempty"`
+	NextResetTime  *time.Time `protobuf:"bytes,7,opt,name=next_reset_time,json=nextResetTime,proto3,stdtime" json:"next_reset_time,omitempty"`
+	// The hour epoch at which the current window started, and the start time of
+	// that epoch. Both are empty if the rate limit does not reset
+	WindowStartEpoch uint64     `protobuf:"varint,8,opt,name=window_start_epoch,json=windowStartEpoch,proto3" json:"window_start_epoch,omitempty"`
+	WindowStartTime  *time.Time `protobuf:"bytes,9,opt,name=window_start_time,json=windowStartTime,proto3,stdtime" json:"window_start_time,omitempty"`
+}
+
+func (m *RateLimitCapacity) Reset()         { *m = RateLimitCapacity{} }
+func (m *RateLimitCapacity) String() string { return proto.CompactTextString(m) }
+func (*RateLimitCapacity) ProtoMessage()    {}
+func (*RateLimitCapacity) Descriptor() ([]byte, []int) {
+	return fileDescriptor_d909918e357d6d0b, []int{18}
+}
+func (m *RateLimitCapacity) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *RateLimitCapacity) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_RateLimitCapacity.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
 	}
-	return interceptor(ctx, in, info, handler)
+}
+func (m *RateLimitCapacity) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_RateLimitCapacity.Merge(m, src)
+}
+func (m *RateLimitCapacity) XXX_Size() int {
+	return m.Size()
+}
+func (m *RateLimitCapacity) XXX_DiscardUnknown() {
+	xxx_messageInfo_RateLimitCapacity.DiscardUnknown(m)
 }
 
-var _Query_serviceDesc = grpc.ServiceDesc{
-	ServiceName: "ratelimit.v1.Query",
-	HandlerType: (*QueryServer)(nil),
-	Methods: []grpc.MethodDesc{
-		{
-			MethodName: "AllRateLimits",
-			Handler:    _Query_AllRateLimits_Handler,
-		},
-		{
-			MethodName: "RateLimit",
-			Handler:    _Query_RateLimit_Handler,
-		},
-		{
-			MethodName: "RateLimitsByChainId",
-			Handler:    _Query_RateLimitsByChainId_Handler,
-		},
-		{
-			MethodName: "RateLimitsByChannelId",
-			Handler:    _Query_RateLimitsByChannelId_Handler,
-		},
-		{
-			MethodName: "AllBlacklistedDenoms",
-			Handler:    _Query_AllBlacklistedDenoms_Handler,
-		},
-		{
-			MethodName: "AllWhitelistedAddresses",
-			Handler:    _Query_AllWhitelistedAddresses_Handler,
-		},
-	},
-	Streams:  []grpc.StreamDesc{},
-	Metadata: "ratelimit/v1/query.proto",
+var xxx_messageInfo_RateLimitCapacity proto.InternalMessageInfo
+
+func (m *RateLimitCapacity) GetRateLimit() RateLimit {
+	if m != nil {
+		return m.RateLimit
+	}
+	return RateLimit{}
 }
 
-func (m *QueryAllRateLimitsRequest) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
+func (m *RateLimitCapacity) GetNextResetEpoch() uint64 {
+	if m != nil {
+		return m.NextResetEpoch
 	}
-	return dAtA[:n], nil
+	return 0
 }
 
-func (m *QueryAllRateLimitsRequest) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+func (m *RateLimitCapacity) GetNextResetTime() *time.Time {
+	if m != nil {
+		return m.NextResetTime
+	}
+	return nil
 }
 
-func (m *QueryAllRateLimitsRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	return len(dAtA) - i, nil
+func (m *RateLimitCapacity) GetWindowStartEpoch() uint64 {
+	if m != nil {
+		return m.WindowStartEpoch
+	}
+	return 0
 }
 
-func (m *QueryAllRateLimitsResponse) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
+func (m *RateLimitCapacity) GetWindowStartTime() *time.Time {
+	if m != nil {
+		return m.WindowStartTime
 	}
-	return dAtA[:n], nil
+	return nil
 }
 
-func (m *QueryAllRateLimitsResponse) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+// Queries the capacity of all rate limits
+type QueryAllRateLimitCapacitiesRequest struct {
+	Pagination *query.PageRequest `protobuf:"bytes,1,opt,name=pagination,proto3" json:"pagination,omitempty"`
 }
 
-func (m *QueryAllRateLimitsResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if len(m.RateLimits) > 0 {
-		for iNdEx := len(m.RateLimits) - 1; iNdEx >= 0; iNdEx-- {
-			{
-				size, err := m.RateLimits[iNdEx].MarshalToSizedBuffer(dAtA[:i])
-				if err != nil {
-					return 0, err
-				}
-				i -= size
-				i = encodeVarintQuery(dAtA, i, uint64(size))
-			}
-			i--
-			dAtA[i] = 0xa
+func (m *QueryAllRateLimitCapacitiesRequest) Reset()         { *m = QueryAllRateLimitCapacitiesRequest{} }
+func (m *QueryAllRateLimitCapacitiesRequest) String() string { return proto.CompactTextString(m) }
+func (*QueryAllRateLimitCapacitiesRequest) ProtoMessage()    {}
+func (*QueryAllRateLimitCapacitiesRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_d909918e357d6d0b, []int{19}
+}
+func (m *QueryAllRateLimitCapacitiesRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryAllRateLimitCapacitiesRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryAllRateLimitCapacitiesRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
 		}
+		return b[:n], nil
 	}
-	return len(dAtA) - i, nil
+}
+func (m *QueryAllRateLimitCapacitiesRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryAllRateLimitCapacitiesRequest.Merge(m, src)
+}
+func (m *QueryAllRateLimitCapacitiesRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryAllRateLimitCapacitiesRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryAllRateLimitCapacitiesRequest.DiscardUnknown(m)
 }
 
-func (m *QueryRateLimitRequest) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
+var xxx_messageInfo_QueryAllRateLimitCapacitiesRequest proto.InternalMessageInfo
+
+func (m *QueryAllRateLimitCapacitiesRequest) GetPagination() *query.PageRequest {
+	if m != nil {
+		return m.Pagination
 	}
-	return dAtA[:n], nil
+	return nil
 }
 
-func (m *QueryRateLimitRequest) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+type QueryAllRateLimitCapacitiesResponse struct {
+	Capacities []RateLimitCapacity `protobuf:"bytes,1,rep,name=capacities,proto3" json:"capacities"`
+	Pagination *query.PageResponse `protobuf:"bytes,2,opt,name=pagination,proto3" json:"pagination,omitempty"`
 }
 
-func (m *QueryRateLimitRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if len(m.ChannelId) > 0 {
-		i -= len(m.ChannelId)
-		copy(dAtA[i:], m.ChannelId)
-		i = encodeVarintQuery(dAtA, i, uint64(len(m.ChannelId)))
-		i--
-		dAtA[i] = 0x12
+func (m *QueryAllRateLimitCapacitiesResponse) Reset()         { *m = QueryAllRateLimitCapacitiesResponse{} }
+func (m *QueryAllRateLimitCapacitiesResponse) String() string { return proto.CompactTextString(m) }
+func (*QueryAllRateLimitCapacitiesResponse) ProtoMessage()    {}
+func (*QueryAllRateLimitCapacitiesResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_d909918e357d6d0b, []int{20}
+}
+func (m *QueryAllRateLimitCapacitiesResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryAllRateLimitCapacitiesResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryAllRateLimitCapacitiesResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
 	}
-	if len(m.Denom) > 0 {
-		i -= len(m.Denom)
-		copy(dAtA[i:], m.Denom)
-		i = encodeVarintQuery(dAtA, i, uint64(len(m.Denom)))
-		i--
-		dAtA[i] = 0xa
+}
+func (m *QueryAllRateLimitCapacitiesResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryAllRateLimitCapacitiesResponse.Merge(m, src)
+}
+func (m *QueryAllRateLimitCapacitiesResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryAllRateLimitCapacitiesResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryAllRateLimitCapacitiesResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QueryAllRateLimitCapacitiesResponse proto.InternalMessageInfo
+
+func (m *QueryAllRateLimitCapacitiesResponse) GetCapacities() []RateLimitCapacity {
+	if m != nil {
+		return m.Capacities
 	}
-	return len(dAtA) - i, nil
+	return nil
 }
 
-func (m *QueryRateLimitResponse) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
+func (m *QueryAllRateLimitCapacitiesResponse) GetPagination() *query.PageResponse {
+	if m != nil {
+		return m.Pagination
 	}
-	return dAtA[:n], nil
+	return nil
 }
 
-func (m *QueryRateLimitResponse) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+// Queries the capacity of a specific rate limit by channel ID and denom
+type QueryRateLimitCapacityRequest struct {
+	Denom     string `protobuf:"bytes,1,opt,name=denom,proto3" json:"denom,omitempty"`
+	ChannelId string `protobuf:"bytes,2,opt,name=channel_id,json=channelId,proto3" json:"channel_id,omitempty"`
 }
 
-func (m *QueryRateLimitResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if m.RateLimit != nil {
-		{
-			size, err := m.RateLimit.MarshalToSizedBuffer(dAtA[:i])
-			if err != nil {
-				return 0, err
-			}
-			i -= size
-			i = encodeVarintQuery(dAtA, i, uint64(size))
+func (m *QueryRateLimitCapacityRequest) Reset()         { *m = QueryRateLimitCapacityRequest{} }
+func (m *QueryRateLimitCapacityRequest) String() string { return proto.CompactTextString(m) }
+func (*QueryRateLimitCapacityRequest) ProtoMessage()    {}
+func (*QueryRateLimitCapacityRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_d909918e357d6d0b, []int{21}
+}
+func (m *QueryRateLimitCapacityRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryRateLimitCapacityRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryRateLimitCapacityRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
 		}
-		i--
-		dAtA[i] = 0xa
+		return b[:n], nil
 	}
-	return len(dAtA) - i, nil
 }
-
-func (m *QueryRateLimitsByChainIdRequest) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
-	}
-	return dAtA[:n], nil
+func (m *QueryRateLimitCapacityRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryRateLimitCapacityRequest.Merge(m, src)
 }
-
-func (m *QueryRateLimitsByChainIdRequest) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+func (m *QueryRateLimitCapacityRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryRateLimitCapacityRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryRateLimitCapacityRequest.DiscardUnknown(m)
 }
 
-func (m *QueryRateLimitsByChainIdRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if len(m.ChainId) > 0 {
-		i -= len(m.ChainId)
-		copy(dAtA[i:], m.ChainId)
-		i = encodeVarintQuery(dAtA, i, uint64(len(m.ChainId)))
-		i--
-		dAtA[i] = 0xa
+var xxx_messageInfo_QueryRateLimitCapacityRequest proto.InternalMessageInfo
+
+func (m *QueryRateLimitCapacityRequest) GetDenom() string {
+	if m != nil {
+		return m.Denom
 	}
-	return len(dAtA) - i, nil
+	return ""
 }
 
-func (m *QueryRateLimitsByChainIdResponse) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
+func (m *QueryRateLimitCapacityRequest) GetChannelId() string {
+	if m != nil {
+		return m.ChannelId
 	}
-	return dAtA[:n], nil
+	return ""
 }
 
-func (m *QueryRateLimitsByChainIdResponse) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+type QueryRateLimitCapacityResponse struct {
+	Capacity *RateLimitCapacity `protobuf:"bytes,1,opt,name=capacity,proto3" json:"capacity,omitempty"`
 }
 
-func (m *QueryRateLimitsByChainIdResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if len(m.RateLimits) > 0 {
-		for iNdEx := len(m.RateLimits) - 1; iNdEx >= 0; iNdEx-- {
-			{
-				size, err := m.RateLimits[iNdEx].MarshalToSizedBuffer(dAtA[:i])
-				if err != nil {
-					return 0, err
-				}
-				i -= size
-				i = encodeVarintQuery(dAtA, i, uint64(size))
-			}
-			i--
-			dAtA[i] = 0xa
+func (m *QueryRateLimitCapacityResponse) Reset()         { *m = QueryRateLimitCapacityResponse{} }
+func (m *QueryRateLimitCapacityResponse) String() string { return proto.CompactTextString(m) }
+func (*QueryRateLimitCapacityResponse) ProtoMessage()    {}
+func (*QueryRateLimitCapacityResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_d909918e357d6d0b, []int{22}
+}
+func (m *QueryRateLimitCapacityResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryRateLimitCapacityResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryRateLimitCapacityResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
 		}
+		return b[:n], nil
 	}
-	return len(dAtA) - i, nil
+}
+func (m *QueryRateLimitCapacityResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryRateLimitCapacityResponse.Merge(m, src)
+}
+func (m *QueryRateLimitCapacityResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryRateLimitCapacityResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryRateLimitCapacityResponse.DiscardUnknown(m)
 }
 
-func (m *QueryRateLimitsByChannelIdRequest) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
+var xxx_messageInfo_QueryRateLimitCapacityResponse proto.InternalMessageInfo
+
+func (m *QueryRateLimitCapacityResponse) GetCapacity() *RateLimitCapacity {
+	if m != nil {
+		return m.Capacity
 	}
-	return dAtA[:n], nil
+	return nil
 }
 
-func (m *QueryRateLimitsByChannelIdRequest) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+// Queries all pending send packets, optionally filtered by channel ID
+type QueryPendingSendPacketsRequest struct {
+	ChannelId  string             `protobuf:"bytes,1,opt,name=channel_id,json=channelId,proto3" json:"channel_id,omitempty"`
+	Pagination *query.PageRequest `protobuf:"bytes,2,opt,name=pagination,proto3" json:"pagination,omitempty"`
 }
 
-func (m *QueryRateLimitsByChannelIdRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if len(m.ChannelId) > 0 {
-		i -= len(m.ChannelId)
-		copy(dAtA[i:], m.ChannelId)
-		i = encodeVarintQuery(dAtA, i, uint64(len(m.ChannelId)))
-		i--
-		dAtA[i] = 0xa
+func (m *QueryPendingSendPacketsRequest) Reset()         { *m = QueryPendingSendPacketsRequest{} }
+func (m *QueryPendingSendPacketsRequest) String() string { return proto.CompactTextString(m) }
+func (*QueryPendingSendPacketsRequest) ProtoMessage()    {}
+func (*QueryPendingSendPacketsRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_d909918e357d6d0b, []int{23}
+}
+func (m *QueryPendingSendPacketsRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryPendingSendPacketsRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryPendingSendPacketsRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
 	}
-	return len(dAtA) - i, nil
+}
+func (m *QueryPendingSendPacketsRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryPendingSendPacketsRequest.Merge(m, src)
+}
+func (m *QueryPendingSendPacketsRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryPendingSendPacketsRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryPendingSendPacketsRequest.DiscardUnknown(m)
 }
 
-func (m *QueryRateLimitsByChannelIdResponse) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
+var xxx_messageInfo_QueryPendingSendPacketsRequest proto.InternalMessageInfo
+
+func (m *QueryPendingSendPacketsRequest) GetChannelId() string {
+	if m != nil {
+		return m.ChannelId
 	}
-	return dAtA[:n], nil
+	return ""
 }
 
-func (m *QueryRateLimitsByChannelIdResponse) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+func (m *QueryPendingSendPacketsRequest) GetPagination() *query.PageRequest {
+	if m != nil {
+		return m.Pagination
+	}
+	return nil
 }
 
-func (m *QueryRateLimitsByChannelIdResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if len(m.RateLimits) > 0 {
-		for iNdEx := len(m.RateLimits) - 1; iNdEx >= 0; iNdEx-- {
-			{
-				size, err := m.RateLimits[iNdEx].MarshalToSizedBuffer(dAtA[:i])
-				if err != nil {
-					return 0, err
-				}
-				i -= size
-				i = encodeVarintQuery(dAtA, i, uint64(size))
-			}
-			i--
-			dAtA[i] = 0xa
-		}
-	}
-	return len(dAtA) - i, nil
+type QueryPendingSendPacketsResponse struct {
+	PendingSendPackets []PendingSendPacket `protobuf:"bytes,1,rep,name=pending_send_packets,json=pendingSendPackets,proto3" json:"pending_send_packets"`
+	Pagination         *query.PageResponse `protobuf:"bytes,2,opt,name=pagination,proto3" json:"pagination,omitempty"`
 }
 
-func (m *QueryAllBlacklistedDenomsRequest) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
+func (m *QueryPendingSendPacketsResponse) Reset()         { *m = QueryPendingSendPacketsResponse{} }
+func (m *QueryPendingSendPacketsResponse) String() string { return proto.CompactTextString(m) }
+func (*QueryPendingSendPacketsResponse) ProtoMessage()    {}
+func (*QueryPendingSendPacketsResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_d909918e357d6d0b, []int{24}
+}
+func (m *QueryPendingSendPacketsResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryPendingSendPacketsResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryPendingSendPacketsResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
 	}
-	return dAtA[:n], nil
 }
-
-func (m *QueryAllBlacklistedDenomsRequest) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+func (m *QueryPendingSendPacketsResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryPendingSendPacketsResponse.Merge(m, src)
+}
+func (m *QueryPendingSendPacketsResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryPendingSendPacketsResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryPendingSendPacketsResponse.DiscardUnknown(m)
 }
 
-func (m *QueryAllBlacklistedDenomsRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	return len(dAtA) - i, nil
+var xxx_messageInfo_QueryPendingSendPacketsResponse proto.InternalMessageInfo
+
+func (m *QueryPendingSendPacketsResponse) GetPendingSendPackets() []PendingSendPacket {
+	if m != nil {
+		return m.PendingSendPackets
+	}
+	return nil
 }
 
-func (m *QueryAllBlacklistedDenomsResponse) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
+func (m *QueryPendingSendPacketsResponse) GetPagination() *query.PageResponse {
+	if m != nil {
+		return m.Pagination
 	}
-	return dAtA[:n], nil
+	return nil
 }
 
-func (m *QueryAllBlacklistedDenomsResponse) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+// Queries all denom groups
+type QueryAllDenomGroupsRequest struct {
+	Pagination *query.PageRequest `protobuf:"bytes,1,opt,name=pagination,proto3" json:"pagination,omitempty"`
 }
 
-func (m *QueryAllBlacklistedDenomsResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if len(m.Denoms) > 0 {
-		for iNdEx := len(m.Denoms) - 1; iNdEx >= 0; iNdEx-- {
-			i -= len(m.Denoms[iNdEx])
-			copy(dAtA[i:], m.Denoms[iNdEx])
-			i = encodeVarintQuery(dAtA, i, uint64(len(m.Denoms[iNdEx])))
-			i--
-			dAtA[i] = 0xa
+func (m *QueryAllDenomGroupsRequest) Reset()         { *m = QueryAllDenomGroupsRequest{} }
+func (m *QueryAllDenomGroupsRequest) String() string { return proto.CompactTextString(m) }
+func (*QueryAllDenomGroupsRequest) ProtoMessage()    {}
+func (*QueryAllDenomGroupsRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_d909918e357d6d0b, []int{25}
+}
+func (m *QueryAllDenomGroupsRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryAllDenomGroupsRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryAllDenomGroupsRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
 		}
+		return b[:n], nil
 	}
-	return len(dAtA) - i, nil
 }
-
-func (m *QueryAllWhitelistedAddressesRequest) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
-	}
-	return dAtA[:n], nil
+func (m *QueryAllDenomGroupsRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryAllDenomGroupsRequest.Merge(m, src)
 }
-
-func (m *QueryAllWhitelistedAddressesRequest) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+func (m *QueryAllDenomGroupsRequest) XXX_Size() int {
+	return m.Size()
 }
-
-func (m *QueryAllWhitelistedAddressesRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	return len(dAtA) - i, nil
+func (m *QueryAllDenomGroupsRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryAllDenomGroupsRequest.DiscardUnknown(m)
 }
 
-func (m *QueryAllWhitelistedAddressesResponse) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
+var xxx_messageInfo_QueryAllDenomGroupsRequest proto.InternalMessageInfo
+
+func (m *QueryAllDenomGroupsRequest) GetPagination() *query.PageRequest {
+	if m != nil {
+		return m.Pagination
 	}
-	return dAtA[:n], nil
+	return nil
 }
 
-func (m *QueryAllWhitelistedAddressesResponse) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+type QueryAllDenomGroupsResponse struct {
+	DenomGroups []DenomGroup        `protobuf:"bytes,1,rep,name=denom_groups,json=denomGroups,proto3" json:"denom_groups"`
+	Pagination  *query.PageResponse `protobuf:"bytes,2,opt,name=pagination,proto3" json:"pagination,omitempty"`
 }
 
-func (m *QueryAllWhitelistedAddressesResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if len(m.AddressPairs) > 0 {
-		for iNdEx := len(m.AddressPairs) - 1; iNdEx >= 0; iNdEx-- {
-			{
-				size, err := m.AddressPairs[iNdEx].MarshalToSizedBuffer(dAtA[:i])
-				if err != nil {
-					return 0, err
-				}
-				i -= size
-				i = encodeVarintQuery(dAtA, i, uint64(size))
-			}
-			i--
-			dAtA[i] = 0xa
+func (m *QueryAllDenomGroupsResponse) Reset()         { *m = QueryAllDenomGroupsResponse{} }
+func (m *QueryAllDenomGroupsResponse) String() string { return proto.CompactTextString(m) }
+func (*QueryAllDenomGroupsResponse) ProtoMessage()    {}
+func (*QueryAllDenomGroupsResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_d909918e357d6d0b, []int{26}
+}
+func (m *QueryAllDenomGroupsResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryAllDenomGroupsResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryAllDenomGroupsResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
 		}
+		return b[:n], nil
 	}
-	return len(dAtA) - i, nil
+}
+func (m *QueryAllDenomGroupsResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryAllDenomGroupsResponse.Merge(m, src)
+}
+func (m *QueryAllDenomGroupsResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryAllDenomGroupsResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryAllDenomGroupsResponse.DiscardUnknown(m)
 }
 
-func encodeVarintQuery(dAtA []byte, offset int, v uint64) int {
-	offset -= sovQuery(v)
-	base := offset
-	for v >= 1<<7 {
-		dAtA[offset] = uint8(v&0x7f | 0x80)
-		v >>= 7
-		offset++
+var xxx_messageInfo_QueryAllDenomGroupsResponse proto.InternalMessageInfo
+
+func (m *QueryAllDenomGroupsResponse) GetDenomGroups() []DenomGroup {
+	if m != nil {
+		return m.DenomGroups
 	}
-	dAtA[offset] = uint8(v)
-	return base
+	return nil
 }
-func (m *QueryAllRateLimitsRequest) Size() (n int) {
-	if m == nil {
-		return 0
+
+func (m *QueryAllDenomGroupsResponse) GetPagination() *query.PageResponse {
+	if m != nil {
+		return m.Pagination
 	}
-	var l int
-	_ = l
-	return n
+	return nil
 }
 
-func (m *QueryAllRateLimitsResponse) Size() (n int) {
-	if m == nil {
-		return 0
-	}
-	var l int
-	_ = l
-	if len(m.RateLimits) > 0 {
-		for _, e := range m.RateLimits {
-			l = e.Size()
-			n += 1 + l + sovQuery(uint64(l))
+// Queries the upcoming scheduled rate limit updates
+// Each of the filters is optional
+type QueryScheduledRateLimitUpdatesRequest struct {
+	Denom      string             `protobuf:"bytes,1,opt,name=denom,proto3" json:"denom,omitempty"`
+	ChannelId  string             `protobuf:"bytes,2,opt,name=channel_id,json=channelId,proto3" json:"channel_id,omitempty"`
+	Pagination *query.PageRequest `protobuf:"bytes,3,opt,name=pagination,proto3" json:"pagination,omitempty"`
+}
+
+func (m *QueryScheduledRateLimitUpdatesRequest) Reset()         { *m = QueryScheduledRateLimitUpdatesRequest{} }
+func (m *QueryScheduledRateLimitUpdatesRequest) String() string { return proto.CompactTextString(m) }
+func (*QueryScheduledRateLimitUpdatesRequest) ProtoMessage()    {}
+func (*QueryScheduledRateLimitUpdatesRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_d909918e357d6d0b, []int{27}
+}
+func (m *QueryScheduledRateLimitUpdatesRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryScheduledRateLimitUpdatesRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryScheduledRateLimitUpdatesRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
 		}
+		return b[:n], nil
 	}
-	return n
+}
+func (m *QueryScheduledRateLimitUpdatesRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryScheduledRateLimitUpdatesRequest.Merge(m, src)
+}
+func (m *QueryScheduledRateLimitUpdatesRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryScheduledRateLimitUpdatesRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryScheduledRateLimitUpdatesRequest.DiscardUnknown(m)
 }
 
-func (m *QueryRateLimitRequest) Size() (n int) {
-	if m == nil {
-		return 0
-	}
-	var l int
-	_ = l
-	l = len(m.Denom)
-	if l > 0 {
-		n += 1 + l + sovQuery(uint64(l))
-	}
-	l = len(m.ChannelId)
-	if l > 0 {
-		n += 1 + l + sovQuery(uint64(l))
+var xxx_messageInfo_QueryScheduledRateLimitUpdatesRequest proto.InternalMessageInfo
+
+func (m *QueryScheduledRateLimitUpdatesRequest) GetDenom() string {
+	if m != nil {
+		return m.Denom
 	}
-	return n
+	return ""
 }
 
-func (m *QueryRateLimitResponse) Size() (n int) {
-	if m == nil {
-		return 0
-	}
-	var l int
-	_ = l
-	if m.RateLimit != nil {
-		l = m.RateLimit.Size()
-		n += 1 + l + sovQuery(uint64(l))
+func (m *QueryScheduledRateLimitUpdatesRequest) GetChannelId() string {
+	if m != nil {
+		return m.ChannelId
 	}
-	return n
+	return ""
 }
 
-func (m *QueryRateLimitsByChainIdRequest) Size() (n int) {
-	if m == nil {
-		return 0
-	}
-	var l int
-	_ = l
-	l = len(m.ChainId)
-	if l > 0 {
-		n += 1 + l + sovQuery(uint64(l))
+func (m *QueryScheduledRateLimitUpdatesRequest) GetPagination() *query.PageRequest {
+	if m != nil {
+		return m.Pagination
 	}
-	return n
+	return nil
 }
 
-func (m *QueryRateLimitsByChainIdResponse) Size() (n int) {
-	if m == nil {
-		return 0
-	}
-	var l int
-	_ = l
-	if len(m.RateLimits) > 0 {
-		for _, e := range m.RateLimits {
-			l = e.Size()
-			n += 1 + l + sovQuery(uint64(l))
+type QueryScheduledRateLimitUpdatesResponse struct {
+	ScheduledUpdates []ScheduledRateLimitUpdate `protobuf:"bytes,1,rep,name=scheduled_updates,json=scheduledUpdates,proto3" json:"scheduled_updates"`
+	Pagination       *query.PageResponse        `protobuf:"bytes,2,opt,name=pagination,proto3" json:"pagination,omitempty"`
+}
+
+func (m *QueryScheduledRateLimitUpdatesResponse) Reset() {
+	*m = QueryScheduledRateLimitUpdatesResponse{}
+}
+func (m *QueryScheduledRateLimitUpdatesResponse) String() string { return proto.CompactTextString(m) }
+func (*QueryScheduledRateLimitUpdatesResponse) ProtoMessage()    {}
+func (*QueryScheduledRateLimitUpdatesResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_d909918e357d6d0b, []int{28}
+}
+func (m *QueryScheduledRateLimitUpdatesResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryScheduledRateLimitUpdatesResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryScheduledRateLimitUpdatesResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
 		}
+		return b[:n], nil
 	}
-	return n
+}
+func (m *QueryScheduledRateLimitUpdatesResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryScheduledRateLimitUpdatesResponse.Merge(m, src)
+}
+func (m *QueryScheduledRateLimitUpdatesResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryScheduledRateLimitUpdatesResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryScheduledRateLimitUpdatesResponse.DiscardUnknown(m)
 }
 
-func (m *QueryRateLimitsByChannelIdRequest) Size() (n int) {
-	if m == nil {
-		return 0
+var xxx_messageInfo_QueryScheduledRateLimitUpdatesResponse proto.InternalMessageInfo
+
+func (m *QueryScheduledRateLimitUpdatesResponse) GetScheduledUpdates() []ScheduledRateLimitUpdate {
+	if m != nil {
+		return m.ScheduledUpdates
 	}
-	var l int
-	_ = l
-	l = len(m.ChannelId)
-	if l > 0 {
-		n += 1 + l + sovQuery(uint64(l))
+	return nil
+}
+
+func (m *QueryScheduledRateLimitUpdatesResponse) GetPagination() *query.PageResponse {
+	if m != nil {
+		return m.Pagination
 	}
-	return n
+	return nil
 }
 
-func (m *QueryRateLimitsByChannelIdResponse) Size() (n int) {
-	if m == nil {
-		return 0
+// Queries the rate limits on channels that do not exist or have been closed
+type QueryRateLimitsOnClosedChannelsRequest struct {
+	Pagination *query.PageRequest `protobuf:"bytes,1,opt,name=pagination,proto3" json:"pagination,omitempty"`
+}
+
+func (m *QueryRateLimitsOnClosedChannelsRequest) Reset() {
+	*m = QueryRateLimitsOnClosedChannelsRequest{}
+}
+func (m *QueryRateLimitsOnClosedChannelsRequest) String() string { return proto.CompactTextString(m) }
+func (*QueryRateLimitsOnClosedChannelsRequest) ProtoMessage()    {}
+func (*QueryRateLimitsOnClosedChannelsRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_d909918e357d6d0b, []int{29}
+}
+func (m *QueryRateLimitsOnClosedChannelsRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryRateLimitsOnClosedChannelsRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryRateLimitsOnClosedChannelsRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
 	}
-	var l int
-	_ = l
-	if len(m.RateLimits) > 0 {
-		for _, e := range m.RateLimits {
-			l = e.Size()
-			n += 1 + l + sovQuery(uint64(l))
+}
+func (m *QueryRateLimitsOnClosedChannelsRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryRateLimitsOnClosedChannelsRequest.Merge(m, src)
+}
+func (m *QueryRateLimitsOnClosedChannelsRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryRateLimitsOnClosedChannelsRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryRateLimitsOnClosedChannelsRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QueryRateLimitsOnClosedChannelsRequest proto.InternalMessageInfo
+
+func (m *QueryRateLimitsOnClosedChannelsRequest) GetPagination() *query.PageRequest {
+	if m != nil {
+		return m.Pagination
+	}
+	return nil
+}
+
+type QueryRateLimitsOnClosedChannelsResponse struct {
+	RateLimits []RateLimit         `protobuf:"bytes,1,rep,name=rate_limits,json=rateLimits,proto3" json:"rate_limits"`
+	Pagination *query.PageResponse `protobuf:"bytes,2,opt,name=pagination,proto3" json:"pagination,omitempty"`
+}
+
+func (m *QueryRateLimitsOnClosedChannelsResponse) Reset() {
+	*m = QueryRateLimitsOnClosedChannelsResponse{}
+}
+func (m *QueryRateLimitsOnClosedChannelsResponse) String() string { return proto.CompactTextString(m) }
+func (*QueryRateLimitsOnClosedChannelsResponse) ProtoMessage()    {}
+func (*QueryRateLimitsOnClosedChannelsResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_d909918e357d6d0b, []int{30}
+}
+func (m *QueryRateLimitsOnClosedChannelsResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryRateLimitsOnClosedChannelsResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryRateLimitsOnClosedChannelsResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
 		}
+		return b[:n], nil
 	}
-	return n
+}
+func (m *QueryRateLimitsOnClosedChannelsResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryRateLimitsOnClosedChannelsResponse.Merge(m, src)
+}
+func (m *QueryRateLimitsOnClosedChannelsResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryRateLimitsOnClosedChannelsResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryRateLimitsOnClosedChannelsResponse.DiscardUnknown(m)
 }
 
-func (m *QueryAllBlacklistedDenomsRequest) Size() (n int) {
-	if m == nil {
-		return 0
+var xxx_messageInfo_QueryRateLimitsOnClosedChannelsResponse proto.InternalMessageInfo
+
+func (m *QueryRateLimitsOnClosedChannelsResponse) GetRateLimits() []RateLimit {
+	if m != nil {
+		return m.RateLimits
 	}
-	var l int
-	_ = l
-	return n
+	return nil
 }
 
-func (m *QueryAllBlacklistedDenomsResponse) Size() (n int) {
-	if m == nil {
-		return 0
+func (m *QueryRateLimitsOnClosedChannelsResponse) GetPagination() *query.PageResponse {
+	if m != nil {
+		return m.Pagination
 	}
-	var l int
-	_ = l
-	if len(m.Denoms) > 0 {
-		for _, s := range m.Denoms {
-			l = len(s)
-			n += 1 + l + sovQuery(uint64(l))
+	return nil
+}
+
+func init() {
+	proto.RegisterType((*QueryAllRateLimitsRequest)(nil), "ratelimit.v1.QueryAllRateLimitsRequest")
+	proto.RegisterType((*QueryAllRateLimitsResponse)(nil), "ratelimit.v1.QueryAllRateLimitsResponse")
+	proto.RegisterType((*QueryRateLimitRequest)(nil), "ratelimit.v1.QueryRateLimitRequest")
+	proto.RegisterType((*QueryRateLimitResponse)(nil), "ratelimit.v1.QueryRateLimitResponse")
+	proto.RegisterType((*QueryRateLimitsByChainIdRequest)(nil), "ratelimit.v1.QueryRateLimitsByChainIdRequest")
+	proto.RegisterType((*QueryRateLimitsByChainIdResponse)(nil), "ratelimit.v1.QueryRateLimitsByChainIdResponse")
+	proto.RegisterType((*QueryRateLimitsByChannelIdRequest)(nil), "ratelimit.v1.QueryRateLimitsByChannelIdRequest")
+	proto.RegisterType((*QueryRateLimitsByChannelIdResponse)(nil), "ratelimit.v1.QueryRateLimitsByChannelIdResponse")
+	proto.RegisterType((*QueryRateLimitsByDenomRequest)(nil), "ratelimit.v1.QueryRateLimitsByDenomRequest")
+	proto.RegisterType((*QueryRateLimitsByDenomResponse)(nil), "ratelimit.v1.QueryRateLimitsByDenomResponse")
+	proto.RegisterType((*QueryAllBlacklistedDenomsRequest)(nil), "ratelimit.v1.QueryAllBlacklistedDenomsRequest")
+	proto.RegisterType((*QueryAllBlacklistedDenomsResponse)(nil), "ratelimit.v1.QueryAllBlacklistedDenomsResponse")
+	proto.RegisterType((*QueryAllWhitelistedAddressesRequest)(nil), "ratelimit.v1.QueryAllWhitelistedAddressesRequest")
+	proto.RegisterType((*QueryAllWhitelistedAddressesResponse)(nil), "ratelimit.v1.QueryAllWhitelistedAddressesResponse")
+	proto.RegisterType((*QueryAllTransferRulesRequest)(nil), "ratelimit.v1.QueryAllTransferRulesRequest")
+	proto.RegisterType((*QueryAllTransferRulesResponse)(nil), "ratelimit.v1.QueryAllTransferRulesResponse")
+	proto.RegisterType((*QueryCheckTransferRequest)(nil), "ratelimit.v1.QueryCheckTransferRequest")
+	proto.RegisterType((*QueryCheckTransferResponse)(nil), "ratelimit.v1.QueryCheckTransferResponse")
+	proto.RegisterType((*RateLimitCapacity)(nil), "ratelimit.v1.RateLimitCapacity")
+	proto.RegisterType((*QueryAllRateLimitCapacitiesRequest)(nil), "ratelimit.v1.QueryAllRateLimitCapacitiesRequest")
+	proto.RegisterType((*QueryAllRateLimitCapacitiesResponse)(nil), "ratelimit.v1.QueryAllRateLimitCapacitiesResponse")
+	proto.RegisterType((*QueryRateLimitCapacityRequest)(nil), "ratelimit.v1.QueryRateLimitCapacityRequest")
+	proto.RegisterType((*QueryRateLimitCapacityResponse)(nil), "ratelimit.v1.QueryRateLimitCapacityResponse")
+	proto.RegisterType((*QueryPendingSendPacketsRequest)(nil), "ratelimit.v1.QueryPendingSendPacketsRequest")
+	proto.RegisterType((*QueryPendingSendPacketsResponse)(nil), "ratelimit.v1.QueryPendingSendPacketsResponse")
+	proto.RegisterType((*QueryAllDenomGroupsRequest)(nil), "ratelimit.v1.QueryAllDenomGroupsRequest")
+	proto.RegisterType((*QueryAllDenomGroupsResponse)(nil), "ratelimit.v1.QueryAllDenomGroupsResponse")
+	proto.RegisterType((*QueryScheduledRateLimitUpdatesRequest)(nil), "ratelimit.v1.QueryScheduledRateLimitUpdatesRequest")
+	proto.RegisterType((*QueryScheduledRateLimitUpdatesResponse)(nil), "ratelimit.v1.QueryScheduledRateLimitUpdatesResponse")
+	proto.RegisterType((*QueryRateLimitsOnClosedChannelsRequest)(nil), "ratelimit.v1.QueryRateLimitsOnClosedChannelsRequest")
+	proto.RegisterType((*QueryRateLimitsOnClosedChannelsResponse)(nil), "ratelimit.v1.QueryRateLimitsOnClosedChannelsResponse")
+}
+
+func init() { proto.RegisterFile("ratelimit/v1/query.proto", fileDescriptor_d909918e357d6d0b) }
+
+var fileDescriptor_d909918e357d6d0b = []byte{
+	// 1846 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xcc, 0x58, 0xdd, 0x6f, 0xdc, 0x4a,
+	0x15, 0xcf, 0xe4, 0xa3, 0xcd, 0x9e, 0x7c, 0x0f, 0xb9, 0xb9, 0x5b, 0xdf, 0x66, 0x93, 0xeb, 0x96,
+	0x24, 0xdc, 0xdb, 0xac, 0x6f, 0xd2, 0x5b, 0x0a, 0x24, 0xfd, 0xc8, 0xe6, 0xab, 0x81, 0x48, 0x0d,
+	0x4e, 0xab, 0xaa, 0x48, 0x95, 0xe5, 0xb5, 0xa7, 0x1b, 0x13, 0xaf, 0xbd, 0xb5, 0xbd, 0x09, 0xa1,
+	0xea, 0x0b, 0xaa, 0x84, 0xc4, 0x53, 0x25, 0xfe, 0x00, 0x5e, 0x5a, 0x89, 0x4a, 0x20, 0x01, 0x02,
+	0x09, 0x21, 0x24, 0x40, 0xf0, 0x50, 0x21, 0x1e, 0x2a, 0x21, 0x21, 0xc4, 0x43, 0x41, 0x2d, 0xe2,
+	0x8d, 0xff, 0x01, 0x79, 0x3c, 0xb6, 0xd7, 0xbb, 0xf6, 0xae, 0x77, 0xb3, 0x42, 0x7d, 0xda, 0x9d,
+	0x99, 0x33, 0xe7, 0xfc, 0xce, 0x6f, 0xce, 0x8c, 0xcf, 0x39, 0x90, 0xb5, 0x64, 0x87, 0xe8, 0x5a,
+	0x59, 0x73, 0x84, 0xa3, 0x25, 0xe1, 0x51, 0x95, 0x58, 0x27, 0xf9, 0x8a, 0x65, 0x3a, 0x26, 0x1e,
+	0x0e, 0x56, 0xf2, 0x47, 0x4b, 0xdc, 0xf9, 0x88, 0x5c, 0xb8, 0x44, 0x65, 0xb9, 0x8f, 0x22, 0xab,
+	0xb6, 0x72, 0x40, 0xd4, 0xaa, 0x4e, 0xd8, 0xe2, 0xf9, 0x92, 0x69, 0x96, 0x74, 0x22, 0xc8, 0x15,
+	0x4d, 0x90, 0x0d, 0xc3, 0x74, 0x64, 0x47, 0x33, 0x0d, 0x9b, 0xad, 0x4e, 0x96, 0xcc, 0x92, 0x49,
+	0xff, 0x0a, 0xee, 0x3f, 0x36, 0x3b, 0xc3, 0xf6, 0xd0, 0x51, 0xb1, 0xfa, 0x50, 0x70, 0xb4, 0x32,
+	0xb1, 0x1d, 0xb9, 0x5c, 0x61, 0x02, 0x9f, 0x28, 0xa6, 0x5d, 0x36, 0x6d, 0xa1, 0x28, 0xdb, 0xc4,
+	0x83, 0x2d, 0x1c, 0x2d, 0x15, 0x89, 0x23, 0x2f, 0x09, 0x15, 0xb9, 0xa4, 0x19, 0xd4, 0x86, 0x27,
+	0xcb, 0xff, 0x01, 0xc1, 0xb9, 0x6f, 0xba, 0x22, 0x6b, 0xba, 0x2e, 0xca, 0x0e, 0xd9, 0x75, 0x81,
+	0xda, 0x22, 0x79, 0x54, 0x25, 0xb6, 0x83, 0xb7, 0x00, 0xc2, 0x1d, 0x59, 0x34, 0x8b, 0x16, 0x86,
+	0x96, 0xe7, 0xf2, 0x9e, 0xfa, 0xbc, 0xab, 0x3e, 0xef, 0xb1, 0xc2, 0xd4, 0xe7, 0xf7, 0xe4, 0x12,
+	0x61, 0x7b, 0xc5, 0x9a, 0x9d, 0x78, 0x12, 0x06, 0x54, 0x62, 0x98, 0xe5, 0x6c, 0xef, 0x2c, 0x5a,
+	0xc8, 0x88, 0xde, 0x00, 0x4f, 0x03, 0x28, 0x07, 0xb2, 0x61, 0x10, 0x5d, 0xd2, 0xd4, 0x6c, 0x1f,
+	0x5d, 0xca, 0xb0, 0x99, 0x1d, 0x15, 0xcf, 0xc3, 0x58, 0x59, 0x33, 0xa4, 0xaa, 0xa3, 0xe9, 0xda,
+	0x77, 0x3d, 0x04, 0xfd, 0x54, 0x66, 0xb4, 0xac, 0x19, 0x77, 0xc3, 0x59, 0xfe, 0x05, 0x02, 0x2e,
+	0xce, 0x07, 0xbb, 0x62, 0x1a, 0x36, 0xc1, 0xd7, 0x61, 0xc8, 0x3d, 0x02, 0x89, 0x9e, 0x81, 0x9d,
+	0x45, 0xb3, 0x7d, 0x0b, 0x43, 0xcb, 0x1f, 0xe6, 0x6b, 0x8f, 0x30, 0x1f, 0x6c, 0x2b, 0xf4, 0xbf,
+	0x7a, 0x33, 0xd3, 0x23, 0x82, 0x15, 0xe8, 0xc1, 0xdb, 0x11, 0x12, 0x7a, 0x29, 0x09, 0xf3, 0x2d,
+	0x49, 0xf0, 0x8c, 0xd7, 0xb2, 0xc0, 0xef, 0xc2, 0x07, 0x14, 0x66, 0x60, 0xcc, 0xa7, 0x39, 0xa0,
+	0x07, 0x25, 0xd3, 0xd3, 0x5b, 0x47, 0x0f, 0xbf, 0x07, 0x53, 0xf5, 0xda, 0x98, 0xc3, 0x5f, 0x06,
+	0x08, 0x1d, 0x66, 0xa7, 0x96, 0xe4, 0xaf, 0x98, 0x09, 0x3c, 0xe5, 0x57, 0x61, 0x26, 0xaa, 0xd1,
+	0x2e, 0x9c, 0xac, 0x1f, 0xc8, 0x9a, 0xb1, 0xa3, 0xfa, 0x48, 0xcf, 0xc1, 0xa0, 0xe2, 0xce, 0xb8,
+	0x88, 0x3c, 0xb0, 0x67, 0x15, 0x4f, 0x82, 0x2f, 0xc2, 0x6c, 0xf2, 0xee, 0xee, 0x1c, 0x05, 0xff,
+	0x03, 0x04, 0x1f, 0xc7, 0x19, 0xf1, 0x28, 0xf1, 0x41, 0x46, 0x89, 0x43, 0xf5, 0x71, 0xb5, 0x15,
+	0x73, 0x9e, 0x1d, 0x04, 0x35, 0xff, 0x53, 0x04, 0x7c, 0x33, 0x30, 0xef, 0x5b, 0xf8, 0x3d, 0x81,
+	0xe9, 0x06, 0xb8, 0x1b, 0x6e, 0xa4, 0x35, 0x0f, 0xc3, 0x6e, 0xd1, 0xf5, 0x12, 0x41, 0x2e, 0xc9,
+	0xfe, 0xfb, 0x46, 0xd5, 0xb7, 0x59, 0x2c, 0xaf, 0xe9, 0x7a, 0x41, 0x97, 0x95, 0x43, 0x5d, 0xb3,
+	0x1d, 0xa2, 0x52, 0xb0, 0xdd, 0x7e, 0x1b, 0xf9, 0xa7, 0x7e, 0x4c, 0xc7, 0x1b, 0x63, 0xd4, 0x4c,
+	0xc1, 0x19, 0x7a, 0x1c, 0x1e, 0x2b, 0x19, 0x91, 0x8d, 0xba, 0xe7, 0x72, 0x19, 0x2e, 0xf8, 0x28,
+	0xee, 0x1d, 0x68, 0x0e, 0xf1, 0x50, 0xac, 0xa9, 0xaa, 0x45, 0x6c, 0x9b, 0x74, 0xdd, 0xeb, 0xdf,
+	0x23, 0xb8, 0xd8, 0xdc, 0x1e, 0x73, 0xfc, 0x36, 0x8c, 0xc8, 0xde, 0xa4, 0x54, 0x91, 0x35, 0xcb,
+	0x8f, 0x8a, 0x8b, 0xd1, 0xa8, 0x68, 0x54, 0xb1, 0x27, 0x6b, 0x16, 0x0b, 0x91, 0x61, 0x39, 0x9c,
+	0xea, 0x22, 0x63, 0x0f, 0xe1, 0xbc, 0xef, 0xc1, 0x1d, 0x4b, 0x36, 0xec, 0x87, 0xc4, 0x12, 0xab,
+	0x7a, 0xf7, 0xa9, 0xfa, 0x39, 0x82, 0xe9, 0x04, 0x43, 0x8c, 0xa3, 0x6d, 0x18, 0x75, 0xd8, 0x82,
+	0x64, 0xb9, 0x2b, 0x8c, 0x24, 0x2e, 0x4a, 0x52, 0xed, 0x66, 0x46, 0xcd, 0x88, 0x53, 0xab, 0xb0,
+	0x7b, 0xdc, 0xfc, 0xd7, 0x4f, 0x2b, 0xd6, 0x0f, 0x88, 0x72, 0x18, 0x18, 0x3e, 0xc5, 0xf7, 0x0e,
+	0xaf, 0x40, 0x46, 0xd5, 0x2c, 0xa2, 0x50, 0x68, 0x6e, 0xb2, 0x30, 0xba, 0x3c, 0x1d, 0xf5, 0x6f,
+	0x4f, 0x56, 0x0e, 0x89, 0xb3, 0xe1, 0x0b, 0x89, 0xa1, 0xbc, 0x7b, 0x7d, 0xe4, 0xb2, 0x59, 0x35,
+	0x1c, 0x96, 0x42, 0xb0, 0x91, 0x3b, 0x6f, 0x13, 0x43, 0x25, 0x56, 0x76, 0xc0, 0x9b, 0xf7, 0x46,
+	0x98, 0x83, 0x41, 0x8b, 0x28, 0x44, 0x3b, 0x22, 0x56, 0xf6, 0x0c, 0x5d, 0x09, 0xc6, 0x18, 0x43,
+	0x7f, 0x99, 0x94, 0xcd, 0xec, 0x59, 0x3a, 0x4f, 0xff, 0xf3, 0xff, 0xf1, 0x53, 0x90, 0x3a, 0x7f,
+	0xd9, 0x01, 0x65, 0xe1, 0xac, 0xac, 0xeb, 0xe6, 0x31, 0xf1, 0x3e, 0x47, 0x83, 0xa2, 0x3f, 0x74,
+	0x01, 0x58, 0x44, 0xb6, 0x19, 0xdb, 0x19, 0x91, 0x8d, 0x5c, 0x8a, 0x88, 0x65, 0x99, 0x16, 0x4b,
+	0x8b, 0xbc, 0x01, 0xfe, 0x18, 0x86, 0xc3, 0x07, 0x92, 0xa8, 0xd4, 0x99, 0x41, 0x71, 0x28, 0x78,
+	0x02, 0x89, 0x8a, 0x1f, 0x00, 0xb6, 0x48, 0x59, 0xd6, 0x0c, 0xcd, 0x28, 0x49, 0x8a, 0x5c, 0x91,
+	0x15, 0xcd, 0x39, 0xf1, 0xbc, 0x2b, 0xe4, 0xdd, 0x33, 0xff, 0xc7, 0x9b, 0x99, 0xb9, 0x92, 0xe6,
+	0x1c, 0x54, 0x8b, 0x79, 0xc5, 0x2c, 0x0b, 0x2c, 0x57, 0xf4, 0x7e, 0x16, 0x6d, 0xf5, 0x50, 0x70,
+	0x4e, 0x2a, 0xc4, 0xce, 0xef, 0x18, 0x8e, 0x38, 0x11, 0x68, 0x5a, 0x67, 0x8a, 0xf8, 0x17, 0x03,
+	0x30, 0x11, 0x3c, 0xc1, 0xfe, 0x2c, 0x5e, 0x6d, 0x23, 0xe3, 0x60, 0x91, 0x17, 0xe6, 0x1d, 0xf8,
+	0x2e, 0x8c, 0x86, 0x90, 0xdd, 0x03, 0xc8, 0xf6, 0x76, 0x04, 0x77, 0x24, 0xd0, 0xb2, 0x4f, 0x0c,
+	0x35, 0xaa, 0xd6, 0x22, 0xca, 0x51, 0xb6, 0xef, 0x94, 0x6a, 0x45, 0xa2, 0x1c, 0xe1, 0xfb, 0x30,
+	0xee, 0x62, 0x6c, 0xcc, 0x4b, 0xdb, 0x52, 0xbc, 0x41, 0x14, 0x71, 0xcc, 0xd5, 0x53, 0x93, 0xc8,
+	0xba, 0xaa, 0x5d, 0x9c, 0x11, 0xd5, 0x03, 0x9d, 0xa9, 0x76, 0xf5, 0xd4, 0xaa, 0x5e, 0x80, 0x71,
+	0x83, 0x7c, 0xc7, 0x91, 0x2c, 0x62, 0x13, 0x47, 0x22, 0x15, 0x53, 0x39, 0xa0, 0x81, 0xdd, 0x2f,
+	0x8e, 0xba, 0xf3, 0xa2, 0x3b, 0xbd, 0xe9, 0xce, 0xe2, 0x5b, 0x30, 0x56, 0x23, 0xe9, 0x68, 0x65,
+	0x42, 0x23, 0xdd, 0x7d, 0x4d, 0xbc, 0xc2, 0x23, 0xef, 0x17, 0x1e, 0xf9, 0x3b, 0x7e, 0xe1, 0x51,
+	0xe8, 0x7f, 0xf6, 0xcf, 0x19, 0x24, 0x8e, 0x04, 0xaa, 0xdc, 0x15, 0x7c, 0x09, 0xf0, 0xb1, 0x66,
+	0xa8, 0xe6, 0xb1, 0x64, 0x3b, 0xb2, 0xe5, 0x5b, 0x1d, 0xa4, 0x56, 0xc7, 0xbd, 0x95, 0x7d, 0x77,
+	0xc1, 0xb3, 0xbb, 0x0b, 0x13, 0x11, 0x69, 0x6a, 0x39, 0x93, 0xd2, 0xf2, 0x58, 0x8d, 0x3a, 0x77,
+	0x8d, 0xd7, 0x81, 0x6f, 0x28, 0x09, 0x58, 0xb8, 0x6a, 0xdd, 0x7f, 0xa2, 0x7f, 0x85, 0xe0, 0x42,
+	0x53, 0x73, 0xec, 0x1d, 0xd8, 0x04, 0x50, 0x82, 0x59, 0xf6, 0x48, 0xcf, 0x24, 0xdc, 0x13, 0xff,
+	0x72, 0xf9, 0x79, 0x4e, 0xb8, 0xb1, 0x7b, 0xcf, 0xf4, 0x9d, 0xfa, 0x94, 0xd0, 0x37, 0x7a, 0xaa,
+	0xca, 0xe4, 0x01, 0xe4, 0x92, 0xb4, 0x32, 0x1e, 0x56, 0x60, 0x30, 0x78, 0x9a, 0x3c, 0xd6, 0x5b,
+	0xb1, 0x20, 0x06, 0x1b, 0xf8, 0xef, 0xfb, 0x89, 0xe4, 0x1e, 0x31, 0x54, 0x76, 0xd9, 0xbd, 0xa7,
+	0xdf, 0xfe, 0x3f, 0x57, 0x00, 0x7f, 0x44, 0x30, 0x93, 0x88, 0x84, 0xb9, 0x7a, 0x0f, 0x26, 0x2b,
+	0xde, 0x2a, 0x7d, 0xda, 0xa4, 0x8a, 0xb7, 0x1e, 0x7f, 0xf8, 0x0d, 0x7a, 0xd8, 0xe1, 0xe3, 0x4a,
+	0x83, 0x81, 0xee, 0x05, 0x81, 0x1a, 0x56, 0xcf, 0x34, 0xe9, 0xdc, 0xb6, 0xcc, 0x6a, 0xa5, 0xeb,
+	0x57, 0xe4, 0x25, 0x82, 0x8f, 0x62, 0xcd, 0x30, 0x9e, 0xd6, 0x60, 0x98, 0x06, 0x97, 0x54, 0xa2,
+	0xf3, 0x8c, 0x9f, 0x6c, 0x94, 0x9f, 0x70, 0x23, 0x23, 0x66, 0x48, 0x0d, 0x55, 0x75, 0x8f, 0x91,
+	0xe7, 0x08, 0xbe, 0x48, 0xb1, 0xee, 0xb3, 0x6e, 0x8d, 0x1a, 0xc4, 0xe3, 0xdd, 0x8a, 0x2a, 0x3b,
+	0xc4, 0x3e, 0xcd, 0xfd, 0xa8, 0xa3, 0xb4, 0xaf, 0x63, 0x4a, 0xff, 0x82, 0x60, 0xae, 0x15, 0x4c,
+	0xc6, 0xee, 0x7d, 0x98, 0xf0, 0x3b, 0x4f, 0xaa, 0x54, 0xf5, 0x16, 0x19, 0xc5, 0x73, 0x51, 0x8a,
+	0x93, 0x74, 0x31, 0xc2, 0xc7, 0x03, 0x35, 0xcc, 0x44, 0xf7, 0x58, 0xaf, 0x30, 0x6f, 0x02, 0xc3,
+	0xf6, 0x6d, 0x63, 0x5d, 0x37, 0x6d, 0xa2, 0xb2, 0xa2, 0xba, 0xeb, 0x31, 0xf9, 0x0b, 0x04, 0xf3,
+	0x2d, 0x4d, 0xbe, 0x67, 0xb5, 0xe9, 0xf2, 0xd3, 0x29, 0x18, 0xa0, 0xa0, 0xf1, 0x8f, 0x10, 0x8c,
+	0x44, 0x5a, 0x5e, 0x78, 0x3e, 0x8a, 0x27, 0xb1, 0xb1, 0xc7, 0x2d, 0xb4, 0x16, 0xf4, 0x4c, 0xf3,
+	0x2b, 0xdf, 0xfb, 0xeb, 0xbf, 0x7f, 0xd8, 0x7b, 0x05, 0x5f, 0x16, 0xf6, 0x1d, 0x4b, 0x53, 0xc9,
+	0xe2, 0xae, 0x5c, 0xb4, 0x05, 0xad, 0xa8, 0x2c, 0xba, 0x1a, 0x16, 0xa9, 0x0a, 0xcd, 0x28, 0x85,
+	0x6d, 0xcf, 0xf0, 0x9f, 0x8d, 0x7f, 0x8c, 0x20, 0x13, 0xe8, 0xc4, 0x17, 0x62, 0x8c, 0xd6, 0xf7,
+	0xc2, 0xb8, 0x8b, 0xcd, 0x85, 0x18, 0xaa, 0x3d, 0x8a, 0xea, 0xeb, 0xf8, 0x56, 0xfb, 0xa8, 0x84,
+	0xc7, 0xe1, 0xdd, 0x7c, 0x22, 0x14, 0x4f, 0x24, 0xef, 0xce, 0xfe, 0x16, 0xc1, 0x17, 0x62, 0x5a,
+	0x57, 0x78, 0xb1, 0x19, 0x9e, 0x86, 0x06, 0x19, 0x97, 0x4f, 0x2b, 0xce, 0x1c, 0xd9, 0xa2, 0x8e,
+	0xdc, 0xc4, 0xd7, 0x3b, 0xa0, 0x57, 0x78, 0xec, 0xf7, 0xe2, 0x9e, 0xe0, 0x3f, 0x21, 0xf8, 0x20,
+	0xb6, 0x0f, 0x85, 0x85, 0xd6, 0x88, 0x22, 0xed, 0x33, 0xee, 0xb3, 0xf4, 0x1b, 0x98, 0x13, 0xb7,
+	0xa8, 0x13, 0x05, 0x7c, 0xb3, 0x53, 0x27, 0xfc, 0xe3, 0xc0, 0xbf, 0x44, 0x30, 0xd1, 0xd0, 0x1f,
+	0xc2, 0x9f, 0xb6, 0x40, 0x54, 0xdb, 0xc5, 0xe2, 0x2e, 0xa5, 0x13, 0x66, 0xd0, 0x37, 0x28, 0xf4,
+	0xeb, 0x78, 0xb5, 0x03, 0xe8, 0x52, 0x6d, 0xf0, 0x4c, 0xc6, 0xb5, 0x6f, 0x70, 0x3e, 0xfe, 0x9e,
+	0x25, 0x35, 0x95, 0x38, 0x21, 0xb5, 0x3c, 0xc3, 0xbf, 0x4e, 0xf1, 0x5f, 0xc3, 0x2b, 0xa9, 0xf1,
+	0x17, 0x43, 0x5d, 0x12, 0x6b, 0x22, 0xbd, 0x42, 0xf0, 0x61, 0x42, 0x1f, 0x06, 0x2f, 0xc5, 0x23,
+	0x6a, 0xd2, 0x23, 0xe2, 0x96, 0xdb, 0xd9, 0xd2, 0xf1, 0x3d, 0x38, 0x0e, 0xd5, 0x49, 0x72, 0x00,
+	0xf7, 0x27, 0x08, 0xc6, 0xeb, 0xfb, 0x24, 0xf8, 0x93, 0x78, 0x40, 0x71, 0x5d, 0x1b, 0xee, 0xd3,
+	0x54, 0xb2, 0x0c, 0xf5, 0x0d, 0x8a, 0xfa, 0xab, 0xf8, 0x6a, 0x6a, 0xd4, 0xd1, 0x3e, 0x0d, 0xfe,
+	0x19, 0x82, 0x91, 0x48, 0xcb, 0x20, 0xf6, 0x09, 0x8f, 0x6b, 0xa2, 0x70, 0x0b, 0xad, 0x05, 0x19,
+	0xca, 0x5d, 0x8a, 0x72, 0x0b, 0x6f, 0xa4, 0x46, 0xa9, 0xb8, 0x7a, 0x24, 0x1f, 0x6b, 0xf4, 0x8a,
+	0xfe, 0x06, 0xc1, 0x54, 0x7c, 0x99, 0x83, 0x3f, 0x6b, 0xf1, 0x55, 0x69, 0x28, 0xc0, 0xb8, 0xa5,
+	0x36, 0x76, 0x74, 0xfc, 0x41, 0xaa, 0xa9, 0x9c, 0x7e, 0x87, 0xe2, 0xda, 0x17, 0x4d, 0xdf, 0x97,
+	0xba, 0x92, 0x88, 0xbb, 0x94, 0x4e, 0x98, 0xa1, 0xbd, 0x4d, 0xd1, 0xee, 0xe0, 0xed, 0x76, 0xd1,
+	0x9e, 0x24, 0x7c, 0xa7, 0x7e, 0x8d, 0x00, 0x37, 0x96, 0x1b, 0x38, 0x0e, 0x55, 0x62, 0x7d, 0xc4,
+	0x2d, 0xa6, 0x94, 0x66, 0x4e, 0x6c, 0x52, 0x27, 0x6e, 0xe0, 0x6b, 0xa9, 0x9d, 0x88, 0x2b, 0x79,
+	0xf0, 0x73, 0x04, 0xa3, 0xd1, 0xec, 0x1f, 0x27, 0xe4, 0x21, 0x8d, 0x75, 0x08, 0xf7, 0xa5, 0x14,
+	0x92, 0x0c, 0xee, 0x35, 0x0a, 0xf7, 0x2a, 0xbe, 0x92, 0x1a, 0x6e, 0x6d, 0xe5, 0x81, 0xff, 0x8c,
+	0xe0, 0x5c, 0x62, 0x46, 0x8d, 0x2f, 0xc7, 0xe0, 0x68, 0x55, 0x26, 0x70, 0x9f, 0xb7, 0xb7, 0x89,
+	0xf9, 0x51, 0xa0, 0x7e, 0xac, 0xe2, 0xaf, 0xa5, 0xf6, 0xa3, 0x21, 0xc7, 0xc7, 0x7f, 0x43, 0xc0,
+	0x25, 0x67, 0xb7, 0xf8, 0xf3, 0xa6, 0x1f, 0xcb, 0x84, 0xfc, 0x9b, 0xbb, 0xd2, 0xe6, 0x2e, 0xe6,
+	0xcf, 0x37, 0xa8, 0x3f, 0x9b, 0x78, 0x3d, 0xfd, 0x5d, 0xa0, 0x8a, 0x24, 0xff, 0x22, 0xd4, 0x24,
+	0xe0, 0x05, 0xf1, 0xd5, 0xdb, 0x1c, 0x7a, 0xfd, 0x36, 0x87, 0xfe, 0xf5, 0x36, 0x87, 0x9e, 0xbd,
+	0xcb, 0xf5, 0xbc, 0x7e, 0x97, 0xeb, 0xf9, 0xfb, 0xbb, 0x5c, 0xcf, 0xb7, 0xbe, 0x52, 0xd3, 0x23,
+	0x4b, 0x6b, 0x88, 0x76, 0xce, 0x8a, 0x67, 0x68, 0x7f, 0xe9, 0xf2, 0xff, 0x06, 0x00, 0x2d, 0x97,
+	0xfc, 0x2d, 0xf9, 0x1f, 0x00, 0x00,
+}
+
+// Reference imports to suppress errors if they are not otherwise used.
+var _ context.Context
+var _ grpc.ClientConn
+
+// This is a compile-time assertion to ensure that this generated file
+// is compatible with the grpc package it is being compiled against.
+const _ = grpc.SupportPackageIsVersion4
+
+// QueryClient is the client API for Query service.
+//
+// For semantics around ctx use and closing/ending streaming RPCs, please refer to https://godoc.org/google.golang.org/grpc#ClientConn.NewStream.
+type QueryClient interface {
+	// Queries all rate limits, optionally filtered by denom, channel, and
+	// utilization
+	// Ex:
+	//  - /ratelimits?denom={denom}&channel_id={channel_id}&min_utilization=80
+	AllRateLimits(ctx context.Context, in *QueryAllRateLimitsRequest, opts ...grpc.CallOption) (*QueryAllRateLimitsResponse, error)
+	// Queries a specific rate limit by channel ID and denom
+	// Ex:
+	//  - /ratelimit/{channel_id}/by_denom?denom={denom}
+	RateLimit(ctx context.Context, in *QueryRateLimitRequest, opts ...grpc.CallOption) (*QueryRateLimitResponse, error)
+	// Queries all the rate limits for a given chain
+	RateLimitsByChainId(ctx context.Context, in *QueryRateLimitsByChainIdRequest, opts ...grpc.CallOption) (*QueryRateLimitsByChainIdResponse, error)
+	// Queries all the rate limits for a given channel ID
+	RateLimitsByChannelId(ctx context.Context, in *QueryRateLimitsByChannelIdRequest, opts ...grpc.CallOption) (*QueryRateLimitsByChannelIdResponse, error)
+	// Queries all the rate limits for a given denom
+	// The denom can be either the base denom, the ibc/ hash, or the full denom
+	// trace (e.g. transfer/channel-X/uatom), which is hashed into the ibc/ form
+	// Ex:
+	//  - /ratelimits_by_denom?denom={denom}
+	RateLimitsByDenom(ctx context.Context, in *QueryRateLimitsByDenomRequest, opts ...grpc.CallOption) (*QueryRateLimitsByDenomResponse, error)
+	// Queries all blacklisted denoms
+	AllBlacklistedDenoms(ctx context.Context, in *QueryAllBlacklistedDenomsRequest, opts ...grpc.CallOption) (*QueryAllBlacklistedDenomsResponse, error)
+	// Queries all whitelisted address pairs
+	AllWhitelistedAddresses(ctx context.Context, in *QueryAllWhitelistedAddressesRequest, opts ...grpc.CallOption) (*QueryAllWhitelistedAddressesResponse, error)
+	// Queries all transfer rules
+	AllTransferRules(ctx context.Context, in *QueryAllTransferRulesRequest, opts ...grpc.CallOption) (*QueryAllTransferRulesResponse, error)
+	// Checks whether a transfer would be allowed by the rate limits, without
+	// updating the flow
+	// Ex:
+	//  - /check_transfer/{channel_id}?denom={denom}&direction=PACKET_SEND&amount={amount}
+	CheckTransfer(ctx context.Context, in *QueryCheckTransferRequest, opts ...grpc.CallOption) (*QueryCheckTransferResponse, error)
+	// Queries the remaining capacity, utilization, and next reset of each rate
+	// limit
+	AllRateLimitCapacities(ctx context.Context, in *QueryAllRateLimitCapacitiesRequest, opts ...grpc.CallOption) (*QueryAllRateLimitCapacitiesResponse, error)
+	// Queries the remaining capacity, utilization, and next reset of a specific
+	// rate limit by channel ID and denom
+	// Ex:
+	//  - /capacity/{channel_id}/by_denom?denom={denom}
+	RateLimitCapacity(ctx context.Context, in *QueryRateLimitCapacityRequest, opts ...grpc.CallOption) (*QueryRateLimitCapacityResponse, error)
+	// Queries all pending send packets (i.e. packets that have not yet been
+	// acknowledged or timed out), optionally filtered by channel ID
+	// Ex:
+	//  - /pending_send_packets
+	//  - /pending_send_packets?channel_id={channel_id}
+	PendingSendPackets(ctx context.Context, in *QueryPendingSendPacketsRequest, opts ...grpc.CallOption) (*QueryPendingSendPacketsResponse, error)
+	// Queries all denom groups
+	AllDenomGroups(ctx context.Context, in *QueryAllDenomGroupsRequest, opts ...grpc.CallOption) (*QueryAllDenomGroupsResponse, error)
+	// Queries the upcoming scheduled rate limit updates, optionally filtered by
+	// denom and channel
+	ScheduledRateLimitUpdates(ctx context.Context, in *QueryScheduledRateLimitUpdatesRequest, opts ...grpc.CallOption) (*QueryScheduledRateLimitUpdatesResponse, error)
+	// Queries the rate limits on channels that do not exist or have been closed
+	// (e.g. an ordered ICA channel that timed out), which should be removed by
+	// governance
+	// Ex:
+	//  - /closed_channel_rate_limits
+	RateLimitsOnClosedChannels(ctx context.Context, in *QueryRateLimitsOnClosedChannelsRequest, opts ...grpc.CallOption) (*QueryRateLimitsOnClosedChannelsResponse, error)
+}
+
+type queryClient struct {
+	cc grpc1.ClientConn
+}
+
+func NewQueryClient(cc grpc1.ClientConn) QueryClient {
+	return &queryClient{cc}
+}
+
+func (c *queryClient) AllRateLimits(ctx context.Context, in *QueryAllRateLimitsRequest, opts ...grpc.CallOption) (*QueryAllRateLimitsResponse, error) {
+	out := new(QueryAllRateLimitsResponse)
+	err := c.cc.Invoke(ctx, "/ratelimit.v1.Query/AllRateLimits", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *queryClient) RateLimit(ctx context.Context, in *QueryRateLimitRequest, opts ...grpc.CallOption) (*QueryRateLimitResponse, error) {
+	out := new(QueryRateLimitResponse)
+	err := c.cc.Invoke(ctx, "/ratelimit.v1.Query/RateLimit", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *queryClient) RateLimitsByChainId(ctx context.Context, in *QueryRateLimitsByChainIdRequest, opts ...grpc.CallOption) (*QueryRateLimitsByChainIdResponse, error) {
+	out := new(QueryRateLimitsByChainIdResponse)
+	err := c.cc.Invoke(ctx, "/ratelimit.v1.Query/RateLimitsByChainId", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *queryClient) RateLimitsByChannelId(ctx context.Context, in *QueryRateLimitsByChannelIdRequest, opts ...grpc.CallOption) (*QueryRateLimitsByChannelIdResponse, error) {
+	out := new(QueryRateLimitsByChannelIdResponse)
+	err := c.cc.Invoke(ctx, "/ratelimit.v1.Query/RateLimitsByChannelId", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *queryClient) RateLimitsByDenom(ctx context.Context, in *QueryRateLimitsByDenomRequest, opts ...grpc.CallOption) (*QueryRateLimitsByDenomResponse, error) {
+	out := new(QueryRateLimitsByDenomResponse)
+	err := c.cc.Invoke(ctx, "/ratelimit.v1.Query/RateLimitsByDenom", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *queryClient) AllBlacklistedDenoms(ctx context.Context, in *QueryAllBlacklistedDenomsRequest, opts ...grpc.CallOption) (*QueryAllBlacklistedDenomsResponse, error) {
+	out := new(QueryAllBlacklistedDenomsResponse)
+	err := c.cc.Invoke(ctx, "/ratelimit.v1.Query/AllBlacklistedDenoms", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *queryClient) AllWhitelistedAddresses(ctx context.Context, in *QueryAllWhitelistedAddressesRequest, opts ...grpc.CallOption) (*QueryAllWhitelistedAddressesResponse, error) {
+	out := new(QueryAllWhitelistedAddressesResponse)
+	err := c.cc.Invoke(ctx, "/ratelimit.v1.Query/AllWhitelistedAddresses", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *queryClient) AllTransferRules(ctx context.Context, in *QueryAllTransferRulesRequest, opts ...grpc.CallOption) (*QueryAllTransferRulesResponse, error) {
+	out := new(QueryAllTransferRulesResponse)
+	err := c.cc.Invoke(ctx, "/ratelimit.v1.Query/AllTransferRules", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *queryClient) CheckTransfer(ctx context.Context, in *QueryCheckTransferRequest, opts ...grpc.CallOption) (*QueryCheckTransferResponse, error) {
+	out := new(QueryCheckTransferResponse)
+	err := c.cc.Invoke(ctx, "/ratelimit.v1.Query/CheckTransfer", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *queryClient) AllRateLimitCapacities(ctx context.Context, in *QueryAllRateLimitCapacitiesRequest, opts ...grpc.CallOption) (*QueryAllRateLimitCapacitiesResponse, error) {
+	out := new(QueryAllRateLimitCapacitiesResponse)
+	err := c.cc.Invoke(ctx, "/ratelimit.v1.Query/AllRateLimitCapacities", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *queryClient) RateLimitCapacity(ctx context.Context, in *QueryRateLimitCapacityRequest, opts ...grpc.CallOption) (*QueryRateLimitCapacityResponse, error) {
+	out := new(QueryRateLimitCapacityResponse)
+	err := c.cc.Invoke(ctx, "/ratelimit.v1.Query/RateLimitCapacity", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *queryClient) PendingSendPackets(ctx context.Context, in *QueryPendingSendPacketsRequest, opts ...grpc.CallOption) (*QueryPendingSendPacketsResponse, error) {
+	out := new(QueryPendingSendPacketsResponse)
+	err := c.cc.Invoke(ctx, "/ratelimit.v1.Query/PendingSendPackets", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *queryClient) AllDenomGroups(ctx context.Context, in *QueryAllDenomGroupsRequest, opts ...grpc.CallOption) (*QueryAllDenomGroupsResponse, error) {
+	out := new(QueryAllDenomGroupsResponse)
+	err := c.cc.Invoke(ctx, "/ratelimit.v1.Query/AllDenomGroups", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *queryClient) ScheduledRateLimitUpdates(ctx context.Context, in *QueryScheduledRateLimitUpdatesRequest, opts ...grpc.CallOption) (*QueryScheduledRateLimitUpdatesResponse, error) {
+	out := new(QueryScheduledRateLimitUpdatesResponse)
+	err := c.cc.Invoke(ctx, "/ratelimit.v1.Query/ScheduledRateLimitUpdates", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *queryClient) RateLimitsOnClosedChannels(ctx context.Context, in *QueryRateLimitsOnClosedChannelsRequest, opts ...grpc.CallOption) (*QueryRateLimitsOnClosedChannelsResponse, error) {
+	out := new(QueryRateLimitsOnClosedChannelsResponse)
+	err := c.cc.Invoke(ctx, "/ratelimit.v1.Query/RateLimitsOnClosedChannels", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+// QueryServer is the server API for Query service.
+type QueryServer interface {
+	// Queries all rate limits, optionally filtered by denom, channel, and
+	// utilization
+	// Ex:
+	//  - /ratelimits?denom={denom}&channel_id={channel_id}&min_utilization=80
+	AllRateLimits(context.Context, *QueryAllRateLimitsRequest) (*QueryAllRateLimitsResponse, error)
+	// Queries a specific rate limit by channel ID and denom
+	// Ex:
+	//  - /ratelimit/{channel_id}/by_denom?denom={denom}
+	RateLimit(context.Context, *QueryRateLimitRequest) (*QueryRateLimitResponse, error)
+	// Queries all the rate limits for a given chain
+	RateLimitsByChainId(context.Context, *QueryRateLimitsByChainIdRequest) (*QueryRateLimitsByChainIdResponse, error)
+	// Queries all the rate limits for a given channel ID
+	RateLimitsByChannelId(context.Context, *QueryRateLimitsByChannelIdRequest) (*QueryRateLimitsByChannelIdResponse, error)
+	// Queries all the rate limits for a given denom
+	// The denom can be either the base denom, the ibc/ hash, or the full denom
+	// trace (e.g. transfer/channel-X/uatom), which is hashed into the ibc/ form
+	// Ex:
+	//  - /ratelimits_by_denom?denom={denom}
+	RateLimitsByDenom(context.Context, *QueryRateLimitsByDenomRequest) (*QueryRateLimitsByDenomResponse, error)
+	// Queries all blacklisted denoms
+	AllBlacklistedDenoms(context.Context, *QueryAllBlacklistedDenomsRequest) (*QueryAllBlacklistedDenomsResponse, error)
+	// Queries all whitelisted address pairs
+	AllWhitelistedAddresses(context.Context, *QueryAllWhitelistedAddressesRequest) (*QueryAllWhitelistedAddressesResponse, error)
+	// Queries all transfer rules
+	AllTransferRules(context.Context, *QueryAllTransferRulesRequest) (*QueryAllTransferRulesResponse, error)
+	// Checks whether a transfer would be allowed by the rate limits, without
+	// updating the flow
+	// Ex:
+	//  - /check_transfer/{channel_id}?denom={denom}&direction=PACKET_SEND&amount={amount}
+	CheckTransfer(context.Context, *QueryCheckTransferRequest) (*QueryCheckTransferResponse, error)
+	// Queries the remaining capacity, utilization, and next reset of each rate
+	// limit
+	AllRateLimitCapacities(context.Context, *QueryAllRateLimitCapacitiesRequest) (*QueryAllRateLimitCapacitiesResponse, error)
+	// Queries the remaining capacity, utilization, and next reset of a specific
+	// rate limit by channel ID and denom
+	// Ex:
+	//  - /capacity/{channel_id}/by_denom?denom={denom}
+	RateLimitCapacity(context.Context, *QueryRateLimitCapacityRequest) (*QueryRateLimitCapacityResponse, error)
+	// Queries all pending send packets (i.e. packets that have not yet been
+	// acknowledged or timed out), optionally filtered by channel ID
+	// Ex:
+	//  - /pending_send_packets
+	//  - /pending_send_packets?channel_id={channel_id}
+	PendingSendPackets(context.Context, *QueryPendingSendPacketsRequest) (*QueryPendingSendPacketsResponse, error)
+	// Queries all denom groups
+	AllDenomGroups(context.Context, *QueryAllDenomGroupsRequest) (*QueryAllDenomGroupsResponse, error)
+	// Queries the upcoming scheduled rate limit updates, optionally filtered by
+	// denom and channel
+	ScheduledRateLimitUpdates(context.Context, *QueryScheduledRateLimitUpdatesRequest) (*QueryScheduledRateLimitUpdatesResponse, error)
+	// Queries the rate limits on channels that do not exist or have been closed
+	// (e.g. an ordered ICA channel that timed out), which should be removed by
+	// governance
+	// Ex:
+	//  - /closed_channel_rate_limits
+	RateLimitsOnClosedChannels(context.Context, *QueryRateLimitsOnClosedChannelsRequest) (*QueryRateLimitsOnClosedChannelsResponse, error)
+}
+
+// UnimplementedQueryServer can be embedded to have forward compatible implementations.
+type UnimplementedQueryServer struct {
+}
+
+func (*UnimplementedQueryServer) AllRateLimits(ctx context.Context, req *QueryAllRateLimitsRequest) (*QueryAllRateLimitsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method AllRateLimits not implemented")
+}
+func (*UnimplementedQueryServer) RateLimit(ctx context.Context, req *QueryRateLimitRequest) (*QueryRateLimitResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method RateLimit not implemented")
+}
+func (*UnimplementedQueryServer) RateLimitsByChainId(ctx context.Context, req *QueryRateLimitsByChainIdRequest) (*QueryRateLimitsByChainIdResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method RateLimitsByChainId not implemented")
+}
+func (*UnimplementedQueryServer) RateLimitsByChannelId(ctx context.Context, req *QueryRateLimitsByChannelIdRequest) (*QueryRateLimitsByChannelIdResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method RateLimitsByChannelId not implemented")
+}
+func (*UnimplementedQueryServer) RateLimitsByDenom(ctx context.Context, req *QueryRateLimitsByDenomRequest) (*QueryRateLimitsByDenomResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method RateLimitsByDenom not implemented")
+}
+func (*UnimplementedQueryServer) AllBlacklistedDenoms(ctx context.Context, req *QueryAllBlacklistedDenomsRequest) (*QueryAllBlacklistedDenomsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method AllBlacklistedDenoms not implemented")
+}
+func (*UnimplementedQueryServer) AllWhitelistedAddresses(ctx context.Context, req *QueryAllWhitelistedAddressesRequest) (*QueryAllWhitelistedAddressesResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method AllWhitelistedAddresses not implemented")
+}
+func (*UnimplementedQueryServer) AllTransferRules(ctx context.Context, req *QueryAllTransferRulesRequest) (*QueryAllTransferRulesResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method AllTransferRules not implemented")
+}
+func (*UnimplementedQueryServer) CheckTransfer(ctx context.Context, req *QueryCheckTransferRequest) (*QueryCheckTransferResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method CheckTransfer not implemented")
+}
+func (*UnimplementedQueryServer) AllRateLimitCapacities(ctx context.Context, req *QueryAllRateLimitCapacitiesRequest) (*QueryAllRateLimitCapacitiesResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method AllRateLimitCapacities not implemented")
+}
+func (*UnimplementedQueryServer) RateLimitCapacity(ctx context.Context, req *QueryRateLimitCapacityRequest) (*QueryRateLimitCapacityResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method RateLimitCapacity not implemented")
+}
+func (*UnimplementedQueryServer) PendingSendPackets(ctx context.Context, req *QueryPendingSendPacketsRequest) (*QueryPendingSendPacketsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method PendingSendPackets not implemented")
+}
+func (*UnimplementedQueryServer) AllDenomGroups(ctx context.Context, req *QueryAllDenomGroupsRequest) (*QueryAllDenomGroupsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method AllDenomGroups not implemented")
+}
+func (*UnimplementedQueryServer) ScheduledRateLimitUpdates(ctx context.Context, req *QueryScheduledRateLimitUpdatesRequest) (*QueryScheduledRateLimitUpdatesResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ScheduledRateLimitUpdates not implemented")
+}
+func (*UnimplementedQueryServer) RateLimitsOnClosedChannels(ctx context.Context, req *QueryRateLimitsOnClosedChannelsRequest) (*QueryRateLimitsOnClosedChannelsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method RateLimitsOnClosedChannels not implemented")
+}
+
+func RegisterQueryServer(s grpc1.Server, srv QueryServer) {
+	s.RegisterService(&_Query_serviceDesc, srv)
+}
+
+func _Query_AllRateLimits_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryAllRateLimitsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).AllRateLimits(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/ratelimit.v1.Query/AllRateLimits",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).AllRateLimits(ctx, req.(*QueryAllRateLimitsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Query_RateLimit_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryRateLimitRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).RateLimit(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/ratelimit.v1.Query/RateLimit",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).RateLimit(ctx, req.(*QueryRateLimitRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Query_RateLimitsByChainId_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryRateLimitsByChainIdRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).RateLimitsByChainId(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/ratelimit.v1.Query/RateLimitsByChainId",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).RateLimitsByChainId(ctx, req.(*QueryRateLimitsByChainIdRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Query_RateLimitsByChannelId_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryRateLimitsByChannelIdRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).RateLimitsByChannelId(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/ratelimit.v1.Query/RateLimitsByChannelId",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).RateLimitsByChannelId(ctx, req.(*QueryRateLimitsByChannelIdRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Query_RateLimitsByDenom_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryRateLimitsByDenomRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).RateLimitsByDenom(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/ratelimit.v1.Query/RateLimitsByDenom",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).RateLimitsByDenom(ctx, req.(*QueryRateLimitsByDenomRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Query_AllBlacklistedDenoms_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryAllBlacklistedDenomsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).AllBlacklistedDenoms(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/ratelimit.v1.Query/AllBlacklistedDenoms",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).AllBlacklistedDenoms(ctx, req.(*QueryAllBlacklistedDenomsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Query_AllWhitelistedAddresses_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryAllWhitelistedAddressesRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).AllWhitelistedAddresses(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/ratelimit.v1.Query/AllWhitelistedAddresses",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).AllWhitelistedAddresses(ctx, req.(*QueryAllWhitelistedAddressesRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Query_AllTransferRules_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryAllTransferRulesRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).AllTransferRules(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/ratelimit.v1.Query/AllTransferRules",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).AllTransferRules(ctx, req.(*QueryAllTransferRulesRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Query_CheckTransfer_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryCheckTransferRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).CheckTransfer(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/ratelimit.v1.Query/CheckTransfer",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).CheckTransfer(ctx, req.(*QueryCheckTransferRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Query_AllRateLimitCapacities_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryAllRateLimitCapacitiesRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).AllRateLimitCapacities(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/ratelimit.v1.Query/AllRateLimitCapacities",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).AllRateLimitCapacities(ctx, req.(*QueryAllRateLimitCapacitiesRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Query_RateLimitCapacity_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryRateLimitCapacityRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).RateLimitCapacity(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/ratelimit.v1.Query/RateLimitCapacity",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).RateLimitCapacity(ctx, req.(*QueryRateLimitCapacityRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Query_PendingSendPackets_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryPendingSendPacketsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).PendingSendPackets(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/ratelimit.v1.Query/PendingSendPackets",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).PendingSendPackets(ctx, req.(*QueryPendingSendPacketsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Query_AllDenomGroups_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryAllDenomGroupsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).AllDenomGroups(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/ratelimit.v1.Query/AllDenomGroups",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).AllDenomGroups(ctx, req.(*QueryAllDenomGroupsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Query_ScheduledRateLimitUpdates_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryScheduledRateLimitUpdatesRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).ScheduledRateLimitUpdates(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/ratelimit.v1.Query/ScheduledRateLimitUpdates",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).ScheduledRateLimitUpdates(ctx, req.(*QueryScheduledRateLimitUpdatesRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Query_RateLimitsOnClosedChannels_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryRateLimitsOnClosedChannelsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).RateLimitsOnClosedChannels(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/ratelimit.v1.Query/RateLimitsOnClosedChannels",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).RateLimitsOnClosedChannels(ctx, req.(*QueryRateLimitsOnClosedChannelsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+var _Query_serviceDesc = grpc.ServiceDesc{
+	ServiceName: "ratelimit.v1.Query",
+	HandlerType: (*QueryServer)(nil),
+	Methods: []grpc.MethodDesc{
+		{
+			MethodName: "AllRateLimits",
+			Handler:    _Query_AllRateLimits_Handler,
+		},
+		{
+			MethodName: "RateLimit",
+			Handler:    _Query_RateLimit_Handler,
+		},
+		{
+			MethodName: "RateLimitsByChainId",
+			Handler:    _Query_RateLimitsByChainId_Handler,
+		},
+		{
+			MethodName: "RateLimitsByChannelId",
+			Handler:    _Query_RateLimitsByChannelId_Handler,
+		},
+		{
+			MethodName: "RateLimitsByDenom",
+			Handler:    _Query_RateLimitsByDenom_Handler,
+		},
+		{
+			MethodName: "AllBlacklistedDenoms",
+			Handler:    _Query_AllBlacklistedDenoms_Handler,
+		},
+		{
+			MethodName: "AllWhitelistedAddresses",
+			Handler:    _Query_AllWhitelistedAddresses_Handler,
+		},
+		{
+			MethodName: "AllTransferRules",
+			Handler:    _Query_AllTransferRules_Handler,
+		},
+		{
+			MethodName: "CheckTransfer",
+			Handler:    _Query_CheckTransfer_Handler,
+		},
+		{
+			MethodName: "AllRateLimitCapacities",
+			Handler:    _Query_AllRateLimitCapacities_Handler,
+		},
+		{
+			MethodName: "RateLimitCapacity",
+			Handler:    _Query_RateLimitCapacity_Handler,
+		},
+		{
+			MethodName: "PendingSendPackets",
+			Handler:    _Query_PendingSendPackets_Handler,
+		},
+		{
+			MethodName: "AllDenomGroups",
+			Handler:    _Query_AllDenomGroups_Handler,
+		},
+		{
+			MethodName: "ScheduledRateLimitUpdates",
+			Handler:    _Query_ScheduledRateLimitUpdates_Handler,
+		},
+		{
+			MethodName: "RateLimitsOnClosedChannels",
+			Handler:    _Query_RateLimitsOnClosedChannels_Handler,
+		},
+	},
+	Streams:  []grpc.StreamDesc{},
+	Metadata: "ratelimit/v1/query.proto",
+}
+
+func (m *QueryAllRateLimitsRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryAllRateLimitsRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryAllRateLimitsRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.MinUtilization) > 0 {
+		i -= len(m.MinUtilization)
+		copy(dAtA[i:], m.MinUtilization)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.MinUtilization)))
+		i--
+		dAtA[i] = 0x22
+	}
+	if len(m.ChannelId) > 0 {
+		i -= len(m.ChannelId)
+		copy(dAtA[i:], m.ChannelId)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.ChannelId)))
+		i--
+		dAtA[i] = 0x1a
+	}
+	if len(m.Denom) > 0 {
+		i -= len(m.Denom)
+		copy(dAtA[i:], m.Denom)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.Denom)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if m.Pagination != nil {
+		{
+			size, err := m.Pagination.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintQuery(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryAllRateLimitsResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryAllRateLimitsResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryAllRateLimitsResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Pagination != nil {
+		{
+			size, err := m.Pagination.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintQuery(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.RateLimits) > 0 {
+		for iNdEx := len(m.RateLimits) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.RateLimits[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintQuery(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0xa
+		}
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryRateLimitRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryRateLimitRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryRateLimitRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.ChannelId) > 0 {
+		i -= len(m.ChannelId)
+		copy(dAtA[i:], m.ChannelId)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.ChannelId)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.Denom) > 0 {
+		i -= len(m.Denom)
+		copy(dAtA[i:], m.Denom)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.Denom)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryRateLimitResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryRateLimitResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryRateLimitResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.RateLimit != nil {
+		{
+			size, err := m.RateLimit.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintQuery(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryRateLimitsByChainIdRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryRateLimitsByChainIdRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryRateLimitsByChainIdRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.ChainId) > 0 {
+		i -= len(m.ChainId)
+		copy(dAtA[i:], m.ChainId)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.ChainId)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryRateLimitsByChainIdResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryRateLimitsByChainIdResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryRateLimitsByChainIdResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.RateLimits) > 0 {
+		for iNdEx := len(m.RateLimits) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.RateLimits[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintQuery(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0xa
+		}
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryRateLimitsByChannelIdRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryRateLimitsByChannelIdRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryRateLimitsByChannelIdRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Pagination != nil {
+		{
+			size, err := m.Pagination.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintQuery(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.ChannelId) > 0 {
+		i -= len(m.ChannelId)
+		copy(dAtA[i:], m.ChannelId)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.ChannelId)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryRateLimitsByChannelIdResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryRateLimitsByChannelIdResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryRateLimitsByChannelIdResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Pagination != nil {
+		{
+			size, err := m.Pagination.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintQuery(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.RateLimits) > 0 {
+		for iNdEx := len(m.RateLimits) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.RateLimits[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintQuery(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0xa
+		}
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryRateLimitsByDenomRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryRateLimitsByDenomRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryRateLimitsByDenomRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Pagination != nil {
+		{
+			size, err := m.Pagination.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintQuery(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.Denom) > 0 {
+		i -= len(m.Denom)
+		copy(dAtA[i:], m.Denom)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.Denom)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryRateLimitsByDenomResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryRateLimitsByDenomResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryRateLimitsByDenomResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Pagination != nil {
+		{
+			size, err := m.Pagination.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintQuery(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.RateLimits) > 0 {
+		for iNdEx := len(m.RateLimits) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.RateLimits[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintQuery(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0xa
+		}
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryAllBlacklistedDenomsRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryAllBlacklistedDenomsRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryAllBlacklistedDenomsRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Pagination != nil {
+		{
+			size, err := m.Pagination.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintQuery(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryAllBlacklistedDenomsResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryAllBlacklistedDenomsResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryAllBlacklistedDenomsResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Pagination != nil {
+		{
+			size, err := m.Pagination.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintQuery(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.Denoms) > 0 {
+		for iNdEx := len(m.Denoms) - 1; iNdEx >= 0; iNdEx-- {
+			i -= len(m.Denoms[iNdEx])
+			copy(dAtA[i:], m.Denoms[iNdEx])
+			i = encodeVarintQuery(dAtA, i, uint64(len(m.Denoms[iNdEx])))
+			i--
+			dAtA[i] = 0xa
+		}
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryAllWhitelistedAddressesRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryAllWhitelistedAddressesRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryAllWhitelistedAddressesRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Pagination != nil {
+		{
+			size, err := m.Pagination.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintQuery(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryAllWhitelistedAddressesResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryAllWhitelistedAddressesResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryAllWhitelistedAddressesResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Pagination != nil {
+		{
+			size, err := m.Pagination.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintQuery(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.AddressPairs) > 0 {
+		for iNdEx := len(m.AddressPairs) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.AddressPairs[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintQuery(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0xa
+		}
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryAllTransferRulesRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryAllTransferRulesRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryAllTransferRulesRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Pagination != nil {
+		{
+			size, err := m.Pagination.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintQuery(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryAllTransferRulesResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryAllTransferRulesResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryAllTransferRulesResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Pagination != nil {
+		{
+			size, err := m.Pagination.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintQuery(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.TransferRules) > 0 {
+		for iNdEx := len(m.TransferRules) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.TransferRules[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintQuery(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0xa
+		}
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryCheckTransferRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryCheckTransferRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryCheckTransferRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Memo) > 0 {
+		i -= len(m.Memo)
+		copy(dAtA[i:], m.Memo)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.Memo)))
+		i--
+		dAtA[i] = 0x3a
+	}
+	if len(m.Receiver) > 0 {
+		i -= len(m.Receiver)
+		copy(dAtA[i:], m.Receiver)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.Receiver)))
+		i--
+		dAtA[i] = 0x32
+	}
+	if len(m.Sender) > 0 {
+		i -= len(m.Sender)
+		copy(dAtA[i:], m.Sender)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.Sender)))
+		i--
+		dAtA[i] = 0x2a
+	}
+	if len(m.Amount) > 0 {
+		i -= len(m.Amount)
+		copy(dAtA[i:], m.Amount)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.Amount)))
+		i--
+		dAtA[i] = 0x22
+	}
+	if m.Direction != 0 {
+		i = encodeVarintQuery(dAtA, i, uint64(m.Direction))
+		i--
+		dAtA[i] = 0x18
+	}
+	if len(m.ChannelId) > 0 {
+		i -= len(m.ChannelId)
+		copy(dAtA[i:], m.ChannelId)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.ChannelId)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.Denom) > 0 {
+		i -= len(m.Denom)
+		copy(dAtA[i:], m.Denom)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.Denom)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryCheckTransferResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryCheckTransferResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryCheckTransferResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	{
+		size := m.RemainingCapacity.Size()
+		i -= size
+		if _, err := m.RemainingCapacity.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintQuery(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x2a
+	if m.RateLimited {
+		i--
+		if m.RateLimited {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i--
+		dAtA[i] = 0x20
+	}
+	if len(m.Error) > 0 {
+		i -= len(m.Error)
+		copy(dAtA[i:], m.Error)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.Error)))
+		i--
+		dAtA[i] = 0x1a
+	}
+	if len(m.Reason) > 0 {
+		i -= len(m.Reason)
+		copy(dAtA[i:], m.Reason)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.Reason)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if m.Allowed {
+		i--
+		if m.Allowed {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *RateLimitCapacity) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *RateLimitCapacity) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *RateLimitCapacity) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.WindowStartTime != nil {
+		n14, err14 := github_com_cosmos_gogoproto_types.StdTimeMarshalTo(*m.WindowStartTime, dAtA[i-github_com_cosmos_gogoproto_types.SizeOfStdTime(*m.WindowStartTime):])
+		if err14 != nil {
+			return 0, err14
+		}
+		i -= n14
+		i = encodeVarintQuery(dAtA, i, uint64(n14))
+		i--
+		dAtA[i] = 0x4a
+	}
+	if m.WindowStartEpoch != 0 {
+		i = encodeVarintQuery(dAtA, i, uint64(m.WindowStartEpoch))
+		i--
+		dAtA[i] = 0x40
+	}
+	if m.NextResetTime != nil {
+		n15, err15 := github_com_cosmos_gogoproto_types.StdTimeMarshalTo(*m.NextResetTime, dAtA[i-github_com_cosmos_gogoproto_types.SizeOfStdTime(*m.NextResetTime):])
+		if err15 != nil {
+			return 0, err15
+		}
+		i -= n15
+		i = encodeVarintQuery(dAtA, i, uint64(n15))
+		i--
+		dAtA[i] = 0x3a
+	}
+	if m.NextResetEpoch != 0 {
+		i = encodeVarintQuery(dAtA, i, uint64(m.NextResetEpoch))
+		i--
+		dAtA[i] = 0x30
+	}
+	{
+		size := m.RecvUtilization.Size()
+		i -= size
+		if _, err := m.RecvUtilization.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintQuery(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x2a
+	{
+		size := m.SendUtilization.Size()
+		i -= size
+		if _, err := m.SendUtilization.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintQuery(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x22
+	{
+		size := m.RemainingRecv.Size()
+		i -= size
+		if _, err := m.RemainingRecv.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintQuery(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x1a
+	{
+		size := m.RemainingSend.Size()
+		i -= size
+		if _, err := m.RemainingSend.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintQuery(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x12
+	{
+		size, err := m.RateLimit.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintQuery(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0xa
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryAllRateLimitCapacitiesRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryAllRateLimitCapacitiesRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryAllRateLimitCapacitiesRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Pagination != nil {
+		{
+			size, err := m.Pagination.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintQuery(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryAllRateLimitCapacitiesResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryAllRateLimitCapacitiesResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryAllRateLimitCapacitiesResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Pagination != nil {
+		{
+			size, err := m.Pagination.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintQuery(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.Capacities) > 0 {
+		for iNdEx := len(m.Capacities) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Capacities[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintQuery(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0xa
+		}
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryRateLimitCapacityRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryRateLimitCapacityRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryRateLimitCapacityRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.ChannelId) > 0 {
+		i -= len(m.ChannelId)
+		copy(dAtA[i:], m.ChannelId)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.ChannelId)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.Denom) > 0 {
+		i -= len(m.Denom)
+		copy(dAtA[i:], m.Denom)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.Denom)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryRateLimitCapacityResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryRateLimitCapacityResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryRateLimitCapacityResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Capacity != nil {
+		{
+			size, err := m.Capacity.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintQuery(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryPendingSendPacketsRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryPendingSendPacketsRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryPendingSendPacketsRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Pagination != nil {
+		{
+			size, err := m.Pagination.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintQuery(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.ChannelId) > 0 {
+		i -= len(m.ChannelId)
+		copy(dAtA[i:], m.ChannelId)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.ChannelId)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryPendingSendPacketsResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryPendingSendPacketsResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryPendingSendPacketsResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Pagination != nil {
+		{
+			size, err := m.Pagination.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintQuery(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.PendingSendPackets) > 0 {
+		for iNdEx := len(m.PendingSendPackets) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.PendingSendPackets[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintQuery(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0xa
+		}
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryAllDenomGroupsRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryAllDenomGroupsRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryAllDenomGroupsRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Pagination != nil {
+		{
+			size, err := m.Pagination.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintQuery(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryAllDenomGroupsResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryAllDenomGroupsResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryAllDenomGroupsResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Pagination != nil {
+		{
+			size, err := m.Pagination.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintQuery(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.DenomGroups) > 0 {
+		for iNdEx := len(m.DenomGroups) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.DenomGroups[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintQuery(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0xa
+		}
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryScheduledRateLimitUpdatesRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryScheduledRateLimitUpdatesRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryScheduledRateLimitUpdatesRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Pagination != nil {
+		{
+			size, err := m.Pagination.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintQuery(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x1a
+	}
+	if len(m.ChannelId) > 0 {
+		i -= len(m.ChannelId)
+		copy(dAtA[i:], m.ChannelId)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.ChannelId)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.Denom) > 0 {
+		i -= len(m.Denom)
+		copy(dAtA[i:], m.Denom)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.Denom)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryScheduledRateLimitUpdatesResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryScheduledRateLimitUpdatesResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryScheduledRateLimitUpdatesResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Pagination != nil {
+		{
+			size, err := m.Pagination.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintQuery(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.ScheduledUpdates) > 0 {
+		for iNdEx := len(m.ScheduledUpdates) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.ScheduledUpdates[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintQuery(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0xa
+		}
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryRateLimitsOnClosedChannelsRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryRateLimitsOnClosedChannelsRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryRateLimitsOnClosedChannelsRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Pagination != nil {
+		{
+			size, err := m.Pagination.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintQuery(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryRateLimitsOnClosedChannelsResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryRateLimitsOnClosedChannelsResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryRateLimitsOnClosedChannelsResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Pagination != nil {
+		{
+			size, err := m.Pagination.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintQuery(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.RateLimits) > 0 {
+		for iNdEx := len(m.RateLimits) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.RateLimits[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintQuery(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0xa
+		}
+	}
+	return len(dAtA) - i, nil
+}
+
+func encodeVarintQuery(dAtA []byte, offset int, v uint64) int {
+	offset -= sovQuery(v)
+	base := offset
+	for v >= 1<<7 {
+		dAtA[offset] = uint8(v&0x7f | 0x80)
+		v >>= 7
+		offset++
+	}
+	dAtA[offset] = uint8(v)
+	return base
+}
+func (m *QueryAllRateLimitsRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Pagination != nil {
+		l = m.Pagination.Size()
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	l = len(m.Denom)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	l = len(m.ChannelId)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	l = len(m.MinUtilization)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QueryAllRateLimitsResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.RateLimits) > 0 {
+		for _, e := range m.RateLimits {
+			l = e.Size()
+			n += 1 + l + sovQuery(uint64(l))
+		}
+	}
+	if m.Pagination != nil {
+		l = m.Pagination.Size()
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QueryRateLimitRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Denom)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	l = len(m.ChannelId)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QueryRateLimitResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.RateLimit != nil {
+		l = m.RateLimit.Size()
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QueryRateLimitsByChainIdRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.ChainId)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QueryRateLimitsByChainIdResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.RateLimits) > 0 {
+		for _, e := range m.RateLimits {
+			l = e.Size()
+			n += 1 + l + sovQuery(uint64(l))
+		}
+	}
+	return n
+}
+
+func (m *QueryRateLimitsByChannelIdRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.ChannelId)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	if m.Pagination != nil {
+		l = m.Pagination.Size()
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QueryRateLimitsByChannelIdResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.RateLimits) > 0 {
+		for _, e := range m.RateLimits {
+			l = e.Size()
+			n += 1 + l + sovQuery(uint64(l))
+		}
+	}
+	if m.Pagination != nil {
+		l = m.Pagination.Size()
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QueryRateLimitsByDenomRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Denom)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	if m.Pagination != nil {
+		l = m.Pagination.Size()
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QueryRateLimitsByDenomResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.RateLimits) > 0 {
+		for _, e := range m.RateLimits {
+			l = e.Size()
+			n += 1 + l + sovQuery(uint64(l))
+		}
+	}
+	if m.Pagination != nil {
+		l = m.Pagination.Size()
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QueryAllBlacklistedDenomsRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Pagination != nil {
+		l = m.Pagination.Size()
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QueryAllBlacklistedDenomsResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.Denoms) > 0 {
+		for _, s := range m.Denoms {
+			l = len(s)
+			n += 1 + l + sovQuery(uint64(l))
+		}
+	}
+	if m.Pagination != nil {
+		l = m.Pagination.Size()
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QueryAllWhitelistedAddressesRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Pagination != nil {
+		l = m.Pagination.Size()
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QueryAllWhitelistedAddressesResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.AddressPairs) > 0 {
+		for _, e := range m.AddressPairs {
+			l = e.Size()
+			n += 1 + l + sovQuery(uint64(l))
+		}
+	}
+	if m.Pagination != nil {
+		l = m.Pagination.Size()
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QueryAllTransferRulesRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Pagination != nil {
+		l = m.Pagination.Size()
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QueryAllTransferRulesResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.TransferRules) > 0 {
+		for _, e := range m.TransferRules {
+			l = e.Size()
+			n += 1 + l + sovQuery(uint64(l))
+		}
+	}
+	if m.Pagination != nil {
+		l = m.Pagination.Size()
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QueryCheckTransferRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Denom)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	l = len(m.ChannelId)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	if m.Direction != 0 {
+		n += 1 + sovQuery(uint64(m.Direction))
+	}
+	l = len(m.Amount)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	l = len(m.Sender)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	l = len(m.Receiver)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	l = len(m.Memo)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QueryCheckTransferResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Allowed {
+		n += 2
+	}
+	l = len(m.Reason)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	l = len(m.Error)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	if m.RateLimited {
+		n += 2
+	}
+	l = m.RemainingCapacity.Size()
+	n += 1 + l + sovQuery(uint64(l))
+	return n
+}
+
+func (m *RateLimitCapacity) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = m.RateLimit.Size()
+	n += 1 + l + sovQuery(uint64(l))
+	l = m.RemainingSend.Size()
+	n += 1 + l + sovQuery(uint64(l))
+	l = m.RemainingRecv.Size()
+	n += 1 + l + sovQuery(uint64(l))
+	l = m.SendUtilization.Size()
+	n += 1 + l + sovQuery(uint64(l))
+	l = m.RecvUtilization.Size()
+	n += 1 + l + sovQuery(uint64(l))
+	if m.NextResetEpoch != 0 {
+		n += 1 + sovQuery(uint64(m.NextResetEpoch))
+	}
+	if m.NextResetTime != nil {
+		l = github_com_cosmos_gogoproto_types.SizeOfStdTime(*m.NextResetTime)
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	if m.WindowStartEpoch != 0 {
+		n += 1 + sovQuery(uint64(m.WindowStartEpoch))
+	}
+	if m.WindowStartTime != nil {
+		l = github_com_cosmos_gogoproto_types.SizeOfStdTime(*m.WindowStartTime)
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QueryAllRateLimitCapacitiesRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Pagination != nil {
+		l = m.Pagination.Size()
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QueryAllRateLimitCapacitiesResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.Capacities) > 0 {
+		for _, e := range m.Capacities {
+			l = e.Size()
+			n += 1 + l + sovQuery(uint64(l))
+		}
+	}
+	if m.Pagination != nil {
+		l = m.Pagination.Size()
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QueryRateLimitCapacityRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Denom)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	l = len(m.ChannelId)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QueryRateLimitCapacityResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Capacity != nil {
+		l = m.Capacity.Size()
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QueryPendingSendPacketsRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.ChannelId)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	if m.Pagination != nil {
+		l = m.Pagination.Size()
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QueryPendingSendPacketsResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.PendingSendPackets) > 0 {
+		for _, e := range m.PendingSendPackets {
+			l = e.Size()
+			n += 1 + l + sovQuery(uint64(l))
+		}
+	}
+	if m.Pagination != nil {
+		l = m.Pagination.Size()
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QueryAllDenomGroupsRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Pagination != nil {
+		l = m.Pagination.Size()
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QueryAllDenomGroupsResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.DenomGroups) > 0 {
+		for _, e := range m.DenomGroups {
+			l = e.Size()
+			n += 1 + l + sovQuery(uint64(l))
+		}
+	}
+	if m.Pagination != nil {
+		l = m.Pagination.Size()
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QueryScheduledRateLimitUpdatesRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Denom)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	l = len(m.ChannelId)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	if m.Pagination != nil {
+		l = m.Pagination.Size()
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QueryScheduledRateLimitUpdatesResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.ScheduledUpdates) > 0 {
+		for _, e := range m.ScheduledUpdates {
+			l = e.Size()
+			n += 1 + l + sovQuery(uint64(l))
+		}
+	}
+	if m.Pagination != nil {
+		l = m.Pagination.Size()
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QueryRateLimitsOnClosedChannelsRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Pagination != nil {
+		l = m.Pagination.Size()
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QueryRateLimitsOnClosedChannelsResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.RateLimits) > 0 {
+		for _, e := range m.RateLimits {
+			l = e.Size()
+			n += 1 + l + sovQuery(uint64(l))
+		}
+	}
+	if m.Pagination != nil {
+		l = m.Pagination.Size()
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func sovQuery(x uint64) (n int) {
+	return (math_bits.Len64(x|1) + 6) / 7
+}
+func sozQuery(x uint64) (n int) {
+	return sovQuery(uint64((x << 1) ^ uint64((int64(x) >> 63))))
+}
+func (m *QueryAllRateLimitsRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryAllRateLimitsRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryAllRateLimitsRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Pagination", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Pagination == nil {
+				m.Pagination = &query.PageRequest{}
+			}
+			if err := m.Pagination.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Denom", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Denom = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ChannelId", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ChannelId = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MinUtilization", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.MinUtilization = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryAllRateLimitsResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryAllRateLimitsResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryAllRateLimitsResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field RateLimits", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.RateLimits = append(m.RateLimits, RateLimit{})
+			if err := m.RateLimits[len(m.RateLimits)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Pagination", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Pagination == nil {
+				m.Pagination = &query.PageResponse{}
+			}
+			if err := m.Pagination.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryRateLimitRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryRateLimitRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryRateLimitRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Denom", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Denom = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ChannelId", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ChannelId = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryRateLimitResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryRateLimitResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryRateLimitResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field RateLimit", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.RateLimit == nil {
+				m.RateLimit = &RateLimit{}
+			}
+			if err := m.RateLimit.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryRateLimitsByChainIdRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryRateLimitsByChainIdRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryRateLimitsByChainIdRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ChainId", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ChainId = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryRateLimitsByChainIdResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryRateLimitsByChainIdResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryRateLimitsByChainIdResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field RateLimits", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.RateLimits = append(m.RateLimits, RateLimit{})
+			if err := m.RateLimits[len(m.RateLimits)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryRateLimitsByChannelIdRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryRateLimitsByChannelIdRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryRateLimitsByChannelIdRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ChannelId", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ChannelId = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Pagination", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Pagination == nil {
+				m.Pagination = &query.PageRequest{}
+			}
+			if err := m.Pagination.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryRateLimitsByChannelIdResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryRateLimitsByChannelIdResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryRateLimitsByChannelIdResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field RateLimits", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.RateLimits = append(m.RateLimits, RateLimit{})
+			if err := m.RateLimits[len(m.RateLimits)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Pagination", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Pagination == nil {
+				m.Pagination = &query.PageResponse{}
+			}
+			if err := m.Pagination.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryRateLimitsByDenomRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryRateLimitsByDenomRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryRateLimitsByDenomRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Denom", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Denom = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Pagination", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Pagination == nil {
+				m.Pagination = &query.PageRequest{}
+			}
+			if err := m.Pagination.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryRateLimitsByDenomResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryRateLimitsByDenomResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryRateLimitsByDenomResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field RateLimits", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.RateLimits = append(m.RateLimits, RateLimit{})
+			if err := m.RateLimits[len(m.RateLimits)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Pagination", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Pagination == nil {
+				m.Pagination = &query.PageResponse{}
+			}
+			if err := m.Pagination.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryAllBlacklistedDenomsRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryAllBlacklistedDenomsRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryAllBlacklistedDenomsRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Pagination", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Pagination == nil {
+				m.Pagination = &query.PageRequest{}
+			}
+			if err := m.Pagination.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryAllBlacklistedDenomsResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryAllBlacklistedDenomsResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryAllBlacklistedDenomsResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Denoms", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Denoms = append(m.Denoms, string(dAtA[iNdEx:postIndex]))
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Pagination", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Pagination == nil {
+				m.Pagination = &query.PageResponse{}
+			}
+			if err := m.Pagination.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryAllWhitelistedAddressesRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryAllWhitelistedAddressesRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryAllWhitelistedAddressesRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Pagination", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Pagination == nil {
+				m.Pagination = &query.PageRequest{}
+			}
+			if err := m.Pagination.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryAllWhitelistedAddressesResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryAllWhitelistedAddressesResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryAllWhitelistedAddressesResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field AddressPairs", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.AddressPairs = append(m.AddressPairs, WhitelistedAddressPair{})
+			if err := m.AddressPairs[len(m.AddressPairs)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Pagination", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Pagination == nil {
+				m.Pagination = &query.PageResponse{}
+			}
+			if err := m.Pagination.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryAllTransferRulesRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryAllTransferRulesRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryAllTransferRulesRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Pagination", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Pagination == nil {
+				m.Pagination = &query.PageRequest{}
+			}
+			if err := m.Pagination.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryAllTransferRulesResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryAllTransferRulesResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryAllTransferRulesResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field TransferRules", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.TransferRules = append(m.TransferRules, TransferRule{})
+			if err := m.TransferRules[len(m.TransferRules)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Pagination", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Pagination == nil {
+				m.Pagination = &query.PageResponse{}
+			}
+			if err := m.Pagination.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryCheckTransferRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryCheckTransferRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryCheckTransferRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Denom", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Denom = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ChannelId", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ChannelId = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Direction", wireType)
+			}
+			m.Direction = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Direction |= PacketDirection(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Amount", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Amount = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 5:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Sender", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Sender = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 6:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Receiver", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Receiver = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 7:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Memo", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Memo = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryCheckTransferResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryCheckTransferResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryCheckTransferResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Allowed", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.Allowed = bool(v != 0)
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Reason", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Reason = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Error", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Error = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 4:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field RateLimited", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.RateLimited = bool(v != 0)
+		case 5:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field RemainingCapacity", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.RemainingCapacity.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *RateLimitCapacity) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: RateLimitCapacity: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: RateLimitCapacity: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field RateLimit", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.RateLimit.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field RemainingSend", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.RemainingSend.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field RemainingRecv", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.RemainingRecv.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field SendUtilization", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.SendUtilization.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 5:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field RecvUtilization", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.RecvUtilization.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 6:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field NextResetEpoch", wireType)
+			}
+			m.NextResetEpoch = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.NextResetEpoch |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 7:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field NextResetTime", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.NextResetTime == nil {
+				m.NextResetTime = new(time.Time)
+			}
+			if err := github_com_cosmos_gogoproto_types.StdTimeUnmarshal(m.NextResetTime, dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 8:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field WindowStartEpoch", wireType)
+			}
+			m.WindowStartEpoch = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.WindowStartEpoch |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 9:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field WindowStartTime", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.WindowStartTime == nil {
+				m.WindowStartTime = new(time.Time)
+			}
+			if err := github_com_cosmos_gogoproto_types.StdTimeUnmarshal(m.WindowStartTime, dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryAllRateLimitCapacitiesRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryAllRateLimitCapacitiesRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryAllRateLimitCapacitiesRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Pagination", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Pagination == nil {
+				m.Pagination = &query.PageRequest{}
+			}
+			if err := m.Pagination.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryAllRateLimitCapacitiesResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryAllRateLimitCapacitiesResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryAllRateLimitCapacitiesResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Capacities", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Capacities = append(m.Capacities, RateLimitCapacity{})
+			if err := m.Capacities[len(m.Capacities)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Pagination", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Pagination == nil {
+				m.Pagination = &query.PageResponse{}
+			}
+			if err := m.Pagination.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
 		}
 	}
-	return n
-}
-
-func (m *QueryAllWhitelistedAddressesRequest) Size() (n int) {
-	if m == nil {
-		return 0
-	}
-	var l int
-	_ = l
-	return n
-}
 
-func (m *QueryAllWhitelistedAddressesResponse) Size() (n int) {
-	if m == nil {
-		return 0
-	}
-	var l int
-	_ = l
-	if len(m.AddressPairs) > 0 {
-		for _, e := range m.AddressPairs {
-			l = e.Size()
-			n += 1 + l + sovQuery(uint64(l))
-		}
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
 	}
-	return n
-}
-
-func sovQuery(x uint64) (n int) {
-	return (math_bits.Len64(x|1) + 6) / 7
-}
-func sozQuery(x uint64) (n int) {
-	return sovQuery(uint64((x << 1) ^ uint64((int64(x) >> 63))))
+	return nil
 }
-func (m *QueryAllRateLimitsRequest) Unmarshal(dAtA []byte) error {
+func (m *QueryRateLimitCapacityRequest) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -1467,12 +7318,76 @@ func (m *QueryAllRateLimitsRequest) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: QueryAllRateLimitsRequest: wiretype end group for non-group")
+			return fmt.Errorf("proto: QueryRateLimitCapacityRequest: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: QueryAllRateLimitsRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: QueryRateLimitCapacityRequest: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Denom", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Denom = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ChannelId", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ChannelId = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipQuery(dAtA[iNdEx:])
@@ -1494,7 +7409,7 @@ func (m *QueryAllRateLimitsRequest) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *QueryAllRateLimitsResponse) Unmarshal(dAtA []byte) error {
+func (m *QueryRateLimitCapacityResponse) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -1517,15 +7432,15 @@ func (m *QueryAllRateLimitsResponse) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: QueryAllRateLimitsResponse: wiretype end group for non-group")
+			return fmt.Errorf("proto: QueryRateLimitCapacityResponse: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: QueryAllRateLimitsResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: QueryRateLimitCapacityResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field RateLimits", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Capacity", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -1552,8 +7467,10 @@ func (m *QueryAllRateLimitsResponse) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.RateLimits = append(m.RateLimits, RateLimit{})
-			if err := m.RateLimits[len(m.RateLimits)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if m.Capacity == nil {
+				m.Capacity = &RateLimitCapacity{}
+			}
+			if err := m.Capacity.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
@@ -1578,7 +7495,7 @@ func (m *QueryAllRateLimitsResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *QueryRateLimitRequest) Unmarshal(dAtA []byte) error {
+func (m *QueryPendingSendPacketsRequest) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -1601,15 +7518,15 @@ func (m *QueryRateLimitRequest) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: QueryRateLimitRequest: wiretype end group for non-group")
+			return fmt.Errorf("proto: QueryPendingSendPacketsRequest: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: QueryRateLimitRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: QueryPendingSendPacketsRequest: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Denom", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field ChannelId", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -1637,13 +7554,13 @@ func (m *QueryRateLimitRequest) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Denom = string(dAtA[iNdEx:postIndex])
+			m.ChannelId = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field ChannelId", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Pagination", wireType)
 			}
-			var stringLen uint64
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowQuery
@@ -1653,23 +7570,27 @@ func (m *QueryRateLimitRequest) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
+			if msglen < 0 {
 				return ErrInvalidLengthQuery
 			}
-			postIndex := iNdEx + intStringLen
+			postIndex := iNdEx + msglen
 			if postIndex < 0 {
 				return ErrInvalidLengthQuery
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.ChannelId = string(dAtA[iNdEx:postIndex])
+			if m.Pagination == nil {
+				m.Pagination = &query.PageRequest{}
+			}
+			if err := m.Pagination.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
 			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
@@ -1692,7 +7613,7 @@ func (m *QueryRateLimitRequest) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *QueryRateLimitResponse) Unmarshal(dAtA []byte) error {
+func (m *QueryPendingSendPacketsResponse) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -1715,15 +7636,15 @@ func (m *QueryRateLimitResponse) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: QueryRateLimitResponse: wiretype end group for non-group")
+			return fmt.Errorf("proto: QueryPendingSendPacketsResponse: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: QueryRateLimitResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: QueryPendingSendPacketsResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field RateLimit", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field PendingSendPackets", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -1750,10 +7671,44 @@ func (m *QueryRateLimitResponse) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.RateLimit == nil {
-				m.RateLimit = &RateLimit{}
+			m.PendingSendPackets = append(m.PendingSendPackets, PendingSendPacket{})
+			if err := m.PendingSendPackets[len(m.PendingSendPackets)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
 			}
-			if err := m.RateLimit.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Pagination", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Pagination == nil {
+				m.Pagination = &query.PageResponse{}
+			}
+			if err := m.Pagination.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
@@ -1778,7 +7733,7 @@ func (m *QueryRateLimitResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *QueryRateLimitsByChainIdRequest) Unmarshal(dAtA []byte) error {
+func (m *QueryAllDenomGroupsRequest) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -1801,17 +7756,17 @@ func (m *QueryRateLimitsByChainIdRequest) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: QueryRateLimitsByChainIdRequest: wiretype end group for non-group")
+			return fmt.Errorf("proto: QueryAllDenomGroupsRequest: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: QueryRateLimitsByChainIdRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: QueryAllDenomGroupsRequest: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field ChainId", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Pagination", wireType)
 			}
-			var stringLen uint64
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowQuery
@@ -1821,23 +7776,27 @@ func (m *QueryRateLimitsByChainIdRequest) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
+			if msglen < 0 {
 				return ErrInvalidLengthQuery
 			}
-			postIndex := iNdEx + intStringLen
+			postIndex := iNdEx + msglen
 			if postIndex < 0 {
 				return ErrInvalidLengthQuery
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.ChainId = string(dAtA[iNdEx:postIndex])
+			if m.Pagination == nil {
+				m.Pagination = &query.PageRequest{}
+			}
+			if err := m.Pagination.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
 			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
@@ -1860,7 +7819,7 @@ func (m *QueryRateLimitsByChainIdRequest) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *QueryRateLimitsByChainIdResponse) Unmarshal(dAtA []byte) error {
+func (m *QueryAllDenomGroupsResponse) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -1879,19 +7838,53 @@ func (m *QueryRateLimitsByChainIdResponse) Unmarshal(dAtA []byte) error {
 			if b < 0x80 {
 				break
 			}
-		}
-		fieldNum := int32(wire >> 3)
-		wireType := int(wire & 0x7)
-		if wireType == 4 {
-			return fmt.Errorf("proto: QueryRateLimitsByChainIdResponse: wiretype end group for non-group")
-		}
-		if fieldNum <= 0 {
-			return fmt.Errorf("proto: QueryRateLimitsByChainIdResponse: illegal tag %d (wire type %d)", fieldNum, wire)
-		}
-		switch fieldNum {
-		case 1:
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryAllDenomGroupsResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryAllDenomGroupsResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field DenomGroups", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.DenomGroups = append(m.DenomGroups, DenomGroup{})
+			if err := m.DenomGroups[len(m.DenomGroups)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field RateLimits", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Pagination", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -1918,8 +7911,10 @@ func (m *QueryRateLimitsByChainIdResponse) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.RateLimits = append(m.RateLimits, RateLimit{})
-			if err := m.RateLimits[len(m.RateLimits)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if m.Pagination == nil {
+				m.Pagination = &query.PageResponse{}
+			}
+			if err := m.Pagination.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
@@ -1944,7 +7939,7 @@ func (m *QueryRateLimitsByChainIdResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *QueryRateLimitsByChannelIdRequest) Unmarshal(dAtA []byte) error {
+func (m *QueryScheduledRateLimitUpdatesRequest) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -1967,13 +7962,45 @@ func (m *QueryRateLimitsByChannelIdRequest) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: QueryRateLimitsByChannelIdRequest: wiretype end group for non-group")
+			return fmt.Errorf("proto: QueryScheduledRateLimitUpdatesRequest: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: QueryRateLimitsByChannelIdRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: QueryScheduledRateLimitUpdatesRequest: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Denom", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Denom = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
 			if wireType != 2 {
 				return fmt.Errorf("proto: wrong wireType = %d for field ChannelId", wireType)
 			}
@@ -2005,6 +8032,42 @@ func (m *QueryRateLimitsByChannelIdRequest) Unmarshal(dAtA []byte) error {
 			}
 			m.ChannelId = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Pagination", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Pagination == nil {
+				m.Pagination = &query.PageRequest{}
+			}
+			if err := m.Pagination.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipQuery(dAtA[iNdEx:])
@@ -2026,7 +8089,7 @@ func (m *QueryRateLimitsByChannelIdRequest) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *QueryRateLimitsByChannelIdResponse) Unmarshal(dAtA []byte) error {
+func (m *QueryScheduledRateLimitUpdatesResponse) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -2049,15 +8112,15 @@ func (m *QueryRateLimitsByChannelIdResponse) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: QueryRateLimitsByChannelIdResponse: wiretype end group for non-group")
+			return fmt.Errorf("proto: QueryScheduledRateLimitUpdatesResponse: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: QueryRateLimitsByChannelIdResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: QueryScheduledRateLimitUpdatesResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field RateLimits", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field ScheduledUpdates", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -2084,61 +8147,47 @@ func (m *QueryRateLimitsByChannelIdResponse) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.RateLimits = append(m.RateLimits, RateLimit{})
-			if err := m.RateLimits[len(m.RateLimits)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			m.ScheduledUpdates = append(m.ScheduledUpdates, ScheduledRateLimitUpdate{})
+			if err := m.ScheduledUpdates[len(m.ScheduledUpdates)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
-		default:
-			iNdEx = preIndex
-			skippy, err := skipQuery(dAtA[iNdEx:])
-			if err != nil {
-				return err
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Pagination", wireType)
 			}
-			if (skippy < 0) || (iNdEx+skippy) < 0 {
-				return ErrInvalidLengthQuery
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
 			}
-			if (iNdEx + skippy) > l {
-				return io.ErrUnexpectedEOF
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
 			}
-			iNdEx += skippy
-		}
-	}
-
-	if iNdEx > l {
-		return io.ErrUnexpectedEOF
-	}
-	return nil
-}
-func (m *QueryAllBlacklistedDenomsRequest) Unmarshal(dAtA []byte) error {
-	l := len(dAtA)
-	iNdEx := 0
-	for iNdEx < l {
-		preIndex := iNdEx
-		var wire uint64
-		for shift := uint(0); ; shift += 7 {
-			if shift >= 64 {
-				return ErrIntOverflowQuery
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
 			}
-			if iNdEx >= l {
+			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			b := dAtA[iNdEx]
-			iNdEx++
-			wire |= uint64(b&0x7F) << shift
-			if b < 0x80 {
-				break
+			if m.Pagination == nil {
+				m.Pagination = &query.PageResponse{}
 			}
-		}
-		fieldNum := int32(wire >> 3)
-		wireType := int(wire & 0x7)
-		if wireType == 4 {
-			return fmt.Errorf("proto: QueryAllBlacklistedDenomsRequest: wiretype end group for non-group")
-		}
-		if fieldNum <= 0 {
-			return fmt.Errorf("proto: QueryAllBlacklistedDenomsRequest: illegal tag %d (wire type %d)", fieldNum, wire)
-		}
-		switch fieldNum {
+			if err := m.Pagination.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipQuery(dAtA[iNdEx:])
@@ -2160,7 +8209,7 @@ func (m *QueryAllBlacklistedDenomsRequest) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *QueryAllBlacklistedDenomsResponse) Unmarshal(dAtA []byte) error {
+func (m *QueryRateLimitsOnClosedChannelsRequest) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -2183,17 +8232,17 @@ func (m *QueryAllBlacklistedDenomsResponse) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: QueryAllBlacklistedDenomsResponse: wiretype end group for non-group")
+			return fmt.Errorf("proto: QueryRateLimitsOnClosedChannelsRequest: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: QueryAllBlacklistedDenomsResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: QueryRateLimitsOnClosedChannelsRequest: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Denoms", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Pagination", wireType)
 			}
-			var stringLen uint64
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowQuery
@@ -2203,23 +8252,27 @@ func (m *QueryAllBlacklistedDenomsResponse) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
+			if msglen < 0 {
 				return ErrInvalidLengthQuery
 			}
-			postIndex := iNdEx + intStringLen
+			postIndex := iNdEx + msglen
 			if postIndex < 0 {
 				return ErrInvalidLengthQuery
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Denoms = append(m.Denoms, string(dAtA[iNdEx:postIndex]))
+			if m.Pagination == nil {
+				m.Pagination = &query.PageRequest{}
+			}
+			if err := m.Pagination.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
 			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
@@ -2242,7 +8295,7 @@ func (m *QueryAllBlacklistedDenomsResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *QueryAllWhitelistedAddressesRequest) Unmarshal(dAtA []byte) error {
+func (m *QueryRateLimitsOnClosedChannelsResponse) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -2265,65 +8318,49 @@ func (m *QueryAllWhitelistedAddressesRequest) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: QueryAllWhitelistedAddressesRequest: wiretype end group for non-group")
+			return fmt.Errorf("proto: QueryRateLimitsOnClosedChannelsResponse: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: QueryAllWhitelistedAddressesRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: QueryRateLimitsOnClosedChannelsResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
-		default:
-			iNdEx = preIndex
-			skippy, err := skipQuery(dAtA[iNdEx:])
-			if err != nil {
-				return err
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field RateLimits", wireType)
 			}
-			if (skippy < 0) || (iNdEx+skippy) < 0 {
-				return ErrInvalidLengthQuery
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
 			}
-			if (iNdEx + skippy) > l {
-				return io.ErrUnexpectedEOF
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
 			}
-			iNdEx += skippy
-		}
-	}
-
-	if iNdEx > l {
-		return io.ErrUnexpectedEOF
-	}
-	return nil
-}
-func (m *QueryAllWhitelistedAddressesResponse) Unmarshal(dAtA []byte) error {
-	l := len(dAtA)
-	iNdEx := 0
-	for iNdEx < l {
-		preIndex := iNdEx
-		var wire uint64
-		for shift := uint(0); ; shift += 7 {
-			if shift >= 64 {
-				return ErrIntOverflowQuery
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
 			}
-			if iNdEx >= l {
+			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			b := dAtA[iNdEx]
-			iNdEx++
-			wire |= uint64(b&0x7F) << shift
-			if b < 0x80 {
-				break
+			m.RateLimits = append(m.RateLimits, RateLimit{})
+			if err := m.RateLimits[len(m.RateLimits)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
 			}
-		}
-		fieldNum := int32(wire >> 3)
-		wireType := int(wire & 0x7)
-		if wireType == 4 {
-			return fmt.Errorf("proto: QueryAllWhitelistedAddressesResponse: wiretype end group for non-group")
-		}
-		if fieldNum <= 0 {
-			return fmt.Errorf("proto: QueryAllWhitelistedAddressesResponse: illegal tag %d (wire type %d)", fieldNum, wire)
-		}
-		switch fieldNum {
-		case 1:
+			iNdEx = postIndex
+		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field AddressPairs", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Pagination", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -2350,8 +8387,10 @@ func (m *QueryAllWhitelistedAddressesResponse) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.AddressPairs = append(m.AddressPairs, WhitelistedAddressPair{})
-			if err := m.AddressPairs[len(m.AddressPairs)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if m.Pagination == nil {
+				m.Pagination = &query.PageResponse{}
+			}
+			if err := m.Pagination.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
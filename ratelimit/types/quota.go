@@ -11,12 +11,61 @@ func (q *Quota) CheckExceedsQuota(direction PacketDirection, amount sdkmath.Int,
 	if totalValue.IsZero() {
 		return false
 	}
-	var threshold sdkmath.Int
+	return amount.GT(q.GetThreshold(direction, totalValue))
+}
+
+// GetThreshold returns the max net flow allowed in the given direction
+func (q *Quota) GetThreshold(direction PacketDirection, totalValue sdkmath.Int) sdkmath.Int {
 	if direction == PACKET_RECV {
-		threshold = totalValue.Mul(q.MaxPercentRecv).Quo(sdkmath.NewInt(100))
-	} else {
-		threshold = totalValue.Mul(q.MaxPercentSend).Quo(sdkmath.NewInt(100))
+		return totalValue.Mul(q.MaxPercentRecv).Quo(sdkmath.NewInt(100))
 	}
+	return totalValue.Mul(q.MaxPercentSend).Quo(sdkmath.NewInt(100))
+}
+
+// Returns the number of epochs to shift the epoch number by, so that the windows
+// start at the quota's offset (i.e. the reset epochs are shifted to multiples of the duration)
+func (q *Quota) getWindowShift() uint64 {
+	return (q.DurationHours - q.WindowOffset%q.DurationHours) % q.DurationHours
+}
+
+// IsResetEpoch checks if the rate limit should be reset at the start of the given hour epoch
+// This is the case when (epochNumber - WindowOffset) % DurationHours is 0
+// Quotas without a duration are never reset
+func (q *Quota) IsResetEpoch(epochNumber uint64) bool {
+	if q.GetDurationHours() == 0 {
+		return false
+	}
+	return epochNumber%q.DurationHours == q.WindowOffset%q.DurationHours
+}
 
-	return amount.GT(threshold)
+// GetWindowId returns the ID of the window that the given hour epoch falls in (i.e. the
+// number of times the quota has been reset by that epoch)
+// Quotas without a duration are never reset, and always stay in window 0
+func (q *Quota) GetWindowId(epochNumber uint64) uint64 {
+	if q.GetDurationHours() == 0 {
+		return 0
+	}
+	return (epochNumber + q.getWindowShift()) / q.DurationHours
+}
+
+// GetWindowStartEpoch returns the hour epoch at which the window containing the given epoch started
+// Returns false if the quota does not have a duration
+func (q *Quota) GetWindowStartEpoch(epochNumber uint64) (startEpoch uint64, found bool) {
+	if q.GetDurationHours() == 0 {
+		return 0, false
+	}
+	windowId := q.GetWindowId(epochNumber)
+	if windowId == 0 {
+		return 0, true
+	}
+	return windowId*q.DurationHours - q.getWindowShift(), true
+}
+
+// GetNextResetEpoch returns the next hour epoch after the given epoch at which the quota will be reset
+// Returns false if the quota does not have a duration
+func (q *Quota) GetNextResetEpoch(epochNumber uint64) (resetEpoch uint64, found bool) {
+	if q.GetDurationHours() == 0 {
+		return 0, false
+	}
+	return (q.GetWindowId(epochNumber)+1)*q.DurationHours - q.getWindowShift(), true
 }
@@ -77,3 +77,101 @@ func TestCheckExceedsQuota(t *testing.T) {
 		})
 	}
 }
+
+func TestQuotaWindows(t *testing.T) {
+	tests := []struct {
+		name                   string
+		durationHours          uint64
+		windowOffset           uint64
+		epochNumber            uint64
+		expectedIsResetEpoch   bool
+		expectedWindowId       uint64
+		expectedWindowStart    uint64
+		expectedNextResetEpoch uint64
+	}{
+		{
+			name:                   "no offset, reset epoch",
+			durationHours:          4,
+			epochNumber:            8,
+			expectedIsResetEpoch:   true,
+			expectedWindowId:       2,
+			expectedWindowStart:    8,
+			expectedNextResetEpoch: 12,
+		},
+		{
+			name:                   "no offset, mid window",
+			durationHours:          4,
+			epochNumber:            10,
+			expectedIsResetEpoch:   false,
+			expectedWindowId:       2,
+			expectedWindowStart:    8,
+			expectedNextResetEpoch: 12,
+		},
+		{
+			name:                   "offset, reset epoch",
+			durationHours:          4,
+			windowOffset:           1,
+			epochNumber:            9,
+			expectedIsResetEpoch:   true,
+			expectedWindowId:       3,
+			expectedWindowStart:    9,
+			expectedNextResetEpoch: 13,
+		},
+		{
+			name:                   "offset, mid window",
+			durationHours:          4,
+			windowOffset:           1,
+			epochNumber:            8,
+			expectedIsResetEpoch:   false,
+			expectedWindowId:       2,
+			expectedWindowStart:    5,
+			expectedNextResetEpoch: 9,
+		},
+		{
+			name:                   "offset, before first reset",
+			durationHours:          24,
+			windowOffset:           12,
+			epochNumber:            3,
+			expectedIsResetEpoch:   false,
+			expectedWindowId:       0,
+			expectedWindowStart:    0,
+			expectedNextResetEpoch: 12,
+		},
+		{
+			name:                   "offset, first reset",
+			durationHours:          24,
+			windowOffset:           12,
+			epochNumber:            12,
+			expectedIsResetEpoch:   true,
+			expectedWindowId:       1,
+			expectedWindowStart:    12,
+			expectedNextResetEpoch: 36,
+		},
+	}
+
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			quota := types.Quota{DurationHours: tc.durationHours, WindowOffset: tc.windowOffset}
+
+			require.Equal(t, tc.expectedIsResetEpoch, quota.IsResetEpoch(tc.epochNumber), "is reset epoch")
+			require.Equal(t, tc.expectedWindowId, quota.GetWindowId(tc.epochNumber), "window id")
+
+			windowStart, found := quota.GetWindowStartEpoch(tc.epochNumber)
+			require.True(t, found, "window start found")
+			require.Equal(t, tc.expectedWindowStart, windowStart, "window start epoch")
+
+			nextReset, found := quota.GetNextResetEpoch(tc.epochNumber)
+			require.True(t, found, "next reset found")
+			require.Equal(t, tc.expectedNextResetEpoch, nextReset, "next reset epoch")
+		})
+	}
+
+	// A quota without a duration is never reset
+	quota := types.Quota{}
+	require.False(t, quota.IsResetEpoch(0), "no duration is reset epoch")
+	require.Equal(t, uint64(0), quota.GetWindowId(10), "no duration window id")
+	_, found := quota.GetWindowStartEpoch(10)
+	require.False(t, found, "no duration window start found")
+	_, found = quota.GetNextResetEpoch(10)
+	require.False(t, found, "no duration next reset found")
+}
@@ -0,0 +1,115 @@
+package types
+
+import (
+	"errors"
+	"fmt"
+	"regexp"
+
+	sdkmath "cosmossdk.io/math"
+)
+
+var channelIdRegex = regexp.MustCompile(`^channel-\d+$`)
+
+// Validate performs stateless validation of a rate limit, with the same checks as when it's
+// added or updated by governance, as well as confirming the flow is not negative
+// Used to validate rate limits from genesis, since a missing path, quota or flow would cause
+// a panic when a packet is processed
+func (r RateLimit) Validate() error {
+	if r.Path == nil {
+		return errors.New("path must be specified")
+	}
+	if r.Quota == nil {
+		return errors.New("quota must be specified")
+	}
+	if r.Flow == nil {
+		return errors.New("flow must be specified")
+	}
+
+	if r.Path.Denom == "" {
+		return errors.New("denom must be specified")
+	}
+	if !channelIdRegex.MatchString(r.Path.ChannelId) {
+		return fmt.Errorf("invalid channel-id (%s), must be of the format 'channel-{N}'", r.Path.ChannelId)
+	}
+
+	if err := r.Quota.Validate(); err != nil {
+		return fmt.Errorf("invalid quota: %s", err.Error())
+	}
+	if err := r.Flow.Validate(); err != nil {
+		return fmt.Errorf("invalid flow: %s", err.Error())
+	}
+
+	if r.ChannelValueStrategy != nil {
+		if err := r.ChannelValueStrategy.Validate(); err != nil {
+			return fmt.Errorf("invalid channel value strategy: %s", err.Error())
+		}
+	}
+
+	return nil
+}
+
+// Validate performs stateless validation of a quota
+func (q Quota) Validate() error {
+	if err := validatePercent("max-percent-send", q.MaxPercentSend); err != nil {
+		return err
+	}
+	if err := validatePercent("max-percent-recv", q.MaxPercentRecv); err != nil {
+		return err
+	}
+	if q.MaxPercentSend.IsZero() && q.MaxPercentRecv.IsZero() {
+		return errors.New("either the max send or max receive threshold must be greater than 0")
+	}
+
+	if q.DurationHours == 0 {
+		return errors.New("duration can not be zero")
+	}
+	if q.WindowOffset >= q.DurationHours {
+		return fmt.Errorf("window offset (%d) must be less than the duration (%d)", q.WindowOffset, q.DurationHours)
+	}
+
+	if q.ValueQuota != nil {
+		if err := q.ValueQuota.Validate(); err != nil {
+			return fmt.Errorf("invalid value quota: %s", err.Error())
+		}
+	}
+
+	return nil
+}
+
+// Validate confirms each amount in the flow is specified and not negative
+func (f Flow) Validate() error {
+	amounts := []struct {
+		name   string
+		amount sdkmath.Int
+	}{
+		{name: "inflow", amount: f.Inflow},
+		{name: "outflow", amount: f.Outflow},
+		{name: "channel value", amount: f.ChannelValue},
+	}
+	for _, a := range amounts {
+		if a.amount.IsNil() {
+			return fmt.Errorf("%s must be specified", a.name)
+		}
+		if a.amount.IsNegative() {
+			return fmt.Errorf("%s (%v) can not be negative", a.name, a.amount)
+		}
+	}
+	if f.ValueInflow != nil && f.ValueInflow.IsNegative() {
+		return fmt.Errorf("value inflow (%v) can not be negative", f.ValueInflow)
+	}
+	if f.ValueOutflow != nil && f.ValueOutflow.IsNegative() {
+		return fmt.Errorf("value outflow (%v) can not be negative", f.ValueOutflow)
+	}
+	return nil
+}
+
+// Confirms a threshold percentage is specified and between 0 and 100 (inclusively)
+func validatePercent(name string, percent sdkmath.Int) error {
+	if percent.IsNil() {
+		return fmt.Errorf("%s must be specified", name)
+	}
+	if percent.IsNegative() || percent.GT(sdkmath.NewInt(100)) {
+		return fmt.Errorf("%s percent must be between 0 and 100 (inclusively), Provided: %v", name, percent)
+	}
+	return nil
+}
@@ -0,0 +1,150 @@
+package types_test
+
+import (
+	"testing"
+
+	sdkmath "cosmossdk.io/math"
+	"github.com/stretchr/testify/require"
+
+	"github.com/Stride-Labs/ibc-rate-limiting/ratelimit/types"
+)
+
+func TestValidateRateLimit(t *testing.T) {
+	// Helper function to build a valid rate limit that can then be modified by each test case
+	validRateLimit := func() types.RateLimit {
+		return types.RateLimit{
+			Path: &types.Path{Denom: "denom", ChannelId: "channel-0"},
+			Quota: &types.Quota{
+				MaxPercentSend: sdkmath.NewInt(10),
+				MaxPercentRecv: sdkmath.NewInt(0),
+				DurationHours:  24,
+				WindowOffset:   7,
+			},
+			Flow: &types.Flow{
+				Inflow:       sdkmath.NewInt(5),
+				Outflow:      sdkmath.NewInt(0),
+				ChannelValue: sdkmath.NewInt(100),
+			},
+		}
+	}
+
+	testCases := []struct {
+		name          string
+		modify        func(rateLimit *types.RateLimit)
+		expectedError string
+	}{
+		{
+			name:   "valid rate limit",
+			modify: func(rateLimit *types.RateLimit) {},
+		},
+		{
+			name: "valid rate limit with channel value strategy and value quota",
+			modify: func(rateLimit *types.RateLimit) {
+				rateLimit.ChannelValueStrategy = &types.ChannelValueStrategy{Source: types.CHANNEL_VALUE_ESCROW}
+				rateLimit.Quota.ValueQuota = &types.ValueQuota{
+					QuoteDenom:   "usd",
+					MaxValueSend: sdkmath.NewInt(1000),
+					MaxValueRecv: sdkmath.NewInt(1000),
+				}
+			},
+		},
+		{
+			name:          "nil path",
+			modify:        func(rateLimit *types.RateLimit) { rateLimit.Path = nil },
+			expectedError: "path must be specified",
+		},
+		{
+			name:          "nil quota",
+			modify:        func(rateLimit *types.RateLimit) { rateLimit.Quota = nil },
+			expectedError: "quota must be specified",
+		},
+		{
+			name:          "nil flow",
+			modify:        func(rateLimit *types.RateLimit) { rateLimit.Flow = nil },
+			expectedError: "flow must be specified",
+		},
+		{
+			name:          "empty denom",
+			modify:        func(rateLimit *types.RateLimit) { rateLimit.Path.Denom = "" },
+			expectedError: "denom must be specified",
+		},
+		{
+			name:          "invalid channel id",
+			modify:        func(rateLimit *types.RateLimit) { rateLimit.Path.ChannelId = "connection-0" },
+			expectedError: "invalid channel-id (connection-0)",
+		},
+		{
+			name:          "unspecified max percent send",
+			modify:        func(rateLimit *types.RateLimit) { rateLimit.Quota.MaxPercentSend = sdkmath.Int{} },
+			expectedError: "invalid quota: max-percent-send must be specified",
+		},
+		{
+			name:          "max percent send above 100",
+			modify:        func(rateLimit *types.RateLimit) { rateLimit.Quota.MaxPercentSend = sdkmath.NewInt(101) },
+			expectedError: "max-percent-send percent must be between 0 and 100",
+		},
+		{
+			name:          "negative max percent recv",
+			modify:        func(rateLimit *types.RateLimit) { rateLimit.Quota.MaxPercentRecv = sdkmath.NewInt(-1) },
+			expectedError: "max-percent-recv percent must be between 0 and 100",
+		},
+		{
+			name:          "both thresholds zero",
+			modify:        func(rateLimit *types.RateLimit) { rateLimit.Quota.MaxPercentSend = sdkmath.ZeroInt() },
+			expectedError: "either the max send or max receive threshold must be greater than 0",
+		},
+		{
+			name:          "zero duration",
+			modify:        func(rateLimit *types.RateLimit) { rateLimit.Quota.DurationHours = 0 },
+			expectedError: "duration can not be zero",
+		},
+		{
+			name:          "window offset not less than duration",
+			modify:        func(rateLimit *types.RateLimit) { rateLimit.Quota.WindowOffset = 24 },
+			expectedError: "window offset (24) must be less than the duration (24)",
+		},
+		{
+			name: "invalid value quota",
+			modify: func(rateLimit *types.RateLimit) {
+				rateLimit.Quota.ValueQuota = &types.ValueQuota{QuoteDenom: "usd", MaxValueSend: sdkmath.NewInt(-1), MaxValueRecv: sdkmath.NewInt(1)}
+			},
+			expectedError: "invalid quota: invalid value quota",
+		},
+		{
+			name:          "unspecified inflow",
+			modify:        func(rateLimit *types.RateLimit) { rateLimit.Flow.Inflow = sdkmath.Int{} },
+			expectedError: "invalid flow: inflow must be specified",
+		},
+		{
+			name:          "negative outflow",
+			modify:        func(rateLimit *types.RateLimit) { rateLimit.Flow.Outflow = sdkmath.NewInt(-1) },
+			expectedError: "invalid flow: outflow (-1) can not be negative",
+		},
+		{
+			name:          "negative channel value",
+			modify:        func(rateLimit *types.RateLimit) { rateLimit.Flow.ChannelValue = sdkmath.NewInt(-1) },
+			expectedError: "invalid flow: channel value (-1) can not be negative",
+		},
+		{
+			name: "invalid channel value strategy",
+			modify: func(rateLimit *types.RateLimit) {
+				rateLimit.ChannelValueStrategy = &types.ChannelValueStrategy{Source: types.ChannelValueSource(10)}
+			},
+			expectedError: "invalid channel value strategy",
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			rateLimit := validRateLimit()
+			tc.modify(&rateLimit)
+
+			err := rateLimit.Validate()
+			if tc.expectedError != "" {
+				require.ErrorContains(t, err, tc.expectedError)
+			} else {
+				require.NoError(t, err)
+			}
+		})
+	}
+}
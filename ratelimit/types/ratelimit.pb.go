@@ -6,6 +6,7 @@ package types
 import (
 	fmt "fmt"
 	github_com_cosmos_cosmos_sdk_types "github.com/cosmos/cosmos-sdk/types"
+	types "github.com/cosmos/cosmos-sdk/types"
 	_ "github.com/cosmos/gogoproto/gogoproto"
 	proto "github.com/cosmos/gogoproto/proto"
 	_ "github.com/cosmos/gogoproto/types"
@@ -55,6 +56,146 @@ func (PacketDirection) EnumDescriptor() ([]byte, []int) {
 	return fileDescriptor_a3afe8dd489c3bd2, []int{0}
 }
 
+// PriceFallback defines how a value quota is enforced when the oracle does
+// not have a price for the denom
+type PriceFallback int32
+
+const (
+	// Only the percentage thresholds are enforced
+	PRICE_FALLBACK_PERCENT PriceFallback = 0
+	// Transfers are rejected until a price is available
+	PRICE_FALLBACK_DENY PriceFallback = 1
+)
+
+var PriceFallback_name = map[int32]string{
+	0: "PRICE_FALLBACK_PERCENT",
+	1: "PRICE_FALLBACK_DENY",
+}
+
+var PriceFallback_value = map[string]int32{
+	"PRICE_FALLBACK_PERCENT": 0,
+	"PRICE_FALLBACK_DENY":    1,
+}
+
+func (x PriceFallback) String() string {
+	return proto.EnumName(PriceFallback_name, int32(x))
+}
+
+func (PriceFallback) EnumDescriptor() ([]byte, []int) {
+	return fileDescriptor_a3afe8dd489c3bd2, []int{1}
+}
+
+// FlowUpdateMode defines what happens to the flow of a rate limit when its
+// quota is updated
+type FlowUpdateMode int32
+
+const (
+	// The inflow and outflow are reset to 0 and the channel value is
+	// re-calculated (i.e. the update starts a fresh allowance)
+	FLOW_UPDATE_RESET FlowUpdateMode = 0
+	// The inflow, outflow and channel value are kept as is, so that the new
+	// quota applies to the transfers already made in the current window
+	FLOW_UPDATE_PRESERVE FlowUpdateMode = 1
+	// The channel value is re-calculated, and the inflow and outflow are
+	// scaled so that they remain the same proportion of the channel value
+	FLOW_UPDATE_PROPORTIONAL FlowUpdateMode = 2
+)
+
+var FlowUpdateMode_name = map[int32]string{
+	0: "FLOW_UPDATE_RESET",
+	1: "FLOW_UPDATE_PRESERVE",
+	2: "FLOW_UPDATE_PROPORTIONAL",
+}
+
+var FlowUpdateMode_value = map[string]int32{
+	"FLOW_UPDATE_RESET":        0,
+	"FLOW_UPDATE_PRESERVE":     1,
+	"FLOW_UPDATE_PROPORTIONAL": 2,
+}
+
+func (x FlowUpdateMode) String() string {
+	return proto.EnumName(FlowUpdateMode_name, int32(x))
+}
+
+func (FlowUpdateMode) EnumDescriptor() ([]byte, []int) {
+	return fileDescriptor_a3afe8dd489c3bd2, []int{2}
+}
+
+// ChannelValueSource defines where the channel value of a rate limit (i.e.
+// the denominator of the threshold percentages) comes from
+type ChannelValueSource int32
+
+const (
+	// The total supply of the denom
+	CHANNEL_VALUE_SUPPLY ChannelValueSource = 0
+	// The balance of the denom in the ICS20 escrow account for the channel
+	CHANNEL_VALUE_ESCROW ChannelValueSource = 1
+	// A fixed value set by governance
+	CHANNEL_VALUE_FIXED ChannelValueSource = 2
+	// The greater of the supply, escrow balance, and fixed value (if set)
+	CHANNEL_VALUE_MAX ChannelValueSource = 3
+	// The lesser of the supply, escrow balance, and fixed value (if set)
+	CHANNEL_VALUE_MIN ChannelValueSource = 4
+)
+
+var ChannelValueSource_name = map[int32]string{
+	0: "CHANNEL_VALUE_SUPPLY",
+	1: "CHANNEL_VALUE_ESCROW",
+	2: "CHANNEL_VALUE_FIXED",
+	3: "CHANNEL_VALUE_MAX",
+	4: "CHANNEL_VALUE_MIN",
+}
+
+var ChannelValueSource_value = map[string]int32{
+	"CHANNEL_VALUE_SUPPLY": 0,
+	"CHANNEL_VALUE_ESCROW": 1,
+	"CHANNEL_VALUE_FIXED":  2,
+	"CHANNEL_VALUE_MAX":    3,
+	"CHANNEL_VALUE_MIN":    4,
+}
+
+func (x ChannelValueSource) String() string {
+	return proto.EnumName(ChannelValueSource_name, int32(x))
+}
+
+func (ChannelValueSource) EnumDescriptor() ([]byte, []int) {
+	return fileDescriptor_a3afe8dd489c3bd2, []int{3}
+}
+
+// TransferRuleAction defines what happens to a transfer that matches a
+// TransferRule
+type TransferRuleAction int32
+
+const (
+	// The transfer is rejected
+	RULE_ACTION_DENY TransferRuleAction = 0
+	// The transfer is allowed and is not counted towards the quota
+	RULE_ACTION_EXEMPT TransferRuleAction = 1
+	// The transfer is checked against the rule's quota instead of the
+	// quota of the rate limit
+	RULE_ACTION_QUOTA TransferRuleAction = 2
+)
+
+var TransferRuleAction_name = map[int32]string{
+	0: "RULE_ACTION_DENY",
+	1: "RULE_ACTION_EXEMPT",
+	2: "RULE_ACTION_QUOTA",
+}
+
+var TransferRuleAction_value = map[string]int32{
+	"RULE_ACTION_DENY":   0,
+	"RULE_ACTION_EXEMPT": 1,
+	"RULE_ACTION_QUOTA":  2,
+}
+
+func (x TransferRuleAction) String() string {
+	return proto.EnumName(TransferRuleAction_name, int32(x))
+}
+
+func (TransferRuleAction) EnumDescriptor() ([]byte, []int) {
+	return fileDescriptor_a3afe8dd489c3bd2, []int{4}
+}
+
 // Path holds the denom and channelID that define the rate limited route
 type Path struct {
 	Denom     string `protobuf:"bytes,1,opt,name=denom,proto3" json:"denom,omitempty"`
@@ -119,6 +260,14 @@ type Quota struct {
 	// DurationHours specifies the number of hours before the rate limit
 	// is reset (e.g. 24 indicates that the rate limit is reset each day)
 	DurationHours uint64 `protobuf:"varint,3,opt,name=duration_hours,json=durationHours,proto3" json:"duration_hours,omitempty"`
+	// An optional threshold denominated in a quote currency (e.g. USD), that's
+	// enforced in addition to the percentage thresholds
+	ValueQuota *ValueQuota `protobuf:"bytes,4,opt,name=value_quota,json=valueQuota,proto3" json:"value_quota,omitempty"`
+	// WindowOffset shifts the hour epochs at which the rate limit is reset, so
+	// that windows with the same duration don't all reset at the same time
+	// The rate limit is reset when (epochNumber - WindowOffset) % DurationHours
+	// is 0. Must be less than DurationHours
+	WindowOffset uint64 `protobuf:"varint,5,opt,name=window_offset,json=windowOffset,proto3" json:"window_offset,omitempty"`
 }
 
 func (m *Quota) Reset()         { *m = Quota{} }
@@ -161,6 +310,80 @@ func (m *Quota) GetDurationHours() uint64 {
 	return 0
 }
 
+func (m *Quota) GetValueQuota() *ValueQuota {
+	if m != nil {
+		return m.ValueQuota
+	}
+	return nil
+}
+
+func (m *Quota) GetWindowOffset() uint64 {
+	if m != nil {
+		return m.WindowOffset
+	}
+	return 0
+}
+
+// ValueQuota defines the max net flow of a rate limit in a quote currency
+// The net flow is converted at the oracle price when each packet is processed
+type ValueQuota struct {
+	// The denom of the quote currency, as known by the oracle (e.g. "usd")
+	QuoteDenom string `protobuf:"bytes,1,opt,name=quote_denom,json=quoteDenom,proto3" json:"quote_denom,omitempty"`
+	// MaxValueSend defines the max net outflow, in units of the quote denom
+	MaxValueSend github_com_cosmos_cosmos_sdk_types.Int `protobuf:"bytes,2,opt,name=max_value_send,json=maxValueSend,proto3,customtype=github.com/cosmos/cosmos-sdk/types.Int" json:"max_value_send"`
+	// MaxValueRecv defines the max net inflow, in units of the quote denom
+	MaxValueRecv github_com_cosmos_cosmos_sdk_types.Int `protobuf:"bytes,3,opt,name=max_value_recv,json=maxValueRecv,proto3,customtype=github.com/cosmos/cosmos-sdk/types.Int" json:"max_value_recv"`
+	// Determines how the quota is enforced if no price is available
+	PriceFallback PriceFallback `protobuf:"varint,4,opt,name=price_fallback,json=priceFallback,proto3,enum=ratelimit.v1.PriceFallback" json:"price_fallback,omitempty"`
+}
+
+func (m *ValueQuota) Reset()         { *m = ValueQuota{} }
+func (m *ValueQuota) String() string { return proto.CompactTextString(m) }
+func (*ValueQuota) ProtoMessage()    {}
+func (*ValueQuota) Descriptor() ([]byte, []int) {
+	return fileDescriptor_a3afe8dd489c3bd2, []int{2}
+}
+func (m *ValueQuota) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *ValueQuota) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_ValueQuota.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *ValueQuota) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ValueQuota.Merge(m, src)
+}
+func (m *ValueQuota) XXX_Size() int {
+	return m.Size()
+}
+func (m *ValueQuota) XXX_DiscardUnknown() {
+	xxx_messageInfo_ValueQuota.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_ValueQuota proto.InternalMessageInfo
+
+func (m *ValueQuota) GetQuoteDenom() string {
+	if m != nil {
+		return m.QuoteDenom
+	}
+	return ""
+}
+
+func (m *ValueQuota) GetPriceFallback() PriceFallback {
+	if m != nil {
+		return m.PriceFallback
+	}
+	return PRICE_FALLBACK_PERCENT
+}
+
 type Flow struct {
 	// Inflow defines the total amount of inbound transfers for the given
 	// rate limit in the current window
@@ -168,18 +391,29 @@ type Flow struct {
 	// Outflow defines the total amount of outbound transfers for the given
 	// rate limit in the current window
 	Outflow github_com_cosmos_cosmos_sdk_types.Int `protobuf:"bytes,2,opt,name=outflow,proto3,customtype=github.com/cosmos/cosmos-sdk/types.Int" json:"outflow"`
-	// ChannelValue stores the total supply of the denom at the start of
-	// the rate limit. This is used as the denominator when checking
-	// the rate limit threshold
+	// ChannelValue stores the value of the denom on the channel at the start
+	// of the rate limit (by default, the total supply of the denom). This is
+	// used as the denominator when checking the rate limit threshold
 	// The ChannelValue is fixed for the duration of the rate limit window
 	ChannelValue github_com_cosmos_cosmos_sdk_types.Int `protobuf:"bytes,3,opt,name=channel_value,json=channelValue,proto3,customtype=github.com/cosmos/cosmos-sdk/types.Int" json:"channel_value"`
+	// WindowId is the ID of the quota window that the flow belongs to (i.e. the
+	// number of times the quota has been reset, based on the hour epoch number,
+	// duration and window offset). Used to determine if the flow is stale when
+	// quotas are reset lazily
+	WindowId uint64 `protobuf:"varint,4,opt,name=window_id,json=windowId,proto3" json:"window_id,omitempty"`
+	// ValueInflow and ValueOutflow define the total value of inbound and outbound
+	// transfers in the current window, in units of the value quota's quote denom
+	// Each transfer is converted at the oracle price when it's processed
+	// These are only tracked for rate limits with a value quota
+	ValueInflow  *github_com_cosmos_cosmos_sdk_types.Dec `protobuf:"bytes,5,opt,name=value_inflow,json=valueInflow,proto3,customtype=github.com/cosmos/cosmos-sdk/types.Dec" json:"value_inflow,omitempty"`
+	ValueOutflow *github_com_cosmos_cosmos_sdk_types.Dec `protobuf:"bytes,6,opt,name=value_outflow,json=valueOutflow,proto3,customtype=github.com/cosmos/cosmos-sdk/types.Dec" json:"value_outflow,omitempty"`
 }
 
 func (m *Flow) Reset()         { *m = Flow{} }
 func (m *Flow) String() string { return proto.CompactTextString(m) }
 func (*Flow) ProtoMessage()    {}
 func (*Flow) Descriptor() ([]byte, []int) {
-	return fileDescriptor_a3afe8dd489c3bd2, []int{2}
+	return fileDescriptor_a3afe8dd489c3bd2, []int{3}
 }
 func (m *Flow) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -208,6 +442,62 @@ func (m *Flow) XXX_DiscardUnknown() {
 
 var xxx_messageInfo_Flow proto.InternalMessageInfo
 
+func (m *Flow) GetWindowId() uint64 {
+	if m != nil {
+		return m.WindowId
+	}
+	return 0
+}
+
+// ChannelValueStrategy defines how the channel value of a rate limit is
+// determined each time the rate limit is reset
+type ChannelValueStrategy struct {
+	Source ChannelValueSource `protobuf:"varint,1,opt,name=source,proto3,enum=ratelimit.v1.ChannelValueSource" json:"source,omitempty"`
+	// The fixed channel value. Required for the fixed source, and optionally
+	// included in the comparison for the max and min sources
+	FixedValue github_com_cosmos_cosmos_sdk_types.Int `protobuf:"bytes,2,opt,name=fixed_value,json=fixedValue,proto3,customtype=github.com/cosmos/cosmos-sdk/types.Int" json:"fixed_value"`
+}
+
+func (m *ChannelValueStrategy) Reset()         { *m = ChannelValueStrategy{} }
+func (m *ChannelValueStrategy) String() string { return proto.CompactTextString(m) }
+func (*ChannelValueStrategy) ProtoMessage()    {}
+func (*ChannelValueStrategy) Descriptor() ([]byte, []int) {
+	return fileDescriptor_a3afe8dd489c3bd2, []int{4}
+}
+func (m *ChannelValueStrategy) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *ChannelValueStrategy) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_ChannelValueStrategy.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *ChannelValueStrategy) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ChannelValueStrategy.Merge(m, src)
+}
+func (m *ChannelValueStrategy) XXX_Size() int {
+	return m.Size()
+}
+func (m *ChannelValueStrategy) XXX_DiscardUnknown() {
+	xxx_messageInfo_ChannelValueStrategy.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_ChannelValueStrategy proto.InternalMessageInfo
+
+func (m *ChannelValueStrategy) GetSource() ChannelValueSource {
+	if m != nil {
+		return m.Source
+	}
+	return CHANNEL_VALUE_SUPPLY
+}
+
 // RateLimit stores all the context about a given rate limit, including
 // the relevant denom and channel, rate limit thresholds, and current
 // progress towards the limits
@@ -215,13 +505,16 @@ type RateLimit struct {
 	Path  *Path  `protobuf:"bytes,1,opt,name=path,proto3" json:"path,omitempty"`
 	Quota *Quota `protobuf:"bytes,2,opt,name=quota,proto3" json:"quota,omitempty"`
 	Flow  *Flow  `protobuf:"bytes,3,opt,name=flow,proto3" json:"flow,omitempty"`
+	// The strategy used to determine the channel value
+	// If not specified, the total supply of the denom is used
+	ChannelValueStrategy *ChannelValueStrategy `protobuf:"bytes,4,opt,name=channel_value_strategy,json=channelValueStrategy,proto3" json:"channel_value_strategy,omitempty"`
 }
 
 func (m *RateLimit) Reset()         { *m = RateLimit{} }
 func (m *RateLimit) String() string { return proto.CompactTextString(m) }
 func (*RateLimit) ProtoMessage()    {}
 func (*RateLimit) Descriptor() ([]byte, []int) {
-	return fileDescriptor_a3afe8dd489c3bd2, []int{3}
+	return fileDescriptor_a3afe8dd489c3bd2, []int{5}
 }
 func (m *RateLimit) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -271,6 +564,13 @@ func (m *RateLimit) GetFlow() *Flow {
 	return nil
 }
 
+func (m *RateLimit) GetChannelValueStrategy() *ChannelValueStrategy {
+	if m != nil {
+		return m.ChannelValueStrategy
+	}
+	return nil
+}
+
 // WhitelistedAddressPair represents a sender-receiver combo that is
 // not subject to rate limit restrictions
 type WhitelistedAddressPair struct {
@@ -282,7 +582,7 @@ func (m *WhitelistedAddressPair) Reset()         { *m = WhitelistedAddressPair{}
 func (m *WhitelistedAddressPair) String() string { return proto.CompactTextString(m) }
 func (*WhitelistedAddressPair) ProtoMessage()    {}
 func (*WhitelistedAddressPair) Descriptor() ([]byte, []int) {
-	return fileDescriptor_a3afe8dd489c3bd2, []int{4}
+	return fileDescriptor_a3afe8dd489c3bd2, []int{6}
 }
 func (m *WhitelistedAddressPair) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -325,6 +625,101 @@ func (m *WhitelistedAddressPair) GetReceiver() string {
 	return ""
 }
 
+// PendingSendPacket represents a packet that was sent from this chain and has
+// not yet been acknowledged or timed out. It stores the tokens that were
+// charged against the flow of the rate limits on the channel, which are
+// refunded if the packet fails
+type PendingSendPacket struct {
+	ChannelId string `protobuf:"bytes,1,opt,name=channel_id,json=channelId,proto3" json:"channel_id,omitempty"`
+	Sequence  uint64 `protobuf:"varint,2,opt,name=sequence,proto3" json:"sequence,omitempty"`
+	// The amount charged to the rate limit of each denom on the channel
+	// When a rate limit is reset, its denom is removed from the amount
+	Amount github_com_cosmos_cosmos_sdk_types.Coins `protobuf:"bytes,3,rep,name=amount,proto3,castrepeated=github.com/cosmos/cosmos-sdk/types.Coins" json:"amount"`
+	// The hour epoch number when the packet was sent
+	EpochNumber uint64 `protobuf:"varint,4,opt,name=epoch_number,json=epochNumber,proto3" json:"epoch_number,omitempty"`
+	// The direction of the flow that was charged
+	Direction PacketDirection `protobuf:"varint,5,opt,name=direction,proto3,enum=ratelimit.v1.PacketDirection" json:"direction,omitempty"`
+	// The value charged to the value quota of the rate limit of each denom on the
+	// channel, in units of the quote denom (only for rate limits with a value quota)
+	// When a rate limit is reset, its denom is removed from the value
+	Value github_com_cosmos_cosmos_sdk_types.DecCoins `protobuf:"bytes,6,rep,name=value,proto3,castrepeated=github.com/cosmos/cosmos-sdk/types.DecCoins" json:"value"`
+}
+
+func (m *PendingSendPacket) Reset()         { *m = PendingSendPacket{} }
+func (m *PendingSendPacket) String() string { return proto.CompactTextString(m) }
+func (*PendingSendPacket) ProtoMessage()    {}
+func (*PendingSendPacket) Descriptor() ([]byte, []int) {
+	return fileDescriptor_a3afe8dd489c3bd2, []int{7}
+}
+func (m *PendingSendPacket) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *PendingSendPacket) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_PendingSendPacket.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *PendingSendPacket) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_PendingSendPacket.Merge(m, src)
+}
+func (m *PendingSendPacket) XXX_Size() int {
+	return m.Size()
+}
+func (m *PendingSendPacket) XXX_DiscardUnknown() {
+	xxx_messageInfo_PendingSendPacket.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_PendingSendPacket proto.InternalMessageInfo
+
+func (m *PendingSendPacket) GetChannelId() string {
+	if m != nil {
+		return m.ChannelId
+	}
+	return ""
+}
+
+func (m *PendingSendPacket) GetSequence() uint64 {
+	if m != nil {
+		return m.Sequence
+	}
+	return 0
+}
+
+func (m *PendingSendPacket) GetAmount() github_com_cosmos_cosmos_sdk_types.Coins {
+	if m != nil {
+		return m.Amount
+	}
+	return nil
+}
+
+func (m *PendingSendPacket) GetEpochNumber() uint64 {
+	if m != nil {
+		return m.EpochNumber
+	}
+	return 0
+}
+
+func (m *PendingSendPacket) GetDirection() PacketDirection {
+	if m != nil {
+		return m.Direction
+	}
+	return PACKET_SEND
+}
+
+func (m *PendingSendPacket) GetValue() github_com_cosmos_cosmos_sdk_types.DecCoins {
+	if m != nil {
+		return m.Value
+	}
+	return nil
+}
+
 type HourEpoch struct {
 	EpochNumber      uint64        `protobuf:"varint,1,opt,name=epoch_number,json=epochNumber,proto3" json:"epoch_number,omitempty"`
 	Duration         time.Duration `protobuf:"bytes,2,opt,name=duration,proto3,stdduration" json:"duration,omitempty"`
@@ -336,7 +731,7 @@ func (m *HourEpoch) Reset()         { *m = HourEpoch{} }
 func (m *HourEpoch) String() string { return proto.CompactTextString(m) }
 func (*HourEpoch) ProtoMessage()    {}
 func (*HourEpoch) Descriptor() ([]byte, []int) {
-	return fileDescriptor_a3afe8dd489c3bd2, []int{5}
+	return fileDescriptor_a3afe8dd489c3bd2, []int{8}
 }
 func (m *HourEpoch) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -393,105 +788,334 @@ func (m *HourEpoch) GetEpochStartHeight() int64 {
 	return 0
 }
 
-func init() {
-	proto.RegisterEnum("ratelimit.v1.PacketDirection", PacketDirection_name, PacketDirection_value)
-	proto.RegisterType((*Path)(nil), "ratelimit.v1.Path")
-	proto.RegisterType((*Quota)(nil), "ratelimit.v1.Quota")
-	proto.RegisterType((*Flow)(nil), "ratelimit.v1.Flow")
-	proto.RegisterType((*RateLimit)(nil), "ratelimit.v1.RateLimit")
-	proto.RegisterType((*WhitelistedAddressPair)(nil), "ratelimit.v1.WhitelistedAddressPair")
-	proto.RegisterType((*HourEpoch)(nil), "ratelimit.v1.HourEpoch")
+// TransferRule is a governance-managed policy that matches transfers based on
+// the packet memo and receiver, and overrides how they are rate limited
+// All non-empty match criteria must be satisfied for the rule to apply
+type TransferRule struct {
+	// Unique identifier for the rule
+	RuleId string `protobuf:"bytes,1,opt,name=rule_id,json=ruleId,proto3" json:"rule_id,omitempty"`
+	// Matches if the memo is a JSON object containing this top-level key
+	// (e.g. "wasm" for wasm hooks or "autopilot")
+	MemoKey string `protobuf:"bytes,2,opt,name=memo_key,json=memoKey,proto3" json:"memo_key,omitempty"`
+	// Matches if the memo contains this substring
+	MemoContains string `protobuf:"bytes,3,opt,name=memo_contains,json=memoContains,proto3" json:"memo_contains,omitempty"`
+	// Matches if the packet receiver is this address
+	Receiver string `protobuf:"bytes,4,opt,name=receiver,proto3" json:"receiver,omitempty"`
+	// Optionally restricts the rule to a specific denom, as it appears on the
+	// rate limited chain
+	Denom string `protobuf:"bytes,5,opt,name=denom,proto3" json:"denom,omitempty"`
+	// Optionally restricts the rule to a specific channel, on the side of the
+	// rate limited chain
+	ChannelId string `protobuf:"bytes,6,opt,name=channel_id,json=channelId,proto3" json:"channel_id,omitempty"`
+	// Action to take on a matching transfer
+	Action TransferRuleAction `protobuf:"varint,7,opt,name=action,proto3,enum=ratelimit.v1.TransferRuleAction" json:"action,omitempty"`
+	// Quota to apply to a matching transfer (only used with RULE_ACTION_QUOTA)
+	// The duration is ignored since the flow is shared with the rate limit
+	Quota *Quota `protobuf:"bytes,8,opt,name=quota,proto3" json:"quota,omitempty"`
+}
+
+func (m *TransferRule) Reset()         { *m = TransferRule{} }
+func (m *TransferRule) String() string { return proto.CompactTextString(m) }
+func (*TransferRule) ProtoMessage()    {}
+func (*TransferRule) Descriptor() ([]byte, []int) {
+	return fileDescriptor_a3afe8dd489c3bd2, []int{9}
+}
+func (m *TransferRule) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *TransferRule) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_TransferRule.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *TransferRule) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_TransferRule.Merge(m, src)
+}
+func (m *TransferRule) XXX_Size() int {
+	return m.Size()
+}
+func (m *TransferRule) XXX_DiscardUnknown() {
+	xxx_messageInfo_TransferRule.DiscardUnknown(m)
 }
 
-func init() { proto.RegisterFile("ratelimit/v1/ratelimit.proto", fileDescriptor_a3afe8dd489c3bd2) }
+var xxx_messageInfo_TransferRule proto.InternalMessageInfo
 
-var fileDescriptor_a3afe8dd489c3bd2 = []byte{
-	// 674 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xa4, 0x94, 0x4d, 0x4f, 0x13, 0x4f,
-	0x1c, 0xc7, 0xbb, 0xb0, 0xf0, 0xa7, 0x53, 0x1e, 0x9a, 0xf9, 0x13, 0x52, 0x1b, 0xdd, 0x62, 0x13,
-	0x09, 0x1a, 0xba, 0x1b, 0xf0, 0xa2, 0xf1, 0x44, 0xa1, 0x04, 0x22, 0x21, 0x75, 0x8a, 0x68, 0xbc,
-	0x6c, 0xa6, 0xbb, 0xc3, 0xee, 0x84, 0xee, 0xce, 0x3a, 0x3b, 0x5b, 0xe0, 0x6c, 0x62, 0x3c, 0x72,
-	0xf4, 0xee, 0x9b, 0xe1, 0xc8, 0xd1, 0x78, 0x40, 0x53, 0x6e, 0x26, 0xbe, 0x07, 0x33, 0xb3, 0xbb,
-	0xb4, 0xa2, 0x17, 0xf1, 0xd4, 0xfd, 0x3d, 0x7d, 0xba, 0xdf, 0x99, 0xef, 0x6f, 0xc1, 0x5d, 0x8e,
-	0x05, 0xe9, 0xd1, 0x80, 0x0a, 0xab, 0xbf, 0x6a, 0x5d, 0x07, 0x66, 0xc4, 0x99, 0x60, 0x70, 0x7a,
-	0x98, 0xe8, 0xaf, 0x56, 0xe7, 0x3d, 0xe6, 0x31, 0x55, 0xb0, 0xe4, 0x53, 0xda, 0x53, 0x35, 0x3c,
-	0xc6, 0xbc, 0x1e, 0xb1, 0x54, 0xd4, 0x4d, 0x0e, 0x2d, 0x37, 0xe1, 0x58, 0x50, 0x16, 0x66, 0xf5,
-	0xda, 0xcd, 0xba, 0xa0, 0x01, 0x89, 0x05, 0x0e, 0xa2, 0xb4, 0xa1, 0xfe, 0x0c, 0xe8, 0x6d, 0x2c,
-	0x7c, 0x38, 0x0f, 0x26, 0x5c, 0x12, 0xb2, 0xa0, 0xa2, 0x2d, 0x6a, 0xcb, 0x45, 0x94, 0x06, 0xf0,
-	0x1e, 0x00, 0x8e, 0x8f, 0xc3, 0x90, 0xf4, 0x6c, 0xea, 0x56, 0xc6, 0x54, 0xa9, 0x98, 0x65, 0x76,
-	0xdc, 0xfa, 0x40, 0x03, 0x13, 0x2f, 0x12, 0x26, 0x30, 0x7c, 0x0d, 0xca, 0x01, 0x3e, 0xb1, 0x23,
-	0xc2, 0x1d, 0x12, 0x0a, 0x3b, 0x26, 0xa1, 0x9b, 0x92, 0x9a, 0xe6, 0xf9, 0x65, 0xad, 0xf0, 0xe5,
-	0xb2, 0xb6, 0xe4, 0x51, 0xe1, 0x27, 0x5d, 0xd3, 0x61, 0x81, 0xe5, 0xb0, 0x38, 0x60, 0x71, 0xf6,
-	0xd3, 0x88, 0xdd, 0x23, 0x4b, 0x9c, 0x46, 0x24, 0x36, 0x77, 0x42, 0x81, 0x66, 0x03, 0x7c, 0xd2,
-	0x4e, 0x31, 0x1d, 0x12, 0xba, 0x37, 0xc9, 0x9c, 0x38, 0xfd, 0xf4, 0x45, 0xfe, 0x85, 0x8c, 0x88,
-	0xd3, 0x87, 0x0f, 0xc0, 0x6c, 0x7e, 0x5a, 0xb6, 0xcf, 0x12, 0x1e, 0x57, 0xc6, 0x17, 0xb5, 0x65,
-	0x1d, 0xcd, 0xe4, 0xd9, 0x6d, 0x99, 0xac, 0xff, 0xd0, 0x80, 0xbe, 0xd5, 0x63, 0xc7, 0x70, 0x0b,
-	0x4c, 0xd2, 0xf0, 0xb0, 0xc7, 0x8e, 0x6f, 0xa9, 0x2c, 0x9b, 0x86, 0xdb, 0xe0, 0x3f, 0x96, 0x08,
-	0x05, 0xba, 0x9d, 0x90, 0x7c, 0x1c, 0x76, 0xc0, 0x4c, 0x7e, 0x3d, 0x7d, 0xdc, 0x4b, 0x88, 0x12,
-	0xf0, 0xf7, 0xbc, 0xe9, 0x0c, 0x72, 0x20, 0x19, 0xf5, 0xf7, 0x1a, 0x28, 0x22, 0x2c, 0xc8, 0xae,
-	0x74, 0x1e, 0x5c, 0x02, 0x7a, 0x84, 0x85, 0xaf, 0x24, 0x97, 0xd6, 0xa0, 0x39, 0xea, 0x49, 0x53,
-	0x3a, 0x07, 0xa9, 0x3a, 0x7c, 0x08, 0x26, 0xde, 0x4a, 0x27, 0x28, 0x49, 0xa5, 0xb5, 0xff, 0x7f,
-	0x6d, 0x54, 0x26, 0x41, 0x69, 0x87, 0x44, 0x2a, 0xf1, 0xe3, 0x7f, 0x42, 0xca, 0x93, 0x46, 0xaa,
-	0x5e, 0xdf, 0x05, 0x0b, 0xaf, 0x7c, 0x2a, 0x6b, 0xb1, 0x20, 0xee, 0xba, 0xeb, 0x72, 0x12, 0xc7,
-	0x6d, 0x4c, 0x39, 0x5c, 0x00, 0x93, 0xd2, 0x61, 0x84, 0x67, 0x6e, 0xcd, 0x22, 0x58, 0x05, 0x53,
-	0x9c, 0x38, 0x84, 0xf6, 0x09, 0xcf, 0xcc, 0x7a, 0x1d, 0xd7, 0xdf, 0x8d, 0x81, 0xa2, 0xbc, 0xd0,
-	0x56, 0xc4, 0x1c, 0x1f, 0xde, 0x07, 0xd3, 0x44, 0x3e, 0xd8, 0x61, 0x12, 0x74, 0x33, 0x8e, 0x8e,
-	0x4a, 0x2a, 0xb7, 0xa7, 0x52, 0xf0, 0x25, 0x98, 0xca, 0x8d, 0x90, 0x89, 0xba, 0x63, 0xa6, 0xdb,
-	0x64, 0xe6, 0xdb, 0x64, 0x6e, 0x66, 0x0d, 0x4d, 0x43, 0x1e, 0xf9, 0xf7, 0xcb, 0x1a, 0xcc, 0x47,
-	0x56, 0x58, 0x40, 0x05, 0x09, 0x22, 0x71, 0xfa, 0xf1, 0x6b, 0x4d, 0x43, 0xd7, 0x28, 0xb8, 0x07,
-	0xca, 0xe9, 0x3f, 0xc7, 0x02, 0x73, 0x61, 0xcb, 0x7d, 0xcc, 0x4e, 0xa2, 0xfa, 0x1b, 0x7e, 0x3f,
-	0x5f, 0xd6, 0xe6, 0x94, 0xe4, 0x9f, 0x49, 0xd2, 0xac, 0x9a, 0xee, 0xc8, 0x61, 0x59, 0x86, 0x2b,
-	0x00, 0x8e, 0xf2, 0x7c, 0x42, 0x3d, 0x5f, 0x54, 0xf4, 0x45, 0x6d, 0x79, 0x1c, 0x95, 0x87, 0xbd,
-	0xdb, 0x2a, 0xff, 0xe8, 0x29, 0x98, 0x6b, 0x63, 0xe7, 0x88, 0x88, 0x4d, 0xca, 0x89, 0xa3, 0x5e,
-	0x68, 0x0e, 0x94, 0xda, 0xeb, 0x1b, 0xcf, 0x5b, 0xfb, 0x76, 0xa7, 0xb5, 0xb7, 0x59, 0x2e, 0x8c,
-	0x24, 0x50, 0x6b, 0xe3, 0xa0, 0xac, 0x55, 0xf5, 0x0f, 0x9f, 0x8c, 0x42, 0x13, 0x9d, 0x0f, 0x0c,
-	0xed, 0x62, 0x60, 0x68, 0xdf, 0x06, 0x86, 0x76, 0x76, 0x65, 0x14, 0x2e, 0xae, 0x8c, 0xc2, 0xe7,
-	0x2b, 0xa3, 0xf0, 0xe6, 0xc9, 0x88, 0xcf, 0x3a, 0x82, 0x53, 0x97, 0x34, 0x76, 0x71, 0x37, 0xb6,
-	0x68, 0xd7, 0x69, 0xc8, 0xcb, 0x6d, 0xa8, 0xdb, 0xa5, 0xa1, 0x37, 0xfc, 0xc4, 0xa5, 0xee, 0xeb,
-	0x4e, 0x2a, 0xa9, 0x8f, 0x7f, 0x06, 0x00, 0x00, 0xff, 0xff, 0xd4, 0xbe, 0x09, 0x0b, 0x09, 0x05,
-	0x00, 0x00,
+func (m *TransferRule) GetRuleId() string {
+	if m != nil {
+		return m.RuleId
+	}
+	return ""
 }
 
-func (m *Path) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
+func (m *TransferRule) GetMemoKey() string {
+	if m != nil {
+		return m.MemoKey
 	}
-	return dAtA[:n], nil
+	return ""
 }
 
-func (m *Path) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+func (m *TransferRule) GetMemoContains() string {
+	if m != nil {
+		return m.MemoContains
+	}
+	return ""
 }
 
-func (m *Path) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if len(m.ChannelId) > 0 {
-		i -= len(m.ChannelId)
-		copy(dAtA[i:], m.ChannelId)
-		i = encodeVarintRatelimit(dAtA, i, uint64(len(m.ChannelId)))
-		i--
-		dAtA[i] = 0x12
+func (m *TransferRule) GetReceiver() string {
+	if m != nil {
+		return m.Receiver
 	}
-	if len(m.Denom) > 0 {
-		i -= len(m.Denom)
-		copy(dAtA[i:], m.Denom)
-		i = encodeVarintRatelimit(dAtA, i, uint64(len(m.Denom)))
-		i--
-		dAtA[i] = 0xa
+	return ""
+}
+
+func (m *TransferRule) GetDenom() string {
+	if m != nil {
+		return m.Denom
 	}
-	return len(dAtA) - i, nil
+	return ""
 }
 
-func (m *Quota) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
+func (m *TransferRule) GetChannelId() string {
+	if m != nil {
+		return m.ChannelId
+	}
+	return ""
+}
+
+func (m *TransferRule) GetAction() TransferRuleAction {
+	if m != nil {
+		return m.Action
+	}
+	return RULE_ACTION_DENY
+}
+
+func (m *TransferRule) GetQuota() *Quota {
+	if m != nil {
+		return m.Quota
+	}
+	return nil
+}
+
+// DenomGroup is a governance-defined set of denoms that represent the same
+// underlying asset (e.g. ATOM received directly and ATOM received via
+// Osmosis), whose flows and channel value are aggregated under one quota
+// Rate limits for the group are added using the group ID as the denom
+type DenomGroup struct {
+	// Identifier of the group, used as the denom of the group's rate limits
+	// Must start with "group/" (e.g. "group/atom")
+	GroupId string `protobuf:"bytes,1,opt,name=group_id,json=groupId,proto3" json:"group_id,omitempty"`
+	// The denoms in the group, as they appear on the rate limited chain
+	Denoms []string `protobuf:"bytes,2,rep,name=denoms,proto3" json:"denoms,omitempty"`
+}
+
+func (m *DenomGroup) Reset()         { *m = DenomGroup{} }
+func (m *DenomGroup) String() string { return proto.CompactTextString(m) }
+func (*DenomGroup) ProtoMessage()    {}
+func (*DenomGroup) Descriptor() ([]byte, []int) {
+	return fileDescriptor_a3afe8dd489c3bd2, []int{10}
+}
+func (m *DenomGroup) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *DenomGroup) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_DenomGroup.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *DenomGroup) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_DenomGroup.Merge(m, src)
+}
+func (m *DenomGroup) XXX_Size() int {
+	return m.Size()
+}
+func (m *DenomGroup) XXX_DiscardUnknown() {
+	xxx_messageInfo_DenomGroup.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_DenomGroup proto.InternalMessageInfo
+
+func (m *DenomGroup) GetGroupId() string {
+	if m != nil {
+		return m.GroupId
+	}
+	return ""
+}
+
+func (m *DenomGroup) GetDenoms() []string {
+	if m != nil {
+		return m.Denoms
+	}
+	return nil
+}
+
+func init() {
+	proto.RegisterEnum("ratelimit.v1.PacketDirection", PacketDirection_name, PacketDirection_value)
+	proto.RegisterEnum("ratelimit.v1.PriceFallback", PriceFallback_name, PriceFallback_value)
+	proto.RegisterEnum("ratelimit.v1.FlowUpdateMode", FlowUpdateMode_name, FlowUpdateMode_value)
+	proto.RegisterEnum("ratelimit.v1.ChannelValueSource", ChannelValueSource_name, ChannelValueSource_value)
+	proto.RegisterEnum("ratelimit.v1.TransferRuleAction", TransferRuleAction_name, TransferRuleAction_value)
+	proto.RegisterType((*Path)(nil), "ratelimit.v1.Path")
+	proto.RegisterType((*Quota)(nil), "ratelimit.v1.Quota")
+	proto.RegisterType((*ValueQuota)(nil), "ratelimit.v1.ValueQuota")
+	proto.RegisterType((*Flow)(nil), "ratelimit.v1.Flow")
+	proto.RegisterType((*ChannelValueStrategy)(nil), "ratelimit.v1.ChannelValueStrategy")
+	proto.RegisterType((*RateLimit)(nil), "ratelimit.v1.RateLimit")
+	proto.RegisterType((*WhitelistedAddressPair)(nil), "ratelimit.v1.WhitelistedAddressPair")
+	proto.RegisterType((*PendingSendPacket)(nil), "ratelimit.v1.PendingSendPacket")
+	proto.RegisterType((*HourEpoch)(nil), "ratelimit.v1.HourEpoch")
+	proto.RegisterType((*TransferRule)(nil), "ratelimit.v1.TransferRule")
+	proto.RegisterType((*DenomGroup)(nil), "ratelimit.v1.DenomGroup")
+}
+
+func init() { proto.RegisterFile("ratelimit/v1/ratelimit.proto", fileDescriptor_a3afe8dd489c3bd2) }
+
+var fileDescriptor_a3afe8dd489c3bd2 = []byte{
+	// 1417 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xa4, 0x57, 0xbf, 0x73, 0x1a, 0xc7,
+	0x17, 0xe7, 0x00, 0x21, 0xe9, 0x21, 0xe1, 0xf3, 0x5a, 0x5f, 0x19, 0xcb, 0x36, 0xd2, 0x97, 0xef,
+	0x7c, 0x3d, 0x8a, 0x63, 0x41, 0x24, 0x37, 0xf6, 0xb8, 0xc8, 0x20, 0x38, 0x45, 0xc4, 0x08, 0xce,
+	0x0b, 0x92, 0xe5, 0x34, 0x37, 0xc7, 0xdd, 0x02, 0x37, 0xe2, 0x6e, 0xf1, 0xdd, 0x82, 0xa4, 0x3a,
+	0x4d, 0x4a, 0x37, 0x99, 0x49, 0x93, 0x22, 0x93, 0xce, 0x7f, 0x46, 0x2a, 0x97, 0x2e, 0x33, 0x29,
+	0x6c, 0x8f, 0xdd, 0xe5, 0x6f, 0x48, 0x91, 0xd9, 0xdd, 0x43, 0xfc, 0x90, 0x92, 0x38, 0x72, 0xc5,
+	0xbd, 0x1f, 0xfb, 0xd9, 0x7d, 0x9f, 0xf7, 0xf6, 0xbd, 0x05, 0x6e, 0xf9, 0x26, 0x23, 0x5d, 0xc7,
+	0x75, 0x58, 0x7e, 0xb0, 0x99, 0x3f, 0x13, 0x72, 0x3d, 0x9f, 0x32, 0x8a, 0x16, 0x46, 0x8a, 0xc1,
+	0xe6, 0xca, 0x52, 0x9b, 0xb6, 0xa9, 0x30, 0xe4, 0xf9, 0x97, 0xf4, 0x59, 0xc9, 0x58, 0x34, 0x70,
+	0x69, 0x90, 0x6f, 0x9a, 0x01, 0xc9, 0x0f, 0x36, 0x9b, 0x84, 0x99, 0x9b, 0x79, 0x8b, 0x3a, 0xde,
+	0xd0, 0xde, 0xa6, 0xb4, 0xdd, 0x25, 0x79, 0x21, 0x35, 0xfb, 0xad, 0xbc, 0xdd, 0xf7, 0x4d, 0xe6,
+	0xd0, 0xa1, 0x7d, 0x75, 0xda, 0xce, 0x1c, 0x97, 0x04, 0xcc, 0x74, 0x7b, 0xd2, 0x21, 0xfb, 0x08,
+	0xe2, 0xba, 0xc9, 0x3a, 0x68, 0x09, 0x66, 0x6c, 0xe2, 0x51, 0x37, 0xad, 0xac, 0x29, 0xeb, 0xf3,
+	0x58, 0x0a, 0xe8, 0x36, 0x80, 0xd5, 0x31, 0x3d, 0x8f, 0x74, 0x0d, 0xc7, 0x4e, 0x47, 0x85, 0x69,
+	0x3e, 0xd4, 0x94, 0xed, 0xec, 0x2f, 0x51, 0x98, 0x79, 0xd2, 0xa7, 0xcc, 0x44, 0x87, 0xa0, 0xba,
+	0xe6, 0x89, 0xd1, 0x23, 0xbe, 0x45, 0x3c, 0x66, 0x04, 0xc4, 0xb3, 0x25, 0xd2, 0x76, 0xee, 0xd5,
+	0x9b, 0xd5, 0xc8, 0x6f, 0x6f, 0x56, 0xef, 0xb4, 0x1d, 0xd6, 0xe9, 0x37, 0x73, 0x16, 0x75, 0xf3,
+	0x61, 0x50, 0xf2, 0x67, 0x23, 0xb0, 0x8f, 0xf2, 0xec, 0xb4, 0x47, 0x82, 0x5c, 0xd9, 0x63, 0x38,
+	0xe5, 0x9a, 0x27, 0xba, 0x84, 0xa9, 0x13, 0xcf, 0x9e, 0x46, 0xf6, 0x89, 0x35, 0x48, 0x47, 0x3f,
+	0x15, 0x19, 0x13, 0x6b, 0x80, 0xfe, 0x0f, 0xa9, 0x21, 0x5b, 0x46, 0x87, 0xf6, 0xfd, 0x20, 0x1d,
+	0x5b, 0x53, 0xd6, 0xe3, 0x78, 0x71, 0xa8, 0xdd, 0xe5, 0x4a, 0xf4, 0x10, 0x92, 0x03, 0xb3, 0xdb,
+	0x27, 0xc6, 0x73, 0x1e, 0x69, 0x3a, 0xbe, 0xa6, 0xac, 0x27, 0xb7, 0xd2, 0xb9, 0xf1, 0xe4, 0xe5,
+	0x0e, 0xb8, 0x83, 0x60, 0x02, 0xc3, 0xe0, 0xec, 0x1b, 0xfd, 0x0f, 0x16, 0x8f, 0x1d, 0xcf, 0xa6,
+	0xc7, 0x06, 0x6d, 0xb5, 0x02, 0xc2, 0xd2, 0x33, 0x62, 0x83, 0x05, 0xa9, 0xac, 0x09, 0x5d, 0xf6,
+	0xc7, 0x28, 0xc0, 0x68, 0x3d, 0x5a, 0x85, 0x24, 0xdf, 0x88, 0x18, 0xe3, 0xe9, 0x00, 0xa1, 0x2a,
+	0x89, 0x9c, 0x34, 0x80, 0x07, 0x62, 0xc8, 0x33, 0x09, 0xa2, 0x2f, 0x47, 0xc7, 0x82, 0x6b, 0x9e,
+	0x88, 0x7d, 0x05, 0xcd, 0x13, 0xa8, 0x82, 0xe4, 0xd8, 0xa7, 0xa1, 0x0a, 0x8a, 0xb7, 0x21, 0xd5,
+	0xf3, 0x1d, 0x8b, 0x18, 0x2d, 0xb3, 0xdb, 0x6d, 0x9a, 0xd6, 0x91, 0xa0, 0x2f, 0xb5, 0x75, 0x73,
+	0x92, 0x3e, 0x9d, 0xfb, 0xec, 0x84, 0x2e, 0x78, 0xb1, 0x37, 0x2e, 0x66, 0x5f, 0xc6, 0x20, 0xbe,
+	0xd3, 0xa5, 0xc7, 0x68, 0x07, 0x12, 0x8e, 0xd7, 0xea, 0xd2, 0xe3, 0x4b, 0x56, 0x56, 0xb8, 0x1a,
+	0xed, 0xc2, 0x2c, 0xed, 0x33, 0x01, 0x74, 0x39, 0xe6, 0x86, 0xcb, 0x51, 0x1d, 0x16, 0x87, 0xd7,
+	0x43, 0x10, 0x77, 0x59, 0xce, 0x42, 0x10, 0xc1, 0x1b, 0xba, 0x09, 0xf3, 0x61, 0xd1, 0x38, 0xb6,
+	0xa0, 0x2b, 0x8e, 0xe7, 0xa4, 0xa2, 0x6c, 0xa3, 0x3d, 0x58, 0x90, 0x29, 0x0a, 0x99, 0x98, 0x11,
+	0x1b, 0xde, 0xfd, 0xc8, 0xcd, 0x4a, 0xc4, 0xc2, 0xb2, 0x98, 0xcb, 0x92, 0x8a, 0x1a, 0x2c, 0x4a,
+	0xb8, 0x21, 0x21, 0x89, 0x7f, 0x8d, 0x27, 0xcf, 0x53, 0x93, 0xeb, 0xb3, 0x3f, 0x29, 0xb0, 0x54,
+	0x1c, 0x8b, 0xa6, 0xce, 0x78, 0xa2, 0xdb, 0xa7, 0xe8, 0x01, 0x24, 0x02, 0xda, 0xf7, 0x2d, 0x22,
+	0x92, 0x97, 0xda, 0x5a, 0x9b, 0xac, 0x80, 0x89, 0x35, 0xc2, 0x0f, 0x87, 0xfe, 0xa8, 0x06, 0xc9,
+	0x96, 0x73, 0x42, 0xec, 0x90, 0xe2, 0xcb, 0xa5, 0x0c, 0x04, 0x84, 0x80, 0xcf, 0xbe, 0x53, 0x60,
+	0x1e, 0x9b, 0x8c, 0x54, 0xf8, 0xe6, 0xe8, 0x0e, 0xc4, 0x7b, 0x26, 0xeb, 0x88, 0x63, 0x25, 0xb7,
+	0xd0, 0x54, 0x61, 0x9a, 0xac, 0x83, 0x85, 0x1d, 0x7d, 0x06, 0x33, 0xb2, 0x01, 0x44, 0x85, 0xe3,
+	0xb5, 0x49, 0x47, 0x79, 0xf7, 0xa5, 0x07, 0x87, 0x14, 0x64, 0xc6, 0x2e, 0x82, 0xe4, 0xa5, 0x8c,
+	0x85, 0x1d, 0x1d, 0xc2, 0xf2, 0x44, 0xf9, 0x18, 0x41, 0xc8, 0x56, 0xd8, 0x64, 0xb2, 0x7f, 0xc3,
+	0x51, 0xe8, 0x89, 0x97, 0xac, 0x0b, 0xb4, 0xd9, 0x0a, 0x2c, 0x3f, 0xed, 0x38, 0x7c, 0x6d, 0xc0,
+	0x88, 0x5d, 0xb0, 0x6d, 0x9f, 0x04, 0x81, 0x6e, 0x3a, 0x3e, 0x5a, 0x86, 0x04, 0xef, 0x19, 0xc4,
+	0x0f, 0x3b, 0x4b, 0x28, 0xa1, 0x15, 0x98, 0xf3, 0x89, 0x45, 0x9c, 0x01, 0xf1, 0xc3, 0x3e, 0x7f,
+	0x26, 0x67, 0xff, 0x88, 0xc2, 0x55, 0x9d, 0x78, 0xb6, 0xe3, 0xb5, 0x79, 0xaf, 0xd0, 0x4d, 0xeb,
+	0x88, 0xb0, 0xa9, 0xd9, 0xa0, 0x4c, 0xcd, 0x06, 0x0e, 0x18, 0x90, 0xe7, 0x7d, 0xe2, 0x59, 0x32,
+	0x67, 0x71, 0x7c, 0x26, 0x23, 0x0b, 0x12, 0xa6, 0x4b, 0xfb, 0x1e, 0x4b, 0xc7, 0xd6, 0x62, 0xeb,
+	0xc9, 0xad, 0x1b, 0x39, 0x99, 0xb4, 0x1c, 0x1f, 0x73, 0xb9, 0x70, 0xcc, 0xe5, 0x8a, 0xd4, 0xf1,
+	0xb6, 0xbf, 0xe0, 0x89, 0x7e, 0xf9, 0x76, 0x75, 0xfd, 0x23, 0x12, 0xcd, 0x17, 0x04, 0x38, 0x84,
+	0x46, 0xff, 0x85, 0x05, 0xd2, 0xa3, 0x56, 0xc7, 0xf0, 0xfa, 0x6e, 0x93, 0xf8, 0xe1, 0x55, 0x4a,
+	0x0a, 0x5d, 0x55, 0xa8, 0xd0, 0x23, 0x98, 0xb7, 0x1d, 0x9f, 0x58, 0xbc, 0xd9, 0x8b, 0xab, 0x94,
+	0xda, 0xba, 0x3d, 0x5d, 0x00, 0x3c, 0xd6, 0xd2, 0xd0, 0x09, 0x8f, 0xfc, 0x51, 0x1b, 0x66, 0x64,
+	0x45, 0x26, 0x44, 0x0c, 0xb7, 0x2e, 0x8c, 0xa1, 0x44, 0x2c, 0x11, 0xc6, 0xfd, 0x30, 0x8c, 0xcf,
+	0x3f, 0xee, 0x56, 0xc9, 0x48, 0x24, 0x7e, 0xf6, 0xdb, 0x28, 0xcc, 0xf3, 0x51, 0xa4, 0xf1, 0x93,
+	0x9f, 0x0b, 0x4b, 0x39, 0x1f, 0xd6, 0x3e, 0xcc, 0x0d, 0x47, 0x58, 0x58, 0xad, 0x37, 0x72, 0xf2,
+	0x1d, 0x90, 0x1b, 0xbe, 0x03, 0x72, 0xa5, 0xd0, 0x61, 0x3b, 0xc3, 0x4f, 0xf6, 0xfb, 0x9b, 0x55,
+	0x34, 0x5c, 0x72, 0x8f, 0xba, 0x0e, 0x23, 0x6e, 0x8f, 0x9d, 0xfe, 0xf0, 0x76, 0x55, 0xc1, 0x67,
+	0x50, 0xa8, 0x0a, 0xaa, 0xdc, 0x39, 0x60, 0xa6, 0xcf, 0x0c, 0xfe, 0x92, 0x08, 0x4b, 0x7c, 0xe5,
+	0x1c, 0x7c, 0x63, 0xf8, 0xcc, 0xd8, 0x9e, 0xe3, 0xf8, 0x2f, 0x38, 0x52, 0x4a, 0xac, 0xae, 0xf3,
+	0xc5, 0xdc, 0x8c, 0xee, 0x01, 0x1a, 0xc7, 0xeb, 0x10, 0xa7, 0xdd, 0x61, 0x22, 0x4d, 0x31, 0xac,
+	0x8e, 0x7c, 0x77, 0x85, 0x9e, 0x8f, 0xc9, 0x85, 0x86, 0x6f, 0x7a, 0x41, 0x8b, 0xf8, 0xb8, 0xdf,
+	0x25, 0xe8, 0x3a, 0xcc, 0xfa, 0xfd, 0x2e, 0x19, 0x15, 0x5f, 0x82, 0x8b, 0x65, 0x1b, 0xdd, 0x80,
+	0x39, 0x97, 0xb8, 0xd4, 0x38, 0x22, 0xa7, 0x61, 0x29, 0xcf, 0x72, 0xf9, 0x31, 0x39, 0xe5, 0x03,
+	0x59, 0x98, 0x2c, 0xea, 0x31, 0xd3, 0xf1, 0xe4, 0xc4, 0x9f, 0xc7, 0x0b, 0x5c, 0x59, 0x0c, 0x75,
+	0x13, 0x57, 0x21, 0x3e, 0x79, 0x15, 0x46, 0xcf, 0xa4, 0x99, 0xbf, 0x7e, 0x26, 0x25, 0xa6, 0xaf,
+	0xc2, 0x03, 0x48, 0x98, 0xb2, 0xc6, 0x66, 0x2f, 0xea, 0x7d, 0xe3, 0x51, 0x15, 0x64, 0x99, 0x85,
+	0xfe, 0xa3, 0xa6, 0x33, 0xf7, 0x4f, 0x4d, 0x27, 0xfb, 0x25, 0x80, 0x78, 0x1f, 0x7c, 0xe5, 0xd3,
+	0x7e, 0x8f, 0x73, 0xd0, 0xe6, 0x1f, 0x23, 0x76, 0x66, 0x85, 0x5c, 0xb6, 0x79, 0x07, 0x10, 0xa7,
+	0x0e, 0xd2, 0xd1, 0xb5, 0x18, 0xa7, 0x4d, 0x4a, 0x77, 0x1f, 0xc2, 0x95, 0xa9, 0x6a, 0x47, 0x57,
+	0x20, 0xa9, 0x17, 0x8a, 0x8f, 0xb5, 0x86, 0x51, 0xd7, 0xaa, 0x25, 0x35, 0x32, 0xa6, 0xc0, 0x5a,
+	0xf1, 0x40, 0x55, 0x56, 0xe2, 0xdf, 0xfd, 0x9c, 0x89, 0xdc, 0xfd, 0x1a, 0x16, 0x27, 0x46, 0x38,
+	0x5a, 0x81, 0x65, 0x1d, 0x97, 0x8b, 0x9a, 0xb1, 0x53, 0xa8, 0x54, 0xb6, 0x0b, 0xc5, 0xc7, 0x86,
+	0xae, 0xe1, 0xa2, 0x56, 0x6d, 0xa8, 0x11, 0x74, 0x1d, 0xae, 0x4d, 0xd9, 0x4a, 0x5a, 0xf5, 0xd9,
+	0x19, 0x16, 0x81, 0x14, 0x6f, 0x91, 0xfb, 0x3d, 0xdb, 0x64, 0x64, 0x8f, 0xda, 0x04, 0xfd, 0x07,
+	0xae, 0xee, 0x54, 0x6a, 0x4f, 0x8d, 0x7d, 0xbd, 0x54, 0x68, 0x68, 0x06, 0xd6, 0xea, 0x1a, 0xc7,
+	0x49, 0xc3, 0xd2, 0xb8, 0x5a, 0xe7, 0x7a, 0x7c, 0xa0, 0xa9, 0x0a, 0xba, 0x05, 0xe9, 0x49, 0x4b,
+	0x4d, 0xaf, 0xe1, 0x46, 0xb9, 0x56, 0x2d, 0x54, 0xd4, 0x68, 0xb8, 0xcd, 0xf7, 0x0a, 0xa0, 0xf3,
+	0x43, 0x87, 0x83, 0x16, 0x77, 0x0b, 0xd5, 0xaa, 0x56, 0x31, 0x0e, 0x0a, 0x95, 0x7d, 0xcd, 0xa8,
+	0xef, 0xeb, 0x7a, 0xe5, 0x99, 0x1a, 0x39, 0x6f, 0xd1, 0xea, 0x45, 0x5c, 0x7b, 0xaa, 0x2a, 0x3c,
+	0xa0, 0x49, 0xcb, 0x4e, 0xf9, 0x50, 0x2b, 0xa9, 0x51, 0x7e, 0xf0, 0x49, 0xc3, 0x5e, 0xe1, 0x50,
+	0x8d, 0x5d, 0xa0, 0x2e, 0x57, 0xd5, 0x78, 0x78, 0x2e, 0x03, 0xd0, 0xf9, 0x7a, 0x40, 0x4b, 0xa0,
+	0xe2, 0xfd, 0x8a, 0x66, 0x14, 0x8a, 0x3c, 0x0c, 0x49, 0x58, 0x04, 0x2d, 0x03, 0x1a, 0xd7, 0x6a,
+	0x87, 0xda, 0x9e, 0xde, 0x50, 0x15, 0xbe, 0xc1, 0xb8, 0xfe, 0xc9, 0x7e, 0xad, 0x51, 0x18, 0x06,
+	0xbe, 0x8d, 0x5f, 0xbd, 0xcf, 0x28, 0xaf, 0xdf, 0x67, 0x94, 0x77, 0xef, 0x33, 0xca, 0x8b, 0x0f,
+	0x99, 0xc8, 0xeb, 0x0f, 0x99, 0xc8, 0xaf, 0x1f, 0x32, 0x91, 0x6f, 0x1e, 0x8c, 0xf5, 0xa6, 0x3a,
+	0xf3, 0x1d, 0x9b, 0x6c, 0x54, 0xcc, 0x66, 0x90, 0x77, 0x9a, 0xd6, 0x06, 0xaf, 0xbb, 0x0d, 0x51,
+	0x78, 0x8e, 0xd7, 0x1e, 0xfd, 0x93, 0x91, 0x1d, 0xab, 0x99, 0x10, 0xf7, 0xfe, 0xfe, 0x9f, 0x03,
+	0x00, 0x9c, 0x64, 0xd5, 0xa2, 0xf0, 0x0c, 0x00, 0x00,
+}
+
+func (m *Path) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *Path) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *Path) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.ChannelId) > 0 {
+		i -= len(m.ChannelId)
+		copy(dAtA[i:], m.ChannelId)
+		i = encodeVarintRatelimit(dAtA, i, uint64(len(m.ChannelId)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.Denom) > 0 {
+		i -= len(m.Denom)
+		copy(dAtA[i:], m.Denom)
+		i = encodeVarintRatelimit(dAtA, i, uint64(len(m.Denom)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *Quota) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
 	n, err := m.MarshalToSizedBuffer(dAtA[:size])
 	if err != nil {
 		return nil, err
@@ -509,6 +1133,23 @@ func (m *Quota) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	_ = i
 	var l int
 	_ = l
+	if m.WindowOffset != 0 {
+		i = encodeVarintRatelimit(dAtA, i, uint64(m.WindowOffset))
+		i--
+		dAtA[i] = 0x28
+	}
+	if m.ValueQuota != nil {
+		{
+			size, err := m.ValueQuota.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintRatelimit(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x22
+	}
 	if m.DurationHours != 0 {
 		i = encodeVarintRatelimit(dAtA, i, uint64(m.DurationHours))
 		i--
@@ -537,6 +1178,61 @@ func (m *Quota) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	return len(dAtA) - i, nil
 }
 
+func (m *ValueQuota) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *ValueQuota) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *ValueQuota) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.PriceFallback != 0 {
+		i = encodeVarintRatelimit(dAtA, i, uint64(m.PriceFallback))
+		i--
+		dAtA[i] = 0x20
+	}
+	{
+		size := m.MaxValueRecv.Size()
+		i -= size
+		if _, err := m.MaxValueRecv.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintRatelimit(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x1a
+	{
+		size := m.MaxValueSend.Size()
+		i -= size
+		if _, err := m.MaxValueSend.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintRatelimit(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x12
+	if len(m.QuoteDenom) > 0 {
+		i -= len(m.QuoteDenom)
+		copy(dAtA[i:], m.QuoteDenom)
+		i = encodeVarintRatelimit(dAtA, i, uint64(len(m.QuoteDenom)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
 func (m *Flow) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
@@ -557,6 +1253,35 @@ func (m *Flow) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	_ = i
 	var l int
 	_ = l
+	if m.ValueOutflow != nil {
+		{
+			size := m.ValueOutflow.Size()
+			i -= size
+			if _, err := m.ValueOutflow.MarshalTo(dAtA[i:]); err != nil {
+				return 0, err
+			}
+			i = encodeVarintRatelimit(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x32
+	}
+	if m.ValueInflow != nil {
+		{
+			size := m.ValueInflow.Size()
+			i -= size
+			if _, err := m.ValueInflow.MarshalTo(dAtA[i:]); err != nil {
+				return 0, err
+			}
+			i = encodeVarintRatelimit(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x2a
+	}
+	if m.WindowId != 0 {
+		i = encodeVarintRatelimit(dAtA, i, uint64(m.WindowId))
+		i--
+		dAtA[i] = 0x20
+	}
 	{
 		size := m.ChannelValue.Size()
 		i -= size
@@ -590,6 +1315,44 @@ func (m *Flow) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	return len(dAtA) - i, nil
 }
 
+func (m *ChannelValueStrategy) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *ChannelValueStrategy) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *ChannelValueStrategy) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	{
+		size := m.FixedValue.Size()
+		i -= size
+		if _, err := m.FixedValue.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintRatelimit(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x12
+	if m.Source != 0 {
+		i = encodeVarintRatelimit(dAtA, i, uint64(m.Source))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
 func (m *RateLimit) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
@@ -610,6 +1373,18 @@ func (m *RateLimit) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	_ = i
 	var l int
 	_ = l
+	if m.ChannelValueStrategy != nil {
+		{
+			size, err := m.ChannelValueStrategy.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintRatelimit(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x22
+	}
 	if m.Flow != nil {
 		{
 			size, err := m.Flow.MarshalToSizedBuffer(dAtA[:i])
@@ -686,7 +1461,7 @@ func (m *WhitelistedAddressPair) MarshalToSizedBuffer(dAtA []byte) (int, error)
 	return len(dAtA) - i, nil
 }
 
-func (m *HourEpoch) Marshal() (dAtA []byte, err error) {
+func (m *PendingSendPacket) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
 	n, err := m.MarshalToSizedBuffer(dAtA[:size])
@@ -696,52 +1471,246 @@ func (m *HourEpoch) Marshal() (dAtA []byte, err error) {
 	return dAtA[:n], nil
 }
 
-func (m *HourEpoch) MarshalTo(dAtA []byte) (int, error) {
+func (m *PendingSendPacket) MarshalTo(dAtA []byte) (int, error) {
 	size := m.Size()
 	return m.MarshalToSizedBuffer(dAtA[:size])
 }
 
-func (m *HourEpoch) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+func (m *PendingSendPacket) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	i := len(dAtA)
 	_ = i
 	var l int
 	_ = l
-	if m.EpochStartHeight != 0 {
-		i = encodeVarintRatelimit(dAtA, i, uint64(m.EpochStartHeight))
+	if len(m.Value) > 0 {
+		for iNdEx := len(m.Value) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Value[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintRatelimit(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x32
+		}
+	}
+	if m.Direction != 0 {
+		i = encodeVarintRatelimit(dAtA, i, uint64(m.Direction))
+		i--
+		dAtA[i] = 0x28
+	}
+	if m.EpochNumber != 0 {
+		i = encodeVarintRatelimit(dAtA, i, uint64(m.EpochNumber))
 		i--
 		dAtA[i] = 0x20
 	}
-	n4, err4 := github_com_cosmos_gogoproto_types.StdTimeMarshalTo(m.EpochStartTime, dAtA[i-github_com_cosmos_gogoproto_types.SizeOfStdTime(m.EpochStartTime):])
-	if err4 != nil {
-		return 0, err4
+	if len(m.Amount) > 0 {
+		for iNdEx := len(m.Amount) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Amount[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintRatelimit(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x1a
+		}
 	}
-	i -= n4
-	i = encodeVarintRatelimit(dAtA, i, uint64(n4))
-	i--
-	dAtA[i] = 0x1a
-	n5, err5 := github_com_cosmos_gogoproto_types.StdDurationMarshalTo(m.Duration, dAtA[i-github_com_cosmos_gogoproto_types.SizeOfStdDuration(m.Duration):])
-	if err5 != nil {
-		return 0, err5
+	if m.Sequence != 0 {
+		i = encodeVarintRatelimit(dAtA, i, uint64(m.Sequence))
+		i--
+		dAtA[i] = 0x10
 	}
-	i -= n5
-	i = encodeVarintRatelimit(dAtA, i, uint64(n5))
-	i--
-	dAtA[i] = 0x12
-	if m.EpochNumber != 0 {
-		i = encodeVarintRatelimit(dAtA, i, uint64(m.EpochNumber))
+	if len(m.ChannelId) > 0 {
+		i -= len(m.ChannelId)
+		copy(dAtA[i:], m.ChannelId)
+		i = encodeVarintRatelimit(dAtA, i, uint64(len(m.ChannelId)))
 		i--
-		dAtA[i] = 0x8
+		dAtA[i] = 0xa
 	}
 	return len(dAtA) - i, nil
 }
 
-func encodeVarintRatelimit(dAtA []byte, offset int, v uint64) int {
-	offset -= sovRatelimit(v)
-	base := offset
-	for v >= 1<<7 {
-		dAtA[offset] = uint8(v&0x7f | 0x80)
-		v >>= 7
-		offset++
+func (m *HourEpoch) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *HourEpoch) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *HourEpoch) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.EpochStartHeight != 0 {
+		i = encodeVarintRatelimit(dAtA, i, uint64(m.EpochStartHeight))
+		i--
+		dAtA[i] = 0x20
+	}
+	n6, err6 := github_com_cosmos_gogoproto_types.StdTimeMarshalTo(m.EpochStartTime, dAtA[i-github_com_cosmos_gogoproto_types.SizeOfStdTime(m.EpochStartTime):])
+	if err6 != nil {
+		return 0, err6
+	}
+	i -= n6
+	i = encodeVarintRatelimit(dAtA, i, uint64(n6))
+	i--
+	dAtA[i] = 0x1a
+	n7, err7 := github_com_cosmos_gogoproto_types.StdDurationMarshalTo(m.Duration, dAtA[i-github_com_cosmos_gogoproto_types.SizeOfStdDuration(m.Duration):])
+	if err7 != nil {
+		return 0, err7
+	}
+	i -= n7
+	i = encodeVarintRatelimit(dAtA, i, uint64(n7))
+	i--
+	dAtA[i] = 0x12
+	if m.EpochNumber != 0 {
+		i = encodeVarintRatelimit(dAtA, i, uint64(m.EpochNumber))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *TransferRule) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *TransferRule) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *TransferRule) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Quota != nil {
+		{
+			size, err := m.Quota.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintRatelimit(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x42
+	}
+	if m.Action != 0 {
+		i = encodeVarintRatelimit(dAtA, i, uint64(m.Action))
+		i--
+		dAtA[i] = 0x38
+	}
+	if len(m.ChannelId) > 0 {
+		i -= len(m.ChannelId)
+		copy(dAtA[i:], m.ChannelId)
+		i = encodeVarintRatelimit(dAtA, i, uint64(len(m.ChannelId)))
+		i--
+		dAtA[i] = 0x32
+	}
+	if len(m.Denom) > 0 {
+		i -= len(m.Denom)
+		copy(dAtA[i:], m.Denom)
+		i = encodeVarintRatelimit(dAtA, i, uint64(len(m.Denom)))
+		i--
+		dAtA[i] = 0x2a
+	}
+	if len(m.Receiver) > 0 {
+		i -= len(m.Receiver)
+		copy(dAtA[i:], m.Receiver)
+		i = encodeVarintRatelimit(dAtA, i, uint64(len(m.Receiver)))
+		i--
+		dAtA[i] = 0x22
+	}
+	if len(m.MemoContains) > 0 {
+		i -= len(m.MemoContains)
+		copy(dAtA[i:], m.MemoContains)
+		i = encodeVarintRatelimit(dAtA, i, uint64(len(m.MemoContains)))
+		i--
+		dAtA[i] = 0x1a
+	}
+	if len(m.MemoKey) > 0 {
+		i -= len(m.MemoKey)
+		copy(dAtA[i:], m.MemoKey)
+		i = encodeVarintRatelimit(dAtA, i, uint64(len(m.MemoKey)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.RuleId) > 0 {
+		i -= len(m.RuleId)
+		copy(dAtA[i:], m.RuleId)
+		i = encodeVarintRatelimit(dAtA, i, uint64(len(m.RuleId)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *DenomGroup) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *DenomGroup) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *DenomGroup) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Denoms) > 0 {
+		for iNdEx := len(m.Denoms) - 1; iNdEx >= 0; iNdEx-- {
+			i -= len(m.Denoms[iNdEx])
+			copy(dAtA[i:], m.Denoms[iNdEx])
+			i = encodeVarintRatelimit(dAtA, i, uint64(len(m.Denoms[iNdEx])))
+			i--
+			dAtA[i] = 0x12
+		}
+	}
+	if len(m.GroupId) > 0 {
+		i -= len(m.GroupId)
+		copy(dAtA[i:], m.GroupId)
+		i = encodeVarintRatelimit(dAtA, i, uint64(len(m.GroupId)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func encodeVarintRatelimit(dAtA []byte, offset int, v uint64) int {
+	offset -= sovRatelimit(v)
+	base := offset
+	for v >= 1<<7 {
+		dAtA[offset] = uint8(v&0x7f | 0x80)
+		v >>= 7
+		offset++
 	}
 	dAtA[offset] = uint8(v)
 	return base
@@ -776,6 +1745,33 @@ func (m *Quota) Size() (n int) {
 	if m.DurationHours != 0 {
 		n += 1 + sovRatelimit(uint64(m.DurationHours))
 	}
+	if m.ValueQuota != nil {
+		l = m.ValueQuota.Size()
+		n += 1 + l + sovRatelimit(uint64(l))
+	}
+	if m.WindowOffset != 0 {
+		n += 1 + sovRatelimit(uint64(m.WindowOffset))
+	}
+	return n
+}
+
+func (m *ValueQuota) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.QuoteDenom)
+	if l > 0 {
+		n += 1 + l + sovRatelimit(uint64(l))
+	}
+	l = m.MaxValueSend.Size()
+	n += 1 + l + sovRatelimit(uint64(l))
+	l = m.MaxValueRecv.Size()
+	n += 1 + l + sovRatelimit(uint64(l))
+	if m.PriceFallback != 0 {
+		n += 1 + sovRatelimit(uint64(m.PriceFallback))
+	}
 	return n
 }
 
@@ -791,6 +1787,31 @@ func (m *Flow) Size() (n int) {
 	n += 1 + l + sovRatelimit(uint64(l))
 	l = m.ChannelValue.Size()
 	n += 1 + l + sovRatelimit(uint64(l))
+	if m.WindowId != 0 {
+		n += 1 + sovRatelimit(uint64(m.WindowId))
+	}
+	if m.ValueInflow != nil {
+		l = m.ValueInflow.Size()
+		n += 1 + l + sovRatelimit(uint64(l))
+	}
+	if m.ValueOutflow != nil {
+		l = m.ValueOutflow.Size()
+		n += 1 + l + sovRatelimit(uint64(l))
+	}
+	return n
+}
+
+func (m *ChannelValueStrategy) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Source != 0 {
+		n += 1 + sovRatelimit(uint64(m.Source))
+	}
+	l = m.FixedValue.Size()
+	n += 1 + l + sovRatelimit(uint64(l))
 	return n
 }
 
@@ -812,6 +1833,10 @@ func (m *RateLimit) Size() (n int) {
 		l = m.Flow.Size()
 		n += 1 + l + sovRatelimit(uint64(l))
 	}
+	if m.ChannelValueStrategy != nil {
+		l = m.ChannelValueStrategy.Size()
+		n += 1 + l + sovRatelimit(uint64(l))
+	}
 	return n
 }
 
@@ -829,35 +1854,1143 @@ func (m *WhitelistedAddressPair) Size() (n int) {
 	if l > 0 {
 		n += 1 + l + sovRatelimit(uint64(l))
 	}
-	return n
-}
+	return n
+}
+
+func (m *PendingSendPacket) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.ChannelId)
+	if l > 0 {
+		n += 1 + l + sovRatelimit(uint64(l))
+	}
+	if m.Sequence != 0 {
+		n += 1 + sovRatelimit(uint64(m.Sequence))
+	}
+	if len(m.Amount) > 0 {
+		for _, e := range m.Amount {
+			l = e.Size()
+			n += 1 + l + sovRatelimit(uint64(l))
+		}
+	}
+	if m.EpochNumber != 0 {
+		n += 1 + sovRatelimit(uint64(m.EpochNumber))
+	}
+	if m.Direction != 0 {
+		n += 1 + sovRatelimit(uint64(m.Direction))
+	}
+	if len(m.Value) > 0 {
+		for _, e := range m.Value {
+			l = e.Size()
+			n += 1 + l + sovRatelimit(uint64(l))
+		}
+	}
+	return n
+}
+
+func (m *HourEpoch) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.EpochNumber != 0 {
+		n += 1 + sovRatelimit(uint64(m.EpochNumber))
+	}
+	l = github_com_cosmos_gogoproto_types.SizeOfStdDuration(m.Duration)
+	n += 1 + l + sovRatelimit(uint64(l))
+	l = github_com_cosmos_gogoproto_types.SizeOfStdTime(m.EpochStartTime)
+	n += 1 + l + sovRatelimit(uint64(l))
+	if m.EpochStartHeight != 0 {
+		n += 1 + sovRatelimit(uint64(m.EpochStartHeight))
+	}
+	return n
+}
+
+func (m *TransferRule) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.RuleId)
+	if l > 0 {
+		n += 1 + l + sovRatelimit(uint64(l))
+	}
+	l = len(m.MemoKey)
+	if l > 0 {
+		n += 1 + l + sovRatelimit(uint64(l))
+	}
+	l = len(m.MemoContains)
+	if l > 0 {
+		n += 1 + l + sovRatelimit(uint64(l))
+	}
+	l = len(m.Receiver)
+	if l > 0 {
+		n += 1 + l + sovRatelimit(uint64(l))
+	}
+	l = len(m.Denom)
+	if l > 0 {
+		n += 1 + l + sovRatelimit(uint64(l))
+	}
+	l = len(m.ChannelId)
+	if l > 0 {
+		n += 1 + l + sovRatelimit(uint64(l))
+	}
+	if m.Action != 0 {
+		n += 1 + sovRatelimit(uint64(m.Action))
+	}
+	if m.Quota != nil {
+		l = m.Quota.Size()
+		n += 1 + l + sovRatelimit(uint64(l))
+	}
+	return n
+}
+
+func (m *DenomGroup) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.GroupId)
+	if l > 0 {
+		n += 1 + l + sovRatelimit(uint64(l))
+	}
+	if len(m.Denoms) > 0 {
+		for _, s := range m.Denoms {
+			l = len(s)
+			n += 1 + l + sovRatelimit(uint64(l))
+		}
+	}
+	return n
+}
+
+func sovRatelimit(x uint64) (n int) {
+	return (math_bits.Len64(x|1) + 6) / 7
+}
+func sozRatelimit(x uint64) (n int) {
+	return sovRatelimit(uint64((x << 1) ^ uint64((int64(x) >> 63))))
+}
+func (m *Path) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowRatelimit
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: Path: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: Path: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Denom", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowRatelimit
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthRatelimit
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthRatelimit
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Denom = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ChannelId", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowRatelimit
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthRatelimit
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthRatelimit
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ChannelId = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipRatelimit(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthRatelimit
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *Quota) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowRatelimit
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: Quota: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: Quota: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MaxPercentSend", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowRatelimit
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthRatelimit
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthRatelimit
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.MaxPercentSend.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MaxPercentRecv", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowRatelimit
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthRatelimit
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthRatelimit
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.MaxPercentRecv.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field DurationHours", wireType)
+			}
+			m.DurationHours = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowRatelimit
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.DurationHours |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ValueQuota", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowRatelimit
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthRatelimit
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthRatelimit
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.ValueQuota == nil {
+				m.ValueQuota = &ValueQuota{}
+			}
+			if err := m.ValueQuota.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 5:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field WindowOffset", wireType)
+			}
+			m.WindowOffset = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowRatelimit
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.WindowOffset |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipRatelimit(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthRatelimit
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *ValueQuota) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowRatelimit
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: ValueQuota: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: ValueQuota: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field QuoteDenom", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowRatelimit
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthRatelimit
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthRatelimit
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.QuoteDenom = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MaxValueSend", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowRatelimit
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthRatelimit
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthRatelimit
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.MaxValueSend.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MaxValueRecv", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowRatelimit
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthRatelimit
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthRatelimit
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.MaxValueRecv.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 4:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field PriceFallback", wireType)
+			}
+			m.PriceFallback = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowRatelimit
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.PriceFallback |= PriceFallback(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipRatelimit(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthRatelimit
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *Flow) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowRatelimit
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: Flow: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: Flow: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Inflow", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowRatelimit
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthRatelimit
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthRatelimit
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.Inflow.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Outflow", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowRatelimit
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthRatelimit
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthRatelimit
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.Outflow.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ChannelValue", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowRatelimit
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthRatelimit
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthRatelimit
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.ChannelValue.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 4:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field WindowId", wireType)
+			}
+			m.WindowId = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowRatelimit
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.WindowId |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 5:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ValueInflow", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowRatelimit
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthRatelimit
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthRatelimit
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			var v github_com_cosmos_cosmos_sdk_types.Dec
+			m.ValueInflow = &v
+			if err := m.ValueInflow.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 6:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ValueOutflow", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowRatelimit
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthRatelimit
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthRatelimit
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			var v github_com_cosmos_cosmos_sdk_types.Dec
+			m.ValueOutflow = &v
+			if err := m.ValueOutflow.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipRatelimit(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthRatelimit
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *ChannelValueStrategy) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowRatelimit
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: ChannelValueStrategy: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: ChannelValueStrategy: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Source", wireType)
+			}
+			m.Source = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowRatelimit
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Source |= ChannelValueSource(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field FixedValue", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowRatelimit
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthRatelimit
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthRatelimit
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.FixedValue.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipRatelimit(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthRatelimit
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *RateLimit) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowRatelimit
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: RateLimit: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: RateLimit: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Path", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowRatelimit
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthRatelimit
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthRatelimit
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Path == nil {
+				m.Path = &Path{}
+			}
+			if err := m.Path.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Quota", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowRatelimit
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthRatelimit
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthRatelimit
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Quota == nil {
+				m.Quota = &Quota{}
+			}
+			if err := m.Quota.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Flow", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowRatelimit
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthRatelimit
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthRatelimit
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Flow == nil {
+				m.Flow = &Flow{}
+			}
+			if err := m.Flow.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ChannelValueStrategy", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowRatelimit
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthRatelimit
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthRatelimit
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.ChannelValueStrategy == nil {
+				m.ChannelValueStrategy = &ChannelValueStrategy{}
+			}
+			if err := m.ChannelValueStrategy.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipRatelimit(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthRatelimit
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
 
-func (m *HourEpoch) Size() (n int) {
-	if m == nil {
-		return 0
-	}
-	var l int
-	_ = l
-	if m.EpochNumber != 0 {
-		n += 1 + sovRatelimit(uint64(m.EpochNumber))
-	}
-	l = github_com_cosmos_gogoproto_types.SizeOfStdDuration(m.Duration)
-	n += 1 + l + sovRatelimit(uint64(l))
-	l = github_com_cosmos_gogoproto_types.SizeOfStdTime(m.EpochStartTime)
-	n += 1 + l + sovRatelimit(uint64(l))
-	if m.EpochStartHeight != 0 {
-		n += 1 + sovRatelimit(uint64(m.EpochStartHeight))
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
 	}
-	return n
-}
-
-func sovRatelimit(x uint64) (n int) {
-	return (math_bits.Len64(x|1) + 6) / 7
-}
-func sozRatelimit(x uint64) (n int) {
-	return sovRatelimit(uint64((x << 1) ^ uint64((int64(x) >> 63))))
+	return nil
 }
-func (m *Path) Unmarshal(dAtA []byte) error {
+func (m *WhitelistedAddressPair) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -880,15 +3013,15 @@ func (m *Path) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: Path: wiretype end group for non-group")
+			return fmt.Errorf("proto: WhitelistedAddressPair: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: Path: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: WhitelistedAddressPair: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Denom", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Sender", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -916,11 +3049,11 @@ func (m *Path) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Denom = string(dAtA[iNdEx:postIndex])
+			m.Sender = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field ChannelId", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Receiver", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -948,7 +3081,7 @@ func (m *Path) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.ChannelId = string(dAtA[iNdEx:postIndex])
+			m.Receiver = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
@@ -971,7 +3104,7 @@ func (m *Path) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *Quota) Unmarshal(dAtA []byte) error {
+func (m *PendingSendPacket) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -994,15 +3127,15 @@ func (m *Quota) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: Quota: wiretype end group for non-group")
+			return fmt.Errorf("proto: PendingSendPacket: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: Quota: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: PendingSendPacket: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field MaxPercentSend", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field ChannelId", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -1030,15 +3163,32 @@ func (m *Quota) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if err := m.MaxPercentSend.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
+			m.ChannelId = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Sequence", wireType)
+			}
+			m.Sequence = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowRatelimit
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Sequence |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 3:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field MaxPercentRecv", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Amount", wireType)
 			}
-			var stringLen uint64
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowRatelimit
@@ -1048,31 +3198,31 @@ func (m *Quota) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
+			if msglen < 0 {
 				return ErrInvalidLengthRatelimit
 			}
-			postIndex := iNdEx + intStringLen
+			postIndex := iNdEx + msglen
 			if postIndex < 0 {
 				return ErrInvalidLengthRatelimit
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if err := m.MaxPercentRecv.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			m.Amount = append(m.Amount, types.Coin{})
+			if err := m.Amount[len(m.Amount)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
-		case 3:
+		case 4:
 			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field DurationHours", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field EpochNumber", wireType)
 			}
-			m.DurationHours = 0
+			m.EpochNumber = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowRatelimit
@@ -1082,11 +3232,64 @@ func (m *Quota) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.DurationHours |= uint64(b&0x7F) << shift
+				m.EpochNumber |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 5:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Direction", wireType)
+			}
+			m.Direction = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowRatelimit
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Direction |= PacketDirection(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 6:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Value", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowRatelimit
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
+			if msglen < 0 {
+				return ErrInvalidLengthRatelimit
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthRatelimit
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Value = append(m.Value, types.DecCoin{})
+			if err := m.Value[len(m.Value)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipRatelimit(dAtA[iNdEx:])
@@ -1108,7 +3311,7 @@ func (m *Quota) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *Flow) Unmarshal(dAtA []byte) error {
+func (m *HourEpoch) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -1131,17 +3334,17 @@ func (m *Flow) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: Flow: wiretype end group for non-group")
+			return fmt.Errorf("proto: HourEpoch: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: Flow: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: HourEpoch: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Inflow", wireType)
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field EpochNumber", wireType)
 			}
-			var stringLen uint64
+			m.EpochNumber = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowRatelimit
@@ -1151,31 +3354,16 @@ func (m *Flow) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				m.EpochNumber |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
-				return ErrInvalidLengthRatelimit
-			}
-			postIndex := iNdEx + intStringLen
-			if postIndex < 0 {
-				return ErrInvalidLengthRatelimit
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			if err := m.Inflow.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
-			iNdEx = postIndex
 		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Outflow", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Duration", wireType)
 			}
-			var stringLen uint64
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowRatelimit
@@ -1185,31 +3373,30 @@ func (m *Flow) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
+			if msglen < 0 {
 				return ErrInvalidLengthRatelimit
 			}
-			postIndex := iNdEx + intStringLen
+			postIndex := iNdEx + msglen
 			if postIndex < 0 {
 				return ErrInvalidLengthRatelimit
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if err := m.Outflow.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if err := github_com_cosmos_gogoproto_types.StdDurationUnmarshal(&m.Duration, dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
 		case 3:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field ChannelValue", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field EpochStartTime", wireType)
 			}
-			var stringLen uint64
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowRatelimit
@@ -1219,26 +3406,44 @@ func (m *Flow) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
+			if msglen < 0 {
 				return ErrInvalidLengthRatelimit
 			}
-			postIndex := iNdEx + intStringLen
+			postIndex := iNdEx + msglen
 			if postIndex < 0 {
 				return ErrInvalidLengthRatelimit
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if err := m.ChannelValue.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if err := github_com_cosmos_gogoproto_types.StdTimeUnmarshal(&m.EpochStartTime, dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
-			iNdEx = postIndex
+			iNdEx = postIndex
+		case 4:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field EpochStartHeight", wireType)
+			}
+			m.EpochStartHeight = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowRatelimit
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.EpochStartHeight |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
 		default:
 			iNdEx = preIndex
 			skippy, err := skipRatelimit(dAtA[iNdEx:])
@@ -1260,7 +3465,7 @@ func (m *Flow) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *RateLimit) Unmarshal(dAtA []byte) error {
+func (m *TransferRule) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -1283,17 +3488,17 @@ func (m *RateLimit) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: RateLimit: wiretype end group for non-group")
+			return fmt.Errorf("proto: TransferRule: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: RateLimit: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: TransferRule: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Path", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field RuleId", wireType)
 			}
-			var msglen int
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowRatelimit
@@ -1303,33 +3508,29 @@ func (m *RateLimit) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
 				return ErrInvalidLengthRatelimit
 			}
-			postIndex := iNdEx + msglen
+			postIndex := iNdEx + intStringLen
 			if postIndex < 0 {
 				return ErrInvalidLengthRatelimit
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.Path == nil {
-				m.Path = &Path{}
-			}
-			if err := m.Path.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
+			m.RuleId = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Quota", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field MemoKey", wireType)
 			}
-			var msglen int
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowRatelimit
@@ -1339,33 +3540,29 @@ func (m *RateLimit) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
 				return ErrInvalidLengthRatelimit
 			}
-			postIndex := iNdEx + msglen
+			postIndex := iNdEx + intStringLen
 			if postIndex < 0 {
 				return ErrInvalidLengthRatelimit
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.Quota == nil {
-				m.Quota = &Quota{}
-			}
-			if err := m.Quota.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
+			m.MemoKey = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		case 3:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Flow", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field MemoContains", wireType)
 			}
-			var msglen int
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowRatelimit
@@ -1375,81 +3572,59 @@ func (m *RateLimit) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
 				return ErrInvalidLengthRatelimit
 			}
-			postIndex := iNdEx + msglen
+			postIndex := iNdEx + intStringLen
 			if postIndex < 0 {
 				return ErrInvalidLengthRatelimit
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.Flow == nil {
-				m.Flow = &Flow{}
-			}
-			if err := m.Flow.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
+			m.MemoContains = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
-		default:
-			iNdEx = preIndex
-			skippy, err := skipRatelimit(dAtA[iNdEx:])
-			if err != nil {
-				return err
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Receiver", wireType)
 			}
-			if (skippy < 0) || (iNdEx+skippy) < 0 {
-				return ErrInvalidLengthRatelimit
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowRatelimit
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
 			}
-			if (iNdEx + skippy) > l {
-				return io.ErrUnexpectedEOF
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthRatelimit
 			}
-			iNdEx += skippy
-		}
-	}
-
-	if iNdEx > l {
-		return io.ErrUnexpectedEOF
-	}
-	return nil
-}
-func (m *WhitelistedAddressPair) Unmarshal(dAtA []byte) error {
-	l := len(dAtA)
-	iNdEx := 0
-	for iNdEx < l {
-		preIndex := iNdEx
-		var wire uint64
-		for shift := uint(0); ; shift += 7 {
-			if shift >= 64 {
-				return ErrIntOverflowRatelimit
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthRatelimit
 			}
-			if iNdEx >= l {
+			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			b := dAtA[iNdEx]
-			iNdEx++
-			wire |= uint64(b&0x7F) << shift
-			if b < 0x80 {
-				break
-			}
-		}
-		fieldNum := int32(wire >> 3)
-		wireType := int(wire & 0x7)
-		if wireType == 4 {
-			return fmt.Errorf("proto: WhitelistedAddressPair: wiretype end group for non-group")
-		}
-		if fieldNum <= 0 {
-			return fmt.Errorf("proto: WhitelistedAddressPair: illegal tag %d (wire type %d)", fieldNum, wire)
-		}
-		switch fieldNum {
-		case 1:
+			m.Receiver = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 5:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Sender", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Denom", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -1477,11 +3652,11 @@ func (m *WhitelistedAddressPair) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Sender = string(dAtA[iNdEx:postIndex])
+			m.Denom = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
-		case 2:
+		case 6:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Receiver", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field ChannelId", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -1509,7 +3684,62 @@ func (m *WhitelistedAddressPair) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Receiver = string(dAtA[iNdEx:postIndex])
+			m.ChannelId = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 7:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Action", wireType)
+			}
+			m.Action = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowRatelimit
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Action |= TransferRuleAction(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 8:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Quota", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowRatelimit
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthRatelimit
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthRatelimit
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Quota == nil {
+				m.Quota = &Quota{}
+			}
+			if err := m.Quota.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
 			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
@@ -1532,7 +3762,7 @@ func (m *WhitelistedAddressPair) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *HourEpoch) Unmarshal(dAtA []byte) error {
+func (m *DenomGroup) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -1555,36 +3785,17 @@ func (m *HourEpoch) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: HourEpoch: wiretype end group for non-group")
+			return fmt.Errorf("proto: DenomGroup: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: HourEpoch: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: DenomGroup: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field EpochNumber", wireType)
-			}
-			m.EpochNumber = 0
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowRatelimit
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				m.EpochNumber |= uint64(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
-		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Duration", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field GroupId", wireType)
 			}
-			var msglen int
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowRatelimit
@@ -1594,30 +3805,29 @@ func (m *HourEpoch) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
 				return ErrInvalidLengthRatelimit
 			}
-			postIndex := iNdEx + msglen
+			postIndex := iNdEx + intStringLen
 			if postIndex < 0 {
 				return ErrInvalidLengthRatelimit
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if err := github_com_cosmos_gogoproto_types.StdDurationUnmarshal(&m.Duration, dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
+			m.GroupId = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
-		case 3:
+		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field EpochStartTime", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Denoms", wireType)
 			}
-			var msglen int
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowRatelimit
@@ -1627,44 +3837,24 @@ func (m *HourEpoch) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
 				return ErrInvalidLengthRatelimit
 			}
-			postIndex := iNdEx + msglen
+			postIndex := iNdEx + intStringLen
 			if postIndex < 0 {
 				return ErrInvalidLengthRatelimit
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if err := github_com_cosmos_gogoproto_types.StdTimeUnmarshal(&m.EpochStartTime, dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
+			m.Denoms = append(m.Denoms, string(dAtA[iNdEx:postIndex]))
 			iNdEx = postIndex
-		case 4:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field EpochStartHeight", wireType)
-			}
-			m.EpochStartHeight = 0
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowRatelimit
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				m.EpochStartHeight |= int64(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
 		default:
 			iNdEx = preIndex
 			skippy, err := skipRatelimit(dAtA[iNdEx:])
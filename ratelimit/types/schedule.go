@@ -0,0 +1,31 @@
+package types
+
+import (
+	"errors"
+	"fmt"
+)
+
+// Confirms exactly one of the execution epoch or height of a scheduled update is specified
+func ValidateScheduleTrigger(executeEpoch uint64, executeHeight int64) error {
+	if executeHeight < 0 {
+		return fmt.Errorf("execute height (%d) can not be negative", executeHeight)
+	}
+	if executeEpoch == 0 && executeHeight == 0 {
+		return errors.New("either the execute epoch or execute height must be specified")
+	}
+	if executeEpoch != 0 && executeHeight != 0 {
+		return errors.New("only one of the execute epoch or execute height can be specified")
+	}
+	return nil
+}
+
+// Validate performs stateless validation of a scheduled rate limit update
+func (s ScheduledRateLimitUpdate) Validate() error {
+	if err := ValidateScheduleTrigger(s.ExecuteEpoch, s.ExecuteHeight); err != nil {
+		return err
+	}
+	if err := s.Update.ValidateBasic(); err != nil {
+		return fmt.Errorf("invalid update: %s", err.Error())
+	}
+	return nil
+}
@@ -0,0 +1,437 @@
+// Code generated by protoc-gen-gogo. DO NOT EDIT.
+// source: ratelimit/v1/schedule.proto
+
+package types
+
+import (
+	fmt "fmt"
+	_ "github.com/cosmos/gogoproto/gogoproto"
+	proto "github.com/cosmos/gogoproto/proto"
+	io "io"
+	math "math"
+	math_bits "math/bits"
+)
+
+// Reference imports to suppress errors if they are not otherwise used.
+var _ = proto.Marshal
+var _ = fmt.Errorf
+var _ = math.Inf
+
+// This is a compile-time assertion to ensure that this generated file
+// is compatible with the proto package it is being compiled against.
+// A compilation error at this line likely means your copy of the
+// proto package needs to be updated.
+const _ = proto.GoGoProtoPackageIsVersion3 // please upgrade the proto package
+
+// ScheduledRateLimitUpdate stores an update to a rate limit that's applied
+// in the BeginBlocker once the execution epoch or height is reached
+// Exactly one of the execution epoch or height is specified
+type ScheduledRateLimitUpdate struct {
+	// Auto-incrementing ID of the scheduled update
+	ScheduleId uint64 `protobuf:"varint,1,opt,name=schedule_id,json=scheduleId,proto3" json:"schedule_id,omitempty"`
+	// The hour epoch at which the update is applied
+	ExecuteEpoch uint64 `protobuf:"varint,2,opt,name=execute_epoch,json=executeEpoch,proto3" json:"execute_epoch,omitempty"`
+	// The block height at which the update is applied
+	ExecuteHeight int64 `protobuf:"varint,3,opt,name=execute_height,json=executeHeight,proto3" json:"execute_height,omitempty"`
+	// The update to apply (with the same behavior as MsgUpdateRateLimit)
+	Update MsgUpdateRateLimit `protobuf:"bytes,4,opt,name=update,proto3" json:"update"`
+}
+
+func (m *ScheduledRateLimitUpdate) Reset()         { *m = ScheduledRateLimitUpdate{} }
+func (m *ScheduledRateLimitUpdate) String() string { return proto.CompactTextString(m) }
+func (*ScheduledRateLimitUpdate) ProtoMessage()    {}
+func (*ScheduledRateLimitUpdate) Descriptor() ([]byte, []int) {
+	return fileDescriptor_ce886c5a4faf1ca3, []int{0}
+}
+func (m *ScheduledRateLimitUpdate) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *ScheduledRateLimitUpdate) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_ScheduledRateLimitUpdate.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *ScheduledRateLimitUpdate) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ScheduledRateLimitUpdate.Merge(m, src)
+}
+func (m *ScheduledRateLimitUpdate) XXX_Size() int {
+	return m.Size()
+}
+func (m *ScheduledRateLimitUpdate) XXX_DiscardUnknown() {
+	xxx_messageInfo_ScheduledRateLimitUpdate.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_ScheduledRateLimitUpdate proto.InternalMessageInfo
+
+func (m *ScheduledRateLimitUpdate) GetScheduleId() uint64 {
+	if m != nil {
+		return m.ScheduleId
+	}
+	return 0
+}
+
+func (m *ScheduledRateLimitUpdate) GetExecuteEpoch() uint64 {
+	if m != nil {
+		return m.ExecuteEpoch
+	}
+	return 0
+}
+
+func (m *ScheduledRateLimitUpdate) GetExecuteHeight() int64 {
+	if m != nil {
+		return m.ExecuteHeight
+	}
+	return 0
+}
+
+func (m *ScheduledRateLimitUpdate) GetUpdate() MsgUpdateRateLimit {
+	if m != nil {
+		return m.Update
+	}
+	return MsgUpdateRateLimit{}
+}
+
+func init() {
+	proto.RegisterType((*ScheduledRateLimitUpdate)(nil), "ratelimit.v1.ScheduledRateLimitUpdate")
+}
+
+func init() { proto.RegisterFile("ratelimit/v1/schedule.proto", fileDescriptor_ce886c5a4faf1ca3) }
+
+var fileDescriptor_ce886c5a4faf1ca3 = []byte{
+	// 286 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xe2, 0x92, 0x2e, 0x4a, 0x2c, 0x49,
+	0xcd, 0xc9, 0xcc, 0xcd, 0x2c, 0xd1, 0x2f, 0x33, 0xd4, 0x2f, 0x4e, 0xce, 0x48, 0x4d, 0x29, 0xcd,
+	0x49, 0xd5, 0x2b, 0x28, 0xca, 0x2f, 0xc9, 0x17, 0xe2, 0x81, 0x4b, 0xea, 0x95, 0x19, 0x4a, 0x89,
+	0xa4, 0xe7, 0xa7, 0xe7, 0x83, 0x25, 0xf4, 0x41, 0x2c, 0x88, 0x1a, 0x29, 0x51, 0x14, 0x03, 0x4a,
+	0x2a, 0x20, 0xc2, 0x4a, 0xc7, 0x19, 0xb9, 0x24, 0x82, 0xa1, 0xa6, 0xa5, 0x04, 0x25, 0x96, 0xa4,
+	0xfa, 0x80, 0x94, 0x84, 0x16, 0xa4, 0x24, 0x96, 0xa4, 0x0a, 0xc9, 0x73, 0x71, 0xc3, 0x6c, 0x8a,
+	0xcf, 0x4c, 0x91, 0x60, 0x54, 0x60, 0xd4, 0x60, 0x09, 0xe2, 0x82, 0x09, 0x79, 0xa6, 0x08, 0x29,
+	0x73, 0xf1, 0xa6, 0x56, 0xa4, 0x26, 0x97, 0x96, 0xa4, 0xc6, 0xa7, 0x16, 0xe4, 0x27, 0x67, 0x48,
+	0x30, 0x81, 0x95, 0xf0, 0x40, 0x05, 0x5d, 0x41, 0x62, 0x42, 0xaa, 0x5c, 0x7c, 0x30, 0x45, 0x19,
+	0xa9, 0x99, 0xe9, 0x19, 0x25, 0x12, 0xcc, 0x0a, 0x8c, 0x1a, 0xcc, 0x41, 0x30, 0xad, 0x1e, 0x60,
+	0x41, 0x21, 0x3b, 0x2e, 0xb6, 0x52, 0xb0, 0xb5, 0x12, 0x2c, 0x0a, 0x8c, 0x1a, 0xdc, 0x46, 0x0a,
+	0x7a, 0xc8, 0xbe, 0xd2, 0xf3, 0x2d, 0x4e, 0x87, 0xb8, 0x0a, 0xee, 0x48, 0x27, 0x96, 0x13, 0xf7,
+	0xe4, 0x19, 0x82, 0xa0, 0xba, 0x9c, 0x82, 0x4e, 0x3c, 0x92, 0x63, 0xbc, 0xf0, 0x48, 0x8e, 0xf1,
+	0xc1, 0x23, 0x39, 0xc6, 0x09, 0x8f, 0xe5, 0x18, 0x2e, 0x3c, 0x96, 0x63, 0xb8, 0xf1, 0x58, 0x8e,
+	0x21, 0xca, 0x22, 0x3d, 0xb3, 0x24, 0xa3, 0x34, 0x49, 0x2f, 0x39, 0x3f, 0x57, 0x3f, 0xb8, 0xa4,
+	0x28, 0x33, 0x25, 0x55, 0xd7, 0x27, 0x31, 0xa9, 0x58, 0x3f, 0x33, 0x29, 0x59, 0x17, 0x64, 0x87,
+	0x2e, 0xd8, 0x92, 0xcc, 0xbc, 0x74, 0x7d, 0x44, 0x18, 0x95, 0x54, 0x16, 0xa4, 0x16, 0x27, 0xb1,
+	0x81, 0x03, 0xc9, 0x18, 0x30, 0x00, 0x88, 0xa5, 0x52, 0x36, 0x7e, 0x01, 0x00, 0x00,
+}
+
+func (m *ScheduledRateLimitUpdate) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *ScheduledRateLimitUpdate) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *ScheduledRateLimitUpdate) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	{
+		size, err := m.Update.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintSchedule(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x22
+	if m.ExecuteHeight != 0 {
+		i = encodeVarintSchedule(dAtA, i, uint64(m.ExecuteHeight))
+		i--
+		dAtA[i] = 0x18
+	}
+	if m.ExecuteEpoch != 0 {
+		i = encodeVarintSchedule(dAtA, i, uint64(m.ExecuteEpoch))
+		i--
+		dAtA[i] = 0x10
+	}
+	if m.ScheduleId != 0 {
+		i = encodeVarintSchedule(dAtA, i, uint64(m.ScheduleId))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func encodeVarintSchedule(dAtA []byte, offset int, v uint64) int {
+	offset -= sovSchedule(v)
+	base := offset
+	for v >= 1<<7 {
+		dAtA[offset] = uint8(v&0x7f | 0x80)
+		v >>= 7
+		offset++
+	}
+	dAtA[offset] = uint8(v)
+	return base
+}
+func (m *ScheduledRateLimitUpdate) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.ScheduleId != 0 {
+		n += 1 + sovSchedule(uint64(m.ScheduleId))
+	}
+	if m.ExecuteEpoch != 0 {
+		n += 1 + sovSchedule(uint64(m.ExecuteEpoch))
+	}
+	if m.ExecuteHeight != 0 {
+		n += 1 + sovSchedule(uint64(m.ExecuteHeight))
+	}
+	l = m.Update.Size()
+	n += 1 + l + sovSchedule(uint64(l))
+	return n
+}
+
+func sovSchedule(x uint64) (n int) {
+	return (math_bits.Len64(x|1) + 6) / 7
+}
+func sozSchedule(x uint64) (n int) {
+	return sovSchedule(uint64((x << 1) ^ uint64((int64(x) >> 63))))
+}
+func (m *ScheduledRateLimitUpdate) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowSchedule
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: ScheduledRateLimitUpdate: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: ScheduledRateLimitUpdate: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ScheduleId", wireType)
+			}
+			m.ScheduleId = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowSchedule
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.ScheduleId |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ExecuteEpoch", wireType)
+			}
+			m.ExecuteEpoch = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowSchedule
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.ExecuteEpoch |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ExecuteHeight", wireType)
+			}
+			m.ExecuteHeight = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowSchedule
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.ExecuteHeight |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Update", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowSchedule
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthSchedule
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthSchedule
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.Update.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipSchedule(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthSchedule
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func skipSchedule(dAtA []byte) (n int, err error) {
+	l := len(dAtA)
+	iNdEx := 0
+	depth := 0
+	for iNdEx < l {
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return 0, ErrIntOverflowSchedule
+			}
+			if iNdEx >= l {
+				return 0, io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= (uint64(b) & 0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		wireType := int(wire & 0x7)
+		switch wireType {
+		case 0:
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return 0, ErrIntOverflowSchedule
+				}
+				if iNdEx >= l {
+					return 0, io.ErrUnexpectedEOF
+				}
+				iNdEx++
+				if dAtA[iNdEx-1] < 0x80 {
+					break
+				}
+			}
+		case 1:
+			iNdEx += 8
+		case 2:
+			var length int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return 0, ErrIntOverflowSchedule
+				}
+				if iNdEx >= l {
+					return 0, io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				length |= (int(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if length < 0 {
+				return 0, ErrInvalidLengthSchedule
+			}
+			iNdEx += length
+		case 3:
+			depth++
+		case 4:
+			if depth == 0 {
+				return 0, ErrUnexpectedEndOfGroupSchedule
+			}
+			depth--
+		case 5:
+			iNdEx += 4
+		default:
+			return 0, fmt.Errorf("proto: illegal wireType %d", wireType)
+		}
+		if iNdEx < 0 {
+			return 0, ErrInvalidLengthSchedule
+		}
+		if depth == 0 {
+			return iNdEx, nil
+		}
+	}
+	return 0, io.ErrUnexpectedEOF
+}
+
+var (
+	ErrInvalidLengthSchedule        = fmt.Errorf("proto: negative length found during unmarshaling")
+	ErrIntOverflowSchedule          = fmt.Errorf("proto: integer overflow")
+	ErrUnexpectedEndOfGroupSchedule = fmt.Errorf("proto: unexpected end of group")
+)
@@ -0,0 +1,74 @@
+package types_test
+
+import (
+	"testing"
+
+	sdkmath "cosmossdk.io/math"
+	authtypes "github.com/cosmos/cosmos-sdk/x/auth/types"
+	govtypes "github.com/cosmos/cosmos-sdk/x/gov/types"
+	"github.com/stretchr/testify/require"
+
+	"github.com/Stride-Labs/ibc-rate-limiting/ratelimit/types"
+	"github.com/Stride-Labs/ibc-rate-limiting/testing/simapp/apptesting"
+)
+
+func TestValidateScheduledRateLimitUpdate(t *testing.T) {
+	apptesting.SetupConfig()
+
+	validUpdate := types.MsgUpdateRateLimit{
+		Authority:      authtypes.NewModuleAddress(govtypes.ModuleName).String(),
+		Denom:          "denom",
+		ChannelId:      "channel-0",
+		MaxPercentSend: sdkmath.NewInt(10),
+		MaxPercentRecv: sdkmath.NewInt(10),
+		DurationHours:  24,
+	}
+	invalidUpdate := validUpdate
+	invalidUpdate.ChannelId = "channel-"
+
+	testCases := []struct {
+		name            string
+		scheduledUpdate types.ScheduledRateLimitUpdate
+		expectedError   string
+	}{
+		{
+			name:            "valid update by epoch",
+			scheduledUpdate: types.ScheduledRateLimitUpdate{ScheduleId: 1, ExecuteEpoch: 10, Update: validUpdate},
+		},
+		{
+			name:            "valid update by height",
+			scheduledUpdate: types.ScheduledRateLimitUpdate{ScheduleId: 1, ExecuteHeight: 100, Update: validUpdate},
+		},
+		{
+			name:            "no epoch or height",
+			scheduledUpdate: types.ScheduledRateLimitUpdate{ScheduleId: 1, Update: validUpdate},
+			expectedError:   "either the execute epoch or execute height must be specified",
+		},
+		{
+			name:            "both epoch and height",
+			scheduledUpdate: types.ScheduledRateLimitUpdate{ScheduleId: 1, ExecuteEpoch: 10, ExecuteHeight: 100, Update: validUpdate},
+			expectedError:   "only one of the execute epoch or execute height can be specified",
+		},
+		{
+			name:            "negative height",
+			scheduledUpdate: types.ScheduledRateLimitUpdate{ScheduleId: 1, ExecuteHeight: -1, Update: validUpdate},
+			expectedError:   "execute height (-1) can not be negative",
+		},
+		{
+			name:            "invalid update",
+			scheduledUpdate: types.ScheduledRateLimitUpdate{ScheduleId: 1, ExecuteEpoch: 10, Update: invalidUpdate},
+			expectedError:   "invalid update",
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			err := tc.scheduledUpdate.Validate()
+			if tc.expectedError != "" {
+				require.ErrorContains(t, err, tc.expectedError)
+			} else {
+				require.NoError(t, err)
+			}
+		})
+	}
+}
@@ -0,0 +1,103 @@
+package types
+
+import (
+	"encoding/json"
+	"errors"
+	"fmt"
+	"regexp"
+	"strings"
+
+	sdkmath "cosmossdk.io/math"
+)
+
+// Validate performs stateless validation of a transfer rule
+func (r TransferRule) Validate() error {
+	if r.RuleId == "" {
+		return errors.New("rule id must be specified")
+	}
+
+	// At least one of the memo/receiver criteria must be provided, otherwise the rule
+	// would apply to every transfer of the denom/channel (which is what a rate limit is for)
+	if r.MemoKey == "" && r.MemoContains == "" && r.Receiver == "" {
+		return errors.New("at least one of memo key, memo contains, or receiver must be specified")
+	}
+
+	// The denom is length prefixed in the rule index
+	if len(r.Denom) > MaxIndexedFieldLength {
+		return fmt.Errorf("denom can not be longer than %d characters", MaxIndexedFieldLength)
+	}
+
+	if r.ChannelId != "" {
+		matched, err := regexp.MatchString(`^channel-\d+$`, r.ChannelId)
+		if err != nil {
+			return fmt.Errorf("unable to verify channel-id (%s)", r.ChannelId)
+		}
+		if !matched {
+			return fmt.Errorf("invalid channel-id (%s), must be of the format 'channel-{N}'", r.ChannelId)
+		}
+	}
+
+	if _, ok := TransferRuleAction_name[int32(r.Action)]; !ok {
+		return fmt.Errorf("invalid transfer rule action (%d)", r.Action)
+	}
+
+	if r.Action == RULE_ACTION_QUOTA {
+		if r.Quota == nil {
+			return errors.New("quota must be specified when the rule action is quota")
+		}
+		if r.Quota.MaxPercentSend.IsNil() || r.Quota.MaxPercentRecv.IsNil() {
+			return errors.New("max percent send and recv must be specified")
+		}
+		if r.Quota.MaxPercentSend.GT(sdkmath.NewInt(100)) || r.Quota.MaxPercentSend.IsNegative() {
+			return fmt.Errorf("max-percent-send percent must be between 0 and 100 (inclusively), Provided: %v", r.Quota.MaxPercentSend)
+		}
+		if r.Quota.MaxPercentRecv.GT(sdkmath.NewInt(100)) || r.Quota.MaxPercentRecv.IsNegative() {
+			return fmt.Errorf("max-percent-recv percent must be between 0 and 100 (inclusively), Provided: %v", r.Quota.MaxPercentRecv)
+		}
+	} else if r.Quota != nil {
+		return errors.New("quota can only be specified when the rule action is quota")
+	}
+
+	return nil
+}
+
+// The top-level keys of a JSON memo
+// The memo is parsed once per packet, so that each rule with a memo key doesn't re-parse it
+type MemoKeys map[string]struct{}
+
+// Parses the top-level keys from a memo, returning no keys if the memo is not a JSON object
+func ParseMemoKeys(memo string) MemoKeys {
+	var memoObject map[string]json.RawMessage
+	if err := json.Unmarshal([]byte(memo), &memoObject); err != nil {
+		return MemoKeys{}
+	}
+
+	memoKeys := MemoKeys{}
+	for key := range memoObject {
+		memoKeys[key] = struct{}{}
+	}
+	return memoKeys
+}
+
+// Matches returns true if the transfer satisfies each of the rule's non-empty criteria
+// The memo keys must be parsed from the memo with ParseMemoKeys
+func (r TransferRule) Matches(denom, channelId, receiver, memo string, memoKeys MemoKeys) bool {
+	if r.Denom != "" && r.Denom != denom {
+		return false
+	}
+	if r.ChannelId != "" && r.ChannelId != channelId {
+		return false
+	}
+	if r.Receiver != "" && r.Receiver != receiver {
+		return false
+	}
+	if r.MemoContains != "" && !strings.Contains(memo, r.MemoContains) {
+		return false
+	}
+	if r.MemoKey != "" {
+		if _, ok := memoKeys[r.MemoKey]; !ok {
+			return false
+		}
+	}
+	return true
+}
@@ -0,0 +1,214 @@
+package types_test
+
+import (
+	"strings"
+	"testing"
+
+	sdkmath "cosmossdk.io/math"
+	"github.com/stretchr/testify/require"
+
+	"github.com/Stride-Labs/ibc-rate-limiting/ratelimit/types"
+)
+
+func TestValidateTransferRule(t *testing.T) {
+	validQuota := &types.Quota{MaxPercentSend: sdkmath.NewInt(5), MaxPercentRecv: sdkmath.NewInt(5)}
+
+	testCases := []struct {
+		name          string
+		rule          types.TransferRule
+		expectedError string
+	}{
+		{
+			name: "valid deny rule",
+			rule: types.TransferRule{RuleId: "rule", MemoKey: "wasm", Action: types.RULE_ACTION_DENY},
+		},
+		{
+			name: "valid exempt rule",
+			rule: types.TransferRule{RuleId: "rule", Receiver: "contract", ChannelId: "channel-0", Action: types.RULE_ACTION_EXEMPT},
+		},
+		{
+			name: "valid quota rule",
+			rule: types.TransferRule{RuleId: "rule", MemoContains: "swap", Action: types.RULE_ACTION_QUOTA, Quota: validQuota},
+		},
+		{
+			name:          "missing rule id",
+			rule:          types.TransferRule{MemoKey: "wasm"},
+			expectedError: "rule id must be specified",
+		},
+		{
+			name:          "no match criteria",
+			rule:          types.TransferRule{RuleId: "rule", Denom: "denom"},
+			expectedError: "at least one of memo key, memo contains, or receiver must be specified",
+		},
+		{
+			name:          "invalid channel",
+			rule:          types.TransferRule{RuleId: "rule", MemoKey: "wasm", ChannelId: "chan-0"},
+			expectedError: "invalid channel-id",
+		},
+		{
+			name:          "denom too long",
+			rule:          types.TransferRule{RuleId: "rule", MemoKey: "wasm", Denom: strings.Repeat("a", 256)},
+			expectedError: "denom can not be longer than 255 characters",
+		},
+		{
+			name:          "invalid action",
+			rule:          types.TransferRule{RuleId: "rule", MemoKey: "wasm", Action: 99},
+			expectedError: "invalid transfer rule action",
+		},
+		{
+			name:          "quota action without quota",
+			rule:          types.TransferRule{RuleId: "rule", MemoKey: "wasm", Action: types.RULE_ACTION_QUOTA},
+			expectedError: "quota must be specified",
+		},
+		{
+			name: "quota action with invalid percent",
+			rule: types.TransferRule{RuleId: "rule", MemoKey: "wasm", Action: types.RULE_ACTION_QUOTA,
+				Quota: &types.Quota{MaxPercentSend: sdkmath.NewInt(101), MaxPercentRecv: sdkmath.NewInt(5)}},
+			expectedError: "max-percent-send percent must be between 0 and 100",
+		},
+		{
+			name:          "quota provided on non-quota action",
+			rule:          types.TransferRule{RuleId: "rule", MemoKey: "wasm", Action: types.RULE_ACTION_DENY, Quota: validQuota},
+			expectedError: "quota can only be specified when the rule action is quota",
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			err := tc.rule.Validate()
+			if tc.expectedError != "" {
+				require.ErrorContains(t, err, tc.expectedError)
+			} else {
+				require.NoError(t, err)
+			}
+		})
+	}
+}
+
+func TestTransferRuleMatches(t *testing.T) {
+	wasmMemo := `{"wasm": {"contract": "contract", "msg": {}}}`
+
+	testCases := []struct {
+		name     string
+		rule     types.TransferRule
+		denom    string
+		channel  string
+		receiver string
+		memo     string
+		expected bool
+	}{
+		{
+			name:     "memo key match",
+			rule:     types.TransferRule{MemoKey: "wasm"},
+			memo:     wasmMemo,
+			expected: true,
+		},
+		{
+			name:     "memo key mismatch",
+			rule:     types.TransferRule{MemoKey: "forward"},
+			memo:     wasmMemo,
+			expected: false,
+		},
+		{
+			name:     "memo key with non-json memo",
+			rule:     types.TransferRule{MemoKey: "wasm"},
+			memo:     "wasm",
+			expected: false,
+		},
+		{
+			name:     "nested memo key is not matched",
+			rule:     types.TransferRule{MemoKey: "contract"},
+			memo:     wasmMemo,
+			expected: false,
+		},
+		{
+			name:     "memo contains match",
+			rule:     types.TransferRule{MemoContains: "contract"},
+			memo:     wasmMemo,
+			expected: true,
+		},
+		{
+			name:     "memo contains mismatch",
+			rule:     types.TransferRule{MemoContains: "autopilot"},
+			memo:     wasmMemo,
+			expected: false,
+		},
+		{
+			name:     "receiver match",
+			rule:     types.TransferRule{Receiver: "contract"},
+			receiver: "contract",
+			expected: true,
+		},
+		{
+			name:     "receiver mismatch",
+			rule:     types.TransferRule{Receiver: "contract"},
+			receiver: "user",
+			expected: false,
+		},
+		{
+			name:     "all criteria match",
+			rule:     types.TransferRule{MemoKey: "wasm", Receiver: "contract", Denom: "denom", ChannelId: "channel-0"},
+			denom:    "denom",
+			channel:  "channel-0",
+			receiver: "contract",
+			memo:     wasmMemo,
+			expected: true,
+		},
+		{
+			name:     "denom mismatch",
+			rule:     types.TransferRule{MemoKey: "wasm", Denom: "denom"},
+			denom:    "other",
+			memo:     wasmMemo,
+			expected: false,
+		},
+		{
+			name:     "channel mismatch",
+			rule:     types.TransferRule{MemoKey: "wasm", ChannelId: "channel-0"},
+			channel:  "channel-1",
+			memo:     wasmMemo,
+			expected: false,
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			actual := tc.rule.Matches(tc.denom, tc.channel, tc.receiver, tc.memo, types.ParseMemoKeys(tc.memo))
+			require.Equal(t, tc.expected, actual)
+		})
+	}
+}
+
+func TestParseMemoKeys(t *testing.T) {
+	testCases := []struct {
+		name         string
+		memo         string
+		expectedKeys types.MemoKeys
+	}{
+		{
+			name:         "json memo",
+			memo:         `{"wasm": {"contract": "contract"}, "forward": {}}`,
+			expectedKeys: types.MemoKeys{"wasm": {}, "forward": {}},
+		},
+		{
+			name:         "empty memo",
+			memo:         "",
+			expectedKeys: types.MemoKeys{},
+		},
+		{
+			name:         "non-json memo",
+			memo:         "wasm",
+			expectedKeys: types.MemoKeys{},
+		},
+		{
+			name:         "json array memo",
+			memo:         `["wasm"]`,
+			expectedKeys: types.MemoKeys{},
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			require.Equal(t, tc.expectedKeys, types.ParseMemoKeys(tc.memo))
+		})
+	}
+}
@@ -50,6 +50,16 @@ type MsgAddRateLimit struct {
 	// DurationHours specifies the number of hours before the rate limit
 	// is reset (e.g. 24 indicates that the rate limit is reset each day)
 	DurationHours uint64 `protobuf:"varint,6,opt,name=duration_hours,json=durationHours,proto3" json:"duration_hours,omitempty"`
+	// The strategy used to determine the channel value
+	// If not specified, the total supply of the denom is used
+	ChannelValueStrategy *ChannelValueStrategy `protobuf:"bytes,7,opt,name=channel_value_strategy,json=channelValueStrategy,proto3" json:"channel_value_strategy,omitempty"`
+	// An optional threshold denominated in a quote currency (e.g. USD)
+	// If no price is available, the quota's price fallback applies
+	ValueQuota *ValueQuota `protobuf:"bytes,8,opt,name=value_quota,json=valueQuota,proto3" json:"value_quota,omitempty"`
+	// The number of hours that the rate limit's windows are offset by, so that
+	// it resets at a different time than other rate limits with the same
+	// duration. Must be less than the duration
+	WindowOffset uint64 `protobuf:"varint,9,opt,name=window_offset,json=windowOffset,proto3" json:"window_offset,omitempty"`
 }
 
 func (m *MsgAddRateLimit) Reset()         { *m = MsgAddRateLimit{} }
@@ -113,6 +123,27 @@ func (m *MsgAddRateLimit) GetDurationHours() uint64 {
 	return 0
 }
 
+func (m *MsgAddRateLimit) GetChannelValueStrategy() *ChannelValueStrategy {
+	if m != nil {
+		return m.ChannelValueStrategy
+	}
+	return nil
+}
+
+func (m *MsgAddRateLimit) GetValueQuota() *ValueQuota {
+	if m != nil {
+		return m.ValueQuota
+	}
+	return nil
+}
+
+func (m *MsgAddRateLimit) GetWindowOffset() uint64 {
+	if m != nil {
+		return m.WindowOffset
+	}
+	return 0
+}
+
 type MsgAddRateLimitResponse struct {
 }
 
@@ -167,6 +198,19 @@ type MsgUpdateRateLimit struct {
 	// DurationHours specifies the number of hours before the rate limit
 	// is reset (e.g. 24 indicates that the rate limit is reset each day)
 	DurationHours uint64 `protobuf:"varint,6,opt,name=duration_hours,json=durationHours,proto3" json:"duration_hours,omitempty"`
+	// The strategy used to determine the channel value
+	// If not specified, the total supply of the denom is used
+	ChannelValueStrategy *ChannelValueStrategy `protobuf:"bytes,7,opt,name=channel_value_strategy,json=channelValueStrategy,proto3" json:"channel_value_strategy,omitempty"`
+	// An optional threshold denominated in a quote currency (e.g. USD)
+	// If no price is available, the quota's price fallback applies
+	ValueQuota *ValueQuota `protobuf:"bytes,8,opt,name=value_quota,json=valueQuota,proto3" json:"value_quota,omitempty"`
+	// The number of hours that the rate limit's windows are offset by, so that
+	// it resets at a different time than other rate limits with the same
+	// duration. Must be less than the duration
+	WindowOffset uint64 `protobuf:"varint,9,opt,name=window_offset,json=windowOffset,proto3" json:"window_offset,omitempty"`
+	// Determines whether the current flow is reset, preserved, or carried over
+	// proportionally to the new channel value (defaults to a reset)
+	FlowUpdateMode FlowUpdateMode `protobuf:"varint,10,opt,name=flow_update_mode,json=flowUpdateMode,proto3,enum=ratelimit.v1.FlowUpdateMode" json:"flow_update_mode,omitempty"`
 }
 
 func (m *MsgUpdateRateLimit) Reset()         { *m = MsgUpdateRateLimit{} }
@@ -230,6 +274,34 @@ func (m *MsgUpdateRateLimit) GetDurationHours() uint64 {
 	return 0
 }
 
+func (m *MsgUpdateRateLimit) GetChannelValueStrategy() *ChannelValueStrategy {
+	if m != nil {
+		return m.ChannelValueStrategy
+	}
+	return nil
+}
+
+func (m *MsgUpdateRateLimit) GetValueQuota() *ValueQuota {
+	if m != nil {
+		return m.ValueQuota
+	}
+	return nil
+}
+
+func (m *MsgUpdateRateLimit) GetWindowOffset() uint64 {
+	if m != nil {
+		return m.WindowOffset
+	}
+	return 0
+}
+
+func (m *MsgUpdateRateLimit) GetFlowUpdateMode() FlowUpdateMode {
+	if m != nil {
+		return m.FlowUpdateMode
+	}
+	return FLOW_UPDATE_RESET
+}
+
 type MsgUpdateRateLimitResponse struct {
 }
 
@@ -468,726 +540,4095 @@ func (m *MsgResetRateLimitResponse) XXX_DiscardUnknown() {
 
 var xxx_messageInfo_MsgResetRateLimitResponse proto.InternalMessageInfo
 
-func init() {
-	proto.RegisterType((*MsgAddRateLimit)(nil), "ratelimit.v1.MsgAddRateLimit")
-	proto.RegisterType((*MsgAddRateLimitResponse)(nil), "ratelimit.v1.MsgAddRateLimitResponse")
-	proto.RegisterType((*MsgUpdateRateLimit)(nil), "ratelimit.v1.MsgUpdateRateLimit")
-	proto.RegisterType((*MsgUpdateRateLimitResponse)(nil), "ratelimit.v1.MsgUpdateRateLimitResponse")
-	proto.RegisterType((*MsgRemoveRateLimit)(nil), "ratelimit.v1.MsgRemoveRateLimit")
-	proto.RegisterType((*MsgRemoveRateLimitResponse)(nil), "ratelimit.v1.MsgRemoveRateLimitResponse")
-	proto.RegisterType((*MsgResetRateLimit)(nil), "ratelimit.v1.MsgResetRateLimit")
-	proto.RegisterType((*MsgResetRateLimitResponse)(nil), "ratelimit.v1.MsgResetRateLimitResponse")
+// Gov tx to add or replace a transfer rule
+type MsgSetTransferRule struct {
+	// Authority defines the x/gov module account
+	Authority string `protobuf:"bytes,1,opt,name=authority,proto3" json:"authority,omitempty"`
+	// Rule to store - if a rule with the same ID exists, it is replaced
+	Rule TransferRule `protobuf:"bytes,2,opt,name=rule,proto3" json:"rule"`
 }
 
-func init() { proto.RegisterFile("ratelimit/v1/tx.proto", fileDescriptor_415b1435b4efaad0) }
-
-var fileDescriptor_415b1435b4efaad0 = []byte{
-	// 577 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xec, 0x55, 0x4f, 0x6b, 0x13, 0x41,
-	0x1c, 0xcd, 0x36, 0x6d, 0xa1, 0x43, 0x4d, 0xed, 0x12, 0xe9, 0x66, 0xdb, 0x6e, 0x42, 0xa0, 0x1a,
-	0x8a, 0xd9, 0xa5, 0x0a, 0x22, 0xde, 0xda, 0x93, 0x85, 0x06, 0x64, 0xab, 0x20, 0x05, 0x09, 0x93,
-	0x9d, 0x61, 0x32, 0xd8, 0x99, 0x09, 0x3b, 0x93, 0x90, 0x5e, 0xbd, 0x08, 0x82, 0xe0, 0x47, 0x09,
-	0xe2, 0x87, 0xe8, 0xb1, 0x78, 0x12, 0x0f, 0x45, 0x92, 0x43, 0x3f, 0x81, 0x77, 0xd9, 0x3f, 0xf9,
-	0xd3, 0xd9, 0x6a, 0x0e, 0x0a, 0xbd, 0x78, 0x49, 0x32, 0xef, 0xf7, 0xe6, 0xcd, 0xbc, 0x79, 0xf3,
-	0x9b, 0x80, 0x7b, 0x21, 0x54, 0xf8, 0x94, 0x32, 0xaa, 0xbc, 0xde, 0x9e, 0xa7, 0xfa, 0x6e, 0x27,
-	0x14, 0x4a, 0x98, 0xab, 0x13, 0xd8, 0xed, 0xed, 0xd9, 0x45, 0x22, 0x88, 0x88, 0x0b, 0x5e, 0xf4,
-	0x2b, 0xe1, 0xd8, 0xeb, 0x90, 0x51, 0x2e, 0xbc, 0xf8, 0x33, 0x85, 0x4a, 0x81, 0x90, 0x4c, 0xc8,
-	0x66, 0xc2, 0x4d, 0x06, 0x69, 0x69, 0x23, 0x19, 0x79, 0x4c, 0x92, 0x68, 0x25, 0x26, 0x49, 0x52,
-	0xa8, 0xbe, 0xcf, 0x83, 0xb5, 0x86, 0x24, 0xfb, 0x08, 0xf9, 0x50, 0xe1, 0xa3, 0x68, 0x4d, 0xf3,
-	0x09, 0x58, 0x81, 0x5d, 0xd5, 0x16, 0x21, 0x55, 0x67, 0x96, 0x51, 0x31, 0x6a, 0x2b, 0x07, 0xd6,
-	0xd7, 0x2f, 0xf5, 0x62, 0xaa, 0xb8, 0x8f, 0x50, 0x88, 0xa5, 0x3c, 0x56, 0x21, 0xe5, 0xc4, 0x9f,
-	0x52, 0xcd, 0x22, 0x58, 0x42, 0x98, 0x0b, 0x66, 0x2d, 0x44, 0x73, 0xfc, 0x64, 0x60, 0x6e, 0x03,
-	0x10, 0xb4, 0x21, 0xe7, 0xf8, 0xb4, 0x49, 0x91, 0x95, 0x8f, 0x4b, 0x2b, 0x29, 0x72, 0x88, 0xcc,
-	0xd7, 0xe0, 0x2e, 0x83, 0xfd, 0x66, 0x07, 0x87, 0x01, 0xe6, 0xaa, 0x29, 0x31, 0x47, 0xd6, 0x62,
-	0xbc, 0xa6, 0x7b, 0x7e, 0x59, 0xce, 0x7d, 0xbf, 0x2c, 0xdf, 0x27, 0x54, 0xb5, 0xbb, 0x2d, 0x37,
-	0x10, 0x2c, 0x35, 0x95, 0x7e, 0xd5, 0x25, 0x7a, 0xeb, 0xa9, 0xb3, 0x0e, 0x96, 0xee, 0x21, 0x57,
-	0x7e, 0x81, 0xc1, 0xfe, 0x8b, 0x44, 0xe6, 0x18, 0xf3, 0x8c, 0x72, 0x88, 0x83, 0x9e, 0xb5, 0xf4,
-	0xb7, 0xca, 0x3e, 0x0e, 0x7a, 0xe6, 0x0e, 0x28, 0xa0, 0x6e, 0x08, 0x15, 0x15, 0xbc, 0xd9, 0x16,
-	0xdd, 0x50, 0x5a, 0xcb, 0x15, 0xa3, 0xb6, 0xe8, 0xdf, 0x19, 0xa3, 0xcf, 0x23, 0xf0, 0xd9, 0xc3,
-	0x77, 0x57, 0x83, 0xdd, 0xe9, 0xf9, 0x7c, 0xb8, 0x1a, 0xec, 0x96, 0xa6, 0x81, 0x6b, 0xa7, 0x5e,
-	0x2d, 0x81, 0x0d, 0x0d, 0xf2, 0xb1, 0xec, 0x08, 0x2e, 0x71, 0xf5, 0x63, 0x1e, 0x98, 0x0d, 0x49,
-	0x5e, 0x75, 0x10, 0x54, 0xf8, 0x7f, 0x4e, 0xff, 0x3a, 0x27, 0x2f, 0x9b, 0xd3, 0xd6, 0xb5, 0x9c,
-	0xb4, 0x83, 0xaf, 0x6e, 0x01, 0x3b, 0x8b, 0x4e, 0xd2, 0xfa, 0x6c, 0xc4, 0x69, 0xf9, 0x98, 0x89,
-	0xde, 0x2d, 0xa5, 0x35, 0xdf, 0x92, 0xb6, 0xbb, 0xd4, 0x92, 0x86, 0x4e, 0x2c, 0x0d, 0x0c, 0xb0,
-	0x1e, 0x97, 0x25, 0x56, 0xb7, 0xe4, 0xc8, 0xcd, 0x3a, 0xda, 0xd4, 0x1c, 0xcd, 0x6e, 0xae, 0xba,
-	0x09, 0x4a, 0x19, 0x70, 0xec, 0xe7, 0xd1, 0xcf, 0x05, 0x90, 0x6f, 0x48, 0x62, 0xbe, 0x04, 0xab,
-	0xd7, 0x5e, 0xbe, 0x6d, 0x77, 0xf6, 0xe5, 0x75, 0xb5, 0x7e, 0xb4, 0x77, 0xfe, 0x58, 0x1e, 0xab,
-	0x9b, 0x6f, 0xc0, 0x9a, 0xde, 0xaa, 0x95, 0xcc, 0x4c, 0x8d, 0x61, 0xd7, 0xe6, 0x31, 0x66, 0xe5,
-	0xf5, 0xbb, 0x95, 0x95, 0xd7, 0x18, 0x37, 0xc8, 0xff, 0x26, 0x6b, 0xf3, 0x04, 0x14, 0xb4, 0x9c,
-	0xcb, 0x37, 0xcc, 0x9d, 0x25, 0xd8, 0x0f, 0xe6, 0x10, 0xc6, 0xda, 0x07, 0xfe, 0xf9, 0xd0, 0x31,
-	0x2e, 0x86, 0x8e, 0xf1, 0x63, 0xe8, 0x18, 0x9f, 0x46, 0x4e, 0xee, 0x62, 0xe4, 0xe4, 0xbe, 0x8d,
-	0x9c, 0xdc, 0xc9, 0xd3, 0x99, 0x16, 0x8f, 0x6e, 0x0c, 0xc2, 0xf5, 0x23, 0xd8, 0x92, 0x1e, 0x6d,
-	0x05, 0xf5, 0x48, 0xbc, 0x1e, 0xab, 0x53, 0x4e, 0xbc, 0x69, 0xe8, 0x71, 0xe3, 0xb7, 0x96, 0xe3,
-	0x3f, 0xb2, 0xc7, 0xbf, 0x02, 0x00, 0x00, 0xff, 0xff, 0xd7, 0x37, 0xb8, 0x9b, 0x4c, 0x07, 0x00,
-	0x00,
+func (m *MsgSetTransferRule) Reset()         { *m = MsgSetTransferRule{} }
+func (m *MsgSetTransferRule) String() string { return proto.CompactTextString(m) }
+func (*MsgSetTransferRule) ProtoMessage()    {}
+func (*MsgSetTransferRule) Descriptor() ([]byte, []int) {
+	return fileDescriptor_415b1435b4efaad0, []int{8}
+}
+func (m *MsgSetTransferRule) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgSetTransferRule) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgSetTransferRule.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *MsgSetTransferRule) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgSetTransferRule.Merge(m, src)
+}
+func (m *MsgSetTransferRule) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgSetTransferRule) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgSetTransferRule.DiscardUnknown(m)
 }
 
-// Reference imports to suppress errors if they are not otherwise used.
-var _ context.Context
-var _ grpc.ClientConn
-
-// This is a compile-time assertion to ensure that this generated file
-// is compatible with the grpc package it is being compiled against.
-const _ = grpc.SupportPackageIsVersion4
+var xxx_messageInfo_MsgSetTransferRule proto.InternalMessageInfo
 
-// MsgClient is the client API for Msg service.
-//
-// For semantics around ctx use and closing/ending streaming RPCs, please refer to https://godoc.org/google.golang.org/grpc#ClientConn.NewStream.
-type MsgClient interface {
-	// Gov tx to add a new rate limit
-	AddRateLimit(ctx context.Context, in *MsgAddRateLimit, opts ...grpc.CallOption) (*MsgAddRateLimitResponse, error)
-	// Gov tx to update an existing rate limit
-	UpdateRateLimit(ctx context.Context, in *MsgUpdateRateLimit, opts ...grpc.CallOption) (*MsgUpdateRateLimitResponse, error)
-	// Gov tx to remove a rate limit
-	RemoveRateLimit(ctx context.Context, in *MsgRemoveRateLimit, opts ...grpc.CallOption) (*MsgRemoveRateLimitResponse, error)
-	// Gov tx to reset the flow on a rate limit
-	ResetRateLimit(ctx context.Context, in *MsgResetRateLimit, opts ...grpc.CallOption) (*MsgResetRateLimitResponse, error)
+func (m *MsgSetTransferRule) GetAuthority() string {
+	if m != nil {
+		return m.Authority
+	}
+	return ""
 }
 
-type msgClient struct {
-	cc grpc1.ClientConn
+func (m *MsgSetTransferRule) GetRule() TransferRule {
+	if m != nil {
+		return m.Rule
+	}
+	return TransferRule{}
 }
 
-func NewMsgClient(cc grpc1.ClientConn) MsgClient {
-	return &msgClient{cc}
+type MsgSetTransferRuleResponse struct {
 }
 
-func (c *msgClient) AddRateLimit(ctx context.Context, in *MsgAddRateLimit, opts ...grpc.CallOption) (*MsgAddRateLimitResponse, error) {
-	out := new(MsgAddRateLimitResponse)
-	err := c.cc.Invoke(ctx, "/ratelimit.v1.Msg/AddRateLimit", in, out, opts...)
-	if err != nil {
-		return nil, err
+func (m *MsgSetTransferRuleResponse) Reset()         { *m = MsgSetTransferRuleResponse{} }
+func (m *MsgSetTransferRuleResponse) String() string { return proto.CompactTextString(m) }
+func (*MsgSetTransferRuleResponse) ProtoMessage()    {}
+func (*MsgSetTransferRuleResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_415b1435b4efaad0, []int{9}
+}
+func (m *MsgSetTransferRuleResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgSetTransferRuleResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgSetTransferRuleResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
 	}
-	return out, nil
+}
+func (m *MsgSetTransferRuleResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgSetTransferRuleResponse.Merge(m, src)
+}
+func (m *MsgSetTransferRuleResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgSetTransferRuleResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgSetTransferRuleResponse.DiscardUnknown(m)
 }
 
-func (c *msgClient) UpdateRateLimit(ctx context.Context, in *MsgUpdateRateLimit, opts ...grpc.CallOption) (*MsgUpdateRateLimitResponse, error) {
-	out := new(MsgUpdateRateLimitResponse)
-	err := c.cc.Invoke(ctx, "/ratelimit.v1.Msg/UpdateRateLimit", in, out, opts...)
-	if err != nil {
-		return nil, err
-	}
-	return out, nil
+var xxx_messageInfo_MsgSetTransferRuleResponse proto.InternalMessageInfo
+
+// Gov tx to remove a transfer rule
+type MsgRemoveTransferRule struct {
+	// Authority defines the x/gov module account
+	Authority string `protobuf:"bytes,1,opt,name=authority,proto3" json:"authority,omitempty"`
+	// ID of the rule to remove
+	RuleId string `protobuf:"bytes,2,opt,name=rule_id,json=ruleId,proto3" json:"rule_id,omitempty"`
 }
 
-func (c *msgClient) RemoveRateLimit(ctx context.Context, in *MsgRemoveRateLimit, opts ...grpc.CallOption) (*MsgRemoveRateLimitResponse, error) {
-	out := new(MsgRemoveRateLimitResponse)
-	err := c.cc.Invoke(ctx, "/ratelimit.v1.Msg/RemoveRateLimit", in, out, opts...)
-	if err != nil {
-		return nil, err
+func (m *MsgRemoveTransferRule) Reset()         { *m = MsgRemoveTransferRule{} }
+func (m *MsgRemoveTransferRule) String() string { return proto.CompactTextString(m) }
+func (*MsgRemoveTransferRule) ProtoMessage()    {}
+func (*MsgRemoveTransferRule) Descriptor() ([]byte, []int) {
+	return fileDescriptor_415b1435b4efaad0, []int{10}
+}
+func (m *MsgRemoveTransferRule) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgRemoveTransferRule) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgRemoveTransferRule.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
 	}
-	return out, nil
+}
+func (m *MsgRemoveTransferRule) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgRemoveTransferRule.Merge(m, src)
+}
+func (m *MsgRemoveTransferRule) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgRemoveTransferRule) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgRemoveTransferRule.DiscardUnknown(m)
 }
 
-func (c *msgClient) ResetRateLimit(ctx context.Context, in *MsgResetRateLimit, opts ...grpc.CallOption) (*MsgResetRateLimitResponse, error) {
-	out := new(MsgResetRateLimitResponse)
-	err := c.cc.Invoke(ctx, "/ratelimit.v1.Msg/ResetRateLimit", in, out, opts...)
-	if err != nil {
-		return nil, err
+var xxx_messageInfo_MsgRemoveTransferRule proto.InternalMessageInfo
+
+func (m *MsgRemoveTransferRule) GetAuthority() string {
+	if m != nil {
+		return m.Authority
 	}
-	return out, nil
+	return ""
 }
 
-// MsgServer is the server API for Msg service.
-type MsgServer interface {
-	// Gov tx to add a new rate limit
-	AddRateLimit(context.Context, *MsgAddRateLimit) (*MsgAddRateLimitResponse, error)
-	// Gov tx to update an existing rate limit
-	UpdateRateLimit(context.Context, *MsgUpdateRateLimit) (*MsgUpdateRateLimitResponse, error)
-	// Gov tx to remove a rate limit
-	RemoveRateLimit(context.Context, *MsgRemoveRateLimit) (*MsgRemoveRateLimitResponse, error)
-	// Gov tx to reset the flow on a rate limit
-	ResetRateLimit(context.Context, *MsgResetRateLimit) (*MsgResetRateLimitResponse, error)
+func (m *MsgRemoveTransferRule) GetRuleId() string {
+	if m != nil {
+		return m.RuleId
+	}
+	return ""
 }
 
-// UnimplementedMsgServer can be embedded to have forward compatible implementations.
-type UnimplementedMsgServer struct {
+type MsgRemoveTransferRuleResponse struct {
 }
 
-func (*UnimplementedMsgServer) AddRateLimit(ctx context.Context, req *MsgAddRateLimit) (*MsgAddRateLimitResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method AddRateLimit not implemented")
+func (m *MsgRemoveTransferRuleResponse) Reset()         { *m = MsgRemoveTransferRuleResponse{} }
+func (m *MsgRemoveTransferRuleResponse) String() string { return proto.CompactTextString(m) }
+func (*MsgRemoveTransferRuleResponse) ProtoMessage()    {}
+func (*MsgRemoveTransferRuleResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_415b1435b4efaad0, []int{11}
 }
-func (*UnimplementedMsgServer) UpdateRateLimit(ctx context.Context, req *MsgUpdateRateLimit) (*MsgUpdateRateLimitResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method UpdateRateLimit not implemented")
+func (m *MsgRemoveTransferRuleResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
 }
-func (*UnimplementedMsgServer) RemoveRateLimit(ctx context.Context, req *MsgRemoveRateLimit) (*MsgRemoveRateLimitResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method RemoveRateLimit not implemented")
+func (m *MsgRemoveTransferRuleResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgRemoveTransferRuleResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
 }
-func (*UnimplementedMsgServer) ResetRateLimit(ctx context.Context, req *MsgResetRateLimit) (*MsgResetRateLimitResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method ResetRateLimit not implemented")
+func (m *MsgRemoveTransferRuleResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgRemoveTransferRuleResponse.Merge(m, src)
+}
+func (m *MsgRemoveTransferRuleResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgRemoveTransferRuleResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgRemoveTransferRuleResponse.DiscardUnknown(m)
 }
 
-func RegisterMsgServer(s grpc1.Server, srv MsgServer) {
-	s.RegisterService(&_Msg_serviceDesc, srv)
+var xxx_messageInfo_MsgRemoveTransferRuleResponse proto.InternalMessageInfo
+
+// Gov tx to add or replace a denom group
+type MsgSetDenomGroup struct {
+	// Authority defines the x/gov module account
+	Authority string `protobuf:"bytes,1,opt,name=authority,proto3" json:"authority,omitempty"`
+	// Group to store - if a group with the same ID exists, it is replaced
+	Group DenomGroup `protobuf:"bytes,2,opt,name=group,proto3" json:"group"`
 }
 
-func _Msg_AddRateLimit_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(MsgAddRateLimit)
-	if err := dec(in); err != nil {
-		return nil, err
-	}
-	if interceptor == nil {
-		return srv.(MsgServer).AddRateLimit(ctx, in)
-	}
-	info := &grpc.UnaryServerInfo{
-		Server:     srv,
-		FullMethod: "/ratelimit.v1.Msg/AddRateLimit",
-	}
-	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(MsgServer).AddRateLimit(ctx, req.(*MsgAddRateLimit))
+func (m *MsgSetDenomGroup) Reset()         { *m = MsgSetDenomGroup{} }
+func (m *MsgSetDenomGroup) String() string { return proto.CompactTextString(m) }
+func (*MsgSetDenomGroup) ProtoMessage()    {}
+func (*MsgSetDenomGroup) Descriptor() ([]byte, []int) {
+	return fileDescriptor_415b1435b4efaad0, []int{12}
+}
+func (m *MsgSetDenomGroup) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgSetDenomGroup) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgSetDenomGroup.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
 	}
-	return interceptor(ctx, in, info, handler)
+}
+func (m *MsgSetDenomGroup) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgSetDenomGroup.Merge(m, src)
+}
+func (m *MsgSetDenomGroup) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgSetDenomGroup) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgSetDenomGroup.DiscardUnknown(m)
 }
 
-func _Msg_UpdateRateLimit_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(MsgUpdateRateLimit)
-	if err := dec(in); err != nil {
-		return nil, err
-	}
-	if interceptor == nil {
-		return srv.(MsgServer).UpdateRateLimit(ctx, in)
-	}
-	info := &grpc.UnaryServerInfo{
-		Server:     srv,
-		FullMethod: "/ratelimit.v1.Msg/UpdateRateLimit",
-	}
-	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(MsgServer).UpdateRateLimit(ctx, req.(*MsgUpdateRateLimit))
+var xxx_messageInfo_MsgSetDenomGroup proto.InternalMessageInfo
+
+func (m *MsgSetDenomGroup) GetAuthority() string {
+	if m != nil {
+		return m.Authority
 	}
-	return interceptor(ctx, in, info, handler)
+	return ""
 }
 
-func _Msg_RemoveRateLimit_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(MsgRemoveRateLimit)
-	if err := dec(in); err != nil {
-		return nil, err
-	}
-	if interceptor == nil {
-		return srv.(MsgServer).RemoveRateLimit(ctx, in)
+func (m *MsgSetDenomGroup) GetGroup() DenomGroup {
+	if m != nil {
+		return m.Group
 	}
-	info := &grpc.UnaryServerInfo{
-		Server:     srv,
-		FullMethod: "/ratelimit.v1.Msg/RemoveRateLimit",
-	}
-	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(MsgServer).RemoveRateLimit(ctx, req.(*MsgRemoveRateLimit))
-	}
-	return interceptor(ctx, in, info, handler)
+	return DenomGroup{}
 }
 
-func _Msg_ResetRateLimit_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(MsgResetRateLimit)
-	if err := dec(in); err != nil {
-		return nil, err
-	}
-	if interceptor == nil {
-		return srv.(MsgServer).ResetRateLimit(ctx, in)
-	}
-	info := &grpc.UnaryServerInfo{
-		Server:     srv,
-		FullMethod: "/ratelimit.v1.Msg/ResetRateLimit",
-	}
-	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(MsgServer).ResetRateLimit(ctx, req.(*MsgResetRateLimit))
+type MsgSetDenomGroupResponse struct {
+}
+
+func (m *MsgSetDenomGroupResponse) Reset()         { *m = MsgSetDenomGroupResponse{} }
+func (m *MsgSetDenomGroupResponse) String() string { return proto.CompactTextString(m) }
+func (*MsgSetDenomGroupResponse) ProtoMessage()    {}
+func (*MsgSetDenomGroupResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_415b1435b4efaad0, []int{13}
+}
+func (m *MsgSetDenomGroupResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgSetDenomGroupResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgSetDenomGroupResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
 	}
-	return interceptor(ctx, in, info, handler)
+}
+func (m *MsgSetDenomGroupResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgSetDenomGroupResponse.Merge(m, src)
+}
+func (m *MsgSetDenomGroupResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgSetDenomGroupResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgSetDenomGroupResponse.DiscardUnknown(m)
 }
 
-var _Msg_serviceDesc = grpc.ServiceDesc{
-	ServiceName: "ratelimit.v1.Msg",
-	HandlerType: (*MsgServer)(nil),
-	Methods: []grpc.MethodDesc{
-		{
-			MethodName: "AddRateLimit",
-			Handler:    _Msg_AddRateLimit_Handler,
-		},
-		{
-			MethodName: "UpdateRateLimit",
-			Handler:    _Msg_UpdateRateLimit_Handler,
-		},
-		{
-			MethodName: "RemoveRateLimit",
-			Handler:    _Msg_RemoveRateLimit_Handler,
-		},
-		{
-			MethodName: "ResetRateLimit",
-			Handler:    _Msg_ResetRateLimit_Handler,
-		},
-	},
-	Streams:  []grpc.StreamDesc{},
-	Metadata: "ratelimit/v1/tx.proto",
+var xxx_messageInfo_MsgSetDenomGroupResponse proto.InternalMessageInfo
+
+// Gov tx to remove a denom group
+type MsgRemoveDenomGroup struct {
+	// Authority defines the x/gov module account
+	Authority string `protobuf:"bytes,1,opt,name=authority,proto3" json:"authority,omitempty"`
+	// ID of the group to remove
+	GroupId string `protobuf:"bytes,2,opt,name=group_id,json=groupId,proto3" json:"group_id,omitempty"`
 }
 
-func (m *MsgAddRateLimit) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
+func (m *MsgRemoveDenomGroup) Reset()         { *m = MsgRemoveDenomGroup{} }
+func (m *MsgRemoveDenomGroup) String() string { return proto.CompactTextString(m) }
+func (*MsgRemoveDenomGroup) ProtoMessage()    {}
+func (*MsgRemoveDenomGroup) Descriptor() ([]byte, []int) {
+	return fileDescriptor_415b1435b4efaad0, []int{14}
+}
+func (m *MsgRemoveDenomGroup) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgRemoveDenomGroup) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgRemoveDenomGroup.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
 	}
-	return dAtA[:n], nil
+}
+func (m *MsgRemoveDenomGroup) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgRemoveDenomGroup.Merge(m, src)
+}
+func (m *MsgRemoveDenomGroup) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgRemoveDenomGroup) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgRemoveDenomGroup.DiscardUnknown(m)
 }
 
-func (m *MsgAddRateLimit) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+var xxx_messageInfo_MsgRemoveDenomGroup proto.InternalMessageInfo
+
+func (m *MsgRemoveDenomGroup) GetAuthority() string {
+	if m != nil {
+		return m.Authority
+	}
+	return ""
 }
 
-func (m *MsgAddRateLimit) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if m.DurationHours != 0 {
-		i = encodeVarintTx(dAtA, i, uint64(m.DurationHours))
-		i--
-		dAtA[i] = 0x30
+func (m *MsgRemoveDenomGroup) GetGroupId() string {
+	if m != nil {
+		return m.GroupId
 	}
-	{
-		size := m.MaxPercentRecv.Size()
-		i -= size
-		if _, err := m.MaxPercentRecv.MarshalTo(dAtA[i:]); err != nil {
-			return 0, err
+	return ""
+}
+
+type MsgRemoveDenomGroupResponse struct {
+}
+
+func (m *MsgRemoveDenomGroupResponse) Reset()         { *m = MsgRemoveDenomGroupResponse{} }
+func (m *MsgRemoveDenomGroupResponse) String() string { return proto.CompactTextString(m) }
+func (*MsgRemoveDenomGroupResponse) ProtoMessage()    {}
+func (*MsgRemoveDenomGroupResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_415b1435b4efaad0, []int{15}
+}
+func (m *MsgRemoveDenomGroupResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgRemoveDenomGroupResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgRemoveDenomGroupResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
 		}
-		i = encodeVarintTx(dAtA, i, uint64(size))
+		return b[:n], nil
 	}
-	i--
-	dAtA[i] = 0x2a
-	{
-		size := m.MaxPercentSend.Size()
-		i -= size
-		if _, err := m.MaxPercentSend.MarshalTo(dAtA[i:]); err != nil {
-			return 0, err
+}
+func (m *MsgRemoveDenomGroupResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgRemoveDenomGroupResponse.Merge(m, src)
+}
+func (m *MsgRemoveDenomGroupResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgRemoveDenomGroupResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgRemoveDenomGroupResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_MsgRemoveDenomGroupResponse proto.InternalMessageInfo
+
+// Gov tx to schedule an update to a rate limit at a future epoch or height
+// Exactly one of the execution epoch or height must be specified
+type MsgScheduleRateLimitUpdate struct {
+	// Authority defines the x/gov module account
+	Authority string `protobuf:"bytes,1,opt,name=authority,proto3" json:"authority,omitempty"`
+	// The hour epoch at which the update should be applied
+	ExecuteEpoch uint64 `protobuf:"varint,2,opt,name=execute_epoch,json=executeEpoch,proto3" json:"execute_epoch,omitempty"`
+	// The block height at which the update should be applied
+	ExecuteHeight int64 `protobuf:"varint,3,opt,name=execute_height,json=executeHeight,proto3" json:"execute_height,omitempty"`
+	// The update to apply (the authority must match the authority above)
+	Update MsgUpdateRateLimit `protobuf:"bytes,4,opt,name=update,proto3" json:"update"`
+}
+
+func (m *MsgScheduleRateLimitUpdate) Reset()         { *m = MsgScheduleRateLimitUpdate{} }
+func (m *MsgScheduleRateLimitUpdate) String() string { return proto.CompactTextString(m) }
+func (*MsgScheduleRateLimitUpdate) ProtoMessage()    {}
+func (*MsgScheduleRateLimitUpdate) Descriptor() ([]byte, []int) {
+	return fileDescriptor_415b1435b4efaad0, []int{16}
+}
+func (m *MsgScheduleRateLimitUpdate) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgScheduleRateLimitUpdate) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgScheduleRateLimitUpdate.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
 		}
-		i = encodeVarintTx(dAtA, i, uint64(size))
+		return b[:n], nil
 	}
-	i--
-	dAtA[i] = 0x22
-	if len(m.ChannelId) > 0 {
-		i -= len(m.ChannelId)
-		copy(dAtA[i:], m.ChannelId)
-		i = encodeVarintTx(dAtA, i, uint64(len(m.ChannelId)))
-		i--
-		dAtA[i] = 0x1a
+}
+func (m *MsgScheduleRateLimitUpdate) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgScheduleRateLimitUpdate.Merge(m, src)
+}
+func (m *MsgScheduleRateLimitUpdate) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgScheduleRateLimitUpdate) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgScheduleRateLimitUpdate.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_MsgScheduleRateLimitUpdate proto.InternalMessageInfo
+
+func (m *MsgScheduleRateLimitUpdate) GetAuthority() string {
+	if m != nil {
+		return m.Authority
 	}
-	if len(m.Denom) > 0 {
-		i -= len(m.Denom)
-		copy(dAtA[i:], m.Denom)
-		i = encodeVarintTx(dAtA, i, uint64(len(m.Denom)))
-		i--
-		dAtA[i] = 0x12
+	return ""
+}
+
+func (m *MsgScheduleRateLimitUpdate) GetExecuteEpoch() uint64 {
+	if m != nil {
+		return m.ExecuteEpoch
 	}
-	if len(m.Authority) > 0 {
-		i -= len(m.Authority)
-		copy(dAtA[i:], m.Authority)
-		i = encodeVarintTx(dAtA, i, uint64(len(m.Authority)))
-		i--
-		dAtA[i] = 0xa
+	return 0
+}
+
+func (m *MsgScheduleRateLimitUpdate) GetExecuteHeight() int64 {
+	if m != nil {
+		return m.ExecuteHeight
 	}
-	return len(dAtA) - i, nil
+	return 0
 }
 
-func (m *MsgAddRateLimitResponse) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
+func (m *MsgScheduleRateLimitUpdate) GetUpdate() MsgUpdateRateLimit {
+	if m != nil {
+		return m.Update
 	}
-	return dAtA[:n], nil
+	return MsgUpdateRateLimit{}
 }
 
-func (m *MsgAddRateLimitResponse) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+type MsgScheduleRateLimitUpdateResponse struct {
+	// ID of the scheduled update, used to cancel it
+	ScheduleId uint64 `protobuf:"varint,1,opt,name=schedule_id,json=scheduleId,proto3" json:"schedule_id,omitempty"`
 }
 
-func (m *MsgAddRateLimitResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	return len(dAtA) - i, nil
+func (m *MsgScheduleRateLimitUpdateResponse) Reset()         { *m = MsgScheduleRateLimitUpdateResponse{} }
+func (m *MsgScheduleRateLimitUpdateResponse) String() string { return proto.CompactTextString(m) }
+func (*MsgScheduleRateLimitUpdateResponse) ProtoMessage()    {}
+func (*MsgScheduleRateLimitUpdateResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_415b1435b4efaad0, []int{17}
+}
+func (m *MsgScheduleRateLimitUpdateResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgScheduleRateLimitUpdateResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgScheduleRateLimitUpdateResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *MsgScheduleRateLimitUpdateResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgScheduleRateLimitUpdateResponse.Merge(m, src)
+}
+func (m *MsgScheduleRateLimitUpdateResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgScheduleRateLimitUpdateResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgScheduleRateLimitUpdateResponse.DiscardUnknown(m)
 }
 
-func (m *MsgUpdateRateLimit) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
+var xxx_messageInfo_MsgScheduleRateLimitUpdateResponse proto.InternalMessageInfo
+
+func (m *MsgScheduleRateLimitUpdateResponse) GetScheduleId() uint64 {
+	if m != nil {
+		return m.ScheduleId
 	}
-	return dAtA[:n], nil
+	return 0
 }
 
-func (m *MsgUpdateRateLimit) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+// Gov tx to cancel a scheduled rate limit update
+type MsgCancelScheduledRateLimitUpdate struct {
+	// Authority defines the x/gov module account
+	Authority string `protobuf:"bytes,1,opt,name=authority,proto3" json:"authority,omitempty"`
+	// ID of the scheduled update to cancel
+	ScheduleId uint64 `protobuf:"varint,2,opt,name=schedule_id,json=scheduleId,proto3" json:"schedule_id,omitempty"`
 }
 
-func (m *MsgUpdateRateLimit) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if m.DurationHours != 0 {
-		i = encodeVarintTx(dAtA, i, uint64(m.DurationHours))
-		i--
-		dAtA[i] = 0x30
-	}
-	{
-		size := m.MaxPercentRecv.Size()
-		i -= size
-		if _, err := m.MaxPercentRecv.MarshalTo(dAtA[i:]); err != nil {
-			return 0, err
-		}
-		i = encodeVarintTx(dAtA, i, uint64(size))
-	}
-	i--
-	dAtA[i] = 0x2a
-	{
-		size := m.MaxPercentSend.Size()
-		i -= size
-		if _, err := m.MaxPercentSend.MarshalTo(dAtA[i:]); err != nil {
-			return 0, err
+func (m *MsgCancelScheduledRateLimitUpdate) Reset()         { *m = MsgCancelScheduledRateLimitUpdate{} }
+func (m *MsgCancelScheduledRateLimitUpdate) String() string { return proto.CompactTextString(m) }
+func (*MsgCancelScheduledRateLimitUpdate) ProtoMessage()    {}
+func (*MsgCancelScheduledRateLimitUpdate) Descriptor() ([]byte, []int) {
+	return fileDescriptor_415b1435b4efaad0, []int{18}
+}
+func (m *MsgCancelScheduledRateLimitUpdate) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgCancelScheduledRateLimitUpdate) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgCancelScheduledRateLimitUpdate.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
 		}
-		i = encodeVarintTx(dAtA, i, uint64(size))
-	}
-	i--
-	dAtA[i] = 0x22
-	if len(m.ChannelId) > 0 {
-		i -= len(m.ChannelId)
-		copy(dAtA[i:], m.ChannelId)
-		i = encodeVarintTx(dAtA, i, uint64(len(m.ChannelId)))
-		i--
-		dAtA[i] = 0x1a
-	}
-	if len(m.Denom) > 0 {
-		i -= len(m.Denom)
-		copy(dAtA[i:], m.Denom)
-		i = encodeVarintTx(dAtA, i, uint64(len(m.Denom)))
-		i--
-		dAtA[i] = 0x12
-	}
-	if len(m.Authority) > 0 {
-		i -= len(m.Authority)
-		copy(dAtA[i:], m.Authority)
-		i = encodeVarintTx(dAtA, i, uint64(len(m.Authority)))
-		i--
-		dAtA[i] = 0xa
+		return b[:n], nil
 	}
-	return len(dAtA) - i, nil
+}
+func (m *MsgCancelScheduledRateLimitUpdate) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgCancelScheduledRateLimitUpdate.Merge(m, src)
+}
+func (m *MsgCancelScheduledRateLimitUpdate) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgCancelScheduledRateLimitUpdate) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgCancelScheduledRateLimitUpdate.DiscardUnknown(m)
 }
 
-func (m *MsgUpdateRateLimitResponse) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
+var xxx_messageInfo_MsgCancelScheduledRateLimitUpdate proto.InternalMessageInfo
+
+func (m *MsgCancelScheduledRateLimitUpdate) GetAuthority() string {
+	if m != nil {
+		return m.Authority
 	}
-	return dAtA[:n], nil
+	return ""
 }
 
-func (m *MsgUpdateRateLimitResponse) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+func (m *MsgCancelScheduledRateLimitUpdate) GetScheduleId() uint64 {
+	if m != nil {
+		return m.ScheduleId
+	}
+	return 0
 }
 
-func (m *MsgUpdateRateLimitResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	return len(dAtA) - i, nil
+type MsgCancelScheduledRateLimitUpdateResponse struct {
 }
 
-func (m *MsgRemoveRateLimit) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
+func (m *MsgCancelScheduledRateLimitUpdateResponse) Reset() {
+	*m = MsgCancelScheduledRateLimitUpdateResponse{}
+}
+func (m *MsgCancelScheduledRateLimitUpdateResponse) String() string {
+	return proto.CompactTextString(m)
+}
+func (*MsgCancelScheduledRateLimitUpdateResponse) ProtoMessage() {}
+func (*MsgCancelScheduledRateLimitUpdateResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_415b1435b4efaad0, []int{19}
+}
+func (m *MsgCancelScheduledRateLimitUpdateResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgCancelScheduledRateLimitUpdateResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgCancelScheduledRateLimitUpdateResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
 	}
-	return dAtA[:n], nil
+}
+func (m *MsgCancelScheduledRateLimitUpdateResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgCancelScheduledRateLimitUpdateResponse.Merge(m, src)
+}
+func (m *MsgCancelScheduledRateLimitUpdateResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgCancelScheduledRateLimitUpdateResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgCancelScheduledRateLimitUpdateResponse.DiscardUnknown(m)
 }
 
-func (m *MsgRemoveRateLimit) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+var xxx_messageInfo_MsgCancelScheduledRateLimitUpdateResponse proto.InternalMessageInfo
+
+// A rate limit in a batch, which is added if it does not exist, or updated
+// if it does
+type RateLimitUpsert struct {
+	// Denom for the rate limit, as it appears on the rate limited chain
+	Denom string `protobuf:"bytes,1,opt,name=denom,proto3" json:"denom,omitempty"`
+	// ChannelId for the rate limit, on the side of the rate limited chain
+	ChannelId string `protobuf:"bytes,2,opt,name=channel_id,json=channelId,proto3" json:"channel_id,omitempty"`
+	// MaxPercentSend defines the threshold for outflows
+	// The threshold is defined as a percentage (e.g. 10 indicates 10%)
+	MaxPercentSend github_com_cosmos_cosmos_sdk_types.Int `protobuf:"bytes,3,opt,name=max_percent_send,json=maxPercentSend,proto3,customtype=github.com/cosmos/cosmos-sdk/types.Int" json:"max_percent_send"`
+	// MaxPercentSend defines the threshold for inflows
+	// The threshold is defined as a percentage (e.g. 10 indicates 10%)
+	MaxPercentRecv github_com_cosmos_cosmos_sdk_types.Int `protobuf:"bytes,4,opt,name=max_percent_recv,json=maxPercentRecv,proto3,customtype=github.com/cosmos/cosmos-sdk/types.Int" json:"max_percent_recv"`
+	// DurationHours specifies the number of hours before the rate limit
+	// is reset (e.g. 24 indicates that the rate limit is reset each day)
+	DurationHours uint64 `protobuf:"varint,5,opt,name=duration_hours,json=durationHours,proto3" json:"duration_hours,omitempty"`
+	// The strategy used to determine the channel value
+	// If not specified, the total supply of the denom is used
+	ChannelValueStrategy *ChannelValueStrategy `protobuf:"bytes,6,opt,name=channel_value_strategy,json=channelValueStrategy,proto3" json:"channel_value_strategy,omitempty"`
+	// An optional threshold denominated in a quote currency (e.g. USD)
+	ValueQuota *ValueQuota `protobuf:"bytes,7,opt,name=value_quota,json=valueQuota,proto3" json:"value_quota,omitempty"`
+	// The number of hours that the rate limit's windows are offset by
+	// Must be less than the duration
+	WindowOffset uint64 `protobuf:"varint,8,opt,name=window_offset,json=windowOffset,proto3" json:"window_offset,omitempty"`
+	// Determines what happens to the current flow if the rate limit already
+	// exists and is updated (defaults to a reset)
+	FlowUpdateMode FlowUpdateMode `protobuf:"varint,9,opt,name=flow_update_mode,json=flowUpdateMode,proto3,enum=ratelimit.v1.FlowUpdateMode" json:"flow_update_mode,omitempty"`
 }
 
-func (m *MsgRemoveRateLimit) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if len(m.ChannelId) > 0 {
-		i -= len(m.ChannelId)
-		copy(dAtA[i:], m.ChannelId)
-		i = encodeVarintTx(dAtA, i, uint64(len(m.ChannelId)))
-		i--
-		dAtA[i] = 0x1a
-	}
-	if len(m.Denom) > 0 {
-		i -= len(m.Denom)
-		copy(dAtA[i:], m.Denom)
-		i = encodeVarintTx(dAtA, i, uint64(len(m.Denom)))
-		i--
-		dAtA[i] = 0x12
-	}
-	if len(m.Authority) > 0 {
-		i -= len(m.Authority)
-		copy(dAtA[i:], m.Authority)
-		i = encodeVarintTx(dAtA, i, uint64(len(m.Authority)))
-		i--
-		dAtA[i] = 0xa
+func (m *RateLimitUpsert) Reset()         { *m = RateLimitUpsert{} }
+func (m *RateLimitUpsert) String() string { return proto.CompactTextString(m) }
+func (*RateLimitUpsert) ProtoMessage()    {}
+func (*RateLimitUpsert) Descriptor() ([]byte, []int) {
+	return fileDescriptor_415b1435b4efaad0, []int{20}
+}
+func (m *RateLimitUpsert) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *RateLimitUpsert) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_RateLimitUpsert.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
 	}
-	return len(dAtA) - i, nil
+}
+func (m *RateLimitUpsert) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_RateLimitUpsert.Merge(m, src)
+}
+func (m *RateLimitUpsert) XXX_Size() int {
+	return m.Size()
+}
+func (m *RateLimitUpsert) XXX_DiscardUnknown() {
+	xxx_messageInfo_RateLimitUpsert.DiscardUnknown(m)
 }
 
-func (m *MsgRemoveRateLimitResponse) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
+var xxx_messageInfo_RateLimitUpsert proto.InternalMessageInfo
+
+func (m *RateLimitUpsert) GetDenom() string {
+	if m != nil {
+		return m.Denom
 	}
-	return dAtA[:n], nil
+	return ""
 }
 
-func (m *MsgRemoveRateLimitResponse) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+func (m *RateLimitUpsert) GetChannelId() string {
+	if m != nil {
+		return m.ChannelId
+	}
+	return ""
 }
 
-func (m *MsgRemoveRateLimitResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	return len(dAtA) - i, nil
+func (m *RateLimitUpsert) GetDurationHours() uint64 {
+	if m != nil {
+		return m.DurationHours
+	}
+	return 0
 }
 
-func (m *MsgResetRateLimit) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
+func (m *RateLimitUpsert) GetChannelValueStrategy() *ChannelValueStrategy {
+	if m != nil {
+		return m.ChannelValueStrategy
 	}
-	return dAtA[:n], nil
+	return nil
 }
 
-func (m *MsgResetRateLimit) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+func (m *RateLimitUpsert) GetValueQuota() *ValueQuota {
+	if m != nil {
+		return m.ValueQuota
+	}
+	return nil
 }
 
-func (m *MsgResetRateLimit) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if len(m.ChannelId) > 0 {
-		i -= len(m.ChannelId)
-		copy(dAtA[i:], m.ChannelId)
-		i = encodeVarintTx(dAtA, i, uint64(len(m.ChannelId)))
-		i--
-		dAtA[i] = 0x1a
-	}
-	if len(m.Denom) > 0 {
-		i -= len(m.Denom)
-		copy(dAtA[i:], m.Denom)
-		i = encodeVarintTx(dAtA, i, uint64(len(m.Denom)))
-		i--
-		dAtA[i] = 0x12
-	}
-	if len(m.Authority) > 0 {
-		i -= len(m.Authority)
-		copy(dAtA[i:], m.Authority)
-		i = encodeVarintTx(dAtA, i, uint64(len(m.Authority)))
-		i--
-		dAtA[i] = 0xa
+func (m *RateLimitUpsert) GetWindowOffset() uint64 {
+	if m != nil {
+		return m.WindowOffset
 	}
-	return len(dAtA) - i, nil
+	return 0
 }
 
-func (m *MsgResetRateLimitResponse) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
+func (m *RateLimitUpsert) GetFlowUpdateMode() FlowUpdateMode {
+	if m != nil {
+		return m.FlowUpdateMode
 	}
-	return dAtA[:n], nil
+	return FLOW_UPDATE_RESET
 }
 
-func (m *MsgResetRateLimitResponse) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+// Gov tx to add, update and remove many rate limits at once
+// The batch is applied atomically: if any entry fails, none are applied
+type MsgBatchUpdateRateLimits struct {
+	// Authority defines the x/gov module account
+	Authority string `protobuf:"bytes,1,opt,name=authority,proto3" json:"authority,omitempty"`
+	// Rate limits to add or update
+	Upserts []RateLimitUpsert `protobuf:"bytes,2,rep,name=upserts,proto3" json:"upserts"`
+	// Rate limits to remove
+	Removals []Path `protobuf:"bytes,3,rep,name=removals,proto3" json:"removals"`
+	// If true, new rate limits with a channel value of zero (e.g. a denom that
+	// has no supply yet) are skipped, instead of failing the batch
+	SkipZeroChannelValue bool `protobuf:"varint,4,opt,name=skip_zero_channel_value,json=skipZeroChannelValue,proto3" json:"skip_zero_channel_value,omitempty"`
 }
 
-func (m *MsgResetRateLimitResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	return len(dAtA) - i, nil
+func (m *MsgBatchUpdateRateLimits) Reset()         { *m = MsgBatchUpdateRateLimits{} }
+func (m *MsgBatchUpdateRateLimits) String() string { return proto.CompactTextString(m) }
+func (*MsgBatchUpdateRateLimits) ProtoMessage()    {}
+func (*MsgBatchUpdateRateLimits) Descriptor() ([]byte, []int) {
+	return fileDescriptor_415b1435b4efaad0, []int{21}
 }
-
-func encodeVarintTx(dAtA []byte, offset int, v uint64) int {
-	offset -= sovTx(v)
-	base := offset
-	for v >= 1<<7 {
-		dAtA[offset] = uint8(v&0x7f | 0x80)
-		v >>= 7
-		offset++
-	}
-	dAtA[offset] = uint8(v)
-	return base
+func (m *MsgBatchUpdateRateLimits) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
 }
-func (m *MsgAddRateLimit) Size() (n int) {
-	if m == nil {
-		return 0
-	}
-	var l int
-	_ = l
-	l = len(m.Authority)
-	if l > 0 {
-		n += 1 + l + sovTx(uint64(l))
-	}
-	l = len(m.Denom)
-	if l > 0 {
-		n += 1 + l + sovTx(uint64(l))
+func (m *MsgBatchUpdateRateLimits) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgBatchUpdateRateLimits.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
 	}
-	l = len(m.ChannelId)
-	if l > 0 {
-		n += 1 + l + sovTx(uint64(l))
+}
+func (m *MsgBatchUpdateRateLimits) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgBatchUpdateRateLimits.Merge(m, src)
+}
+func (m *MsgBatchUpdateRateLimits) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgBatchUpdateRateLimits) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgBatchUpdateRateLimits.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_MsgBatchUpdateRateLimits proto.InternalMessageInfo
+
+func (m *MsgBatchUpdateRateLimits) GetAuthority() string {
+	if m != nil {
+		return m.Authority
 	}
-	l = m.MaxPercentSend.Size()
-	n += 1 + l + sovTx(uint64(l))
-	l = m.MaxPercentRecv.Size()
-	n += 1 + l + sovTx(uint64(l))
-	if m.DurationHours != 0 {
-		n += 1 + sovTx(uint64(m.DurationHours))
+	return ""
+}
+
+func (m *MsgBatchUpdateRateLimits) GetUpserts() []RateLimitUpsert {
+	if m != nil {
+		return m.Upserts
 	}
-	return n
+	return nil
 }
 
-func (m *MsgAddRateLimitResponse) Size() (n int) {
-	if m == nil {
-		return 0
+func (m *MsgBatchUpdateRateLimits) GetRemovals() []Path {
+	if m != nil {
+		return m.Removals
 	}
-	var l int
-	_ = l
-	return n
+	return nil
 }
 
-func (m *MsgUpdateRateLimit) Size() (n int) {
-	if m == nil {
-		return 0
+func (m *MsgBatchUpdateRateLimits) GetSkipZeroChannelValue() bool {
+	if m != nil {
+		return m.SkipZeroChannelValue
 	}
-	var l int
-	_ = l
-	l = len(m.Authority)
-	if l > 0 {
-		n += 1 + l + sovTx(uint64(l))
+	return false
+}
+
+type MsgBatchUpdateRateLimitsResponse struct {
+	// The rate limits that were skipped because their channel value was zero
+	Skipped []Path `protobuf:"bytes,1,rep,name=skipped,proto3" json:"skipped"`
+}
+
+func (m *MsgBatchUpdateRateLimitsResponse) Reset()         { *m = MsgBatchUpdateRateLimitsResponse{} }
+func (m *MsgBatchUpdateRateLimitsResponse) String() string { return proto.CompactTextString(m) }
+func (*MsgBatchUpdateRateLimitsResponse) ProtoMessage()    {}
+func (*MsgBatchUpdateRateLimitsResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_415b1435b4efaad0, []int{22}
+}
+func (m *MsgBatchUpdateRateLimitsResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgBatchUpdateRateLimitsResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgBatchUpdateRateLimitsResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
 	}
-	l = len(m.Denom)
-	if l > 0 {
-		n += 1 + l + sovTx(uint64(l))
+}
+func (m *MsgBatchUpdateRateLimitsResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgBatchUpdateRateLimitsResponse.Merge(m, src)
+}
+func (m *MsgBatchUpdateRateLimitsResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgBatchUpdateRateLimitsResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgBatchUpdateRateLimitsResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_MsgBatchUpdateRateLimitsResponse proto.InternalMessageInfo
+
+func (m *MsgBatchUpdateRateLimitsResponse) GetSkipped() []Path {
+	if m != nil {
+		return m.Skipped
 	}
-	l = len(m.ChannelId)
-	if l > 0 {
-		n += 1 + l + sovTx(uint64(l))
+	return nil
+}
+
+func init() {
+	proto.RegisterType((*MsgAddRateLimit)(nil), "ratelimit.v1.MsgAddRateLimit")
+	proto.RegisterType((*MsgAddRateLimitResponse)(nil), "ratelimit.v1.MsgAddRateLimitResponse")
+	proto.RegisterType((*MsgUpdateRateLimit)(nil), "ratelimit.v1.MsgUpdateRateLimit")
+	proto.RegisterType((*MsgUpdateRateLimitResponse)(nil), "ratelimit.v1.MsgUpdateRateLimitResponse")
+	proto.RegisterType((*MsgRemoveRateLimit)(nil), "ratelimit.v1.MsgRemoveRateLimit")
+	proto.RegisterType((*MsgRemoveRateLimitResponse)(nil), "ratelimit.v1.MsgRemoveRateLimitResponse")
+	proto.RegisterType((*MsgResetRateLimit)(nil), "ratelimit.v1.MsgResetRateLimit")
+	proto.RegisterType((*MsgResetRateLimitResponse)(nil), "ratelimit.v1.MsgResetRateLimitResponse")
+	proto.RegisterType((*MsgSetTransferRule)(nil), "ratelimit.v1.MsgSetTransferRule")
+	proto.RegisterType((*MsgSetTransferRuleResponse)(nil), "ratelimit.v1.MsgSetTransferRuleResponse")
+	proto.RegisterType((*MsgRemoveTransferRule)(nil), "ratelimit.v1.MsgRemoveTransferRule")
+	proto.RegisterType((*MsgRemoveTransferRuleResponse)(nil), "ratelimit.v1.MsgRemoveTransferRuleResponse")
+	proto.RegisterType((*MsgSetDenomGroup)(nil), "ratelimit.v1.MsgSetDenomGroup")
+	proto.RegisterType((*MsgSetDenomGroupResponse)(nil), "ratelimit.v1.MsgSetDenomGroupResponse")
+	proto.RegisterType((*MsgRemoveDenomGroup)(nil), "ratelimit.v1.MsgRemoveDenomGroup")
+	proto.RegisterType((*MsgRemoveDenomGroupResponse)(nil), "ratelimit.v1.MsgRemoveDenomGroupResponse")
+	proto.RegisterType((*MsgScheduleRateLimitUpdate)(nil), "ratelimit.v1.MsgScheduleRateLimitUpdate")
+	proto.RegisterType((*MsgScheduleRateLimitUpdateResponse)(nil), "ratelimit.v1.MsgScheduleRateLimitUpdateResponse")
+	proto.RegisterType((*MsgCancelScheduledRateLimitUpdate)(nil), "ratelimit.v1.MsgCancelScheduledRateLimitUpdate")
+	proto.RegisterType((*MsgCancelScheduledRateLimitUpdateResponse)(nil), "ratelimit.v1.MsgCancelScheduledRateLimitUpdateResponse")
+	proto.RegisterType((*RateLimitUpsert)(nil), "ratelimit.v1.RateLimitUpsert")
+	proto.RegisterType((*MsgBatchUpdateRateLimits)(nil), "ratelimit.v1.MsgBatchUpdateRateLimits")
+	proto.RegisterType((*MsgBatchUpdateRateLimitsResponse)(nil), "ratelimit.v1.MsgBatchUpdateRateLimitsResponse")
+}
+
+func init() { proto.RegisterFile("ratelimit/v1/tx.proto", fileDescriptor_415b1435b4efaad0) }
+
+var fileDescriptor_415b1435b4efaad0 = []byte{
+	// 1309 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xec, 0x58, 0x4b, 0x73, 0xd3, 0x56,
+	0x14, 0x8e, 0x1c, 0xe7, 0x75, 0xf2, 0x20, 0xa8, 0xa1, 0x51, 0x44, 0xe2, 0x18, 0x51, 0xa8, 0x81,
+	0xda, 0x06, 0x17, 0x4a, 0xeb, 0x99, 0x76, 0x86, 0x50, 0x28, 0x99, 0x21, 0x53, 0xaa, 0x50, 0xca,
+	0x30, 0xd3, 0x51, 0x15, 0xe9, 0x5a, 0xd6, 0x60, 0xeb, 0xba, 0xba, 0x57, 0x4e, 0xe8, 0xb2, 0x4b,
+	0x36, 0xed, 0xb6, 0x9d, 0xee, 0xfa, 0x03, 0xca, 0xf4, 0xf1, 0x03, 0xba, 0x63, 0xc9, 0x74, 0xd5,
+	0xe9, 0x82, 0xe9, 0xc0, 0x82, 0x4d, 0x7f, 0x44, 0xe7, 0x5e, 0xc9, 0xb2, 0x5e, 0x8e, 0x03, 0x09,
+	0xc3, 0x86, 0x4d, 0xe2, 0x7b, 0xce, 0x77, 0x1e, 0xdf, 0x3d, 0x47, 0x47, 0xc7, 0x86, 0x23, 0xae,
+	0x4e, 0x51, 0xcb, 0x6e, 0xdb, 0xb4, 0xda, 0x3d, 0x57, 0xa5, 0x3b, 0x95, 0x8e, 0x8b, 0x29, 0x16,
+	0x67, 0x42, 0x71, 0xa5, 0x7b, 0x4e, 0x5e, 0xb0, 0xb0, 0x85, 0xb9, 0xa2, 0xca, 0x3e, 0xf9, 0x18,
+	0xf9, 0xb0, 0xde, 0xb6, 0x1d, 0x5c, 0xe5, 0x7f, 0x03, 0xd1, 0x92, 0x81, 0x49, 0x1b, 0x13, 0xcd,
+	0xc7, 0xfa, 0x87, 0x40, 0xb5, 0xe8, 0x9f, 0xaa, 0x6d, 0x62, 0xb1, 0x48, 0x6d, 0x62, 0x05, 0x8a,
+	0xe5, 0x58, 0x06, 0xfd, 0xb8, 0x5c, 0xab, 0xfc, 0x99, 0x87, 0x43, 0x1b, 0xc4, 0xba, 0x64, 0x9a,
+	0xaa, 0x4e, 0xd1, 0x75, 0xa6, 0x11, 0xdf, 0x83, 0x29, 0xdd, 0xa3, 0x4d, 0xec, 0xda, 0xf4, 0x9e,
+	0x24, 0x14, 0x85, 0xd2, 0xd4, 0x9a, 0xf4, 0xd7, 0xef, 0xe5, 0x85, 0x20, 0xde, 0x25, 0xd3, 0x74,
+	0x11, 0x21, 0x9b, 0xd4, 0xb5, 0x1d, 0x4b, 0xed, 0x43, 0xc5, 0x05, 0x18, 0x33, 0x91, 0x83, 0xdb,
+	0x52, 0x8e, 0xd9, 0xa8, 0xfe, 0x41, 0x5c, 0x01, 0x30, 0x9a, 0xba, 0xe3, 0xa0, 0x96, 0x66, 0x9b,
+	0xd2, 0x28, 0x57, 0x4d, 0x05, 0x92, 0x75, 0x53, 0xbc, 0x0d, 0xf3, 0x6d, 0x7d, 0x47, 0xeb, 0x20,
+	0xd7, 0x40, 0x0e, 0xd5, 0x08, 0x72, 0x4c, 0x29, 0xcf, 0x63, 0x56, 0x1e, 0x3e, 0x5e, 0x1d, 0xf9,
+	0xe7, 0xf1, 0xea, 0x49, 0xcb, 0xa6, 0x4d, 0x6f, 0xab, 0x62, 0xe0, 0x76, 0x40, 0x39, 0xf8, 0x57,
+	0x26, 0xe6, 0xdd, 0x2a, 0xbd, 0xd7, 0x41, 0xa4, 0xb2, 0xee, 0x50, 0x75, 0xae, 0xad, 0xef, 0xdc,
+	0xf0, 0xdd, 0x6c, 0x22, 0x27, 0xe5, 0xd9, 0x45, 0x46, 0x57, 0x1a, 0xdb, 0xaf, 0x67, 0x15, 0x19,
+	0x5d, 0xf1, 0x04, 0xcc, 0x99, 0x9e, 0xab, 0x53, 0x1b, 0x3b, 0x5a, 0x13, 0x7b, 0x2e, 0x91, 0xc6,
+	0x8b, 0x42, 0x29, 0xaf, 0xce, 0xf6, 0xa4, 0xd7, 0x98, 0x50, 0xbc, 0x0d, 0x6f, 0xf6, 0x98, 0x77,
+	0xf5, 0x96, 0x87, 0x34, 0x42, 0xd9, 0xf5, 0x5b, 0xf7, 0xa4, 0x89, 0xa2, 0x50, 0x9a, 0xae, 0x29,
+	0x95, 0x68, 0x17, 0x54, 0x2e, 0xfb, 0xd8, 0x5b, 0x0c, 0xba, 0x19, 0x20, 0xd5, 0x05, 0x23, 0x43,
+	0x2a, 0x7e, 0x00, 0xd3, 0xbe, 0xc7, 0xaf, 0x3d, 0x4c, 0x75, 0x69, 0x92, 0xbb, 0x93, 0xe2, 0xee,
+	0xb8, 0xc5, 0x67, 0x4c, 0xaf, 0x42, 0x37, 0xfc, 0x2c, 0x1e, 0x87, 0xd9, 0x6d, 0xdb, 0x31, 0xf1,
+	0xb6, 0x86, 0x1b, 0x0d, 0x82, 0xa8, 0x34, 0xc5, 0x53, 0x9f, 0xf1, 0x85, 0x9f, 0x72, 0x59, 0xfd,
+	0x9d, 0x6f, 0x9f, 0x3d, 0x38, 0xdd, 0xaf, 0xec, 0xfd, 0x67, 0x0f, 0x4e, 0x2f, 0xf5, 0xdb, 0x28,
+	0xd1, 0x2f, 0xca, 0x12, 0x2c, 0x26, 0x44, 0x2a, 0x22, 0x1d, 0xec, 0x10, 0xa4, 0x7c, 0x37, 0x06,
+	0xe2, 0x06, 0xb1, 0x3e, 0xef, 0x98, 0x3a, 0x45, 0xaf, 0x3b, 0xec, 0x75, 0x87, 0x05, 0x1d, 0x26,
+	0x5e, 0x85, 0xf9, 0x46, 0x0b, 0x6f, 0x6b, 0x1e, 0xef, 0x0c, 0xad, 0x8d, 0x4d, 0x24, 0x41, 0x51,
+	0x28, 0xcd, 0xd5, 0x96, 0xe3, 0x41, 0xae, 0xb6, 0xf0, 0xb6, 0xdf, 0x3e, 0x1b, 0xd8, 0x44, 0xea,
+	0x5c, 0x23, 0x76, 0xae, 0x57, 0xd3, 0x9d, 0xba, 0x1c, 0xeb, 0xd4, 0x44, 0xeb, 0x29, 0xcb, 0x20,
+	0xa7, 0xa5, 0x61, 0xbf, 0xfe, 0x2a, 0xf0, 0x7e, 0x55, 0x51, 0x1b, 0x77, 0x5f, 0x51, 0xbf, 0x0e,
+	0xa7, 0x94, 0xc8, 0x2e, 0xa0, 0x94, 0x90, 0x86, 0x94, 0x1e, 0x08, 0x70, 0x98, 0xab, 0x09, 0xa2,
+	0xaf, 0x88, 0x51, 0x25, 0xcd, 0xe8, 0x68, 0x82, 0x51, 0x34, 0x39, 0xe5, 0x28, 0x2c, 0xa5, 0x84,
+	0x21, 0x9f, 0xdf, 0xfc, 0x12, 0x6d, 0x22, 0x7a, 0xd3, 0xd5, 0x1d, 0xd2, 0x40, 0xae, 0xea, 0xb5,
+	0xd0, 0x0b, 0x13, 0x3a, 0x0f, 0x79, 0xd7, 0x6b, 0x21, 0xce, 0x67, 0xba, 0x26, 0xc7, 0x9b, 0x2f,
+	0x1a, 0x61, 0x2d, 0xcf, 0x9e, 0x69, 0x95, 0xa3, 0x87, 0xd7, 0x28, 0x91, 0x5e, 0x50, 0xa3, 0x84,
+	0x34, 0xe4, 0xf4, 0x93, 0x00, 0x47, 0xc2, 0x12, 0x1e, 0x08, 0xad, 0x45, 0x98, 0x60, 0x89, 0xb2,
+	0x72, 0xf8, 0x95, 0x1a, 0x67, 0xc7, 0x75, 0xb3, 0x5e, 0x4b, 0x67, 0xbe, 0x9a, 0xd1, 0x5d, 0xb1,
+	0xe4, 0x57, 0x61, 0x25, 0x53, 0x11, 0xe6, 0xff, 0x8b, 0x00, 0xf3, 0x3e, 0xbd, 0x8f, 0x59, 0x3f,
+	0x7c, 0xe2, 0x62, 0xaf, 0xb3, 0x8f, 0x8a, 0x8c, 0x59, 0xcc, 0x81, 0x94, 0xcb, 0x1a, 0x3a, 0xfd,
+	0x00, 0x41, 0x41, 0x7c, 0x70, 0xbd, 0x9c, 0xe6, 0x25, 0x27, 0x2b, 0xd2, 0xb7, 0x55, 0x64, 0x90,
+	0x92, 0xb2, 0x90, 0xcd, 0x8f, 0x02, 0xbc, 0x11, 0xf2, 0x3d, 0x00, 0x42, 0x4b, 0x30, 0xc9, 0x73,
+	0xec, 0x17, 0x63, 0x82, 0x9f, 0xd7, 0xcd, 0xfa, 0xd9, 0x74, 0xd6, 0x2b, 0x19, 0xd5, 0x88, 0x24,
+	0xbe, 0x02, 0x47, 0x33, 0xc4, 0x61, 0xee, 0x3f, 0xe4, 0xfc, 0x46, 0x33, 0x9a, 0xc8, 0x64, 0x15,
+	0xea, 0x3d, 0x3e, 0xfe, 0xc0, 0x7b, 0x61, 0x0a, 0xc7, 0x61, 0x16, 0xed, 0x20, 0xc3, 0xa3, 0x48,
+	0x43, 0x1d, 0x6c, 0x34, 0x39, 0x8f, 0xbc, 0x3a, 0x13, 0x08, 0xaf, 0x30, 0x19, 0x7b, 0x69, 0xf5,
+	0x40, 0x4d, 0x64, 0x5b, 0x4d, 0xca, 0x27, 0xc1, 0xa8, 0xda, 0x33, 0xbd, 0xc6, 0x85, 0xe2, 0x47,
+	0x30, 0xee, 0x4f, 0x7d, 0xfe, 0x16, 0x9e, 0xae, 0x15, 0xe3, 0x05, 0x4e, 0x4f, 0xe7, 0xa0, 0xd0,
+	0x81, 0x55, 0xfd, 0x62, 0xfa, 0xce, 0xde, 0x8a, 0x57, 0x3a, 0x9b, 0xbc, 0x72, 0x05, 0x94, 0xc1,
+	0xda, 0xde, 0x0d, 0x8a, 0xab, 0x30, 0x4d, 0x02, 0x08, 0x2b, 0x98, 0xc0, 0x89, 0x42, 0x4f, 0xb4,
+	0x6e, 0xb2, 0x01, 0x74, 0x6c, 0x83, 0x58, 0x97, 0x75, 0xc7, 0x40, 0xad, 0x9e, 0x37, 0xf3, 0xa0,
+	0x6e, 0x3a, 0x11, 0x3e, 0x97, 0x0c, 0x5f, 0xbf, 0x90, 0xa6, 0xaf, 0xc4, 0xe8, 0x27, 0x12, 0x0b,
+	0xc8, 0x9f, 0x81, 0x53, 0x43, 0x93, 0x0e, 0xbb, 0xe8, 0xe7, 0x3c, 0x1c, 0x8a, 0xe8, 0x08, 0x72,
+	0x69, 0x7f, 0xf2, 0x0b, 0x83, 0x27, 0x7f, 0x6e, 0x2f, 0xbb, 0xd7, 0xe8, 0x4b, 0xdb, 0xbd, 0xf2,
+	0x2f, 0x69, 0xf7, 0x1a, 0x7b, 0xbe, 0xdd, 0x6b, 0xfc, 0x60, 0x77, 0xaf, 0x89, 0xfd, 0xec, 0x5e,
+	0x93, 0x7b, 0xdc, 0xbd, 0xa6, 0x9e, 0x7f, 0xf7, 0x52, 0xfe, 0xc8, 0xf1, 0x21, 0xba, 0xa6, 0x53,
+	0xa3, 0x99, 0x78, 0x64, 0xc9, 0x0b, 0xf7, 0xff, 0x87, 0x30, 0xe1, 0xf1, 0x86, 0x23, 0x52, 0xae,
+	0x38, 0x5a, 0x9a, 0xae, 0xad, 0xc4, 0x73, 0x4a, 0xb4, 0x65, 0x30, 0x1b, 0x7a, 0x36, 0xe2, 0x79,
+	0x98, 0x74, 0xd9, 0x6c, 0xd4, 0x5b, 0x44, 0x1a, 0xe5, 0xf6, 0x62, 0xdc, 0xfe, 0x86, 0x4e, 0x9b,
+	0x81, 0x51, 0x88, 0x14, 0x2f, 0xc0, 0x22, 0xb9, 0x6b, 0x77, 0xb4, 0x6f, 0x90, 0x8b, 0xb5, 0x58,
+	0x55, 0x79, 0x4f, 0x4d, 0xaa, 0x0b, 0x4c, 0x7d, 0x07, 0xb9, 0x38, 0x5a, 0xc6, 0xe1, 0x8f, 0x62,
+	0xe6, 0xd5, 0x28, 0xb7, 0xa0, 0x38, 0x48, 0x17, 0x4e, 0xa1, 0x1a, 0x4c, 0xb0, 0x90, 0x1d, 0xc4,
+	0x26, 0xd0, 0xee, 0x34, 0x7a, 0xc0, 0xda, 0x7f, 0x93, 0x30, 0xba, 0x41, 0x2c, 0xf1, 0x26, 0xcc,
+	0xc4, 0xbe, 0xcf, 0xaf, 0xa4, 0x06, 0x6c, 0x54, 0x2d, 0x9f, 0xd8, 0x55, 0x1d, 0x66, 0xf4, 0x25,
+	0x1c, 0x4a, 0x7e, 0x8d, 0x1b, 0x3a, 0xb9, 0xe5, 0xd2, 0x30, 0x44, 0xd4, 0x7d, 0x72, 0xeb, 0x4e,
+	0xbb, 0x4f, 0x20, 0xe4, 0xd2, 0x30, 0x44, 0xe8, 0xfe, 0x0e, 0xcc, 0x25, 0x36, 0xe0, 0xd5, 0x0c,
+	0xdb, 0x28, 0x40, 0x7e, 0x7b, 0x08, 0x20, 0x9a, 0x7a, 0x72, 0x1b, 0x4d, 0xa7, 0x9e, 0x40, 0xc8,
+	0xa5, 0x61, 0x88, 0xd0, 0x7d, 0x03, 0xc4, 0x8c, 0xc5, 0xf0, 0xf8, 0x00, 0xea, 0xb1, 0x20, 0x67,
+	0xf6, 0x00, 0x0a, 0xe3, 0x7c, 0x01, 0xb3, 0xf1, 0x05, 0xae, 0x90, 0x95, 0x62, 0x5f, 0x2f, 0x9f,
+	0xdc, 0x5d, 0x1f, 0x3a, 0xfe, 0x0a, 0xe6, 0x53, 0xbb, 0xd4, 0xb1, 0x01, 0x99, 0x45, 0xdc, 0x9f,
+	0x1a, 0x0a, 0x09, 0x23, 0x78, 0xb0, 0x38, 0x68, 0xe3, 0xc9, 0xb8, 0xe7, 0x6c, 0xa4, 0x7c, 0x76,
+	0xaf, 0xc8, 0x30, 0xec, 0x7d, 0x01, 0x0a, 0x43, 0xd6, 0x80, 0x6a, 0xca, 0xe9, 0xee, 0x06, 0xf2,
+	0xc5, 0xe7, 0x34, 0x08, 0x93, 0xc1, 0x70, 0x24, 0x7b, 0x12, 0xa7, 0xcb, 0x94, 0x89, 0x93, 0x2b,
+	0x7b, 0xc3, 0xf5, 0x02, 0xae, 0xa9, 0x0f, 0x9f, 0x14, 0x84, 0x47, 0x4f, 0x0a, 0xc2, 0xbf, 0x4f,
+	0x0a, 0xc2, 0xf7, 0x4f, 0x0b, 0x23, 0x8f, 0x9e, 0x16, 0x46, 0xfe, 0x7e, 0x5a, 0x18, 0xb9, 0xf3,
+	0x7e, 0xe4, 0xcd, 0xcb, 0x26, 0xbc, 0x89, 0xca, 0xd7, 0xf5, 0x2d, 0x52, 0xb5, 0xb7, 0x8c, 0x32,
+	0x8b, 0x51, 0xe6, 0x41, 0x6c, 0xc7, 0xea, 0xff, 0x1c, 0xe9, 0xbf, 0x8f, 0xb7, 0xc6, 0xf9, 0xaf,
+	0x92, 0xef, 0xfe, 0x3f, 0x00, 0xfe, 0xf6, 0x12, 0xbc, 0x37, 0x15, 0x00, 0x00,
+}
+
+// Reference imports to suppress errors if they are not otherwise used.
+var _ context.Context
+var _ grpc.ClientConn
+
+// This is a compile-time assertion to ensure that this generated file
+// is compatible with the grpc package it is being compiled against.
+const _ = grpc.SupportPackageIsVersion4
+
+// MsgClient is the client API for Msg service.
+//
+// For semantics around ctx use and closing/ending streaming RPCs, please refer to https://godoc.org/google.golang.org/grpc#ClientConn.NewStream.
+type MsgClient interface {
+	// Gov tx to add a new rate limit
+	AddRateLimit(ctx context.Context, in *MsgAddRateLimit, opts ...grpc.CallOption) (*MsgAddRateLimitResponse, error)
+	// Gov tx to update an existing rate limit
+	UpdateRateLimit(ctx context.Context, in *MsgUpdateRateLimit, opts ...grpc.CallOption) (*MsgUpdateRateLimitResponse, error)
+	// Gov tx to remove a rate limit
+	RemoveRateLimit(ctx context.Context, in *MsgRemoveRateLimit, opts ...grpc.CallOption) (*MsgRemoveRateLimitResponse, error)
+	// Gov tx to reset the flow on a rate limit
+	ResetRateLimit(ctx context.Context, in *MsgResetRateLimit, opts ...grpc.CallOption) (*MsgResetRateLimitResponse, error)
+	// Gov tx to add or replace a transfer rule
+	SetTransferRule(ctx context.Context, in *MsgSetTransferRule, opts ...grpc.CallOption) (*MsgSetTransferRuleResponse, error)
+	// Gov tx to remove a transfer rule
+	RemoveTransferRule(ctx context.Context, in *MsgRemoveTransferRule, opts ...grpc.CallOption) (*MsgRemoveTransferRuleResponse, error)
+	// Gov tx to add or replace a denom group
+	SetDenomGroup(ctx context.Context, in *MsgSetDenomGroup, opts ...grpc.CallOption) (*MsgSetDenomGroupResponse, error)
+	// Gov tx to remove a denom group
+	RemoveDenomGroup(ctx context.Context, in *MsgRemoveDenomGroup, opts ...grpc.CallOption) (*MsgRemoveDenomGroupResponse, error)
+	// Gov tx to schedule an update to a rate limit at a future epoch or height
+	ScheduleRateLimitUpdate(ctx context.Context, in *MsgScheduleRateLimitUpdate, opts ...grpc.CallOption) (*MsgScheduleRateLimitUpdateResponse, error)
+	// Gov tx to cancel a scheduled rate limit update
+	CancelScheduledRateLimitUpdate(ctx context.Context, in *MsgCancelScheduledRateLimitUpdate, opts ...grpc.CallOption) (*MsgCancelScheduledRateLimitUpdateResponse, error)
+	// Gov tx to add, update and remove many rate limits at once
+	BatchUpdateRateLimits(ctx context.Context, in *MsgBatchUpdateRateLimits, opts ...grpc.CallOption) (*MsgBatchUpdateRateLimitsResponse, error)
+}
+
+type msgClient struct {
+	cc grpc1.ClientConn
+}
+
+func NewMsgClient(cc grpc1.ClientConn) MsgClient {
+	return &msgClient{cc}
+}
+
+func (c *msgClient) AddRateLimit(ctx context.Context, in *MsgAddRateLimit, opts ...grpc.CallOption) (*MsgAddRateLimitResponse, error) {
+	out := new(MsgAddRateLimitResponse)
+	err := c.cc.Invoke(ctx, "/ratelimit.v1.Msg/AddRateLimit", in, out, opts...)
+	if err != nil {
+		return nil, err
 	}
-	l = m.MaxPercentSend.Size()
-	n += 1 + l + sovTx(uint64(l))
-	l = m.MaxPercentRecv.Size()
-	n += 1 + l + sovTx(uint64(l))
-	if m.DurationHours != 0 {
-		n += 1 + sovTx(uint64(m.DurationHours))
+	return out, nil
+}
+
+func (c *msgClient) UpdateRateLimit(ctx context.Context, in *MsgUpdateRateLimit, opts ...grpc.CallOption) (*MsgUpdateRateLimitResponse, error) {
+	out := new(MsgUpdateRateLimitResponse)
+	err := c.cc.Invoke(ctx, "/ratelimit.v1.Msg/UpdateRateLimit", in, out, opts...)
+	if err != nil {
+		return nil, err
 	}
-	return n
+	return out, nil
 }
 
-func (m *MsgUpdateRateLimitResponse) Size() (n int) {
-	if m == nil {
-		return 0
+func (c *msgClient) RemoveRateLimit(ctx context.Context, in *MsgRemoveRateLimit, opts ...grpc.CallOption) (*MsgRemoveRateLimitResponse, error) {
+	out := new(MsgRemoveRateLimitResponse)
+	err := c.cc.Invoke(ctx, "/ratelimit.v1.Msg/RemoveRateLimit", in, out, opts...)
+	if err != nil {
+		return nil, err
 	}
-	var l int
-	_ = l
-	return n
+	return out, nil
 }
 
-func (m *MsgRemoveRateLimit) Size() (n int) {
-	if m == nil {
-		return 0
+func (c *msgClient) ResetRateLimit(ctx context.Context, in *MsgResetRateLimit, opts ...grpc.CallOption) (*MsgResetRateLimitResponse, error) {
+	out := new(MsgResetRateLimitResponse)
+	err := c.cc.Invoke(ctx, "/ratelimit.v1.Msg/ResetRateLimit", in, out, opts...)
+	if err != nil {
+		return nil, err
 	}
-	var l int
-	_ = l
-	l = len(m.Authority)
-	if l > 0 {
-		n += 1 + l + sovTx(uint64(l))
+	return out, nil
+}
+
+func (c *msgClient) SetTransferRule(ctx context.Context, in *MsgSetTransferRule, opts ...grpc.CallOption) (*MsgSetTransferRuleResponse, error) {
+	out := new(MsgSetTransferRuleResponse)
+	err := c.cc.Invoke(ctx, "/ratelimit.v1.Msg/SetTransferRule", in, out, opts...)
+	if err != nil {
+		return nil, err
 	}
-	l = len(m.Denom)
-	if l > 0 {
-		n += 1 + l + sovTx(uint64(l))
+	return out, nil
+}
+
+func (c *msgClient) RemoveTransferRule(ctx context.Context, in *MsgRemoveTransferRule, opts ...grpc.CallOption) (*MsgRemoveTransferRuleResponse, error) {
+	out := new(MsgRemoveTransferRuleResponse)
+	err := c.cc.Invoke(ctx, "/ratelimit.v1.Msg/RemoveTransferRule", in, out, opts...)
+	if err != nil {
+		return nil, err
 	}
-	l = len(m.ChannelId)
-	if l > 0 {
-		n += 1 + l + sovTx(uint64(l))
+	return out, nil
+}
+
+func (c *msgClient) SetDenomGroup(ctx context.Context, in *MsgSetDenomGroup, opts ...grpc.CallOption) (*MsgSetDenomGroupResponse, error) {
+	out := new(MsgSetDenomGroupResponse)
+	err := c.cc.Invoke(ctx, "/ratelimit.v1.Msg/SetDenomGroup", in, out, opts...)
+	if err != nil {
+		return nil, err
 	}
-	return n
+	return out, nil
 }
 
-func (m *MsgRemoveRateLimitResponse) Size() (n int) {
-	if m == nil {
-		return 0
+func (c *msgClient) RemoveDenomGroup(ctx context.Context, in *MsgRemoveDenomGroup, opts ...grpc.CallOption) (*MsgRemoveDenomGroupResponse, error) {
+	out := new(MsgRemoveDenomGroupResponse)
+	err := c.cc.Invoke(ctx, "/ratelimit.v1.Msg/RemoveDenomGroup", in, out, opts...)
+	if err != nil {
+		return nil, err
 	}
-	var l int
-	_ = l
-	return n
+	return out, nil
 }
 
-func (m *MsgResetRateLimit) Size() (n int) {
-	if m == nil {
-		return 0
+func (c *msgClient) ScheduleRateLimitUpdate(ctx context.Context, in *MsgScheduleRateLimitUpdate, opts ...grpc.CallOption) (*MsgScheduleRateLimitUpdateResponse, error) {
+	out := new(MsgScheduleRateLimitUpdateResponse)
+	err := c.cc.Invoke(ctx, "/ratelimit.v1.Msg/ScheduleRateLimitUpdate", in, out, opts...)
+	if err != nil {
+		return nil, err
 	}
-	var l int
-	_ = l
-	l = len(m.Authority)
-	if l > 0 {
-		n += 1 + l + sovTx(uint64(l))
+	return out, nil
+}
+
+func (c *msgClient) CancelScheduledRateLimitUpdate(ctx context.Context, in *MsgCancelScheduledRateLimitUpdate, opts ...grpc.CallOption) (*MsgCancelScheduledRateLimitUpdateResponse, error) {
+	out := new(MsgCancelScheduledRateLimitUpdateResponse)
+	err := c.cc.Invoke(ctx, "/ratelimit.v1.Msg/CancelScheduledRateLimitUpdate", in, out, opts...)
+	if err != nil {
+		return nil, err
 	}
-	l = len(m.Denom)
-	if l > 0 {
-		n += 1 + l + sovTx(uint64(l))
+	return out, nil
+}
+
+func (c *msgClient) BatchUpdateRateLimits(ctx context.Context, in *MsgBatchUpdateRateLimits, opts ...grpc.CallOption) (*MsgBatchUpdateRateLimitsResponse, error) {
+	out := new(MsgBatchUpdateRateLimitsResponse)
+	err := c.cc.Invoke(ctx, "/ratelimit.v1.Msg/BatchUpdateRateLimits", in, out, opts...)
+	if err != nil {
+		return nil, err
 	}
-	l = len(m.ChannelId)
-	if l > 0 {
-		n += 1 + l + sovTx(uint64(l))
+	return out, nil
+}
+
+// MsgServer is the server API for Msg service.
+type MsgServer interface {
+	// Gov tx to add a new rate limit
+	AddRateLimit(context.Context, *MsgAddRateLimit) (*MsgAddRateLimitResponse, error)
+	// Gov tx to update an existing rate limit
+	UpdateRateLimit(context.Context, *MsgUpdateRateLimit) (*MsgUpdateRateLimitResponse, error)
+	// Gov tx to remove a rate limit
+	RemoveRateLimit(context.Context, *MsgRemoveRateLimit) (*MsgRemoveRateLimitResponse, error)
+	// Gov tx to reset the flow on a rate limit
+	ResetRateLimit(context.Context, *MsgResetRateLimit) (*MsgResetRateLimitResponse, error)
+	// Gov tx to add or replace a transfer rule
+	SetTransferRule(context.Context, *MsgSetTransferRule) (*MsgSetTransferRuleResponse, error)
+	// Gov tx to remove a transfer rule
+	RemoveTransferRule(context.Context, *MsgRemoveTransferRule) (*MsgRemoveTransferRuleResponse, error)
+	// Gov tx to add or replace a denom group
+	SetDenomGroup(context.Context, *MsgSetDenomGroup) (*MsgSetDenomGroupResponse, error)
+	// Gov tx to remove a denom group
+	RemoveDenomGroup(context.Context, *MsgRemoveDenomGroup) (*MsgRemoveDenomGroupResponse, error)
+	// Gov tx to schedule an update to a rate limit at a future epoch or height
+	ScheduleRateLimitUpdate(context.Context, *MsgScheduleRateLimitUpdate) (*MsgScheduleRateLimitUpdateResponse, error)
+	// Gov tx to cancel a scheduled rate limit update
+	CancelScheduledRateLimitUpdate(context.Context, *MsgCancelScheduledRateLimitUpdate) (*MsgCancelScheduledRateLimitUpdateResponse, error)
+	// Gov tx to add, update and remove many rate limits at once
+	BatchUpdateRateLimits(context.Context, *MsgBatchUpdateRateLimits) (*MsgBatchUpdateRateLimitsResponse, error)
+}
+
+// UnimplementedMsgServer can be embedded to have forward compatible implementations.
+type UnimplementedMsgServer struct {
+}
+
+func (*UnimplementedMsgServer) AddRateLimit(ctx context.Context, req *MsgAddRateLimit) (*MsgAddRateLimitResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method AddRateLimit not implemented")
+}
+func (*UnimplementedMsgServer) UpdateRateLimit(ctx context.Context, req *MsgUpdateRateLimit) (*MsgUpdateRateLimitResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method UpdateRateLimit not implemented")
+}
+func (*UnimplementedMsgServer) RemoveRateLimit(ctx context.Context, req *MsgRemoveRateLimit) (*MsgRemoveRateLimitResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method RemoveRateLimit not implemented")
+}
+func (*UnimplementedMsgServer) ResetRateLimit(ctx context.Context, req *MsgResetRateLimit) (*MsgResetRateLimitResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ResetRateLimit not implemented")
+}
+func (*UnimplementedMsgServer) SetTransferRule(ctx context.Context, req *MsgSetTransferRule) (*MsgSetTransferRuleResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method SetTransferRule not implemented")
+}
+func (*UnimplementedMsgServer) RemoveTransferRule(ctx context.Context, req *MsgRemoveTransferRule) (*MsgRemoveTransferRuleResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method RemoveTransferRule not implemented")
+}
+func (*UnimplementedMsgServer) SetDenomGroup(ctx context.Context, req *MsgSetDenomGroup) (*MsgSetDenomGroupResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method SetDenomGroup not implemented")
+}
+func (*UnimplementedMsgServer) RemoveDenomGroup(ctx context.Context, req *MsgRemoveDenomGroup) (*MsgRemoveDenomGroupResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method RemoveDenomGroup not implemented")
+}
+func (*UnimplementedMsgServer) ScheduleRateLimitUpdate(ctx context.Context, req *MsgScheduleRateLimitUpdate) (*MsgScheduleRateLimitUpdateResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ScheduleRateLimitUpdate not implemented")
+}
+func (*UnimplementedMsgServer) CancelScheduledRateLimitUpdate(ctx context.Context, req *MsgCancelScheduledRateLimitUpdate) (*MsgCancelScheduledRateLimitUpdateResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method CancelScheduledRateLimitUpdate not implemented")
+}
+func (*UnimplementedMsgServer) BatchUpdateRateLimits(ctx context.Context, req *MsgBatchUpdateRateLimits) (*MsgBatchUpdateRateLimitsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method BatchUpdateRateLimits not implemented")
+}
+
+func RegisterMsgServer(s grpc1.Server, srv MsgServer) {
+	s.RegisterService(&_Msg_serviceDesc, srv)
+}
+
+func _Msg_AddRateLimit_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgAddRateLimit)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MsgServer).AddRateLimit(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/ratelimit.v1.Msg/AddRateLimit",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).AddRateLimit(ctx, req.(*MsgAddRateLimit))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Msg_UpdateRateLimit_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgUpdateRateLimit)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MsgServer).UpdateRateLimit(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/ratelimit.v1.Msg/UpdateRateLimit",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).UpdateRateLimit(ctx, req.(*MsgUpdateRateLimit))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Msg_RemoveRateLimit_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgRemoveRateLimit)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MsgServer).RemoveRateLimit(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/ratelimit.v1.Msg/RemoveRateLimit",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).RemoveRateLimit(ctx, req.(*MsgRemoveRateLimit))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Msg_ResetRateLimit_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgResetRateLimit)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MsgServer).ResetRateLimit(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/ratelimit.v1.Msg/ResetRateLimit",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).ResetRateLimit(ctx, req.(*MsgResetRateLimit))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Msg_SetTransferRule_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgSetTransferRule)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MsgServer).SetTransferRule(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/ratelimit.v1.Msg/SetTransferRule",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).SetTransferRule(ctx, req.(*MsgSetTransferRule))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Msg_RemoveTransferRule_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgRemoveTransferRule)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MsgServer).RemoveTransferRule(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/ratelimit.v1.Msg/RemoveTransferRule",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).RemoveTransferRule(ctx, req.(*MsgRemoveTransferRule))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Msg_SetDenomGroup_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgSetDenomGroup)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MsgServer).SetDenomGroup(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/ratelimit.v1.Msg/SetDenomGroup",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).SetDenomGroup(ctx, req.(*MsgSetDenomGroup))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Msg_RemoveDenomGroup_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgRemoveDenomGroup)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MsgServer).RemoveDenomGroup(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/ratelimit.v1.Msg/RemoveDenomGroup",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).RemoveDenomGroup(ctx, req.(*MsgRemoveDenomGroup))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Msg_ScheduleRateLimitUpdate_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgScheduleRateLimitUpdate)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MsgServer).ScheduleRateLimitUpdate(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/ratelimit.v1.Msg/ScheduleRateLimitUpdate",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).ScheduleRateLimitUpdate(ctx, req.(*MsgScheduleRateLimitUpdate))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Msg_CancelScheduledRateLimitUpdate_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgCancelScheduledRateLimitUpdate)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MsgServer).CancelScheduledRateLimitUpdate(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/ratelimit.v1.Msg/CancelScheduledRateLimitUpdate",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).CancelScheduledRateLimitUpdate(ctx, req.(*MsgCancelScheduledRateLimitUpdate))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Msg_BatchUpdateRateLimits_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgBatchUpdateRateLimits)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MsgServer).BatchUpdateRateLimits(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/ratelimit.v1.Msg/BatchUpdateRateLimits",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).BatchUpdateRateLimits(ctx, req.(*MsgBatchUpdateRateLimits))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+var _Msg_serviceDesc = grpc.ServiceDesc{
+	ServiceName: "ratelimit.v1.Msg",
+	HandlerType: (*MsgServer)(nil),
+	Methods: []grpc.MethodDesc{
+		{
+			MethodName: "AddRateLimit",
+			Handler:    _Msg_AddRateLimit_Handler,
+		},
+		{
+			MethodName: "UpdateRateLimit",
+			Handler:    _Msg_UpdateRateLimit_Handler,
+		},
+		{
+			MethodName: "RemoveRateLimit",
+			Handler:    _Msg_RemoveRateLimit_Handler,
+		},
+		{
+			MethodName: "ResetRateLimit",
+			Handler:    _Msg_ResetRateLimit_Handler,
+		},
+		{
+			MethodName: "SetTransferRule",
+			Handler:    _Msg_SetTransferRule_Handler,
+		},
+		{
+			MethodName: "RemoveTransferRule",
+			Handler:    _Msg_RemoveTransferRule_Handler,
+		},
+		{
+			MethodName: "SetDenomGroup",
+			Handler:    _Msg_SetDenomGroup_Handler,
+		},
+		{
+			MethodName: "RemoveDenomGroup",
+			Handler:    _Msg_RemoveDenomGroup_Handler,
+		},
+		{
+			MethodName: "ScheduleRateLimitUpdate",
+			Handler:    _Msg_ScheduleRateLimitUpdate_Handler,
+		},
+		{
+			MethodName: "CancelScheduledRateLimitUpdate",
+			Handler:    _Msg_CancelScheduledRateLimitUpdate_Handler,
+		},
+		{
+			MethodName: "BatchUpdateRateLimits",
+			Handler:    _Msg_BatchUpdateRateLimits_Handler,
+		},
+	},
+	Streams:  []grpc.StreamDesc{},
+	Metadata: "ratelimit/v1/tx.proto",
+}
+
+func (m *MsgAddRateLimit) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgAddRateLimit) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgAddRateLimit) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.WindowOffset != 0 {
+		i = encodeVarintTx(dAtA, i, uint64(m.WindowOffset))
+		i--
+		dAtA[i] = 0x48
+	}
+	if m.ValueQuota != nil {
+		{
+			size, err := m.ValueQuota.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintTx(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x42
+	}
+	if m.ChannelValueStrategy != nil {
+		{
+			size, err := m.ChannelValueStrategy.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintTx(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x3a
+	}
+	if m.DurationHours != 0 {
+		i = encodeVarintTx(dAtA, i, uint64(m.DurationHours))
+		i--
+		dAtA[i] = 0x30
+	}
+	{
+		size := m.MaxPercentRecv.Size()
+		i -= size
+		if _, err := m.MaxPercentRecv.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintTx(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x2a
+	{
+		size := m.MaxPercentSend.Size()
+		i -= size
+		if _, err := m.MaxPercentSend.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintTx(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x22
+	if len(m.ChannelId) > 0 {
+		i -= len(m.ChannelId)
+		copy(dAtA[i:], m.ChannelId)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.ChannelId)))
+		i--
+		dAtA[i] = 0x1a
+	}
+	if len(m.Denom) > 0 {
+		i -= len(m.Denom)
+		copy(dAtA[i:], m.Denom)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Denom)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.Authority) > 0 {
+		i -= len(m.Authority)
+		copy(dAtA[i:], m.Authority)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Authority)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgAddRateLimitResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgAddRateLimitResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgAddRateLimitResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgUpdateRateLimit) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgUpdateRateLimit) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgUpdateRateLimit) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.FlowUpdateMode != 0 {
+		i = encodeVarintTx(dAtA, i, uint64(m.FlowUpdateMode))
+		i--
+		dAtA[i] = 0x50
+	}
+	if m.WindowOffset != 0 {
+		i = encodeVarintTx(dAtA, i, uint64(m.WindowOffset))
+		i--
+		dAtA[i] = 0x48
+	}
+	if m.ValueQuota != nil {
+		{
+			size, err := m.ValueQuota.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintTx(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x42
+	}
+	if m.ChannelValueStrategy != nil {
+		{
+			size, err := m.ChannelValueStrategy.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintTx(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x3a
+	}
+	if m.DurationHours != 0 {
+		i = encodeVarintTx(dAtA, i, uint64(m.DurationHours))
+		i--
+		dAtA[i] = 0x30
+	}
+	{
+		size := m.MaxPercentRecv.Size()
+		i -= size
+		if _, err := m.MaxPercentRecv.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintTx(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x2a
+	{
+		size := m.MaxPercentSend.Size()
+		i -= size
+		if _, err := m.MaxPercentSend.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintTx(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x22
+	if len(m.ChannelId) > 0 {
+		i -= len(m.ChannelId)
+		copy(dAtA[i:], m.ChannelId)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.ChannelId)))
+		i--
+		dAtA[i] = 0x1a
+	}
+	if len(m.Denom) > 0 {
+		i -= len(m.Denom)
+		copy(dAtA[i:], m.Denom)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Denom)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.Authority) > 0 {
+		i -= len(m.Authority)
+		copy(dAtA[i:], m.Authority)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Authority)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgUpdateRateLimitResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgUpdateRateLimitResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgUpdateRateLimitResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgRemoveRateLimit) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgRemoveRateLimit) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgRemoveRateLimit) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.ChannelId) > 0 {
+		i -= len(m.ChannelId)
+		copy(dAtA[i:], m.ChannelId)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.ChannelId)))
+		i--
+		dAtA[i] = 0x1a
+	}
+	if len(m.Denom) > 0 {
+		i -= len(m.Denom)
+		copy(dAtA[i:], m.Denom)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Denom)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.Authority) > 0 {
+		i -= len(m.Authority)
+		copy(dAtA[i:], m.Authority)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Authority)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgRemoveRateLimitResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgRemoveRateLimitResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgRemoveRateLimitResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgResetRateLimit) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgResetRateLimit) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgResetRateLimit) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.ChannelId) > 0 {
+		i -= len(m.ChannelId)
+		copy(dAtA[i:], m.ChannelId)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.ChannelId)))
+		i--
+		dAtA[i] = 0x1a
+	}
+	if len(m.Denom) > 0 {
+		i -= len(m.Denom)
+		copy(dAtA[i:], m.Denom)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Denom)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.Authority) > 0 {
+		i -= len(m.Authority)
+		copy(dAtA[i:], m.Authority)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Authority)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgResetRateLimitResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgResetRateLimitResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgResetRateLimitResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgSetTransferRule) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgSetTransferRule) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgSetTransferRule) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	{
+		size, err := m.Rule.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintTx(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x12
+	if len(m.Authority) > 0 {
+		i -= len(m.Authority)
+		copy(dAtA[i:], m.Authority)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Authority)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgSetTransferRuleResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgSetTransferRuleResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgSetTransferRuleResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgRemoveTransferRule) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgRemoveTransferRule) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgRemoveTransferRule) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.RuleId) > 0 {
+		i -= len(m.RuleId)
+		copy(dAtA[i:], m.RuleId)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.RuleId)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.Authority) > 0 {
+		i -= len(m.Authority)
+		copy(dAtA[i:], m.Authority)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Authority)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgRemoveTransferRuleResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgRemoveTransferRuleResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgRemoveTransferRuleResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgSetDenomGroup) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgSetDenomGroup) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgSetDenomGroup) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	{
+		size, err := m.Group.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintTx(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x12
+	if len(m.Authority) > 0 {
+		i -= len(m.Authority)
+		copy(dAtA[i:], m.Authority)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Authority)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgSetDenomGroupResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgSetDenomGroupResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgSetDenomGroupResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgRemoveDenomGroup) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgRemoveDenomGroup) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgRemoveDenomGroup) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.GroupId) > 0 {
+		i -= len(m.GroupId)
+		copy(dAtA[i:], m.GroupId)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.GroupId)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.Authority) > 0 {
+		i -= len(m.Authority)
+		copy(dAtA[i:], m.Authority)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Authority)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgRemoveDenomGroupResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgRemoveDenomGroupResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgRemoveDenomGroupResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgScheduleRateLimitUpdate) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgScheduleRateLimitUpdate) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgScheduleRateLimitUpdate) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	{
+		size, err := m.Update.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintTx(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x22
+	if m.ExecuteHeight != 0 {
+		i = encodeVarintTx(dAtA, i, uint64(m.ExecuteHeight))
+		i--
+		dAtA[i] = 0x18
+	}
+	if m.ExecuteEpoch != 0 {
+		i = encodeVarintTx(dAtA, i, uint64(m.ExecuteEpoch))
+		i--
+		dAtA[i] = 0x10
+	}
+	if len(m.Authority) > 0 {
+		i -= len(m.Authority)
+		copy(dAtA[i:], m.Authority)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Authority)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgScheduleRateLimitUpdateResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgScheduleRateLimitUpdateResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgScheduleRateLimitUpdateResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.ScheduleId != 0 {
+		i = encodeVarintTx(dAtA, i, uint64(m.ScheduleId))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgCancelScheduledRateLimitUpdate) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgCancelScheduledRateLimitUpdate) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgCancelScheduledRateLimitUpdate) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.ScheduleId != 0 {
+		i = encodeVarintTx(dAtA, i, uint64(m.ScheduleId))
+		i--
+		dAtA[i] = 0x10
+	}
+	if len(m.Authority) > 0 {
+		i -= len(m.Authority)
+		copy(dAtA[i:], m.Authority)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Authority)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgCancelScheduledRateLimitUpdateResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgCancelScheduledRateLimitUpdateResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgCancelScheduledRateLimitUpdateResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	return len(dAtA) - i, nil
+}
+
+func (m *RateLimitUpsert) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *RateLimitUpsert) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *RateLimitUpsert) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.FlowUpdateMode != 0 {
+		i = encodeVarintTx(dAtA, i, uint64(m.FlowUpdateMode))
+		i--
+		dAtA[i] = 0x48
+	}
+	if m.WindowOffset != 0 {
+		i = encodeVarintTx(dAtA, i, uint64(m.WindowOffset))
+		i--
+		dAtA[i] = 0x40
+	}
+	if m.ValueQuota != nil {
+		{
+			size, err := m.ValueQuota.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintTx(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x3a
+	}
+	if m.ChannelValueStrategy != nil {
+		{
+			size, err := m.ChannelValueStrategy.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintTx(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x32
+	}
+	if m.DurationHours != 0 {
+		i = encodeVarintTx(dAtA, i, uint64(m.DurationHours))
+		i--
+		dAtA[i] = 0x28
+	}
+	{
+		size := m.MaxPercentRecv.Size()
+		i -= size
+		if _, err := m.MaxPercentRecv.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintTx(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x22
+	{
+		size := m.MaxPercentSend.Size()
+		i -= size
+		if _, err := m.MaxPercentSend.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintTx(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x1a
+	if len(m.ChannelId) > 0 {
+		i -= len(m.ChannelId)
+		copy(dAtA[i:], m.ChannelId)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.ChannelId)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.Denom) > 0 {
+		i -= len(m.Denom)
+		copy(dAtA[i:], m.Denom)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Denom)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgBatchUpdateRateLimits) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgBatchUpdateRateLimits) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgBatchUpdateRateLimits) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.SkipZeroChannelValue {
+		i--
+		if m.SkipZeroChannelValue {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i--
+		dAtA[i] = 0x20
+	}
+	if len(m.Removals) > 0 {
+		for iNdEx := len(m.Removals) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Removals[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintTx(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x1a
+		}
+	}
+	if len(m.Upserts) > 0 {
+		for iNdEx := len(m.Upserts) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Upserts[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintTx(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x12
+		}
+	}
+	if len(m.Authority) > 0 {
+		i -= len(m.Authority)
+		copy(dAtA[i:], m.Authority)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Authority)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgBatchUpdateRateLimitsResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgBatchUpdateRateLimitsResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgBatchUpdateRateLimitsResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Skipped) > 0 {
+		for iNdEx := len(m.Skipped) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Skipped[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintTx(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0xa
+		}
+	}
+	return len(dAtA) - i, nil
+}
+
+func encodeVarintTx(dAtA []byte, offset int, v uint64) int {
+	offset -= sovTx(v)
+	base := offset
+	for v >= 1<<7 {
+		dAtA[offset] = uint8(v&0x7f | 0x80)
+		v >>= 7
+		offset++
+	}
+	dAtA[offset] = uint8(v)
+	return base
+}
+func (m *MsgAddRateLimit) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Authority)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	l = len(m.Denom)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	l = len(m.ChannelId)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	l = m.MaxPercentSend.Size()
+	n += 1 + l + sovTx(uint64(l))
+	l = m.MaxPercentRecv.Size()
+	n += 1 + l + sovTx(uint64(l))
+	if m.DurationHours != 0 {
+		n += 1 + sovTx(uint64(m.DurationHours))
+	}
+	if m.ChannelValueStrategy != nil {
+		l = m.ChannelValueStrategy.Size()
+		n += 1 + l + sovTx(uint64(l))
+	}
+	if m.ValueQuota != nil {
+		l = m.ValueQuota.Size()
+		n += 1 + l + sovTx(uint64(l))
+	}
+	if m.WindowOffset != 0 {
+		n += 1 + sovTx(uint64(m.WindowOffset))
+	}
+	return n
+}
+
+func (m *MsgAddRateLimitResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
+}
+
+func (m *MsgUpdateRateLimit) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Authority)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	l = len(m.Denom)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	l = len(m.ChannelId)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	l = m.MaxPercentSend.Size()
+	n += 1 + l + sovTx(uint64(l))
+	l = m.MaxPercentRecv.Size()
+	n += 1 + l + sovTx(uint64(l))
+	if m.DurationHours != 0 {
+		n += 1 + sovTx(uint64(m.DurationHours))
+	}
+	if m.ChannelValueStrategy != nil {
+		l = m.ChannelValueStrategy.Size()
+		n += 1 + l + sovTx(uint64(l))
+	}
+	if m.ValueQuota != nil {
+		l = m.ValueQuota.Size()
+		n += 1 + l + sovTx(uint64(l))
+	}
+	if m.WindowOffset != 0 {
+		n += 1 + sovTx(uint64(m.WindowOffset))
+	}
+	if m.FlowUpdateMode != 0 {
+		n += 1 + sovTx(uint64(m.FlowUpdateMode))
+	}
+	return n
+}
+
+func (m *MsgUpdateRateLimitResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
+}
+
+func (m *MsgRemoveRateLimit) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Authority)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	l = len(m.Denom)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	l = len(m.ChannelId)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	return n
+}
+
+func (m *MsgRemoveRateLimitResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
+}
+
+func (m *MsgResetRateLimit) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Authority)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	l = len(m.Denom)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	l = len(m.ChannelId)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	return n
+}
+
+func (m *MsgResetRateLimitResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
+}
+
+func (m *MsgSetTransferRule) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Authority)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	l = m.Rule.Size()
+	n += 1 + l + sovTx(uint64(l))
+	return n
+}
+
+func (m *MsgSetTransferRuleResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
+}
+
+func (m *MsgRemoveTransferRule) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Authority)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	l = len(m.RuleId)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	return n
+}
+
+func (m *MsgRemoveTransferRuleResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
+}
+
+func (m *MsgSetDenomGroup) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Authority)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	l = m.Group.Size()
+	n += 1 + l + sovTx(uint64(l))
+	return n
+}
+
+func (m *MsgSetDenomGroupResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
+}
+
+func (m *MsgRemoveDenomGroup) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Authority)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	l = len(m.GroupId)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	return n
+}
+
+func (m *MsgRemoveDenomGroupResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
+}
+
+func (m *MsgScheduleRateLimitUpdate) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Authority)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	if m.ExecuteEpoch != 0 {
+		n += 1 + sovTx(uint64(m.ExecuteEpoch))
+	}
+	if m.ExecuteHeight != 0 {
+		n += 1 + sovTx(uint64(m.ExecuteHeight))
+	}
+	l = m.Update.Size()
+	n += 1 + l + sovTx(uint64(l))
+	return n
+}
+
+func (m *MsgScheduleRateLimitUpdateResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.ScheduleId != 0 {
+		n += 1 + sovTx(uint64(m.ScheduleId))
+	}
+	return n
+}
+
+func (m *MsgCancelScheduledRateLimitUpdate) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Authority)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	if m.ScheduleId != 0 {
+		n += 1 + sovTx(uint64(m.ScheduleId))
+	}
+	return n
+}
+
+func (m *MsgCancelScheduledRateLimitUpdateResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
+}
+
+func (m *RateLimitUpsert) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Denom)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	l = len(m.ChannelId)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	l = m.MaxPercentSend.Size()
+	n += 1 + l + sovTx(uint64(l))
+	l = m.MaxPercentRecv.Size()
+	n += 1 + l + sovTx(uint64(l))
+	if m.DurationHours != 0 {
+		n += 1 + sovTx(uint64(m.DurationHours))
+	}
+	if m.ChannelValueStrategy != nil {
+		l = m.ChannelValueStrategy.Size()
+		n += 1 + l + sovTx(uint64(l))
+	}
+	if m.ValueQuota != nil {
+		l = m.ValueQuota.Size()
+		n += 1 + l + sovTx(uint64(l))
+	}
+	if m.WindowOffset != 0 {
+		n += 1 + sovTx(uint64(m.WindowOffset))
+	}
+	if m.FlowUpdateMode != 0 {
+		n += 1 + sovTx(uint64(m.FlowUpdateMode))
+	}
+	return n
+}
+
+func (m *MsgBatchUpdateRateLimits) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Authority)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	if len(m.Upserts) > 0 {
+		for _, e := range m.Upserts {
+			l = e.Size()
+			n += 1 + l + sovTx(uint64(l))
+		}
+	}
+	if len(m.Removals) > 0 {
+		for _, e := range m.Removals {
+			l = e.Size()
+			n += 1 + l + sovTx(uint64(l))
+		}
+	}
+	if m.SkipZeroChannelValue {
+		n += 2
+	}
+	return n
+}
+
+func (m *MsgBatchUpdateRateLimitsResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.Skipped) > 0 {
+		for _, e := range m.Skipped {
+			l = e.Size()
+			n += 1 + l + sovTx(uint64(l))
+		}
+	}
+	return n
+}
+
+func sovTx(x uint64) (n int) {
+	return (math_bits.Len64(x|1) + 6) / 7
+}
+func sozTx(x uint64) (n int) {
+	return sovTx(uint64((x << 1) ^ uint64((int64(x) >> 63))))
+}
+func (m *MsgAddRateLimit) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgAddRateLimit: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgAddRateLimit: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Authority", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Authority = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Denom", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Denom = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ChannelId", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ChannelId = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MaxPercentSend", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.MaxPercentSend.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 5:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MaxPercentRecv", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.MaxPercentRecv.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 6:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field DurationHours", wireType)
+			}
+			m.DurationHours = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.DurationHours |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 7:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ChannelValueStrategy", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.ChannelValueStrategy == nil {
+				m.ChannelValueStrategy = &ChannelValueStrategy{}
+			}
+			if err := m.ChannelValueStrategy.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 8:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ValueQuota", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.ValueQuota == nil {
+				m.ValueQuota = &ValueQuota{}
+			}
+			if err := m.ValueQuota.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 9:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field WindowOffset", wireType)
+			}
+			m.WindowOffset = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.WindowOffset |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MsgAddRateLimitResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgAddRateLimitResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgAddRateLimitResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MsgUpdateRateLimit) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgUpdateRateLimit: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgUpdateRateLimit: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Authority", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Authority = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Denom", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Denom = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ChannelId", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ChannelId = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MaxPercentSend", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.MaxPercentSend.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 5:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MaxPercentRecv", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.MaxPercentRecv.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 6:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field DurationHours", wireType)
+			}
+			m.DurationHours = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.DurationHours |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 7:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ChannelValueStrategy", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.ChannelValueStrategy == nil {
+				m.ChannelValueStrategy = &ChannelValueStrategy{}
+			}
+			if err := m.ChannelValueStrategy.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 8:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ValueQuota", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.ValueQuota == nil {
+				m.ValueQuota = &ValueQuota{}
+			}
+			if err := m.ValueQuota.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 9:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field WindowOffset", wireType)
+			}
+			m.WindowOffset = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.WindowOffset |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 10:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field FlowUpdateMode", wireType)
+			}
+			m.FlowUpdateMode = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.FlowUpdateMode |= FlowUpdateMode(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MsgUpdateRateLimitResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgUpdateRateLimitResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgUpdateRateLimitResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MsgRemoveRateLimit) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgRemoveRateLimit: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgRemoveRateLimit: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Authority", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Authority = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Denom", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Denom = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ChannelId", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ChannelId = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MsgRemoveRateLimitResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgRemoveRateLimitResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgRemoveRateLimitResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MsgResetRateLimit) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgResetRateLimit: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgResetRateLimit: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Authority", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Authority = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Denom", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Denom = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ChannelId", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ChannelId = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MsgResetRateLimitResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgResetRateLimitResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgResetRateLimitResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MsgSetTransferRule) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgSetTransferRule: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgSetTransferRule: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Authority", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Authority = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Rule", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.Rule.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
 	}
-	return n
-}
 
-func (m *MsgResetRateLimitResponse) Size() (n int) {
-	if m == nil {
-		return 0
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
 	}
-	var l int
-	_ = l
-	return n
+	return nil
 }
+func (m *MsgSetTransferRuleResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgSetTransferRuleResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgSetTransferRuleResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
 
-func sovTx(x uint64) (n int) {
-	return (math_bits.Len64(x|1) + 6) / 7
-}
-func sozTx(x uint64) (n int) {
-	return sovTx(uint64((x << 1) ^ uint64((int64(x) >> 63))))
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
 }
-func (m *MsgAddRateLimit) Unmarshal(dAtA []byte) error {
+func (m *MsgRemoveTransferRule) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -1210,10 +4651,10 @@ func (m *MsgAddRateLimit) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: MsgAddRateLimit: wiretype end group for non-group")
+			return fmt.Errorf("proto: MsgRemoveTransferRule: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: MsgAddRateLimit: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: MsgRemoveTransferRule: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
@@ -1250,7 +4691,7 @@ func (m *MsgAddRateLimit) Unmarshal(dAtA []byte) error {
 			iNdEx = postIndex
 		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Denom", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field RuleId", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -1278,11 +4719,111 @@ func (m *MsgAddRateLimit) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Denom = string(dAtA[iNdEx:postIndex])
+			m.RuleId = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
-		case 3:
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MsgRemoveTransferRuleResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgRemoveTransferRuleResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgRemoveTransferRuleResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MsgSetDenomGroup) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgSetDenomGroup: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgSetDenomGroup: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field ChannelId", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Authority", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -1299,22 +4840,155 @@ func (m *MsgAddRateLimit) Unmarshal(dAtA []byte) error {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
-				return ErrInvalidLengthTx
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Authority = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Group", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.Group.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MsgSetDenomGroupResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgSetDenomGroupResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgSetDenomGroupResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
 			}
-			postIndex := iNdEx + intStringLen
-			if postIndex < 0 {
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
 				return ErrInvalidLengthTx
 			}
-			if postIndex > l {
+			if (iNdEx + skippy) > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.ChannelId = string(dAtA[iNdEx:postIndex])
-			iNdEx = postIndex
-		case 4:
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MsgRemoveDenomGroup) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgRemoveDenomGroup: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgRemoveDenomGroup: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field MaxPercentSend", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Authority", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -1342,13 +5016,11 @@ func (m *MsgAddRateLimit) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if err := m.MaxPercentSend.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
+			m.Authority = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
-		case 5:
+		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field MaxPercentRecv", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field GroupId", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -1376,29 +5048,8 @@ func (m *MsgAddRateLimit) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if err := m.MaxPercentRecv.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
+			m.GroupId = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
-		case 6:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field DurationHours", wireType)
-			}
-			m.DurationHours = 0
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowTx
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				m.DurationHours |= uint64(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
 		default:
 			iNdEx = preIndex
 			skippy, err := skipTx(dAtA[iNdEx:])
@@ -1420,7 +5071,7 @@ func (m *MsgAddRateLimit) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *MsgAddRateLimitResponse) Unmarshal(dAtA []byte) error {
+func (m *MsgRemoveDenomGroupResponse) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -1443,10 +5094,10 @@ func (m *MsgAddRateLimitResponse) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: MsgAddRateLimitResponse: wiretype end group for non-group")
+			return fmt.Errorf("proto: MsgRemoveDenomGroupResponse: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: MsgAddRateLimitResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: MsgRemoveDenomGroupResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		default:
@@ -1470,7 +5121,7 @@ func (m *MsgAddRateLimitResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *MsgUpdateRateLimit) Unmarshal(dAtA []byte) error {
+func (m *MsgScheduleRateLimitUpdate) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -1493,10 +5144,10 @@ func (m *MsgUpdateRateLimit) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: MsgUpdateRateLimit: wiretype end group for non-group")
+			return fmt.Errorf("proto: MsgScheduleRateLimitUpdate: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: MsgUpdateRateLimit: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: MsgScheduleRateLimitUpdate: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
@@ -1532,10 +5183,10 @@ func (m *MsgUpdateRateLimit) Unmarshal(dAtA []byte) error {
 			m.Authority = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		case 2:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Denom", wireType)
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ExecuteEpoch", wireType)
 			}
-			var stringLen uint64
+			m.ExecuteEpoch = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowTx
@@ -1545,29 +5196,35 @@ func (m *MsgUpdateRateLimit) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				m.ExecuteEpoch |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
-				return ErrInvalidLengthTx
-			}
-			postIndex := iNdEx + intStringLen
-			if postIndex < 0 {
-				return ErrInvalidLengthTx
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ExecuteHeight", wireType)
 			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
+			m.ExecuteHeight = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.ExecuteHeight |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
 			}
-			m.Denom = string(dAtA[iNdEx:postIndex])
-			iNdEx = postIndex
-		case 3:
+		case 4:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field ChannelId", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Update", wireType)
 			}
-			var stringLen uint64
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowTx
@@ -1577,29 +5234,80 @@ func (m *MsgUpdateRateLimit) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
+			if msglen < 0 {
 				return ErrInvalidLengthTx
 			}
-			postIndex := iNdEx + intStringLen
+			postIndex := iNdEx + msglen
 			if postIndex < 0 {
 				return ErrInvalidLengthTx
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.ChannelId = string(dAtA[iNdEx:postIndex])
+			if err := m.Update.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
 			iNdEx = postIndex
-		case 4:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field MaxPercentSend", wireType)
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
 			}
-			var stringLen uint64
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MsgScheduleRateLimitUpdateResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgScheduleRateLimitUpdateResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgScheduleRateLimitUpdateResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ScheduleId", wireType)
+			}
+			m.ScheduleId = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowTx
@@ -1609,29 +5317,64 @@ func (m *MsgUpdateRateLimit) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				m.ScheduleId |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
-				return ErrInvalidLengthTx
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
 			}
-			postIndex := iNdEx + intStringLen
-			if postIndex < 0 {
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
 				return ErrInvalidLengthTx
 			}
-			if postIndex > l {
+			if (iNdEx + skippy) > l {
 				return io.ErrUnexpectedEOF
 			}
-			if err := m.MaxPercentSend.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MsgCancelScheduledRateLimitUpdate) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
 			}
-			iNdEx = postIndex
-		case 5:
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgCancelScheduledRateLimitUpdate: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgCancelScheduledRateLimitUpdate: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field MaxPercentRecv", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Authority", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -1659,15 +5402,13 @@ func (m *MsgUpdateRateLimit) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if err := m.MaxPercentRecv.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
+			m.Authority = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
-		case 6:
+		case 2:
 			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field DurationHours", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field ScheduleId", wireType)
 			}
-			m.DurationHours = 0
+			m.ScheduleId = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowTx
@@ -1677,7 +5418,7 @@ func (m *MsgUpdateRateLimit) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.DurationHours |= uint64(b&0x7F) << shift
+				m.ScheduleId |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
@@ -1703,7 +5444,7 @@ func (m *MsgUpdateRateLimit) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *MsgUpdateRateLimitResponse) Unmarshal(dAtA []byte) error {
+func (m *MsgCancelScheduledRateLimitUpdateResponse) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -1726,10 +5467,10 @@ func (m *MsgUpdateRateLimitResponse) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: MsgUpdateRateLimitResponse: wiretype end group for non-group")
+			return fmt.Errorf("proto: MsgCancelScheduledRateLimitUpdateResponse: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: MsgUpdateRateLimitResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: MsgCancelScheduledRateLimitUpdateResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		default:
@@ -1753,7 +5494,7 @@ func (m *MsgUpdateRateLimitResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *MsgRemoveRateLimit) Unmarshal(dAtA []byte) error {
+func (m *RateLimitUpsert) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -1776,15 +5517,15 @@ func (m *MsgRemoveRateLimit) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: MsgRemoveRateLimit: wiretype end group for non-group")
+			return fmt.Errorf("proto: RateLimitUpsert: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: MsgRemoveRateLimit: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: RateLimitUpsert: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Authority", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Denom", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -1812,11 +5553,11 @@ func (m *MsgRemoveRateLimit) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Authority = string(dAtA[iNdEx:postIndex])
+			m.Denom = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Denom", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field ChannelId", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -1844,11 +5585,11 @@ func (m *MsgRemoveRateLimit) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Denom = string(dAtA[iNdEx:postIndex])
+			m.ChannelId = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		case 3:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field ChannelId", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field MaxPercentSend", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -1876,58 +5617,173 @@ func (m *MsgRemoveRateLimit) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.ChannelId = string(dAtA[iNdEx:postIndex])
+			if err := m.MaxPercentSend.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
 			iNdEx = postIndex
-		default:
-			iNdEx = preIndex
-			skippy, err := skipTx(dAtA[iNdEx:])
-			if err != nil {
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MaxPercentRecv", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.MaxPercentRecv.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
-			if (skippy < 0) || (iNdEx+skippy) < 0 {
+			iNdEx = postIndex
+		case 5:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field DurationHours", wireType)
+			}
+			m.DurationHours = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.DurationHours |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 6:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ChannelValueStrategy", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
 				return ErrInvalidLengthTx
 			}
-			if (iNdEx + skippy) > l {
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			iNdEx += skippy
-		}
-	}
-
-	if iNdEx > l {
-		return io.ErrUnexpectedEOF
-	}
-	return nil
-}
-func (m *MsgRemoveRateLimitResponse) Unmarshal(dAtA []byte) error {
-	l := len(dAtA)
-	iNdEx := 0
-	for iNdEx < l {
-		preIndex := iNdEx
-		var wire uint64
-		for shift := uint(0); ; shift += 7 {
-			if shift >= 64 {
-				return ErrIntOverflowTx
+			if m.ChannelValueStrategy == nil {
+				m.ChannelValueStrategy = &ChannelValueStrategy{}
 			}
-			if iNdEx >= l {
+			if err := m.ChannelValueStrategy.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 7:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ValueQuota", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			b := dAtA[iNdEx]
-			iNdEx++
-			wire |= uint64(b&0x7F) << shift
-			if b < 0x80 {
-				break
+			if m.ValueQuota == nil {
+				m.ValueQuota = &ValueQuota{}
+			}
+			if err := m.ValueQuota.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 8:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field WindowOffset", wireType)
+			}
+			m.WindowOffset = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.WindowOffset |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 9:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field FlowUpdateMode", wireType)
+			}
+			m.FlowUpdateMode = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.FlowUpdateMode |= FlowUpdateMode(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
 			}
-		}
-		fieldNum := int32(wire >> 3)
-		wireType := int(wire & 0x7)
-		if wireType == 4 {
-			return fmt.Errorf("proto: MsgRemoveRateLimitResponse: wiretype end group for non-group")
-		}
-		if fieldNum <= 0 {
-			return fmt.Errorf("proto: MsgRemoveRateLimitResponse: illegal tag %d (wire type %d)", fieldNum, wire)
-		}
-		switch fieldNum {
 		default:
 			iNdEx = preIndex
 			skippy, err := skipTx(dAtA[iNdEx:])
@@ -1949,7 +5805,7 @@ func (m *MsgRemoveRateLimitResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *MsgResetRateLimit) Unmarshal(dAtA []byte) error {
+func (m *MsgBatchUpdateRateLimits) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -1972,10 +5828,10 @@ func (m *MsgResetRateLimit) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: MsgResetRateLimit: wiretype end group for non-group")
+			return fmt.Errorf("proto: MsgBatchUpdateRateLimits: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: MsgResetRateLimit: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: MsgBatchUpdateRateLimits: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
@@ -2012,9 +5868,9 @@ func (m *MsgResetRateLimit) Unmarshal(dAtA []byte) error {
 			iNdEx = postIndex
 		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Denom", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Upserts", wireType)
 			}
-			var stringLen uint64
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowTx
@@ -2024,29 +5880,31 @@ func (m *MsgResetRateLimit) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
+			if msglen < 0 {
 				return ErrInvalidLengthTx
 			}
-			postIndex := iNdEx + intStringLen
+			postIndex := iNdEx + msglen
 			if postIndex < 0 {
 				return ErrInvalidLengthTx
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Denom = string(dAtA[iNdEx:postIndex])
+			m.Upserts = append(m.Upserts, RateLimitUpsert{})
+			if err := m.Upserts[len(m.Upserts)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
 			iNdEx = postIndex
 		case 3:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field ChannelId", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Removals", wireType)
 			}
-			var stringLen uint64
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowTx
@@ -2056,24 +5914,46 @@ func (m *MsgResetRateLimit) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
+			if msglen < 0 {
 				return ErrInvalidLengthTx
 			}
-			postIndex := iNdEx + intStringLen
+			postIndex := iNdEx + msglen
 			if postIndex < 0 {
 				return ErrInvalidLengthTx
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.ChannelId = string(dAtA[iNdEx:postIndex])
+			m.Removals = append(m.Removals, Path{})
+			if err := m.Removals[len(m.Removals)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
 			iNdEx = postIndex
+		case 4:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field SkipZeroChannelValue", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.SkipZeroChannelValue = bool(v != 0)
 		default:
 			iNdEx = preIndex
 			skippy, err := skipTx(dAtA[iNdEx:])
@@ -2095,7 +5975,7 @@ func (m *MsgResetRateLimit) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *MsgResetRateLimitResponse) Unmarshal(dAtA []byte) error {
+func (m *MsgBatchUpdateRateLimitsResponse) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -2118,12 +5998,46 @@ func (m *MsgResetRateLimitResponse) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: MsgResetRateLimitResponse: wiretype end group for non-group")
+			return fmt.Errorf("proto: MsgBatchUpdateRateLimitsResponse: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: MsgResetRateLimitResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: MsgBatchUpdateRateLimitsResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Skipped", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Skipped = append(m.Skipped, Path{})
+			if err := m.Skipped[len(m.Skipped)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipTx(dAtA[iNdEx:])
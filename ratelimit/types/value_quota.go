@@ -0,0 +1,55 @@
+package types
+
+import (
+	"errors"
+	"fmt"
+
+	sdkmath "cosmossdk.io/math"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+)
+
+// Validate performs stateless validation of a value quota
+func (q ValueQuota) Validate() error {
+	if err := sdk.ValidateDenom(q.QuoteDenom); err != nil {
+		return fmt.Errorf("invalid quote denom: %s", err.Error())
+	}
+	if q.MaxValueSend.IsNil() || q.MaxValueSend.IsNegative() {
+		return errors.New("max value send must be specified and can not be negative")
+	}
+	if q.MaxValueRecv.IsNil() || q.MaxValueRecv.IsNegative() {
+		return errors.New("max value recv must be specified and can not be negative")
+	}
+	if _, ok := PriceFallback_name[int32(q.PriceFallback)]; !ok {
+		return fmt.Errorf("invalid price fallback (%d)", q.PriceFallback)
+	}
+	return nil
+}
+
+// Returns the max net flow in the given direction, in units of the quote denom
+func (q ValueQuota) GetMaxValue(direction PacketDirection) sdkmath.Int {
+	if direction == PACKET_RECV {
+		return q.MaxValueRecv
+	}
+	return q.MaxValueSend
+}
+
+// Converts an amount of the rate limited denom to the quote denom using the given price
+// (i.e. the value of one unit of the denom in units of the quote denom)
+func ConvertToQuoteValue(amount sdkmath.Int, price sdk.Dec) sdk.Dec {
+	return price.MulInt(amount)
+}
+
+// Checks if the net value of the flow in the given direction exceeds the max value
+func (q ValueQuota) CheckExceedsQuota(direction PacketDirection, netValue sdk.Dec) bool {
+	return netValue.GT(sdk.NewDecFromInt(q.GetMaxValue(direction)))
+}
+
+// Returns the amount of the rate limited denom that can still be transferred in the given
+// direction before the max value is exceeded, if it's transferred at the given price
+func (q ValueQuota) GetRemainingCapacity(direction PacketDirection, netValue sdk.Dec, price sdk.Dec) sdkmath.Int {
+	remainingValue := sdk.NewDecFromInt(q.GetMaxValue(direction)).Sub(netValue)
+	if !remainingValue.IsPositive() || !price.IsPositive() {
+		return sdkmath.ZeroInt()
+	}
+	return remainingValue.Quo(price).TruncateInt()
+}
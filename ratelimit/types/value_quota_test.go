@@ -0,0 +1,151 @@
+package types_test
+
+import (
+	"testing"
+
+	sdkmath "cosmossdk.io/math"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/stretchr/testify/require"
+
+	"github.com/Stride-Labs/ibc-rate-limiting/ratelimit/types"
+)
+
+func TestValidateValueQuota(t *testing.T) {
+	testCases := []struct {
+		name  string
+		quota types.ValueQuota
+		err   string
+	}{
+		{
+			name: "valid value quota",
+			quota: types.ValueQuota{
+				QuoteDenom:   "usd",
+				MaxValueSend: sdkmath.NewInt(5_000_000),
+				MaxValueRecv: sdkmath.NewInt(1_000_000),
+			},
+		},
+		{
+			name: "valid value quota with deny fallback",
+			quota: types.ValueQuota{
+				QuoteDenom:    "usd",
+				MaxValueSend:  sdkmath.NewInt(5_000_000),
+				MaxValueRecv:  sdkmath.ZeroInt(),
+				PriceFallback: types.PRICE_FALLBACK_DENY,
+			},
+		},
+		{
+			name: "invalid quote denom",
+			quota: types.ValueQuota{
+				QuoteDenom:   "",
+				MaxValueSend: sdkmath.NewInt(5_000_000),
+				MaxValueRecv: sdkmath.NewInt(1_000_000),
+			},
+			err: "invalid quote denom",
+		},
+		{
+			name: "missing max value send",
+			quota: types.ValueQuota{
+				QuoteDenom:   "usd",
+				MaxValueRecv: sdkmath.NewInt(1_000_000),
+			},
+			err: "max value send must be specified",
+		},
+		{
+			name: "negative max value recv",
+			quota: types.ValueQuota{
+				QuoteDenom:   "usd",
+				MaxValueSend: sdkmath.NewInt(5_000_000),
+				MaxValueRecv: sdkmath.NewInt(-1),
+			},
+			err: "max value recv must be specified and can not be negative",
+		},
+		{
+			name: "invalid price fallback",
+			quota: types.ValueQuota{
+				QuoteDenom:    "usd",
+				MaxValueSend:  sdkmath.NewInt(5_000_000),
+				MaxValueRecv:  sdkmath.NewInt(1_000_000),
+				PriceFallback: types.PriceFallback(10),
+			},
+			err: "invalid price fallback",
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			if tc.err == "" {
+				require.NoError(t, tc.quota.Validate(), "no error expected")
+			} else {
+				require.ErrorContains(t, tc.quota.Validate(), tc.err)
+			}
+		})
+	}
+}
+
+func TestCheckExceedsValueQuota(t *testing.T) {
+	quota := types.ValueQuota{
+		QuoteDenom:   "usd",
+		MaxValueSend: sdkmath.NewInt(100),
+		MaxValueRecv: sdkmath.NewInt(50),
+	}
+	price := sdk.MustNewDecFromStr("2.5")
+
+	testCases := []struct {
+		name      string
+		direction types.PacketDirection
+		netValue  string
+		exceeded  bool
+		remaining sdkmath.Int
+	}{
+		{
+			name:      "outflow under threshold",
+			direction: types.PACKET_SEND,
+			netValue:  "50",
+			exceeded:  false,
+			remaining: sdkmath.NewInt(20),
+		},
+		{
+			name:      "outflow at threshold",
+			direction: types.PACKET_SEND,
+			netValue:  "100",
+			exceeded:  false,
+			remaining: sdkmath.ZeroInt(),
+		},
+		{
+			name:      "outflow over threshold",
+			direction: types.PACKET_SEND,
+			netValue:  "100.5",
+			exceeded:  true,
+			remaining: sdkmath.ZeroInt(),
+		},
+		{
+			name:      "negative net outflow",
+			direction: types.PACKET_SEND,
+			netValue:  "-25",
+			exceeded:  false,
+			remaining: sdkmath.NewInt(50),
+		},
+		{
+			name:      "inflow under threshold",
+			direction: types.PACKET_RECV,
+			netValue:  "25",
+			exceeded:  false,
+			remaining: sdkmath.NewInt(10),
+		},
+		{
+			name:      "inflow over threshold",
+			direction: types.PACKET_RECV,
+			netValue:  "52.5",
+			exceeded:  true,
+			remaining: sdkmath.ZeroInt(),
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			netValue := sdk.MustNewDecFromStr(tc.netValue)
+			require.Equal(t, tc.exceeded, quota.CheckExceedsQuota(tc.direction, netValue), "exceeded")
+			require.Equal(t, tc.remaining.Int64(), quota.GetRemainingCapacity(tc.direction, netValue, price).Int64(), "remaining")
+		})
+	}
+}
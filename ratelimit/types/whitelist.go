@@ -0,0 +1,34 @@
+package types
+
+import (
+	"errors"
+	"fmt"
+	"strings"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+)
+
+// Validate confirms the sender of a whitelisted address pair is a valid address on this chain,
+// and that the receiver is specified
+func (p WhitelistedAddressPair) Validate() error {
+	if _, err := sdk.AccAddressFromBech32(p.Sender); err != nil {
+		return fmt.Errorf("invalid sender address (%s): %s", p.Sender, err.Error())
+	}
+	if err := validateCounterpartyReceiver(p.Receiver); err != nil {
+		return err
+	}
+	return nil
+}
+
+// Confirms the receiver of a whitelisted address pair is specified
+// The address is not decoded, since the receiver of an outbound transfer is an address on
+// the counterparty chain, which may have a different format
+func validateCounterpartyReceiver(receiver string) error {
+	if receiver == "" {
+		return errors.New("receiver must be specified")
+	}
+	if strings.TrimSpace(receiver) != receiver {
+		return errors.New("receiver can not contain leading or trailing whitespace")
+	}
+	return nil
+}
@@ -19,6 +19,10 @@ cd proto
 
 generate_protos "./ratelimit"
 
+# The app wiring config proto is only generated with pulsar since it is consumed by depinject
+echo "Generating pulsar proto code"
+buf generate --template buf.gen.pulsar.yaml --path ./ratelimit/module
+
 cd ..
 
 # move proto files to the right places
@@ -0,0 +1,36 @@
+package simapp
+
+import (
+	sdk "github.com/cosmos/cosmos-sdk/types"
+)
+
+// MockOracleKeeper is an in-memory price oracle used to test rate limit quotas
+// that are denominated in a quote currency
+// Prices are not stored in state, so they must be set in each test
+type MockOracleKeeper struct {
+	prices map[string]sdk.Dec
+}
+
+func NewMockOracleKeeper() *MockOracleKeeper {
+	return &MockOracleKeeper{prices: map[string]sdk.Dec{}}
+}
+
+func mockPriceKey(denom, quoteDenom string) string {
+	return denom + "/" + quoteDenom
+}
+
+// Sets the price of one unit of the denom in units of the quote denom
+func (k *MockOracleKeeper) SetPrice(denom, quoteDenom string, price sdk.Dec) {
+	k.prices[mockPriceKey(denom, quoteDenom)] = price
+}
+
+// Removes the price of the denom, simulating an unavailable price feed
+func (k *MockOracleKeeper) RemovePrice(denom, quoteDenom string) {
+	delete(k.prices, mockPriceKey(denom, quoteDenom))
+}
+
+// Returns the price of the denom, or false if no price was set
+func (k *MockOracleKeeper) GetPrice(ctx sdk.Context, denom, quoteDenom string) (sdk.Dec, bool) {
+	price, found := k.prices[mockPriceKey(denom, quoteDenom)]
+	return price, found
+}